@@ -0,0 +1,59 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"g_dev/internal/database"
+	"g_dev/internal/redis"
+	"g_dev/internal/service"
+)
+
+// main 함수는 프로그램의 진입점
+// DB의 정상 점수 기록으로 Redis 리더보드를 재구축
+func main() {
+	gameID := flag.Uint("game", 0, "재구축할 게임 ID (0이면 모든 게임)")
+	at := flag.String("at", "", "기준 시각 (RFC3339, 비어 있으면 현재 시각)")
+	flag.Parse()
+
+	when := time.Now()
+	if *at != "" {
+		parsed, err := time.Parse(time.RFC3339, *at)
+		if err != nil {
+			fmt.Printf("잘못된 기준 시각: %v\n", err)
+			os.Exit(1)
+		}
+		when = parsed
+	}
+
+	if err := rebuild(uint(*gameID), when); err != nil {
+		fmt.Printf("리더보드 재구축 실패: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// rebuild는 MySQL과 Redis에 연결한 뒤 기준 시각이 속한 기간의 리더보드를 재구축합니다.
+func rebuild(gameID uint, at time.Time) error {
+	db := database.NewDatabase(database.NewDatabaseConfig())
+	if err := db.Connect(); err != nil {
+		return fmt.Errorf("데이터베이스 연결 실패: %w", err)
+	}
+	defer db.Disconnect()
+
+	redisClient := redis.NewRedisClient(redis.NewRedisConfig())
+	if err := redisClient.Connect(); err != nil {
+		return fmt.Errorf("Redis 연결 실패: %w", err)
+	}
+	defer redisClient.Disconnect()
+
+	leaderboards := service.NewLeaderboardService(db.GetDB(), service.NewRedisLeaderboardStore(redisClient.Client))
+	count, err := leaderboards.RebuildAll(gameID, at)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("리더보드 %d개 재구축 완료 (기준 시각: %s)\n", count, at.Format(time.RFC3339))
+	return nil
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"g_dev/internal/database"
+	"g_dev/internal/service"
+)
+
+// main 함수는 프로그램의 진입점
+// DB의 정상 점수 기록으로 게임 간 공동 플레이 유사도를 다시 만듦
+func main() {
+	if err := build(); err != nil {
+		fmt.Printf("게임 유사도 생성 실패: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// build는 MySQL에 연결한 뒤 추천에 쓰는 게임 유사도 표를 다시 만듭니다.
+func build() error {
+	db := database.NewDatabase(database.NewDatabaseConfig())
+	if err := db.Connect(); err != nil {
+		return fmt.Errorf("데이터베이스 연결 실패: %w", err)
+	}
+	defer db.Disconnect()
+
+	started := time.Now()
+	count, err := service.NewRecommendationService(db.GetDB()).BuildSimilarities()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("게임 유사도 %d건 생성 완료 (소요 시간: %s)\n", count, time.Since(started).Round(time.Millisecond))
+	return nil
+}
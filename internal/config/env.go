@@ -59,6 +59,43 @@ type GameConfig struct {
 	DefaultDiamond int
 }
 
+// 트렌딩/인기도 점수 계산 설정
+type TrendingConfig struct {
+	// 플레이 1회, 플레이 시간 1분, 5점 만점 평점 1개당 활동 점수
+	PlayWeight       float64
+	PlayMinuteWeight float64
+	RatingWeight     float64
+
+	// 인기도에 반영하는 누적 통계 비율
+	LifetimeWeight float64
+
+	// 활동 점수 반감기, 집계 기간, 점수 갱신 주기
+	HalfLife        time.Duration
+	Window          time.Duration
+	RefreshInterval time.Duration
+}
+
+// 스킬 레이팅 설정
+type SkillRatingConfig struct {
+	// 레이팅 알고리즘 (glicko2, elo)
+	Algorithm string
+
+	// 레이팅 기간 (이 기간 동안 경기가 없으면 편차가 늘어남)
+	Period time.Duration
+}
+
+// 실시간 멀티플레이 룸 설정
+type RoomConfig struct {
+	// 연결이 끊긴 참가자의 자리를 유지하는 시간
+	ReconnectGrace time.Duration
+
+	// 서버 권한 게임 로직 틱 주기
+	TickInterval time.Duration
+
+	// Redis pub/sub으로 여러 서버가 룸을 나눠 가질지 여부
+	PubSubEnabled bool
+}
+
 // 전체 애플리케이션 설정
 type Config struct {
 	Server   ServerConfig
@@ -68,6 +105,9 @@ type Config struct {
 	Security SecurityConfig
 	Log      LogConfig
 	Game     GameConfig
+	Trending TrendingConfig
+	Rating   SkillRatingConfig
+	Room     RoomConfig
 }
 
 // LoadConfig는 환경변수에서 설정 로드
@@ -150,9 +190,83 @@ func LoadConfig() (*Config, error) {
 		DefaultDiamond: getEnvAsIntOrDefault("GAME_DEFAULT_DIAMOND", 10),
 	}
 
+	// 트렌딩 설정 로드
+	halfLife, err := time.ParseDuration(getEnvOrDefault("TRENDING_HALF_LIFE", "24h"))
+	if err != nil {
+		return nil, fmt.Errorf("잘못된 TRENDING_HALF_LIFE 형식: %w", err)
+	}
+
+	trendingWindow, err := time.ParseDuration(getEnvOrDefault("TRENDING_WINDOW", "168h"))
+	if err != nil {
+		return nil, fmt.Errorf("잘못된 TRENDING_WINDOW 형식: %w", err)
+	}
+
+	trendingInterval, err := time.ParseDuration(getEnvOrDefault("TRENDING_REFRESH_INTERVAL", "15m"))
+	if err != nil {
+		return nil, fmt.Errorf("잘못된 TRENDING_REFRESH_INTERVAL 형식: %w", err)
+	}
+
+	config.Trending = TrendingConfig{
+		PlayWeight:       getEnvAsFloatOrDefault("TRENDING_PLAY_WEIGHT", 1),
+		PlayMinuteWeight: getEnvAsFloatOrDefault("TRENDING_PLAY_MINUTE_WEIGHT", 0.05),
+		RatingWeight:     getEnvAsFloatOrDefault("TRENDING_RATING_WEIGHT", 2),
+		LifetimeWeight:   getEnvAsFloatOrDefault("POPULARITY_LIFETIME_WEIGHT", 0.1),
+		HalfLife:         halfLife,
+		Window:           trendingWindow,
+		RefreshInterval:  trendingInterval,
+	}
+
+	// 스킬 레이팅 설정 로드
+	ratingPeriod, err := time.ParseDuration(getEnvOrDefault("SKILL_RATING_PERIOD", "168h"))
+	if err != nil {
+		return nil, fmt.Errorf("잘못된 SKILL_RATING_PERIOD 형식: %w", err)
+	}
+	config.Rating = SkillRatingConfig{
+		Algorithm: getEnvOrDefault("SKILL_RATING_ALGORITHM", "glicko2"),
+		Period:    ratingPeriod,
+	}
+
+	// 실시간 룸 설정 로드
+	reconnectGrace, err := time.ParseDuration(getEnvOrDefault("ROOM_RECONNECT_GRACE", "30s"))
+	if err != nil {
+		return nil, fmt.Errorf("잘못된 ROOM_RECONNECT_GRACE 형식: %w", err)
+	}
+	tickInterval, err := time.ParseDuration(getEnvOrDefault("ROOM_TICK_INTERVAL", "100ms"))
+	if err != nil {
+		return nil, fmt.Errorf("잘못된 ROOM_TICK_INTERVAL 형식: %w", err)
+	}
+	if tickInterval <= 0 {
+		return nil, fmt.Errorf("ROOM_TICK_INTERVAL은 0보다 커야 합니다: %s", tickInterval)
+	}
+	config.Room = RoomConfig{
+		ReconnectGrace: reconnectGrace,
+		TickInterval:   tickInterval,
+		PubSubEnabled:  getEnvAsBoolOrDefault("ROOM_PUBSUB_ENABLED", false),
+	}
+
 	return config, nil
 }
 
+// 환경변수를 실수로 가져오거나 기본값을 반환
+func getEnvAsFloatOrDefault(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
+// 환경변수를 불리언으로 가져오거나 기본값을 반환
+func getEnvAsBoolOrDefault(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
 // 환경변수를 정수로 가져오거나 기본값을 반환
 func getEnvAsIntOrDefault(key string, defaultValue int) int {
 	if value := os.Getenv(key); value != "" {
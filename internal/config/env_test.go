@@ -59,6 +59,24 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, 1, config.Game.DefaultLevel)
 	assert.Equal(t, 1000, config.Game.DefaultGold)
 	assert.Equal(t, 10, config.Game.DefaultDiamond)
+
+	// 트렌딩 설정 확인
+	assert.Equal(t, 1.0, config.Trending.PlayWeight)
+	assert.Equal(t, 0.05, config.Trending.PlayMinuteWeight)
+	assert.Equal(t, 2.0, config.Trending.RatingWeight)
+	assert.Equal(t, 0.1, config.Trending.LifetimeWeight)
+	assert.Equal(t, 24*time.Hour, config.Trending.HalfLife)
+	assert.Equal(t, 168*time.Hour, config.Trending.Window)
+	assert.Equal(t, 15*time.Minute, config.Trending.RefreshInterval)
+
+	// 스킬 레이팅 설정 확인
+	assert.Equal(t, "glicko2", config.Rating.Algorithm)
+	assert.Equal(t, 168*time.Hour, config.Rating.Period)
+
+	// 실시간 룸 설정 확인
+	assert.Equal(t, 30*time.Second, config.Room.ReconnectGrace)
+	assert.Equal(t, 100*time.Millisecond, config.Room.TickInterval)
+	assert.False(t, config.Room.PubSubEnabled)
 }
 
 // JWT 시크릿 키가 없을 때의 에러를 테스트
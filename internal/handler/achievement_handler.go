@@ -0,0 +1,310 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type AchievementServiceInterface interface {
+	GetAchievements() ([]service.AchievementInfo, error)
+	GetUserAchievements(userID uint) (*service.UserAchievementList, error)
+	CreateAchievement(achievement *model.Achievement) error
+	UpdateAchievement(id uint, achievement *model.Achievement) (*model.Achievement, error)
+	DeleteAchievement(id uint) error
+	GetAllAchievements(limit, offset int) ([]model.Achievement, error)
+}
+
+// 업적 관련 HTTP 요청을 처리하는 핸들러
+type AchievementHandler struct {
+	achievementService AchievementServiceInterface
+}
+
+// 새로운 AchievementHandler 인스턴스를 생성
+func NewAchievementHandler(achievementService AchievementServiceInterface) *AchievementHandler {
+	return &AchievementHandler{
+		achievementService: achievementService,
+	}
+}
+
+// 업적 생성/수정 요청
+type AchievementRequest struct {
+	Code        string                     `json:"code" binding:"required,max=50"`
+	Name        string                     `json:"name" binding:"required,max=100"`
+	Description string                     `json:"description" binding:"max=500"`
+	GameID      *uint                      `json:"game_id"`
+	Condition   model.AchievementCondition `json:"condition" binding:"required,oneof=first_completion score accuracy plays streak event"`
+	Difficulty  model.GameDifficulty       `json:"difficulty"`
+	EventType   model.AchievementEventType `json:"event_type" binding:"max=50"`
+	Target      float64                    `json:"target" binding:"required,gt=0"`
+	IsHidden    bool                       `json:"is_hidden"`
+	IsActive    *bool                      `json:"is_active"`
+	Gold        int                        `json:"gold" binding:"min=0"`
+	Diamond     int                        `json:"diamond" binding:"min=0"`
+	Experience  int                        `json:"experience" binding:"min=0"`
+	ItemID      string                     `json:"item_id" binding:"max=50"`
+	ItemName    string                     `json:"item_name" binding:"max=100"`
+	ItemType    string                     `json:"item_type" binding:"max=20"`
+	Rarity      string                     `json:"rarity"`
+	Quantity    int                        `json:"quantity" binding:"min=0"`
+}
+
+// 업적 목록 응답 (관리자용)
+type AchievementListResponse struct {
+	Achievements []model.Achievement `json:"achievements"`
+	Total        int                 `json:"total"`
+}
+
+// 요청 정보를 업적 모델로 변환
+func (req *AchievementRequest) toModel() *model.Achievement {
+	achievement := &model.Achievement{
+		Code:        req.Code,
+		Name:        req.Name,
+		Description: req.Description,
+		GameID:      req.GameID,
+		Condition:   req.Condition,
+		Difficulty:  req.Difficulty,
+		EventType:   req.EventType,
+		Target:      req.Target,
+		IsHidden:    req.IsHidden,
+		IsActive:    true,
+		Gold:        req.Gold,
+		Diamond:     req.Diamond,
+		Experience:  req.Experience,
+		ItemID:      req.ItemID,
+		ItemName:    req.ItemName,
+		ItemType:    req.ItemType,
+		Rarity:      req.Rarity,
+		Quantity:    req.Quantity,
+	}
+	if req.IsActive != nil {
+		achievement.IsActive = *req.IsActive
+	}
+	return achievement
+}
+
+// 공개 업적 목록을 조회
+// @Summary 업적 목록
+// @Description 공개 업적 목록과 업적별 달성률(달성한 플레이어 비율)을 조회합니다.
+// @Tags Achievements
+// @Accept json
+// @Produce json
+// @Success 200 {array} service.AchievementInfo
+// @Failure 500 {object} ErrorResponse
+// @Router /api/achievements [get]
+func (h *AchievementHandler) GetAchievements(c *gin.Context) {
+	achievements, err := h.achievementService.GetAchievements()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "업적 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, achievements)
+}
+
+// 사용자의 업적 진행 상황을 조회
+// @Summary 업적 진행 상황
+// @Description 사용자가 달성한 업적(최근 달성 순)과 진행 중인 업적을 진행도와 함께 조회합니다. user_id가 없으면 내 업적을 조회합니다.
+// @Tags Achievements
+// @Accept json
+// @Produce json
+// @Param user_id query int false "사용자 ID"
+// @Success 200 {object} service.UserAchievementList
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/achievements/progress [get]
+func (h *AchievementHandler) GetProgress(c *gin.Context) {
+	userID, ok := parseUserIDQuery(c)
+	if !ok {
+		return
+	}
+	if userID == 0 {
+		userInfo, ok := requireAuthUser(c)
+		if !ok {
+			return
+		}
+		userID = userInfo.UserID
+	}
+
+	list, err := h.achievementService.GetUserAchievements(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "업적 진행 상황 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, list)
+}
+
+// 전체 업적 목록을 조회 (관리자용)
+// @Summary 업적 관리 목록
+// @Description 숨김/비활성 업적을 포함한 전체 업적 목록을 조회합니다. (관리자/중재자)
+// @Tags Achievements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} AchievementListResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /api/admin/achievements [get]
+func (h *AchievementHandler) AdminListAchievements(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	achievements, err := h.achievementService.GetAllAchievements(limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "업적 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, AchievementListResponse{
+		Achievements: achievements,
+		Total:        len(achievements),
+	})
+}
+
+// 업적을 생성 (관리자용)
+// @Summary 업적 생성
+// @Description 새로운 업적을 생성합니다. (관리자/중재자)
+// @Tags Achievements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body AchievementRequest true "업적 정보"
+// @Success 201 {object} model.Achievement
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/achievements [post]
+func (h *AchievementHandler) AdminCreateAchievement(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	var req AchievementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	achievement := req.toModel()
+	if err := h.achievementService.CreateAchievement(achievement); err != nil {
+		c.JSON(achievementErrorStatus(err), ErrorResponse{
+			Error:   "업적 생성에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, achievement)
+}
+
+// 업적을 수정 (관리자용)
+// @Summary 업적 수정
+// @Description 업적 조건과 보상을 수정합니다. 이미 달성한 기록과 지급된 보상은 바뀌지 않습니다. (관리자/중재자)
+// @Tags Achievements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "업적 ID"
+// @Param request body AchievementRequest true "업적 정보"
+// @Success 200 {object} model.Achievement
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/admin/achievements/{id} [put]
+func (h *AchievementHandler) AdminUpdateAchievement(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req AchievementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	achievement, err := h.achievementService.UpdateAchievement(id, req.toModel())
+	if err != nil {
+		c.JSON(achievementErrorStatus(err), ErrorResponse{
+			Error:   "업적 수정에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, achievement)
+}
+
+// 업적을 삭제 (관리자용)
+// @Summary 업적 삭제
+// @Description 업적을 삭제합니다. (관리자/중재자)
+// @Tags Achievements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "업적 ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/admin/achievements/{id} [delete]
+func (h *AchievementHandler) AdminDeleteAchievement(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.achievementService.DeleteAchievement(id); err != nil {
+		c.JSON(achievementErrorStatus(err), ErrorResponse{
+			Error:   "업적 삭제에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "업적이 삭제되었습니다",
+	})
+}
+
+// 업적 서비스 에러를 HTTP 상태 코드로 변환
+func achievementErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrAchievementNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrInvalidAchievement):
+		return http.StatusBadRequest
+	case errors.Is(err, model.ErrDuplicateAchievementCode):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 업적 서비스
+type MockAchievementService struct {
+	mock.Mock
+}
+
+func (m *MockAchievementService) GetAchievements() ([]service.AchievementInfo, error) {
+	args := m.Called()
+	return args.Get(0).([]service.AchievementInfo), args.Error(1)
+}
+
+func (m *MockAchievementService) GetUserAchievements(userID uint) (*service.UserAchievementList, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.UserAchievementList), args.Error(1)
+}
+
+func (m *MockAchievementService) CreateAchievement(achievement *model.Achievement) error {
+	args := m.Called(achievement)
+	return args.Error(0)
+}
+
+func (m *MockAchievementService) UpdateAchievement(id uint, achievement *model.Achievement) (*model.Achievement, error) {
+	args := m.Called(id, achievement)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Achievement), args.Error(1)
+}
+
+func (m *MockAchievementService) DeleteAchievement(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockAchievementService) GetAllAchievements(limit, offset int) ([]model.Achievement, error) {
+	args := m.Called(limit, offset)
+	return args.Get(0).([]model.Achievement), args.Error(1)
+}
+
+// 테스트용 업적 라우터 설정
+func setupAchievementTestRouter() (*gin.Engine, *MockAchievementService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockAchievementService{}
+	handler := NewAchievementHandler(mockService)
+
+	achievements := router.Group("/api/achievements")
+	{
+		achievements.GET("", handler.GetAchievements)
+		achievements.GET("/progress", handler.GetProgress)
+	}
+	adminAchievements := router.Group("/api/admin/achievements")
+	{
+		adminAchievements.GET("", handler.AdminListAchievements)
+		adminAchievements.POST("", handler.AdminCreateAchievement)
+		adminAchievements.PUT("/:id", handler.AdminUpdateAchievement)
+		adminAchievements.DELETE("/:id", handler.AdminDeleteAchievement)
+	}
+
+	return router, mockService
+}
+
+// 업적 목록과 진행 상황 조회 테스트
+func TestAchievementHandler_Queries(t *testing.T) {
+	router, mockService := setupAchievementTestRouter()
+	mockService.On("GetAchievements").Return([]service.AchievementInfo{{Rarity: 3}}, nil)
+	mockService.On("GetUserAchievements", uint(5)).Return(&service.UserAchievementList{UserID: 5}, nil)
+	mockService.On("GetUserAchievements", uint(8)).Return(&service.UserAchievementList{UserID: 8}, nil)
+
+	tests := []struct {
+		name           string
+		path           string
+		userID         uint
+		expectedStatus int
+	}{
+		{name: "업적 목록", path: "/api/achievements", expectedStatus: http.StatusOK},
+		{name: "내 업적", path: "/api/achievements/progress", userID: 5, expectedStatus: http.StatusOK},
+		{name: "다른 플레이어 업적", path: "/api/achievements/progress?user_id=8", expectedStatus: http.StatusOK},
+		{name: "잘못된 사용자 ID", path: "/api/achievements/progress?user_id=abc", expectedStatus: http.StatusBadRequest},
+		{name: "인증 없는 내 업적", path: "/api/achievements/progress", expectedStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			if tt.userID != 0 {
+				req = withAuthUser(req, tt.userID, "user")
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+		})
+	}
+	mockService.AssertExpectations(t)
+}
+
+// 업적 관리 테스트
+func TestAchievementHandler_Admin(t *testing.T) {
+	router, mockService := setupAchievementTestRouter()
+	mockService.On("CreateAchievement", mock.MatchedBy(func(achievement *model.Achievement) bool {
+		return achievement.Code == "hard_1000" && achievement.Difficulty == model.GameDifficultyHard && achievement.IsActive
+	})).Return(nil)
+	mockService.On("UpdateAchievement", uint(2), mock.MatchedBy(func(achievement *model.Achievement) bool {
+		return !achievement.IsActive
+	})).Return(nil, model.ErrAchievementNotFound)
+	mockService.On("DeleteAchievement", uint(1)).Return(nil)
+
+	body, _ := json.Marshal(AchievementRequest{
+		Code:       "hard_1000",
+		Name:       "고수",
+		Condition:  model.AchievementScore,
+		Difficulty: model.GameDifficultyHard,
+		Target:     1000,
+		Gold:       100,
+	})
+	req, _ := http.NewRequest("POST", "/api/admin/achievements", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	// 알 수 없는 조건은 바인딩 단계에서 거부
+	body, _ = json.Marshal(AchievementRequest{Code: "x", Name: "x", Condition: "login", Target: 1})
+	req, _ = http.NewRequest("POST", "/api/admin/achievements", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	inactive := false
+	body, _ = json.Marshal(AchievementRequest{Code: "plays", Name: "단골", Condition: model.AchievementPlays, Target: 100, IsActive: &inactive})
+	req, _ = http.NewRequest("PUT", "/api/admin/achievements/2", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	req, _ = http.NewRequest("DELETE", "/api/admin/achievements/1", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "moderator"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("DELETE", "/api/admin/achievements/1", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,403 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type BattlePassServiceInterface interface {
+	CreatePass(pass *model.BattlePass) error
+	GetPasses(limit, offset int) ([]model.BattlePass, error)
+	GetProgress(userID, passID uint) (*service.BattlePassProgress, error)
+	UnlockPremium(userID, passID uint) (*service.BattlePassClaimResult, error)
+	SkipTiers(userID, passID uint, tiers int) (*service.BattlePassSkipResult, error)
+	ClaimReward(userID, passID uint, tier int, track model.BattlePassTrack) (*service.BattlePassClaimResult, error)
+	ClaimAll(userID, passID uint) (*service.BattlePassClaimResult, error)
+}
+
+// 배틀패스 관련 HTTP 요청을 처리하는 핸들러
+type BattlePassHandler struct {
+	battlePassService BattlePassServiceInterface
+}
+
+// 새로운 BattlePassHandler 인스턴스를 생성
+func NewBattlePassHandler(battlePassService BattlePassServiceInterface) *BattlePassHandler {
+	return &BattlePassHandler{
+		battlePassService: battlePassService,
+	}
+}
+
+// 배틀패스 생성 요청
+type BattlePassRequest struct {
+	Name          string                   `json:"name" binding:"required,max=100"`
+	StartAt       time.Time                `json:"start_at" binding:"required"`
+	EndAt         time.Time                `json:"end_at" binding:"required"`
+	MaxTier       int                      `json:"max_tier" binding:"required,gt=0"`
+	XPPerTier     int                      `json:"xp_per_tier" binding:"required,gt=0"`
+	SessionXP     int                      `json:"session_xp" binding:"min=0"`
+	MissionXP     int                      `json:"mission_xp" binding:"min=0"`
+	PremiumPrice  int                      `json:"premium_price" binding:"min=0"`
+	TierSkipPrice int                      `json:"tier_skip_price" binding:"min=0"`
+	Rewards       []model.BattlePassReward `json:"rewards"`
+}
+
+// 요청을 배틀패스 모델로 변환
+func (req *BattlePassRequest) toModel() *model.BattlePass {
+	pass := &model.BattlePass{
+		Name:          req.Name,
+		StartAt:       req.StartAt,
+		EndAt:         req.EndAt,
+		MaxTier:       req.MaxTier,
+		XPPerTier:     req.XPPerTier,
+		SessionXP:     req.SessionXP,
+		MissionXP:     req.MissionXP,
+		PremiumPrice:  req.PremiumPrice,
+		TierSkipPrice: req.TierSkipPrice,
+		Rewards:       req.Rewards,
+	}
+	for i := range pass.Rewards {
+		pass.Rewards[i].ID = 0
+		pass.Rewards[i].PassID = 0
+	}
+	return pass
+}
+
+// 티어 건너뛰기 요청
+type TierSkipRequest struct {
+	Tiers int `json:"tiers" binding:"required,gt=0"`
+}
+
+// 보상 수령 요청
+type BattlePassClaimRequest struct {
+	Tier  int                   `json:"tier" binding:"required,gt=0"`
+	Track model.BattlePassTrack `json:"track" binding:"required,oneof=free premium"`
+}
+
+// 배틀패스 목록 응답 (관리자용)
+type BattlePassListResponse struct {
+	Passes []model.BattlePass `json:"passes"`
+	Total  int                `json:"total"`
+}
+
+// 진행 중인 배틀패스의 내 진행 상황을 조회
+// @Summary 배틀패스 진행 상황
+// @Description 진행 중인 배틀패스의 패스 경험치, 티어, 프리미엄 여부와 티어별 무료/프리미엄 보상의 수령 상태를 조회합니다.
+// @Tags BattlePass
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} service.BattlePassProgress
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/battle-pass [get]
+func (h *BattlePassHandler) GetCurrent(c *gin.Context) {
+	h.respondProgress(c, 0)
+}
+
+// 배틀패스의 내 진행 상황을 조회
+// @Summary 배틀패스 진행 상황 (패스 지정)
+// @Description 지정한 배틀패스의 진행 상황과 전체 트랙을 조회합니다. 종료된 패스의 남은 보상을 확인할 때 사용합니다.
+// @Tags BattlePass
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "배틀패스 ID"
+// @Success 200 {object} service.BattlePassProgress
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/battle-pass/{id} [get]
+func (h *BattlePassHandler) GetProgress(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	h.respondProgress(c, id)
+}
+
+// 진행 상황 조회 응답을 작성
+func (h *BattlePassHandler) respondProgress(c *gin.Context, passID uint) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	progress, err := h.battlePassService.GetProgress(userInfo.UserID, passID)
+	if err != nil {
+		c.JSON(battlePassErrorStatus(err), ErrorResponse{
+			Error:   "배틀패스 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, progress)
+}
+
+// 프리미엄 트랙을 해금
+// @Summary 프리미엄 패스 구매
+// @Description 다이아몬드로 프리미엄 트랙을 해금합니다. 이미 도달한 티어의 프리미엄 보상은 바로 지급됩니다.
+// @Tags BattlePass
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "배틀패스 ID"
+// @Success 200 {object} service.BattlePassClaimResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/battle-pass/{id}/premium [post]
+func (h *BattlePassHandler) UnlockPremium(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	result, err := h.battlePassService.UnlockPremium(userInfo.UserID, id)
+	if err != nil {
+		c.JSON(battlePassErrorStatus(err), ErrorResponse{
+			Error:   "프리미엄 패스 구매에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 다이아몬드로 티어를 건너뜀
+// @Summary 티어 건너뛰기
+// @Description 티어당 정해진 다이아몬드를 사용해 티어를 올립니다. 건너뛴 티어의 보상은 수령 요청으로 받습니다.
+// @Tags BattlePass
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "배틀패스 ID"
+// @Param request body TierSkipRequest true "건너뛸 티어 수"
+// @Success 200 {object} service.BattlePassSkipResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/battle-pass/{id}/skip [post]
+func (h *BattlePassHandler) SkipTiers(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req TierSkipRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.battlePassService.SkipTiers(userInfo.UserID, id, req.Tiers)
+	if err != nil {
+		c.JSON(battlePassErrorStatus(err), ErrorResponse{
+			Error:   "티어 건너뛰기에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 티어 보상을 수령
+// @Summary 배틀패스 보상 수령
+// @Description 도달한 티어의 무료 또는 프리미엄 보상을 수령합니다.
+// @Tags BattlePass
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "배틀패스 ID"
+// @Param request body BattlePassClaimRequest true "티어와 트랙"
+// @Success 200 {object} service.BattlePassClaimResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/battle-pass/{id}/claim [post]
+func (h *BattlePassHandler) ClaimReward(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req BattlePassClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.battlePassService.ClaimReward(userInfo.UserID, id, req.Tier, req.Track)
+	if err != nil {
+		c.JSON(battlePassErrorStatus(err), ErrorResponse{
+			Error:   "배틀패스 보상 수령에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 수령 가능한 보상을 모두 수령
+// @Summary 배틀패스 보상 모두 수령
+// @Description 도달한 티어 중 아직 받지 않은 무료 보상과 (프리미엄 보유 시) 프리미엄 보상을 모두 수령합니다.
+// @Tags BattlePass
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "배틀패스 ID"
+// @Success 200 {object} service.BattlePassClaimResult
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/battle-pass/{id}/claim-all [post]
+func (h *BattlePassHandler) ClaimAll(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	result, err := h.battlePassService.ClaimAll(userInfo.UserID, id)
+	if err != nil {
+		c.JSON(battlePassErrorStatus(err), ErrorResponse{
+			Error:   "배틀패스 보상 수령에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 배틀패스 목록을 조회 (관리자용)
+// @Summary 배틀패스 관리 목록
+// @Description 배틀패스 목록을 최근 시작 순으로 조회합니다. (관리자/중재자)
+// @Tags BattlePass
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} BattlePassListResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /api/admin/battle-passes [get]
+func (h *BattlePassHandler) AdminListPasses(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	passes, err := h.battlePassService.GetPasses(limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "배틀패스 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, BattlePassListResponse{
+		Passes: passes,
+		Total:  len(passes),
+	})
+}
+
+// 배틀패스를 생성 (관리자용)
+// @Summary 배틀패스 생성
+// @Description 기간, 티어 구성, 가격과 티어별 무료/프리미엄 보상으로 배틀패스를 생성합니다. (관리자/중재자)
+// @Tags BattlePass
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body BattlePassRequest true "배틀패스 정보"
+// @Success 201 {object} model.BattlePass
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/battle-passes [post]
+func (h *BattlePassHandler) AdminCreatePass(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	var req BattlePassRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	pass := req.toModel()
+	if err := h.battlePassService.CreatePass(pass); err != nil {
+		c.JSON(battlePassErrorStatus(err), ErrorResponse{
+			Error:   "배틀패스 생성에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, pass)
+}
+
+// 배틀패스 서비스 에러를 HTTP 상태 코드로 변환
+func battlePassErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrBattlePassNotFound),
+		errors.Is(err, model.ErrBattlePassRewardNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrBattlePassPremiumRequired):
+		return http.StatusForbidden
+	case errors.Is(err, model.ErrBattlePassOverlap),
+		errors.Is(err, model.ErrBattlePassNotActive),
+		errors.Is(err, model.ErrBattlePassPremiumOwned),
+		errors.Is(err, model.ErrBattlePassTierLocked),
+		errors.Is(err, model.ErrBattlePassRewardClaimed),
+		errors.Is(err, model.ErrBattlePassNothingToClaim):
+		return http.StatusConflict
+	case errors.Is(err, model.ErrInvalidBattlePass),
+		errors.Is(err, model.ErrInvalidTierSkip),
+		errors.Is(err, model.ErrInvalidUserID),
+		errors.Is(err, service.ErrInsufficientDiamond):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// 테스트용 Mock 배틀패스 서비스
+type MockBattlePassService struct {
+	mock.Mock
+}
+
+func (m *MockBattlePassService) CreatePass(pass *model.BattlePass) error {
+	args := m.Called(pass)
+	return args.Error(0)
+}
+
+func (m *MockBattlePassService) GetPasses(limit, offset int) ([]model.BattlePass, error) {
+	args := m.Called(limit, offset)
+	return args.Get(0).([]model.BattlePass), args.Error(1)
+}
+
+func (m *MockBattlePassService) GetProgress(userID, passID uint) (*service.BattlePassProgress, error) {
+	args := m.Called(userID, passID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.BattlePassProgress), args.Error(1)
+}
+
+func (m *MockBattlePassService) UnlockPremium(userID, passID uint) (*service.BattlePassClaimResult, error) {
+	args := m.Called(userID, passID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.BattlePassClaimResult), args.Error(1)
+}
+
+func (m *MockBattlePassService) SkipTiers(userID, passID uint, tiers int) (*service.BattlePassSkipResult, error) {
+	args := m.Called(userID, passID, tiers)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.BattlePassSkipResult), args.Error(1)
+}
+
+func (m *MockBattlePassService) ClaimReward(userID, passID uint, tier int, track model.BattlePassTrack) (*service.BattlePassClaimResult, error) {
+	args := m.Called(userID, passID, tier, track)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.BattlePassClaimResult), args.Error(1)
+}
+
+func (m *MockBattlePassService) ClaimAll(userID, passID uint) (*service.BattlePassClaimResult, error) {
+	args := m.Called(userID, passID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.BattlePassClaimResult), args.Error(1)
+}
+
+// 테스트용 배틀패스 라우터 설정
+func setupBattlePassTestRouter() (*gin.Engine, *MockBattlePassService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockBattlePassService{}
+	handler := NewBattlePassHandler(mockService)
+
+	battlePass := router.Group("/api/battle-pass")
+	{
+		battlePass.GET("", handler.GetCurrent)
+		battlePass.GET("/:id", handler.GetProgress)
+		battlePass.POST("/:id/premium", handler.UnlockPremium)
+		battlePass.POST("/:id/skip", handler.SkipTiers)
+		battlePass.POST("/:id/claim", handler.ClaimReward)
+		battlePass.POST("/:id/claim-all", handler.ClaimAll)
+	}
+	adminBattlePasses := router.Group("/api/admin/battle-passes")
+	{
+		adminBattlePasses.GET("", handler.AdminListPasses)
+		adminBattlePasses.POST("", handler.AdminCreatePass)
+	}
+
+	return router, mockService
+}
+
+// 배틀패스 진행 상황 조회와 구매, 수령 테스트
+func TestBattlePassHandler_Player(t *testing.T) {
+	router, mockService := setupBattlePassTestRouter()
+	mockService.On("GetProgress", uint(5), uint(0)).Return(&service.BattlePassProgress{Tier: 2}, nil)
+	mockService.On("GetProgress", uint(5), uint(9)).Return(nil, model.ErrBattlePassNotFound)
+	mockService.On("UnlockPremium", uint(5), uint(1)).Return(nil, service.ErrInsufficientDiamond)
+	mockService.On("SkipTiers", uint(5), uint(1), 2).Return(&service.BattlePassSkipResult{ToTier: 4}, nil)
+	mockService.On("ClaimReward", uint(5), uint(1), 3, model.BattlePassPremium).Return(nil, model.ErrBattlePassPremiumRequired)
+	mockService.On("ClaimAll", uint(5), uint(1)).Return(nil, model.ErrBattlePassNothingToClaim)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           interface{}
+		userID         uint
+		expectedStatus int
+	}{
+		{name: "진행 중인 패스", method: "GET", path: "/api/battle-pass", userID: 5, expectedStatus: http.StatusOK},
+		{name: "인증 없는 조회", method: "GET", path: "/api/battle-pass", expectedStatus: http.StatusUnauthorized},
+		{name: "없는 패스", method: "GET", path: "/api/battle-pass/9", userID: 5, expectedStatus: http.StatusNotFound},
+		{name: "다이아몬드 부족", method: "POST", path: "/api/battle-pass/1/premium", userID: 5, expectedStatus: http.StatusBadRequest},
+		{name: "티어 건너뛰기", method: "POST", path: "/api/battle-pass/1/skip", body: TierSkipRequest{Tiers: 2}, userID: 5, expectedStatus: http.StatusOK},
+		{name: "건너뛸 티어 없음", method: "POST", path: "/api/battle-pass/1/skip", body: TierSkipRequest{}, userID: 5, expectedStatus: http.StatusBadRequest},
+		{name: "프리미엄 필요", method: "POST", path: "/api/battle-pass/1/claim", body: BattlePassClaimRequest{Tier: 3, Track: model.BattlePassPremium}, userID: 5, expectedStatus: http.StatusForbidden},
+		{name: "알 수 없는 트랙", method: "POST", path: "/api/battle-pass/1/claim", body: BattlePassClaimRequest{Tier: 3, Track: "vip"}, userID: 5, expectedStatus: http.StatusBadRequest},
+		{name: "수령할 보상 없음", method: "POST", path: "/api/battle-pass/1/claim-all", userID: 5, expectedStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := bytes.NewBuffer(nil)
+			if tt.body != nil {
+				data, _ := json.Marshal(tt.body)
+				body = bytes.NewBuffer(data)
+			}
+			req, _ := http.NewRequest(tt.method, tt.path, body)
+			req.Header.Set("Content-Type", "application/json")
+			if tt.userID != 0 {
+				req = withAuthUser(req, tt.userID, "user")
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+		})
+	}
+	mockService.AssertExpectations(t)
+}
+
+// 배틀패스 관리 테스트
+func TestBattlePassHandler_Admin(t *testing.T) {
+	router, mockService := setupBattlePassTestRouter()
+	start := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	mockService.On("GetPasses", 20, 0).Return([]model.BattlePass{{Name: "시즌 1"}}, nil)
+	mockService.On("CreatePass", mock.MatchedBy(func(pass *model.BattlePass) bool {
+		return pass.Name == "시즌 2" && len(pass.Rewards) == 1 && pass.Rewards[0].ID == 0
+	})).Return(model.ErrBattlePassOverlap)
+
+	req, _ := http.NewRequest("GET", "/api/admin/battle-passes", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	body, _ := json.Marshal(BattlePassRequest{
+		Name: "시즌 2", StartAt: start, EndAt: start.AddDate(0, 1, 0), MaxTier: 50, XPPerTier: 1000, PremiumPrice: 500,
+		Rewards: []model.BattlePassReward{{ID: 7, Tier: 1, Track: model.BattlePassFree, Gold: 100}},
+	})
+	req, _ = http.NewRequest("POST", "/api/admin/battle-passes", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	req, _ = http.NewRequest("GET", "/api/admin/battle-passes", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,456 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type CraftingServiceInterface interface {
+	Craft(userID, recipeID uint, times int) (*service.CraftResult, error)
+	GetPlayerRecipes(userID uint) ([]service.PlayerRecipe, error)
+	DiscoverRecipes(userID uint) ([]model.Recipe, error)
+	UnlockRecipe(userID, recipeID uint) error
+	CreateRecipe(recipe *model.Recipe) error
+	UpdateRecipe(id uint, recipe *model.Recipe) (*model.Recipe, error)
+	DeleteRecipe(id uint) error
+	GetRecipeByID(id uint) (*model.Recipe, error)
+	GetAllRecipes(limit, offset int) ([]model.Recipe, error)
+}
+
+// 제작 및 레시피 관련 HTTP 요청을 처리하는 핸들러
+type CraftingHandler struct {
+	craftingService CraftingServiceInterface
+}
+
+// 새로운 CraftingHandler 인스턴스를 생성
+func NewCraftingHandler(craftingService CraftingServiceInterface) *CraftingHandler {
+	return &CraftingHandler{
+		craftingService: craftingService,
+	}
+}
+
+// 제작 요청
+type CraftRequest struct {
+	Times int `json:"times" binding:"min=0,max=10"`
+}
+
+// 레시피 재료 요청
+type RecipeMaterialRequest struct {
+	ItemID   string `json:"item_id" binding:"required"`
+	Quantity int    `json:"quantity" binding:"required,min=1"`
+}
+
+// 레시피 생성/수정 요청
+type RecipeRequest struct {
+	Code           string                  `json:"code" binding:"required,max=50"`
+	Name           string                  `json:"name" binding:"required,max=100"`
+	Description    string                  `json:"description" binding:"max=500"`
+	OutputItemID   string                  `json:"output_item_id" binding:"required"`
+	OutputItemName string                  `json:"output_item_name" binding:"required"`
+	OutputItemType string                  `json:"output_item_type" binding:"required"`
+	OutputRarity   string                  `json:"output_rarity" binding:"required,oneof=common rare epic legendary"`
+	OutputLevel    int                     `json:"output_level" binding:"min=0"`
+	OutputQuantity int                     `json:"output_quantity" binding:"min=0"`
+	GoldCost       int                     `json:"gold_cost" binding:"min=0"`
+	RequiredLevel  int                     `json:"required_level" binding:"min=0"`
+	SuccessRate    float64                 `json:"success_rate" binding:"required,gt=0,lte=1"`
+	IsHidden       bool                    `json:"is_hidden"`
+	IsActive       *bool                   `json:"is_active"`
+	Materials      []RecipeMaterialRequest `json:"materials" binding:"required,min=1,dive"`
+}
+
+// 레시피 해금 요청
+type UnlockRecipeRequest struct {
+	UserID uint `json:"user_id" binding:"required"`
+}
+
+// 레시피 목록 응답
+type RecipeListResponse struct {
+	Recipes []model.Recipe `json:"recipes"`
+	Total   int            `json:"total"`
+}
+
+// 플레이어 레시피 목록 응답
+type PlayerRecipeListResponse struct {
+	Recipes []service.PlayerRecipe `json:"recipes"`
+	Total   int                    `json:"total"`
+}
+
+// 요청 정보를 레시피 모델로 변환
+func (req *RecipeRequest) toModel() *model.Recipe {
+	recipe := &model.Recipe{
+		Code:           req.Code,
+		Name:           req.Name,
+		Description:    req.Description,
+		OutputItemID:   req.OutputItemID,
+		OutputItemName: req.OutputItemName,
+		OutputItemType: req.OutputItemType,
+		OutputRarity:   req.OutputRarity,
+		OutputLevel:    req.OutputLevel,
+		OutputQuantity: req.OutputQuantity,
+		GoldCost:       req.GoldCost,
+		RequiredLevel:  req.RequiredLevel,
+		SuccessRate:    req.SuccessRate,
+		IsHidden:       req.IsHidden,
+		IsActive:       true,
+		Materials:      make([]model.RecipeMaterial, len(req.Materials)),
+	}
+	if recipe.OutputLevel == 0 {
+		recipe.OutputLevel = 1
+	}
+	if recipe.OutputQuantity == 0 {
+		recipe.OutputQuantity = 1
+	}
+	if req.IsActive != nil {
+		recipe.IsActive = *req.IsActive
+	}
+	for i, material := range req.Materials {
+		recipe.Materials[i] = model.RecipeMaterial{
+			ItemID:   material.ItemID,
+			Quantity: material.Quantity,
+		}
+	}
+	return recipe
+}
+
+// 사용 가능한 레시피 목록을 조회
+// @Summary 레시피 목록 조회
+// @Description 공개 레시피와 해금한 숨김 레시피를 현재 제작 가능 여부와 함께 조회합니다.
+// @Tags Crafting
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} PlayerRecipeListResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/crafting/recipes [get]
+func (h *CraftingHandler) GetRecipes(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	recipes, err := h.craftingService.GetPlayerRecipes(userInfo.UserID)
+	if err != nil {
+		c.JSON(craftingErrorStatus(err), ErrorResponse{
+			Error:   "레시피 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PlayerRecipeListResponse{
+		Recipes: recipes,
+		Total:   len(recipes),
+	})
+}
+
+// 숨김 레시피 발견
+// @Summary 레시피 발견
+// @Description 재료를 모두 보유한 숨김 레시피를 해금하고, 새로 발견한 레시피를 반환합니다.
+// @Tags Crafting
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} RecipeListResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/crafting/recipes/discover [post]
+func (h *CraftingHandler) DiscoverRecipes(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	recipes, err := h.craftingService.DiscoverRecipes(userInfo.UserID)
+	if err != nil {
+		c.JSON(craftingErrorStatus(err), ErrorResponse{
+			Error:   "레시피 발견 처리에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, RecipeListResponse{
+		Recipes: recipes,
+		Total:   len(recipes),
+	})
+}
+
+// 레시피로 아이템을 제작
+// @Summary 아이템 제작
+// @Description 재료와 골드를 소모하여 아이템을 제작합니다. 성공 확률에 따라 실패할 수 있으며 실패 시에도 재료는 소모됩니다.
+// @Tags Crafting
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "레시피 ID"
+// @Param request body CraftRequest false "제작 횟수"
+// @Success 200 {object} service.CraftResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/crafting/recipes/{id}/craft [post]
+func (h *CraftingHandler) Craft(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	recipeID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req CraftRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "잘못된 요청 형식입니다",
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
+	result, err := h.craftingService.Craft(userInfo.UserID, recipeID, req.Times)
+	if err != nil {
+		c.JSON(craftingErrorStatus(err), ErrorResponse{
+			Error:   "아이템 제작에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 전체 레시피 목록을 조회 (관리자용)
+// @Summary 전체 레시피 조회
+// @Description 숨김/비활성 레시피를 포함한 전체 레시피를 조회합니다. (관리자/중재자)
+// @Tags Crafting
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} RecipeListResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /api/admin/recipes [get]
+func (h *CraftingHandler) AdminListRecipes(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	recipes, err := h.craftingService.GetAllRecipes(limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "레시피 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, RecipeListResponse{
+		Recipes: recipes,
+		Total:   len(recipes),
+	})
+}
+
+// 레시피를 생성 (관리자용)
+// @Summary 레시피 생성
+// @Description 새로운 제작 레시피를 생성합니다. (관리자/중재자)
+// @Tags Crafting
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body RecipeRequest true "레시피 정보"
+// @Success 201 {object} model.Recipe
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/recipes [post]
+func (h *CraftingHandler) AdminCreateRecipe(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	var req RecipeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	recipe := req.toModel()
+	if err := h.craftingService.CreateRecipe(recipe); err != nil {
+		c.JSON(craftingErrorStatus(err), ErrorResponse{
+			Error:   "레시피 생성에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, recipe)
+}
+
+// 레시피를 수정 (관리자용)
+// @Summary 레시피 수정
+// @Description 레시피 정보와 재료 목록을 수정합니다. (관리자/중재자)
+// @Tags Crafting
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "레시피 ID"
+// @Param request body RecipeRequest true "레시피 정보"
+// @Success 200 {object} model.Recipe
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/admin/recipes/{id} [put]
+func (h *CraftingHandler) AdminUpdateRecipe(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	recipeID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req RecipeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	recipe, err := h.craftingService.UpdateRecipe(recipeID, req.toModel())
+	if err != nil {
+		c.JSON(craftingErrorStatus(err), ErrorResponse{
+			Error:   "레시피 수정에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, recipe)
+}
+
+// 레시피를 삭제 (관리자용)
+// @Summary 레시피 삭제
+// @Description 레시피를 삭제합니다. (관리자/중재자)
+// @Tags Crafting
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "레시피 ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/admin/recipes/{id} [delete]
+func (h *CraftingHandler) AdminDeleteRecipe(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	recipeID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.craftingService.DeleteRecipe(recipeID); err != nil {
+		c.JSON(craftingErrorStatus(err), ErrorResponse{
+			Error:   "레시피 삭제에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "레시피가 삭제되었습니다",
+	})
+}
+
+// 사용자에게 레시피를 해금 (관리자용)
+// @Summary 레시피 해금
+// @Description 지정한 사용자에게 레시피를 해금합니다. (관리자/중재자)
+// @Tags Crafting
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "레시피 ID"
+// @Param request body UnlockRecipeRequest true "해금 대상 사용자"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/admin/recipes/{id}/unlock [post]
+func (h *CraftingHandler) AdminUnlockRecipe(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	recipeID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req UnlockRecipeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := h.craftingService.UnlockRecipe(req.UserID, recipeID); err != nil {
+		c.JSON(craftingErrorStatus(err), ErrorResponse{
+			Error:   "레시피 해금에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "레시피가 해금되었습니다",
+		UserID:  req.UserID,
+	})
+}
+
+// 제작 서비스 에러를 HTTP 상태 코드로 변환
+func craftingErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrRecipeNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrRecipeLocked),
+		errors.Is(err, model.ErrLevelTooLow):
+		return http.StatusForbidden
+	case errors.Is(err, model.ErrDuplicateRecipeCode):
+		return http.StatusConflict
+	case errors.Is(err, model.ErrRecipeInactive),
+		errors.Is(err, model.ErrInvalidRecipe),
+		errors.Is(err, model.ErrInvalidRecipeOutput),
+		errors.Is(err, model.ErrInvalidSuccessRate),
+		errors.Is(err, model.ErrRecipeNoMaterials),
+		errors.Is(err, model.ErrDuplicateMaterial),
+		errors.Is(err, model.ErrInvalidUserID),
+		errors.Is(err, model.ErrInvalidItemID),
+		errors.Is(err, model.ErrInvalidQuantity),
+		errors.Is(err, model.ErrInsufficientQuantity),
+		errors.Is(err, service.ErrItemNotOwned),
+		errors.Is(err, service.ErrInsufficientGold):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
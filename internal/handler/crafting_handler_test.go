@@ -0,0 +1,249 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 제작 서비스
+type MockCraftingService struct {
+	mock.Mock
+}
+
+func (m *MockCraftingService) Craft(userID, recipeID uint, times int) (*service.CraftResult, error) {
+	args := m.Called(userID, recipeID, times)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.CraftResult), args.Error(1)
+}
+
+func (m *MockCraftingService) GetPlayerRecipes(userID uint) ([]service.PlayerRecipe, error) {
+	args := m.Called(userID)
+	return args.Get(0).([]service.PlayerRecipe), args.Error(1)
+}
+
+func (m *MockCraftingService) DiscoverRecipes(userID uint) ([]model.Recipe, error) {
+	args := m.Called(userID)
+	return args.Get(0).([]model.Recipe), args.Error(1)
+}
+
+func (m *MockCraftingService) UnlockRecipe(userID, recipeID uint) error {
+	args := m.Called(userID, recipeID)
+	return args.Error(0)
+}
+
+func (m *MockCraftingService) CreateRecipe(recipe *model.Recipe) error {
+	args := m.Called(recipe)
+	return args.Error(0)
+}
+
+func (m *MockCraftingService) UpdateRecipe(id uint, recipe *model.Recipe) (*model.Recipe, error) {
+	args := m.Called(id, recipe)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Recipe), args.Error(1)
+}
+
+func (m *MockCraftingService) DeleteRecipe(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockCraftingService) GetRecipeByID(id uint) (*model.Recipe, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Recipe), args.Error(1)
+}
+
+func (m *MockCraftingService) GetAllRecipes(limit, offset int) ([]model.Recipe, error) {
+	args := m.Called(limit, offset)
+	return args.Get(0).([]model.Recipe), args.Error(1)
+}
+
+// 테스트용 제작 라우터 설정
+func setupCraftingTestRouter() (*gin.Engine, *MockCraftingService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockCraftingService{}
+	handler := NewCraftingHandler(mockService)
+
+	crafting := router.Group("/api/crafting")
+	{
+		crafting.GET("/recipes", handler.GetRecipes)
+		crafting.POST("/recipes/discover", handler.DiscoverRecipes)
+		crafting.POST("/recipes/:id/craft", handler.Craft)
+	}
+	admin := router.Group("/api/admin/recipes")
+	{
+		admin.GET("", handler.AdminListRecipes)
+		admin.POST("", handler.AdminCreateRecipe)
+		admin.PUT("/:id", handler.AdminUpdateRecipe)
+		admin.DELETE("/:id", handler.AdminDeleteRecipe)
+		admin.POST("/:id/unlock", handler.AdminUnlockRecipe)
+	}
+
+	return router, mockService
+}
+
+// Craft 핸들러 테스트
+func TestCraftingHandler_Craft(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           interface{}
+		times          int
+		mockResult     *service.CraftResult
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "기본 1회 제작",
+			times:          0,
+			mockResult:     &service.CraftResult{RecipeID: 1, Attempts: 1, Successes: 1},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "여러 번 제작",
+			body:           CraftRequest{Times: 3},
+			times:          3,
+			mockResult:     &service.CraftResult{RecipeID: 1, Attempts: 3, Successes: 2, Failures: 1},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "해금되지 않은 레시피",
+			times:          0,
+			mockError:      model.ErrRecipeLocked,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "재료 부족",
+			times:          0,
+			mockError:      model.ErrInsufficientQuantity,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "최대 횟수 초과",
+			body:           CraftRequest{Times: 50},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupCraftingTestRouter()
+			if tt.mockResult != nil {
+				mockService.On("Craft", uint(1), uint(1), tt.times).Return(tt.mockResult, nil)
+			} else if tt.mockError != nil {
+				mockService.On("Craft", uint(1), uint(1), tt.times).Return(nil, tt.mockError)
+			}
+
+			var body []byte
+			if tt.body != nil {
+				body, _ = json.Marshal(tt.body)
+			}
+			req, _ := http.NewRequest("POST", "/api/crafting/recipes/1/craft", bytes.NewBuffer(body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+// 레시피 목록 조회 핸들러 테스트
+func TestCraftingHandler_GetRecipes(t *testing.T) {
+	router, mockService := setupCraftingTestRouter()
+	mockService.On("GetPlayerRecipes", uint(1)).Return([]service.PlayerRecipe{
+		{Recipe: model.Recipe{Code: "iron_sword"}, Unlocked: true, CanCraft: true},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/crafting/recipes", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response PlayerRecipeListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Total)
+	assert.True(t, response.Recipes[0].CanCraft)
+	mockService.AssertExpectations(t)
+}
+
+// 관리자 레시피 관리 핸들러 테스트
+func TestCraftingHandler_AdminRecipes(t *testing.T) {
+	request := RecipeRequest{
+		Code:           "iron_sword",
+		Name:           "철검 제작",
+		OutputItemID:   "iron_sword",
+		OutputItemName: "철검",
+		OutputItemType: "weapon",
+		OutputRarity:   "rare",
+		SuccessRate:    0.8,
+		Materials:      []RecipeMaterialRequest{{ItemID: "iron_ore", Quantity: 3}},
+	}
+
+	t.Run("일반 사용자 접근 거부", func(t *testing.T) {
+		router, mockService := setupCraftingTestRouter()
+
+		body, _ := json.Marshal(request)
+		req, _ := http.NewRequest("POST", "/api/admin/recipes", bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		mockService.AssertNotCalled(t, "CreateRecipe", mock.Anything)
+	})
+
+	t.Run("레시피 생성", func(t *testing.T) {
+		router, mockService := setupCraftingTestRouter()
+		mockService.On("CreateRecipe", mock.MatchedBy(func(r *model.Recipe) bool {
+			return r.Code == "iron_sword" && r.OutputLevel == 1 && r.OutputQuantity == 1 && r.IsActive && len(r.Materials) == 1
+		})).Return(nil)
+
+		body, _ := json.Marshal(request)
+		req, _ := http.NewRequest("POST", "/api/admin/recipes", bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("중복 코드", func(t *testing.T) {
+		router, mockService := setupCraftingTestRouter()
+		mockService.On("CreateRecipe", mock.Anything).Return(model.ErrDuplicateRecipeCode)
+
+		body, _ := json.Marshal(request)
+		req, _ := http.NewRequest("POST", "/api/admin/recipes", bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("레시피 해금", func(t *testing.T) {
+		router, mockService := setupCraftingTestRouter()
+		mockService.On("UnlockRecipe", uint(3), uint(2)).Return(nil)
+
+		body, _ := json.Marshal(UnlockRecipeRequest{UserID: 3})
+		req, _ := http.NewRequest("POST", "/api/admin/recipes/2/unlock", bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, withAuthUser(req, 9, "moderator"))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type EnhancementServiceInterface interface {
+	Enhance(userID, inventoryID uint, useProtection bool) (*service.EnhanceResult, error)
+	GetPreview(userID, inventoryID uint) (*service.EnhancePreview, error)
+	GetLogs(userID uint, limit, offset int) ([]model.EnhancementLog, error)
+}
+
+// 아이템 강화 관련 HTTP 요청을 처리하는 핸들러
+type EnhancementHandler struct {
+	enhancementService EnhancementServiceInterface
+}
+
+// 새로운 EnhancementHandler 인스턴스를 생성
+func NewEnhancementHandler(enhancementService EnhancementServiceInterface) *EnhancementHandler {
+	return &EnhancementHandler{
+		enhancementService: enhancementService,
+	}
+}
+
+// 강화 요청
+type EnhanceRequest struct {
+	UseProtection bool `json:"use_protection"`
+}
+
+// 강화 기록 목록 응답
+type EnhancementLogListResponse struct {
+	Logs  []model.EnhancementLog `json:"logs"`
+	Total int                    `json:"total"`
+}
+
+// 강화 비용과 확률을 미리 조회
+// @Summary 강화 정보 조회
+// @Description 아이템 강화에 필요한 비용, 성공 확률(천장 보정 포함), 실패 시 결과를 조회합니다.
+// @Tags Enhancement
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "인벤토리 ID"
+// @Success 200 {object} service.EnhancePreview
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/enhancement/items/{id} [get]
+func (h *EnhancementHandler) GetPreview(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	inventoryID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	preview, err := h.enhancementService.GetPreview(userInfo.UserID, inventoryID)
+	if err != nil {
+		c.JSON(enhancementErrorStatus(err), ErrorResponse{
+			Error:   "강화 정보 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, preview)
+}
+
+// 아이템 강화를 시도
+// @Summary 아이템 강화
+// @Description 골드와 강화석을 소모하여 아이템 레벨을 올립니다. 실패 시 희귀도별 규칙에 따라 하락하거나 파괴될 수 있습니다.
+// @Tags Enhancement
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "인벤토리 ID"
+// @Param request body EnhanceRequest false "보호 주문서 사용 여부"
+// @Success 200 {object} service.EnhanceResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/enhancement/items/{id}/enhance [post]
+func (h *EnhancementHandler) Enhance(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	inventoryID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req EnhanceRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "잘못된 요청 형식입니다",
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
+	result, err := h.enhancementService.Enhance(userInfo.UserID, inventoryID, req.UseProtection)
+	if err != nil {
+		c.JSON(enhancementErrorStatus(err), ErrorResponse{
+			Error:   "아이템 강화에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 내 강화 기록을 조회
+// @Summary 강화 기록 조회
+// @Description 로그인한 사용자의 강화 시도 기록을 최신순으로 조회합니다.
+// @Tags Enhancement
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} EnhancementLogListResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/enhancement/logs [get]
+func (h *EnhancementHandler) GetMyLogs(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	h.respondLogs(c, userInfo.UserID, limit, offset)
+}
+
+// 강화 기록을 감사용으로 조회 (관리자용)
+// @Summary 강화 기록 감사
+// @Description 전체 또는 특정 사용자의 강화 시도 기록(난수 값 포함)을 조회합니다. (관리자/중재자)
+// @Tags Enhancement
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param user_id query int false "사용자 ID"
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} EnhancementLogListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /api/admin/enhancement/logs [get]
+func (h *EnhancementHandler) AdminGetLogs(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	userID, ok := parseUserIDQuery(c)
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	h.respondLogs(c, userID, limit, offset)
+}
+
+// 강화 기록 조회 결과를 응답으로 작성
+func (h *EnhancementHandler) respondLogs(c *gin.Context, userID uint, limit, offset int) {
+	logs, err := h.enhancementService.GetLogs(userID, limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "강화 기록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, EnhancementLogListResponse{
+		Logs:  logs,
+		Total: len(logs),
+	})
+}
+
+// 강화 서비스 에러를 HTTP 상태 코드로 변환
+func enhancementErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrMaxEnhanceLevel):
+		return http.StatusConflict
+	case errors.Is(err, model.ErrItemNotEnhanceable),
+		errors.Is(err, model.ErrInsufficientQuantity),
+		errors.Is(err, service.ErrItemNotOwned),
+		errors.Is(err, service.ErrInsufficientGold):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
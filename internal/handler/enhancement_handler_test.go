@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 강화 서비스
+type MockEnhancementService struct {
+	mock.Mock
+}
+
+func (m *MockEnhancementService) Enhance(userID, inventoryID uint, useProtection bool) (*service.EnhanceResult, error) {
+	args := m.Called(userID, inventoryID, useProtection)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.EnhanceResult), args.Error(1)
+}
+
+func (m *MockEnhancementService) GetPreview(userID, inventoryID uint) (*service.EnhancePreview, error) {
+	args := m.Called(userID, inventoryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.EnhancePreview), args.Error(1)
+}
+
+func (m *MockEnhancementService) GetLogs(userID uint, limit, offset int) ([]model.EnhancementLog, error) {
+	args := m.Called(userID, limit, offset)
+	return args.Get(0).([]model.EnhancementLog), args.Error(1)
+}
+
+// 테스트용 강화 라우터 설정
+func setupEnhancementTestRouter() (*gin.Engine, *MockEnhancementService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockEnhancementService{}
+	handler := NewEnhancementHandler(mockService)
+
+	enhancement := router.Group("/api/enhancement")
+	{
+		enhancement.GET("/items/:id", handler.GetPreview)
+		enhancement.POST("/items/:id/enhance", handler.Enhance)
+		enhancement.GET("/logs", handler.GetMyLogs)
+	}
+	router.GET("/api/admin/enhancement/logs", handler.AdminGetLogs)
+
+	return router, mockService
+}
+
+// Enhance 핸들러 테스트
+func TestEnhancementHandler_Enhance(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           *EnhanceRequest
+		protection     bool
+		mockResult     *service.EnhanceResult
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "강화 성공",
+			mockResult:     &service.EnhanceResult{Log: &model.EnhancementLog{Outcome: model.EnhanceOutcomeSuccess, ToLevel: 2}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "보호 주문서 사용",
+			body:           &EnhanceRequest{UseProtection: true},
+			protection:     true,
+			mockResult:     &service.EnhanceResult{Log: &model.EnhancementLog{Outcome: model.EnhanceOutcomeProtected, Protected: true}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "최대 레벨",
+			mockError:      model.ErrMaxEnhanceLevel,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "강화 불가 아이템",
+			mockError:      model.ErrItemNotEnhanceable,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "골드 부족",
+			mockError:      service.ErrInsufficientGold,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupEnhancementTestRouter()
+			if tt.mockResult != nil {
+				mockService.On("Enhance", uint(1), uint(5), tt.protection).Return(tt.mockResult, nil)
+			} else {
+				mockService.On("Enhance", uint(1), uint(5), tt.protection).Return(nil, tt.mockError)
+			}
+
+			var body []byte
+			if tt.body != nil {
+				body, _ = json.Marshal(tt.body)
+			}
+			req, _ := http.NewRequest("POST", "/api/enhancement/items/5/enhance", bytes.NewBuffer(body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+// GetPreview 핸들러 테스트
+func TestEnhancementHandler_GetPreview(t *testing.T) {
+	router, mockService := setupEnhancementTestRouter()
+	mockService.On("GetPreview", uint(1), uint(5)).Return(&service.EnhancePreview{InventoryID: 5, CurrentLevel: 3, SuccessRate: 0.78}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/enhancement/items/5", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var preview service.EnhancePreview
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &preview))
+	assert.Equal(t, 3, preview.CurrentLevel)
+	mockService.AssertExpectations(t)
+}
+
+// 강화 기록 조회 핸들러 테스트
+func TestEnhancementHandler_Logs(t *testing.T) {
+	router, mockService := setupEnhancementTestRouter()
+	mockService.On("GetLogs", uint(1), defaultPageLimit, 0).Return([]model.EnhancementLog{{UserID: 1}}, nil)
+	mockService.On("GetLogs", uint(7), defaultPageLimit, 0).Return([]model.EnhancementLog{{UserID: 7}, {UserID: 7}}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/enhancement/logs", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("GET", "/api/admin/enhancement/logs?user_id=7", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusForbidden, w.Code, "일반 사용자는 감사 기록에 접근할 수 없어야 합니다")
+
+	req, _ = http.NewRequest("GET", "/api/admin/enhancement/logs?user_id=7", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response EnhancementLogListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 2, response.Total)
+
+	mockService.AssertExpectations(t)
+}
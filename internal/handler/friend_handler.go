@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type FriendServiceInterface interface {
+	SendRequest(userID, friendID uint) (*model.Friendship, error)
+	RemoveFriend(userID, friendID uint) error
+	GetFriends(userID uint) ([]model.Friendship, error)
+	GetPendingRequests(userID uint) ([]model.Friendship, error)
+}
+
+// 친구 관련 HTTP 요청을 처리하는 핸들러
+type FriendHandler struct {
+	friendService FriendServiceInterface
+}
+
+// 새로운 FriendHandler 인스턴스를 생성
+func NewFriendHandler(friendService FriendServiceInterface) *FriendHandler {
+	return &FriendHandler{
+		friendService: friendService,
+	}
+}
+
+// 친구 목록을 조회
+// @Summary 친구 목록
+// @Description 수락된 친구 목록을 조회합니다.
+// @Tags Friends
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} model.Friendship
+// @Failure 401 {object} ErrorResponse
+// @Router /api/friends [get]
+func (h *FriendHandler) GetFriends(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	friends, err := h.friendService.GetFriends(userInfo.UserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "친구 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, friends)
+}
+
+// 받은 친구 요청을 조회
+// @Summary 받은 친구 요청
+// @Description 아직 수락하지 않은 받은 친구 요청을 조회합니다.
+// @Tags Friends
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} model.Friendship
+// @Failure 401 {object} ErrorResponse
+// @Router /api/friends/requests [get]
+func (h *FriendHandler) GetPendingRequests(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	requests, err := h.friendService.GetPendingRequests(userInfo.UserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "친구 요청 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, requests)
+}
+
+// 친구 요청을 보내거나 받은 요청을 수락
+// @Summary 친구 요청
+// @Description 친구 요청을 보냅니다. 상대가 이미 요청을 보냈다면 수락되어 친구가 됩니다.
+// @Tags Friends
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param user_id path int true "상대 사용자 ID"
+// @Success 200 {object} model.Friendship
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/friends/{user_id} [post]
+func (h *FriendHandler) SendRequest(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	friendID, ok := parseIDParam(c, "user_id")
+	if !ok {
+		return
+	}
+
+	friendship, err := h.friendService.SendRequest(userInfo.UserID, friendID)
+	if err != nil {
+		c.JSON(friendErrorStatus(err), ErrorResponse{
+			Error:   "친구 요청에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, friendship)
+}
+
+// 친구를 삭제하거나 요청을 취소/거절
+// @Summary 친구 삭제
+// @Description 친구 관계를 삭제합니다. 보낸 요청 취소와 받은 요청 거절에도 사용합니다.
+// @Tags Friends
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param user_id path int true "상대 사용자 ID"
+// @Success 204
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/friends/{user_id} [delete]
+func (h *FriendHandler) RemoveFriend(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	friendID, ok := parseIDParam(c, "user_id")
+	if !ok {
+		return
+	}
+
+	if err := h.friendService.RemoveFriend(userInfo.UserID, friendID); err != nil {
+		c.JSON(friendErrorStatus(err), ErrorResponse{
+			Error:   "친구 삭제에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// 친구 서비스 에러를 HTTP 상태 코드로 변환
+func friendErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrCannotFriendSelf):
+		return http.StatusBadRequest
+	case errors.Is(err, model.ErrFriendshipNotFound),
+		errors.Is(err, model.ErrInvalidUserID):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrFriendRequestExists):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"g_dev/internal/model"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 친구 서비스
+type MockFriendService struct {
+	mock.Mock
+}
+
+func (m *MockFriendService) SendRequest(userID, friendID uint) (*model.Friendship, error) {
+	args := m.Called(userID, friendID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Friendship), args.Error(1)
+}
+
+func (m *MockFriendService) RemoveFriend(userID, friendID uint) error {
+	args := m.Called(userID, friendID)
+	return args.Error(0)
+}
+
+func (m *MockFriendService) GetFriends(userID uint) ([]model.Friendship, error) {
+	args := m.Called(userID)
+	return args.Get(0).([]model.Friendship), args.Error(1)
+}
+
+func (m *MockFriendService) GetPendingRequests(userID uint) ([]model.Friendship, error) {
+	args := m.Called(userID)
+	return args.Get(0).([]model.Friendship), args.Error(1)
+}
+
+// 테스트용 친구 라우터 설정
+func setupFriendTestRouter() (*gin.Engine, *MockFriendService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockFriendService{}
+	handler := NewFriendHandler(mockService)
+
+	friends := router.Group("/api/friends")
+	{
+		friends.GET("", handler.GetFriends)
+		friends.GET("/requests", handler.GetPendingRequests)
+		friends.POST("/:user_id", handler.SendRequest)
+		friends.DELETE("/:user_id", handler.RemoveFriend)
+	}
+
+	return router, mockService
+}
+
+// 친구 요청 테스트
+func TestFriendHandler_SendRequest(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockError      error
+		expectedStatus int
+	}{
+		{name: "요청 성공", expectedStatus: http.StatusOK},
+		{name: "자기 자신", mockError: model.ErrCannotFriendSelf, expectedStatus: http.StatusBadRequest},
+		{name: "없는 사용자", mockError: model.ErrInvalidUserID, expectedStatus: http.StatusNotFound},
+		{name: "중복 요청", mockError: model.ErrFriendRequestExists, expectedStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupFriendTestRouter()
+			if tt.mockError != nil {
+				mockService.On("SendRequest", uint(1), uint(2)).Return(nil, tt.mockError)
+			} else {
+				mockService.On("SendRequest", uint(1), uint(2)).
+					Return(&model.Friendship{UserID: 1, FriendID: 2, Status: model.FriendshipPending}, nil)
+			}
+
+			req, _ := http.NewRequest("POST", "/api/friends/2", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+// 친구 목록 조회와 삭제 테스트
+func TestFriendHandler_ListAndRemove(t *testing.T) {
+	router, mockService := setupFriendTestRouter()
+	mockService.On("GetFriends", uint(1)).Return([]model.Friendship{{UserID: 1, FriendID: 2, Status: model.FriendshipAccepted}}, nil)
+	mockService.On("GetPendingRequests", uint(1)).Return([]model.Friendship{}, nil)
+	mockService.On("RemoveFriend", uint(1), uint(2)).Return(nil)
+	mockService.On("RemoveFriend", uint(1), uint(3)).Return(model.ErrFriendshipNotFound)
+
+	req, _ := http.NewRequest("GET", "/api/friends", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("GET", "/api/friends/requests", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("DELETE", "/api/friends/2", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	req, _ = http.NewRequest("DELETE", "/api/friends/3", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	req, _ = http.NewRequest("GET", "/api/friends", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	mockService.AssertExpectations(t)
+}
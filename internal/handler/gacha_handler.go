@@ -0,0 +1,424 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type GachaServiceInterface interface {
+	Pull(userID, bannerID uint, count int) (*service.PullResult, error)
+	GetActiveBanners() ([]model.GachaBanner, error)
+	GetBannerRates(bannerID uint) (*service.BannerRates, error)
+	GetPity(userID, bannerID uint) (*model.GachaPity, error)
+	GetPullHistory(userID uint, limit, offset int) ([]model.GachaPull, error)
+	CreateBanner(banner *model.GachaBanner) error
+	SetBannerActive(bannerID uint, active bool) error
+}
+
+// 가챠(뽑기) 관련 HTTP 요청을 처리하는 핸들러
+type GachaHandler struct {
+	gachaService GachaServiceInterface
+}
+
+// 새로운 GachaHandler 인스턴스를 생성
+func NewGachaHandler(gachaService GachaServiceInterface) *GachaHandler {
+	return &GachaHandler{
+		gachaService: gachaService,
+	}
+}
+
+// 뽑기 요청
+type PullRequest struct {
+	Count int `json:"count" binding:"omitempty,oneof=1 10"`
+}
+
+// 배너 드롭 아이템 요청
+type GachaEntryRequest struct {
+	Rarity   string `json:"rarity" binding:"required,oneof=common rare epic legendary"`
+	ItemID   string `json:"item_id" binding:"required"`
+	ItemName string `json:"item_name" binding:"required"`
+	ItemType string `json:"item_type" binding:"required"`
+	Quantity int    `json:"quantity" binding:"min=0"`
+	Weight   int    `json:"weight" binding:"required,min=1"`
+}
+
+// 배너 생성 요청
+type CreateBannerRequest struct {
+	Code              string              `json:"code" binding:"required,max=50"`
+	Name              string              `json:"name" binding:"required,max=100"`
+	Description       string              `json:"description" binding:"max=500"`
+	CostDiamond       int                 `json:"cost_diamond" binding:"required,min=1"`
+	BundleCostDiamond int                 `json:"bundle_cost_diamond" binding:"min=0"`
+	CommonWeight      int                 `json:"common_weight" binding:"min=0"`
+	RareWeight        int                 `json:"rare_weight" binding:"min=0"`
+	EpicWeight        int                 `json:"epic_weight" binding:"min=0"`
+	LegendaryWeight   int                 `json:"legendary_weight" binding:"min=0"`
+	PityThreshold     int                 `json:"pity_threshold" binding:"min=0"`
+	BundleGuarantee   string              `json:"bundle_guarantee" binding:"omitempty,oneof=common rare epic legendary"`
+	StartsAt          *time.Time          `json:"starts_at"`
+	EndsAt            *time.Time          `json:"ends_at"`
+	Entries           []GachaEntryRequest `json:"entries" binding:"required,min=1,dive"`
+}
+
+// 배너 활성화 변경 요청
+type SetBannerActiveRequest struct {
+	IsActive bool `json:"is_active"`
+}
+
+// 배너 목록 응답
+type BannerListResponse struct {
+	Banners []model.GachaBanner `json:"banners"`
+	Total   int                 `json:"total"`
+}
+
+// 뽑기 기록 목록 응답
+type PullHistoryResponse struct {
+	Pulls []model.GachaPull `json:"pulls"`
+	Total int               `json:"total"`
+}
+
+// 진행 중인 배너 목록을 조회
+// @Summary 배너 목록 조회
+// @Description 현재 뽑기가 가능한 배너 목록을 조회합니다. 인증 없이 조회할 수 있습니다.
+// @Tags Gacha
+// @Accept json
+// @Produce json
+// @Success 200 {object} BannerListResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/gacha/banners [get]
+func (h *GachaHandler) GetBanners(c *gin.Context) {
+	banners, err := h.gachaService.GetActiveBanners()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "배너 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, BannerListResponse{
+		Banners: banners,
+		Total:   len(banners),
+	})
+}
+
+// 배너의 드롭 확률을 공개
+// @Summary 드롭 확률 조회
+// @Description 배너의 등급별, 아이템별 드롭 확률을 조회합니다. 확률 공시를 위해 인증 없이 조회할 수 있습니다.
+// @Tags Gacha
+// @Accept json
+// @Produce json
+// @Param id path int true "배너 ID"
+// @Success 200 {object} service.BannerRates
+// @Failure 404 {object} ErrorResponse
+// @Router /api/gacha/banners/{id}/rates [get]
+func (h *GachaHandler) GetRates(c *gin.Context) {
+	bannerID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	rates, err := h.gachaService.GetBannerRates(bannerID)
+	if err != nil {
+		c.JSON(gachaErrorStatus(err), ErrorResponse{
+			Error:   "드롭 확률 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, rates)
+}
+
+// 뽑기를 진행
+// @Summary 뽑기
+// @Description 다이아몬드를 소모하여 1회 또는 10연차 뽑기를 진행합니다.
+// @Tags Gacha
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "배너 ID"
+// @Param request body PullRequest false "뽑기 횟수 (1 또는 10)"
+// @Success 200 {object} service.PullResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/gacha/banners/{id}/pull [post]
+func (h *GachaHandler) Pull(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	bannerID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	req := PullRequest{Count: 1}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "잘못된 요청 형식입니다",
+				Message: err.Error(),
+			})
+			return
+		}
+		if req.Count == 0 {
+			req.Count = 1
+		}
+	}
+
+	result, err := h.gachaService.Pull(userInfo.UserID, bannerID, req.Count)
+	if err != nil {
+		c.JSON(gachaErrorStatus(err), ErrorResponse{
+			Error:   "뽑기에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 내 천장 카운터를 조회
+// @Summary 천장 카운터 조회
+// @Description 배너별 마지막 legendary 이후 뽑기 횟수를 조회합니다.
+// @Tags Gacha
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "배너 ID"
+// @Success 200 {object} model.GachaPity
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/gacha/banners/{id}/pity [get]
+func (h *GachaHandler) GetPity(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	bannerID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	pity, err := h.gachaService.GetPity(userInfo.UserID, bannerID)
+	if err != nil {
+		c.JSON(gachaErrorStatus(err), ErrorResponse{
+			Error:   "천장 카운터 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, pity)
+}
+
+// 내 뽑기 기록을 조회
+// @Summary 뽑기 기록 조회
+// @Description 로그인한 사용자의 뽑기 기록을 최신순으로 조회합니다.
+// @Tags Gacha
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} PullHistoryResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/gacha/history [get]
+func (h *GachaHandler) GetMyHistory(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	h.respondPullHistory(c, userInfo.UserID, limit, offset)
+}
+
+// 뽑기 기록을 감사용으로 조회 (관리자용)
+// @Summary 뽑기 기록 감사
+// @Description 전체 또는 특정 사용자의 뽑기 기록을 조회합니다. (관리자/중재자)
+// @Tags Gacha
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param user_id query int false "사용자 ID"
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} PullHistoryResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /api/admin/gacha/pulls [get]
+func (h *GachaHandler) AdminGetPulls(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	userID, ok := parseUserIDQuery(c)
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	h.respondPullHistory(c, userID, limit, offset)
+}
+
+// 배너를 생성 (관리자용)
+// @Summary 배너 생성
+// @Description 등급별 가중치와 드롭 아이템으로 새로운 배너를 생성합니다. 생성된 배너는 비활성 상태입니다. (관리자/중재자)
+// @Tags Gacha
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateBannerRequest true "배너 정보"
+// @Success 201 {object} model.GachaBanner
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/gacha/banners [post]
+func (h *GachaHandler) AdminCreateBanner(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	var req CreateBannerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	banner := &model.GachaBanner{
+		Code:              req.Code,
+		Name:              req.Name,
+		Description:       req.Description,
+		CostDiamond:       req.CostDiamond,
+		BundleCostDiamond: req.BundleCostDiamond,
+		CommonWeight:      req.CommonWeight,
+		RareWeight:        req.RareWeight,
+		EpicWeight:        req.EpicWeight,
+		LegendaryWeight:   req.LegendaryWeight,
+		PityThreshold:     req.PityThreshold,
+		BundleGuarantee:   req.BundleGuarantee,
+		StartsAt:          req.StartsAt,
+		EndsAt:            req.EndsAt,
+		Entries:           make([]model.GachaEntry, len(req.Entries)),
+	}
+	for i, entry := range req.Entries {
+		quantity := entry.Quantity
+		if quantity == 0 {
+			quantity = 1
+		}
+		banner.Entries[i] = model.GachaEntry{
+			Rarity:   entry.Rarity,
+			ItemID:   entry.ItemID,
+			ItemName: entry.ItemName,
+			ItemType: entry.ItemType,
+			Quantity: quantity,
+			Weight:   entry.Weight,
+		}
+	}
+
+	if err := h.gachaService.CreateBanner(banner); err != nil {
+		c.JSON(gachaErrorStatus(err), ErrorResponse{
+			Error:   "배너 생성에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, banner)
+}
+
+// 배너 활성화 여부를 변경 (관리자용)
+// @Summary 배너 활성화 변경
+// @Description 배너를 활성화하거나 비활성화합니다. (관리자/중재자)
+// @Tags Gacha
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "배너 ID"
+// @Param request body SetBannerActiveRequest true "활성화 여부"
+// @Success 200 {object} SuccessResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/admin/gacha/banners/{id}/active [post]
+func (h *GachaHandler) AdminSetBannerActive(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	bannerID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req SetBannerActiveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := h.gachaService.SetBannerActive(bannerID, req.IsActive); err != nil {
+		c.JSON(gachaErrorStatus(err), ErrorResponse{
+			Error:   "배너 상태 변경에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "배너 상태가 변경되었습니다",
+	})
+}
+
+// 뽑기 기록 조회 결과를 응답으로 작성
+func (h *GachaHandler) respondPullHistory(c *gin.Context, userID uint, limit, offset int) {
+	pulls, err := h.gachaService.GetPullHistory(userID, limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "뽑기 기록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PullHistoryResponse{
+		Pulls: pulls,
+		Total: len(pulls),
+	})
+}
+
+// 가챠 서비스 에러를 HTTP 상태 코드로 변환
+func gachaErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrBannerNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrBannerClosed),
+		errors.Is(err, model.ErrDuplicateBannerCode):
+		return http.StatusConflict
+	case errors.Is(err, model.ErrInvalidPullCount),
+		errors.Is(err, model.ErrInvalidBanner),
+		errors.Is(err, model.ErrEmptyDropTable),
+		errors.Is(err, model.ErrInvalidDropWeight),
+		errors.Is(err, model.ErrInvalidRarity),
+		errors.Is(err, model.ErrInvalidItemID),
+		errors.Is(err, model.ErrInvalidQuantity),
+		errors.Is(err, service.ErrInsufficientDiamond):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 가챠 서비스
+type MockGachaService struct {
+	mock.Mock
+}
+
+func (m *MockGachaService) Pull(userID, bannerID uint, count int) (*service.PullResult, error) {
+	args := m.Called(userID, bannerID, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.PullResult), args.Error(1)
+}
+
+func (m *MockGachaService) GetActiveBanners() ([]model.GachaBanner, error) {
+	args := m.Called()
+	return args.Get(0).([]model.GachaBanner), args.Error(1)
+}
+
+func (m *MockGachaService) GetBannerRates(bannerID uint) (*service.BannerRates, error) {
+	args := m.Called(bannerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.BannerRates), args.Error(1)
+}
+
+func (m *MockGachaService) GetPity(userID, bannerID uint) (*model.GachaPity, error) {
+	args := m.Called(userID, bannerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.GachaPity), args.Error(1)
+}
+
+func (m *MockGachaService) GetPullHistory(userID uint, limit, offset int) ([]model.GachaPull, error) {
+	args := m.Called(userID, limit, offset)
+	return args.Get(0).([]model.GachaPull), args.Error(1)
+}
+
+func (m *MockGachaService) CreateBanner(banner *model.GachaBanner) error {
+	args := m.Called(banner)
+	return args.Error(0)
+}
+
+func (m *MockGachaService) SetBannerActive(bannerID uint, active bool) error {
+	args := m.Called(bannerID, active)
+	return args.Error(0)
+}
+
+// 테스트용 가챠 라우터 설정
+func setupGachaTestRouter() (*gin.Engine, *MockGachaService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockGachaService{}
+	handler := NewGachaHandler(mockService)
+
+	gacha := router.Group("/api/gacha")
+	{
+		gacha.GET("/banners", handler.GetBanners)
+		gacha.GET("/banners/:id/rates", handler.GetRates)
+		gacha.POST("/banners/:id/pull", handler.Pull)
+		gacha.GET("/banners/:id/pity", handler.GetPity)
+		gacha.GET("/history", handler.GetMyHistory)
+	}
+	admin := router.Group("/api/admin/gacha")
+	{
+		admin.POST("/banners", handler.AdminCreateBanner)
+		admin.POST("/banners/:id/active", handler.AdminSetBannerActive)
+		admin.GET("/pulls", handler.AdminGetPulls)
+	}
+
+	return router, mockService
+}
+
+// 공개 확률 조회 테스트
+func TestGachaHandler_GetRates(t *testing.T) {
+	router, mockService := setupGachaTestRouter()
+	mockService.On("GetBannerRates", uint(1)).Return(&service.BannerRates{
+		Banner: &model.GachaBanner{Code: "starter"},
+		Rates:  []model.DropRate{{Rarity: "common", Probability: 0.9}, {Rarity: "legendary", Probability: 0.1}},
+	}, nil)
+	mockService.On("GetBannerRates", uint(2)).Return(nil, model.ErrBannerNotFound)
+
+	req, _ := http.NewRequest("GET", "/api/gacha/banners/1/rates", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code, "인증 없이 확률을 조회할 수 있어야 합니다")
+
+	var rates service.BannerRates
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &rates))
+	assert.Len(t, rates.Rates, 2)
+
+	req, _ = http.NewRequest("GET", "/api/gacha/banners/2/rates", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	mockService.AssertExpectations(t)
+}
+
+// Pull 핸들러 테스트
+func TestGachaHandler_Pull(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           interface{}
+		authenticated  bool
+		count          int
+		mockError      error
+		expectedStatus int
+	}{
+		{name: "기본 1회 뽑기", authenticated: true, count: 1, expectedStatus: http.StatusOK},
+		{name: "10연차", body: PullRequest{Count: 10}, authenticated: true, count: 10, expectedStatus: http.StatusOK},
+		{name: "잘못된 횟수", body: PullRequest{Count: 5}, authenticated: true, expectedStatus: http.StatusBadRequest},
+		{name: "다이아몬드 부족", authenticated: true, count: 1, mockError: service.ErrInsufficientDiamond, expectedStatus: http.StatusBadRequest},
+		{name: "종료된 배너", authenticated: true, count: 1, mockError: model.ErrBannerClosed, expectedStatus: http.StatusConflict},
+		{name: "인증 없음", expectedStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupGachaTestRouter()
+			if tt.count > 0 {
+				if tt.mockError != nil {
+					mockService.On("Pull", uint(1), uint(3), tt.count).Return(nil, tt.mockError)
+				} else {
+					mockService.On("Pull", uint(1), uint(3), tt.count).Return(&service.PullResult{BannerID: 3}, nil)
+				}
+			}
+
+			var body []byte
+			if tt.body != nil {
+				body, _ = json.Marshal(tt.body)
+			}
+			req, _ := http.NewRequest("POST", "/api/gacha/banners/3/pull", bytes.NewBuffer(body))
+			if tt.authenticated {
+				req = withAuthUser(req, 1, "user")
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+// 관리자 배너 생성 테스트
+func TestGachaHandler_AdminCreateBanner(t *testing.T) {
+	router, mockService := setupGachaTestRouter()
+	mockService.On("CreateBanner", mock.MatchedBy(func(b *model.GachaBanner) bool {
+		return b.Code == "starter" && len(b.Entries) == 1 && b.Entries[0].Quantity == 1 && !b.IsActive
+	})).Return(nil)
+
+	body, _ := json.Marshal(CreateBannerRequest{
+		Code:         "starter",
+		Name:         "스타터 배너",
+		CostDiamond:  100,
+		CommonWeight: 1,
+		Entries:      []GachaEntryRequest{{Rarity: "common", ItemID: "potion", ItemName: "포션", ItemType: "consumable", Weight: 1}},
+	})
+
+	req, _ := http.NewRequest("POST", "/api/admin/gacha/banners", bytes.NewBuffer(body))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	req, _ = http.NewRequest("POST", "/api/admin/gacha/banners", bytes.NewBuffer(body))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	mockService.AssertExpectations(t)
+}
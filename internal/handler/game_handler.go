@@ -0,0 +1,427 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type GameServiceInterface interface {
+	SearchGames(filter *service.GameSearchFilter) ([]model.Game, int64, error)
+	GetGameByID(id uint) (*model.Game, error)
+	CreateGame(game *model.Game) error
+	UpdateGame(id uint, game *model.Game) (*model.Game, error)
+	SetGameStatus(id uint, status model.GameStatus) (*model.Game, error)
+	GetTagCounts(limit int) ([]service.TagCount, error)
+	GetTrendingGames(limit int) ([]model.Game, error)
+}
+
+// 게임 카탈로그 관련 HTTP 요청을 처리하는 핸들러
+type GameHandler struct {
+	gameService GameServiceInterface
+}
+
+// 새로운 GameHandler 인스턴스를 생성
+func NewGameHandler(gameService GameServiceInterface) *GameHandler {
+	return &GameHandler{
+		gameService: gameService,
+	}
+}
+
+// 게임 등록/수정 요청
+type GameRequest struct {
+	Name               string     `json:"name" binding:"required,max=100"`
+	Description        string     `json:"description" binding:"max=1000"`
+	Category           string     `json:"category" binding:"required"`
+	Difficulty         string     `json:"difficulty" binding:"required"`
+	Version            string     `json:"version" binding:"max=20"`
+	MinLevel           int        `json:"min_level" binding:"min=0"`
+	MaxPlayers         int        `json:"max_players" binding:"min=0"`
+	EstimatedPlayTime  int        `json:"estimated_play_time" binding:"min=0"`
+	ImageURL           string     `json:"image_url" binding:"max=500"`
+	IconURL            string     `json:"icon_url" binding:"max=500"`
+	Tags               []string   `json:"tags"`
+	ReleaseDate        *time.Time `json:"release_date"`
+	Developer          string     `json:"developer" binding:"max=100"`
+	SupportedLanguages []string   `json:"supported_languages"`
+	SupportedPlatforms []string   `json:"supported_platforms"`
+	FileSize           int        `json:"file_size" binding:"min=0"`
+	DownloadURL        string     `json:"download_url" binding:"max=500"`
+	PlayURL            string     `json:"play_url" binding:"max=500"`
+	TutorialURL        string     `json:"tutorial_url" binding:"max=500"`
+	FAQURL             string     `json:"faq_url" binding:"max=500"`
+	CommunityURL       string     `json:"community_url" binding:"max=500"`
+	OfficialURL        string     `json:"official_url" binding:"max=500"`
+	License            string     `json:"license" binding:"max=100"`
+	Price              int        `json:"price"`
+	Currency           string     `json:"currency" binding:"max=10"`
+	DiscountRate       int        `json:"discount_rate"`
+	DiscountEndDate    *time.Time `json:"discount_end_date"`
+}
+
+// 요청을 게임 모델로 변환 (비어 있는 값은 기본값으로 채움)
+func (req *GameRequest) toModel() *model.Game {
+	game := &model.Game{
+		Name:               req.Name,
+		Description:        req.Description,
+		Category:           model.GameCategory(req.Category),
+		Difficulty:         model.GameDifficulty(req.Difficulty),
+		Version:            req.Version,
+		MinLevel:           req.MinLevel,
+		MaxPlayers:         req.MaxPlayers,
+		EstimatedPlayTime:  req.EstimatedPlayTime,
+		ImageURL:           req.ImageURL,
+		IconURL:            req.IconURL,
+		ReleaseDate:        req.ReleaseDate,
+		Developer:          req.Developer,
+		SupportedLanguages: strings.Join(req.SupportedLanguages, ","),
+		SupportedPlatforms: strings.Join(req.SupportedPlatforms, ","),
+		FileSize:           req.FileSize,
+		DownloadURL:        req.DownloadURL,
+		PlayURL:            req.PlayURL,
+		TutorialURL:        req.TutorialURL,
+		FAQURL:             req.FAQURL,
+		CommunityURL:       req.CommunityURL,
+		OfficialURL:        req.OfficialURL,
+		License:            req.License,
+		Price:              req.Price,
+		Currency:           req.Currency,
+		DiscountRate:       req.DiscountRate,
+		DiscountEndDate:    req.DiscountEndDate,
+	}
+	game.SetTags(req.Tags)
+
+	if game.Version == "" {
+		game.Version = "1.0.0"
+	}
+	if game.MinLevel == 0 {
+		game.MinLevel = 1
+	}
+	if game.MaxPlayers == 0 {
+		game.MaxPlayers = 1
+	}
+	if game.SupportedLanguages == "" {
+		game.SupportedLanguages = "ko,en"
+	}
+	if game.SupportedPlatforms == "" {
+		game.SupportedPlatforms = "web"
+	}
+	if game.Currency == "" {
+		game.Currency = "KRW"
+	}
+	return game
+}
+
+// 게임 상태 변경 요청
+type SetGameStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+// 게임 목록 응답
+type GameListResponse struct {
+	Games []model.Game `json:"games"`
+	Total int64        `json:"total"`
+}
+
+// 태그 클라우드 응답
+type TagCloudResponse struct {
+	Tags  []service.TagCount `json:"tags"`
+	Total int                `json:"total"`
+}
+
+// 게임 목록을 검색
+// @Summary 게임 목록 조회
+// @Description 카테고리, 난이도, 태그, 언어, 플랫폼, 무료/할인 여부로 게임을 검색합니다. 인증 없이 조회할 수 있습니다.
+// @Tags Games
+// @Accept json
+// @Produce json
+// @Param category query string false "카테고리"
+// @Param difficulty query string false "난이도"
+// @Param tags query string false "태그 (쉼표로 구분)"
+// @Param tag_mode query string false "태그 조건 (any: 하나 이상, all: 모두)"
+// @Param language query string false "지원 언어"
+// @Param platform query string false "플랫폼"
+// @Param free query bool false "무료 게임만"
+// @Param discounted query bool false "할인 중인 게임만"
+// @Param sort query string false "정렬 (popularity, trending, rating, release)"
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} GameListResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/games [get]
+func (h *GameHandler) SearchGames(c *gin.Context) {
+	limit, offset := parsePagination(c)
+	free, _ := strconv.ParseBool(c.Query("free"))
+	discounted, _ := strconv.ParseBool(c.Query("discounted"))
+
+	games, total, err := h.gameService.SearchGames(&service.GameSearchFilter{
+		Category:     model.GameCategory(c.Query("category")),
+		Difficulty:   model.GameDifficulty(c.Query("difficulty")),
+		Tags:         parseTagsQuery(c),
+		MatchAllTags: c.Query("tag_mode") == "all",
+		Language:     c.Query("language"),
+		Platform:     c.Query("platform"),
+		Free:         free,
+		Discounted:   discounted,
+		SortBy:       c.Query("sort"),
+		Limit:        limit,
+		Offset:       offset,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "게임 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameListResponse{
+		Games: games,
+		Total: total,
+	})
+}
+
+// 태그별 게임 수를 조회
+// @Summary 태그 클라우드
+// @Description 공개된 게임에 달린 태그를 게임 수가 많은 순으로 조회합니다. 인증 없이 조회할 수 있습니다.
+// @Tags Games
+// @Accept json
+// @Produce json
+// @Param limit query int false "조회 개수"
+// @Success 200 {object} TagCloudResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/games/tags [get]
+func (h *GameHandler) GetTagCloud(c *gin.Context) {
+	limit, _ := parsePagination(c)
+
+	tags, err := h.gameService.GetTagCounts(limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "태그 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, TagCloudResponse{
+		Tags:  tags,
+		Total: len(tags),
+	})
+}
+
+// 지금 뜨는 게임 목록을 조회
+// @Summary 트렌딩 게임
+// @Description 최근 플레이, 평점, 플레이 시간을 시간 감쇠로 합산한 트렌딩 점수 순으로 게임을 조회합니다. 인증 없이 조회할 수 있습니다.
+// @Tags Games
+// @Accept json
+// @Produce json
+// @Param limit query int false "조회 개수"
+// @Success 200 {object} GameListResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/games/trending [get]
+func (h *GameHandler) GetTrending(c *gin.Context) {
+	limit, _ := parsePagination(c)
+
+	games, err := h.gameService.GetTrendingGames(limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "트렌딩 게임 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameListResponse{
+		Games: games,
+		Total: int64(len(games)),
+	})
+}
+
+// 게임 상세 정보를 조회
+// @Summary 게임 상세 조회
+// @Description 게임 상세 정보를 조회합니다. 비활성 게임은 조회되지 않습니다.
+// @Tags Games
+// @Accept json
+// @Produce json
+// @Param id path int true "게임 ID"
+// @Success 200 {object} model.Game
+// @Failure 404 {object} ErrorResponse
+// @Router /api/games/{id} [get]
+func (h *GameHandler) GetGame(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	game, err := h.gameService.GetGameByID(gameID)
+	if err == nil && !game.IsListed() {
+		err = model.ErrGameNotFound
+	}
+	if err != nil {
+		c.JSON(gameErrorStatus(err), ErrorResponse{
+			Error:   "게임 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, game)
+}
+
+// 새 게임을 등록 (관리자용)
+// @Summary 게임 등록
+// @Description 새 게임을 카탈로그에 등록합니다. (관리자/중재자)
+// @Tags Games
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body GameRequest true "게임 정보"
+// @Success 201 {object} model.Game
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/games [post]
+func (h *GameHandler) AdminCreateGame(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	var req GameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	game := req.toModel()
+	if err := h.gameService.CreateGame(game); err != nil {
+		c.JSON(gameErrorStatus(err), ErrorResponse{
+			Error:   "게임 등록에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, game)
+}
+
+// 게임 정보를 수정 (관리자용)
+// @Summary 게임 수정
+// @Description 게임 정보를 수정합니다. 상태와 통계 값은 변경되지 않습니다. (관리자/중재자)
+// @Tags Games
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "게임 ID"
+// @Param request body GameRequest true "게임 정보"
+// @Success 200 {object} model.Game
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/games/{id} [put]
+func (h *GameHandler) AdminUpdateGame(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req GameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	game, err := h.gameService.UpdateGame(gameID, req.toModel())
+	if err != nil {
+		c.JSON(gameErrorStatus(err), ErrorResponse{
+			Error:   "게임 수정에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, game)
+}
+
+// 게임 상태를 변경 (관리자용)
+// @Summary 게임 상태 변경
+// @Description 게임 상태를 변경합니다. (active, inactive, maintenance, beta, alpha) (관리자/중재자)
+// @Tags Games
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "게임 ID"
+// @Param request body SetGameStatusRequest true "게임 상태"
+// @Success 200 {object} model.Game
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/admin/games/{id}/status [post]
+func (h *GameHandler) AdminSetGameStatus(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	gameID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req SetGameStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	game, err := h.gameService.SetGameStatus(gameID, model.GameStatus(req.Status))
+	if err != nil {
+		c.JSON(gameErrorStatus(err), ErrorResponse{
+			Error:   "게임 상태 변경에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, game)
+}
+
+// tags 쿼리(쉼표 구분)와 반복된 tag 쿼리를 합쳐 태그 목록으로 변환
+func parseTagsQuery(c *gin.Context) []string {
+	tags := c.QueryArray("tag")
+	if raw := c.Query("tags"); raw != "" {
+		tags = append(tags, strings.Split(raw, ",")...)
+	}
+	return tags
+}
+
+// 게임 서비스 에러를 HTTP 상태 코드로 변환
+func gameErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrGameNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrDuplicateGameName):
+		return http.StatusConflict
+	case errors.Is(err, model.ErrInvalidGame):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 게임 서비스
+type MockGameService struct {
+	mock.Mock
+}
+
+func (m *MockGameService) SearchGames(filter *service.GameSearchFilter) ([]model.Game, int64, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]model.Game), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockGameService) GetGameByID(id uint) (*model.Game, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Game), args.Error(1)
+}
+
+func (m *MockGameService) CreateGame(game *model.Game) error {
+	args := m.Called(game)
+	return args.Error(0)
+}
+
+func (m *MockGameService) UpdateGame(id uint, game *model.Game) (*model.Game, error) {
+	args := m.Called(id, game)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Game), args.Error(1)
+}
+
+func (m *MockGameService) GetTagCounts(limit int) ([]service.TagCount, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]service.TagCount), args.Error(1)
+}
+
+func (m *MockGameService) GetTrendingGames(limit int) ([]model.Game, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]model.Game), args.Error(1)
+}
+
+func (m *MockGameService) SetGameStatus(id uint, status model.GameStatus) (*model.Game, error) {
+	args := m.Called(id, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Game), args.Error(1)
+}
+
+// 테스트용 게임 라우터 설정
+func setupGameTestRouter() (*gin.Engine, *MockGameService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockGameService{}
+	handler := NewGameHandler(mockService)
+
+	router.GET("/api/games", handler.SearchGames)
+	router.GET("/api/games/tags", handler.GetTagCloud)
+	router.GET("/api/games/trending", handler.GetTrending)
+	router.GET("/api/games/:id", handler.GetGame)
+	admin := router.Group("/api/admin/games")
+	{
+		admin.POST("", handler.AdminCreateGame)
+		admin.PUT("/:id", handler.AdminUpdateGame)
+		admin.POST("/:id/status", handler.AdminSetGameStatus)
+	}
+
+	return router, mockService
+}
+
+// 게임 목록 검색 테스트
+func TestGameHandler_SearchGames(t *testing.T) {
+	router, mockService := setupGameTestRouter()
+	expected := &service.GameSearchFilter{
+		Category:     model.GameCategoryPuzzle,
+		Tags:         []string{"casual", "brain", "relax"},
+		MatchAllTags: true,
+		Free:         true,
+		SortBy:       service.GameSortRating,
+		Limit:        5,
+		Offset:       10,
+	}
+	mockService.On("SearchGames", expected).Return([]model.Game{{Name: "Block Puzzle"}}, int64(11), nil)
+
+	req, _ := http.NewRequest("GET", "/api/games?category=puzzle&tag=casual&tags=brain,relax&tag_mode=all&free=true&sort=rating&limit=5&offset=10", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response GameListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, int64(11), response.Total)
+	assert.Len(t, response.Games, 1)
+	mockService.AssertExpectations(t)
+}
+
+// 태그 클라우드 테스트
+func TestGameHandler_GetTagCloud(t *testing.T) {
+	router, mockService := setupGameTestRouter()
+	mockService.On("GetTagCounts", 3).Return([]service.TagCount{{Name: "casual", Count: 4}}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/games/tags?limit=3", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response TagCloudResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Total)
+	assert.Equal(t, "casual", response.Tags[0].Name)
+	mockService.AssertExpectations(t)
+}
+
+// 트렌딩 게임 목록 테스트
+func TestGameHandler_GetTrending(t *testing.T) {
+	router, mockService := setupGameTestRouter()
+	mockService.On("GetTrendingGames", 5).Return([]model.Game{{Name: "Tetris", TrendingScore: 12.5}}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/games/trending?limit=5", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response GameListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, int64(1), response.Total)
+	assert.Equal(t, 12.5, response.Games[0].TrendingScore)
+	mockService.AssertExpectations(t)
+}
+
+// 게임 상세 조회 테스트 (비활성 게임은 404)
+func TestGameHandler_GetGame(t *testing.T) {
+	router, mockService := setupGameTestRouter()
+	mockService.On("GetGameByID", uint(1)).Return(&model.Game{Name: "Chess", Status: model.GameStatusActive}, nil)
+	mockService.On("GetGameByID", uint(2)).Return(&model.Game{Name: "Retired", Status: model.GameStatusInactive}, nil)
+	mockService.On("GetGameByID", uint(3)).Return(nil, model.ErrGameNotFound)
+
+	for id, status := range map[int]int{1: http.StatusOK, 2: http.StatusNotFound, 3: http.StatusNotFound} {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/games/%d", id), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, status, w.Code, "game %d", id)
+	}
+	mockService.AssertExpectations(t)
+}
+
+// 관리자 게임 등록 테스트
+func TestGameHandler_AdminCreateGame(t *testing.T) {
+	tests := []struct {
+		name           string
+		role           string
+		body           string
+		mockError      error
+		expectedStatus int
+	}{
+		{name: "성공", role: "admin", body: `{"name":"Chess","category":"board","difficulty":"hard","tags":["classic"],"supported_platforms":["web","mobile"]}`, expectedStatus: http.StatusCreated},
+		{name: "유효성 검사 실패", role: "admin", body: `{"name":"Chess","category":"shooter","difficulty":"hard"}`, mockError: fmt.Errorf("%w: invalid game category", model.ErrInvalidGame), expectedStatus: http.StatusBadRequest},
+		{name: "중복 이름", role: "moderator", body: `{"name":"Chess","category":"board","difficulty":"hard"}`, mockError: model.ErrDuplicateGameName, expectedStatus: http.StatusConflict},
+		{name: "필수 값 누락", role: "admin", body: `{"category":"board"}`, expectedStatus: http.StatusBadRequest},
+		{name: "일반 사용자", role: "user", body: `{}`, expectedStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupGameTestRouter()
+			mockService.On("CreateGame", mock.AnythingOfType("*model.Game")).Return(tt.mockError)
+
+			req, _ := http.NewRequest("POST", "/api/admin/games", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, withAuthUser(req, 9, tt.role))
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+			if tt.expectedStatus == http.StatusCreated {
+				game := mockService.Calls[0].Arguments.Get(0).(*model.Game)
+				assert.Equal(t, "classic", game.Tags)
+				assert.Equal(t, "web,mobile", game.SupportedPlatforms)
+				assert.Equal(t, 1, game.MinLevel, "비어 있는 값은 기본값으로 채워야 합니다")
+			}
+		})
+	}
+}
+
+// 관리자 게임 수정/상태 변경 테스트
+func TestGameHandler_AdminUpdate(t *testing.T) {
+	router, mockService := setupGameTestRouter()
+	mockService.On("UpdateGame", uint(1), mock.AnythingOfType("*model.Game")).Return(&model.Game{Name: "Chess 2"}, nil)
+	mockService.On("UpdateGame", uint(2), mock.AnythingOfType("*model.Game")).Return(nil, model.ErrGameNotFound)
+	mockService.On("SetGameStatus", uint(1), model.GameStatusMaintenance).Return(&model.Game{Status: model.GameStatusMaintenance}, nil)
+
+	body := `{"name":"Chess 2","category":"board","difficulty":"hard"}`
+	req, _ := http.NewRequest("PUT", "/api/admin/games/1", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("PUT", "/api/admin/games/2", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	req, _ = http.NewRequest("POST", "/api/admin/games/1/status", bytes.NewBufferString(`{"status":"maintenance"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	mockService.AssertExpectations(t)
+}
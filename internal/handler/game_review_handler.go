@@ -0,0 +1,451 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type GameReviewServiceInterface interface {
+	SaveReview(userID, gameID uint, rating int, content string) (*model.GameReview, error)
+	DeleteReview(userID, reviewID uint) error
+	GetGameReviews(gameID uint, sort string, limit, offset int) ([]model.GameReview, int64, error)
+	GetUserReview(userID, gameID uint) (*model.GameReview, error)
+	MarkHelpful(userID, reviewID uint) (*model.GameReview, error)
+	ReportReview(userID, reviewID uint, reason string) error
+	GetModerationQueue(filter string, limit, offset int) ([]model.GameReview, error)
+	HideReview(moderatorID, reviewID uint, reason string) (*model.GameReview, error)
+	RestoreReview(reviewID uint) (*model.GameReview, error)
+}
+
+// 게임 리뷰 관련 HTTP 요청을 처리하는 핸들러
+type GameReviewHandler struct {
+	reviewService GameReviewServiceInterface
+}
+
+// 새로운 GameReviewHandler 인스턴스를 생성
+func NewGameReviewHandler(reviewService GameReviewServiceInterface) *GameReviewHandler {
+	return &GameReviewHandler{
+		reviewService: reviewService,
+	}
+}
+
+// 리뷰 작성/수정 요청
+type GameReviewRequest struct {
+	GameID  uint   `json:"game_id" binding:"required"`
+	Rating  int    `json:"rating" binding:"required,min=1,max=5"`
+	Content string `json:"content"`
+}
+
+// 리뷰 신고 요청
+type ReviewReportRequest struct {
+	Reason string `json:"reason" binding:"required,max=200"`
+}
+
+// 리뷰 숨김 요청
+type ReviewHideRequest struct {
+	Reason string `json:"reason" binding:"max=200"`
+}
+
+// 리뷰 목록 응답
+type GameReviewListResponse struct {
+	Reviews []model.GameReview `json:"reviews"`
+	Total   int64              `json:"total"`
+}
+
+// 게임 리뷰 목록을 조회
+// @Summary 게임 리뷰 목록
+// @Description 게임의 공개 리뷰를 조회합니다. sort=helpful이면 도움이 됐어요 많은 순, 기본은 최근 작성 순입니다.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Param game_id query int true "게임 ID"
+// @Param sort query string false "정렬 (recent, helpful)"
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} GameReviewListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/reviews [get]
+func (h *GameReviewHandler) GetReviews(c *gin.Context) {
+	gameID, ok := requireGameIDQuery(c)
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	reviews, total, err := h.reviewService.GetGameReviews(gameID, c.DefaultQuery("sort", service.ReviewSortRecent), limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "리뷰 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameReviewListResponse{
+		Reviews: reviews,
+		Total:   total,
+	})
+}
+
+// 게임에 작성한 내 리뷰를 조회
+// @Summary 내 리뷰
+// @Description 게임에 작성한 내 리뷰를 조회합니다. 숨김 처리된 리뷰도 조회됩니다.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param game_id query int true "게임 ID"
+// @Success 200 {object} model.GameReview
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/reviews/me [get]
+func (h *GameReviewHandler) GetMyReview(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	gameID, ok := requireGameIDQuery(c)
+	if !ok {
+		return
+	}
+
+	review, err := h.reviewService.GetUserReview(userInfo.UserID, gameID)
+	if err != nil {
+		c.JSON(gameReviewErrorStatus(err), ErrorResponse{
+			Error:   "리뷰 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// 리뷰를 작성하거나 수정
+// @Summary 리뷰 작성/수정
+// @Description 게임에 평점(1~5)과 선택 본문으로 리뷰를 남깁니다. 게임당 하나이며 다시 요청하면 수정됩니다. 게임을 완료한 플레이어만 작성할 수 있습니다.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body GameReviewRequest true "리뷰 정보"
+// @Success 200 {object} model.GameReview
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/reviews [put]
+func (h *GameReviewHandler) SaveReview(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	var req GameReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	review, err := h.reviewService.SaveReview(userInfo.UserID, req.GameID, req.Rating, req.Content)
+	if err != nil {
+		c.JSON(gameReviewErrorStatus(err), ErrorResponse{
+			Error:   "리뷰 저장에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// 내 리뷰를 삭제
+// @Summary 리뷰 삭제
+// @Description 내가 작성한 리뷰를 삭제하고 게임 평점을 다시 계산합니다.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "리뷰 ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/reviews/{id} [delete]
+func (h *GameReviewHandler) DeleteReview(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.reviewService.DeleteReview(userInfo.UserID, id); err != nil {
+		c.JSON(gameReviewErrorStatus(err), ErrorResponse{
+			Error:   "리뷰 삭제에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "리뷰가 삭제되었습니다",
+	})
+}
+
+// 리뷰에 도움이 됐어요를 표시
+// @Summary 리뷰 도움 투표
+// @Description 다른 사용자의 리뷰에 도움이 됐어요를 표시합니다. 리뷰당 한 번만 가능합니다.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "리뷰 ID"
+// @Success 200 {object} model.GameReview
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/reviews/{id}/helpful [post]
+func (h *GameReviewHandler) MarkHelpful(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	review, err := h.reviewService.MarkHelpful(userInfo.UserID, id)
+	if err != nil {
+		c.JSON(gameReviewErrorStatus(err), ErrorResponse{
+			Error:   "리뷰 투표에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// 부적절한 리뷰를 신고
+// @Summary 리뷰 신고
+// @Description 욕설이나 스팸 같은 부적절한 리뷰를 신고합니다. 신고된 리뷰는 중재자가 검토합니다.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "리뷰 ID"
+// @Param request body ReviewReportRequest true "신고 사유"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/reviews/{id}/report [post]
+func (h *GameReviewHandler) ReportReview(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req ReviewReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := h.reviewService.ReportReview(userInfo.UserID, id, req.Reason); err != nil {
+		c.JSON(gameReviewErrorStatus(err), ErrorResponse{
+			Error:   "리뷰 신고에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "리뷰가 신고되었습니다",
+	})
+}
+
+// 중재 대상 리뷰 목록을 조회 (관리자용)
+// @Summary 리뷰 중재 대기열
+// @Description 신고된 리뷰(신고 많은 순) 또는 숨김 처리된 리뷰를 조회합니다. (관리자/중재자)
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "reported(기본) 또는 hidden"
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} GameReviewListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /api/admin/reviews [get]
+func (h *GameReviewHandler) AdminListReviews(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	filter := c.DefaultQuery("status", service.ReviewFilterReported)
+	if filter != service.ReviewFilterReported && filter != service.ReviewFilterHidden {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 리뷰 상태입니다",
+			Message: "status는 reported 또는 hidden이어야 합니다",
+		})
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	reviews, err := h.reviewService.GetModerationQueue(filter, limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "리뷰 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameReviewListResponse{
+		Reviews: reviews,
+		Total:   int64(len(reviews)),
+	})
+}
+
+// 리뷰를 숨김 처리 (관리자용)
+// @Summary 리뷰 숨기기
+// @Description 부적절한 리뷰를 숨깁니다. 숨긴 리뷰는 목록과 게임 평점에서 제외됩니다. (관리자/중재자)
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "리뷰 ID"
+// @Param request body ReviewHideRequest false "숨김 사유"
+// @Success 200 {object} model.GameReview
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/admin/reviews/{id}/hide [post]
+func (h *GameReviewHandler) AdminHideReview(c *gin.Context) {
+	userInfo, ok := requireStaffUser(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req ReviewHideRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "잘못된 요청 형식입니다",
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
+	review, err := h.reviewService.HideReview(userInfo.UserID, id, req.Reason)
+	if err != nil {
+		c.JSON(gameReviewErrorStatus(err), ErrorResponse{
+			Error:   "리뷰 숨김 처리에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// 숨긴 리뷰를 복구 (관리자용)
+// @Summary 리뷰 복구
+// @Description 숨긴 리뷰를 다시 공개하고 신고 기록을 초기화합니다. (관리자/중재자)
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "리뷰 ID"
+// @Success 200 {object} model.GameReview
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/admin/reviews/{id}/restore [post]
+func (h *GameReviewHandler) AdminRestoreReview(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	review, err := h.reviewService.RestoreReview(id)
+	if err != nil {
+		c.JSON(gameReviewErrorStatus(err), ErrorResponse{
+			Error:   "리뷰 복구에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// 필수 게임 ID 쿼리를 파싱
+// 값이 없거나 형식이 잘못되면 400 응답을 작성
+func requireGameIDQuery(c *gin.Context) (uint, bool) {
+	gameID, ok := parseGameIDQuery(c)
+	if !ok {
+		return 0, false
+	}
+	if gameID == 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "게임 ID가 필요합니다",
+			Message: "game_id 쿼리 파라미터가 필요합니다",
+		})
+		return 0, false
+	}
+	return gameID, true
+}
+
+// 리뷰 서비스 에러를 HTTP 상태 코드로 변환
+func gameReviewErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrReviewNotFound),
+		errors.Is(err, model.ErrGameNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrReviewNotEligible),
+		errors.Is(err, model.ErrReviewHidden):
+		return http.StatusForbidden
+	case errors.Is(err, model.ErrReviewAlreadyVoted),
+		errors.Is(err, model.ErrReviewAlreadyReported):
+		return http.StatusConflict
+	case errors.Is(err, model.ErrInvalidReview),
+		errors.Is(err, model.ErrOwnReview):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
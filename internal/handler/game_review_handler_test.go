@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"g_dev/internal/model"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 게임 리뷰 서비스
+type MockGameReviewService struct {
+	mock.Mock
+}
+
+func (m *MockGameReviewService) SaveReview(userID, gameID uint, rating int, content string) (*model.GameReview, error) {
+	args := m.Called(userID, gameID, rating, content)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.GameReview), args.Error(1)
+}
+
+func (m *MockGameReviewService) DeleteReview(userID, reviewID uint) error {
+	args := m.Called(userID, reviewID)
+	return args.Error(0)
+}
+
+func (m *MockGameReviewService) GetGameReviews(gameID uint, sort string, limit, offset int) ([]model.GameReview, int64, error) {
+	args := m.Called(gameID, sort, limit, offset)
+	return args.Get(0).([]model.GameReview), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockGameReviewService) GetUserReview(userID, gameID uint) (*model.GameReview, error) {
+	args := m.Called(userID, gameID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.GameReview), args.Error(1)
+}
+
+func (m *MockGameReviewService) MarkHelpful(userID, reviewID uint) (*model.GameReview, error) {
+	args := m.Called(userID, reviewID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.GameReview), args.Error(1)
+}
+
+func (m *MockGameReviewService) ReportReview(userID, reviewID uint, reason string) error {
+	args := m.Called(userID, reviewID, reason)
+	return args.Error(0)
+}
+
+func (m *MockGameReviewService) GetModerationQueue(filter string, limit, offset int) ([]model.GameReview, error) {
+	args := m.Called(filter, limit, offset)
+	return args.Get(0).([]model.GameReview), args.Error(1)
+}
+
+func (m *MockGameReviewService) HideReview(moderatorID, reviewID uint, reason string) (*model.GameReview, error) {
+	args := m.Called(moderatorID, reviewID, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.GameReview), args.Error(1)
+}
+
+func (m *MockGameReviewService) RestoreReview(reviewID uint) (*model.GameReview, error) {
+	args := m.Called(reviewID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.GameReview), args.Error(1)
+}
+
+// 테스트용 게임 리뷰 라우터 설정
+func setupGameReviewTestRouter() (*gin.Engine, *MockGameReviewService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockGameReviewService{}
+	handler := NewGameReviewHandler(mockService)
+
+	reviews := router.Group("/api/reviews")
+	{
+		reviews.GET("", handler.GetReviews)
+		reviews.GET("/me", handler.GetMyReview)
+		reviews.PUT("", handler.SaveReview)
+		reviews.DELETE("/:id", handler.DeleteReview)
+		reviews.POST("/:id/helpful", handler.MarkHelpful)
+		reviews.POST("/:id/report", handler.ReportReview)
+	}
+	adminReviews := router.Group("/api/admin/reviews")
+	{
+		adminReviews.GET("", handler.AdminListReviews)
+		adminReviews.POST("/:id/hide", handler.AdminHideReview)
+		adminReviews.POST("/:id/restore", handler.AdminRestoreReview)
+	}
+
+	return router, mockService
+}
+
+// 리뷰 작성, 조회, 투표, 신고 테스트
+func TestGameReviewHandler_Player(t *testing.T) {
+	router, mockService := setupGameReviewTestRouter()
+	mockService.On("GetGameReviews", uint(3), "helpful", 20, 0).Return([]model.GameReview{{ID: 1, Rating: 5}}, int64(1), nil)
+	mockService.On("GetUserReview", uint(5), uint(3)).Return(nil, model.ErrReviewNotFound)
+	mockService.On("SaveReview", uint(5), uint(3), 4, "좋아요").Return(&model.GameReview{ID: 1, Rating: 4}, nil)
+	mockService.On("SaveReview", uint(5), uint(4), 4, "").Return(nil, model.ErrReviewNotEligible)
+	mockService.On("DeleteReview", uint(5), uint(1)).Return(nil)
+	mockService.On("MarkHelpful", uint(5), uint(2)).Return(nil, model.ErrReviewAlreadyVoted)
+	mockService.On("MarkHelpful", uint(5), uint(1)).Return(nil, model.ErrOwnReview)
+	mockService.On("ReportReview", uint(5), uint(2), "스팸").Return(nil)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           interface{}
+		userID         uint
+		expectedStatus int
+	}{
+		{name: "리뷰 목록", method: "GET", path: "/api/reviews?game_id=3&sort=helpful", expectedStatus: http.StatusOK},
+		{name: "게임 ID 없음", method: "GET", path: "/api/reviews", expectedStatus: http.StatusBadRequest},
+		{name: "내 리뷰 없음", method: "GET", path: "/api/reviews/me?game_id=3", userID: 5, expectedStatus: http.StatusNotFound},
+		{name: "인증 없는 내 리뷰", method: "GET", path: "/api/reviews/me?game_id=3", expectedStatus: http.StatusUnauthorized},
+		{name: "리뷰 작성", method: "PUT", path: "/api/reviews", body: GameReviewRequest{GameID: 3, Rating: 4, Content: "좋아요"}, userID: 5, expectedStatus: http.StatusOK},
+		{name: "완료하지 않은 게임", method: "PUT", path: "/api/reviews", body: GameReviewRequest{GameID: 4, Rating: 4}, userID: 5, expectedStatus: http.StatusForbidden},
+		{name: "평점 범위 초과", method: "PUT", path: "/api/reviews", body: GameReviewRequest{GameID: 3, Rating: 6}, userID: 5, expectedStatus: http.StatusBadRequest},
+		{name: "리뷰 삭제", method: "DELETE", path: "/api/reviews/1", userID: 5, expectedStatus: http.StatusOK},
+		{name: "중복 투표", method: "POST", path: "/api/reviews/2/helpful", userID: 5, expectedStatus: http.StatusConflict},
+		{name: "내 리뷰 투표", method: "POST", path: "/api/reviews/1/helpful", userID: 5, expectedStatus: http.StatusBadRequest},
+		{name: "리뷰 신고", method: "POST", path: "/api/reviews/2/report", body: ReviewReportRequest{Reason: "스팸"}, userID: 5, expectedStatus: http.StatusOK},
+		{name: "사유 없는 신고", method: "POST", path: "/api/reviews/2/report", body: ReviewReportRequest{}, userID: 5, expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := bytes.NewBuffer(nil)
+			if tt.body != nil {
+				data, _ := json.Marshal(tt.body)
+				body = bytes.NewBuffer(data)
+			}
+			req, _ := http.NewRequest(tt.method, tt.path, body)
+			req.Header.Set("Content-Type", "application/json")
+			if tt.userID != 0 {
+				req = withAuthUser(req, tt.userID, "user")
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+		})
+	}
+	mockService.AssertExpectations(t)
+}
+
+// 리뷰 중재 테스트
+func TestGameReviewHandler_Moderation(t *testing.T) {
+	router, mockService := setupGameReviewTestRouter()
+	mockService.On("GetModerationQueue", "reported", 20, 0).Return([]model.GameReview{{ID: 2, ReportCount: 3}}, nil)
+	mockService.On("HideReview", uint(9), uint(2), "스팸").Return(&model.GameReview{ID: 2, IsHidden: true}, nil)
+	mockService.On("RestoreReview", uint(7)).Return(nil, model.ErrReviewNotFound)
+
+	req, _ := http.NewRequest("GET", "/api/admin/reviews", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "moderator"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("GET", "/api/admin/reviews?status=deleted", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "moderator"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	body, _ := json.Marshal(ReviewHideRequest{Reason: "스팸"})
+	req, _ = http.NewRequest("POST", "/api/admin/reviews/2/hide", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "moderator"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("POST", "/api/admin/reviews/7/restore", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	req, _ = http.NewRequest("POST", "/api/admin/reviews/2/hide", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type GameSessionServiceInterface interface {
+	StartSession(userID uint, req *service.StartSessionRequest) (*service.GameSessionStart, error)
+	RecordEvent(token string, userID uint, event model.GameSessionEvent) (*model.GameSession, error)
+	EndSession(token string, userID uint, submission *service.ScoreSubmission) (*service.GameSessionResult, error)
+}
+
+// 게임 세션과 점수 제출 관련 HTTP 요청을 처리하는 핸들러
+type GameSessionHandler struct {
+	sessionService GameSessionServiceInterface
+}
+
+// 새로운 GameSessionHandler 인스턴스를 생성
+func NewGameSessionHandler(sessionService GameSessionServiceInterface) *GameSessionHandler {
+	return &GameSessionHandler{
+		sessionService: sessionService,
+	}
+}
+
+// 세션 시작 요청
+type StartSessionRequest struct {
+	GameID      uint   `json:"game_id" binding:"required"`
+	GameMode    string `json:"game_mode" binding:"max=50"`
+	PlayerCount int    `json:"player_count" binding:"min=0"`
+	Platform    string `json:"platform" binding:"max=20"`
+	GameVersion string `json:"game_version" binding:"max=20"`
+}
+
+// 세션 이벤트 요청
+type SessionEventRequest struct {
+	Token string `json:"token" binding:"required"`
+	Event string `json:"event" binding:"required,oneof=heartbeat pause resume save load restart"`
+}
+
+// 세션 종료(점수 제출) 요청
+type EndSessionRequest struct {
+	Token        string  `json:"token" binding:"required"`
+	GameID       uint    `json:"game_id"`
+	Score        int     `json:"score" binding:"min=0"`
+	Completed    bool    `json:"completed"`
+	Difficulty   string  `json:"difficulty" binding:"omitempty,oneof=easy normal hard expert"`
+	GameData     string  `json:"game_data" binding:"max=2000"`
+	SuccessRate  float64 `json:"success_rate" binding:"min=0,max=100"`
+	Accuracy     float64 `json:"accuracy" binding:"min=0,max=100"`
+	PlayTime     int     `json:"play_time" binding:"min=0"`
+	PauseCount   int     `json:"pause_count" binding:"min=0"`
+	SaveCount    int     `json:"save_count" binding:"min=0"`
+	LoadCount    int     `json:"load_count" binding:"min=0"`
+	RestartCount int     `json:"restart_count" binding:"min=0"`
+}
+
+// 요청을 서비스 제출 정보로 변환
+func (r *EndSessionRequest) toSubmission() *service.ScoreSubmission {
+	return &service.ScoreSubmission{
+		GameID:       r.GameID,
+		Score:        r.Score,
+		Completed:    r.Completed,
+		Difficulty:   model.GameDifficulty(r.Difficulty),
+		GameData:     r.GameData,
+		SuccessRate:  r.SuccessRate,
+		Accuracy:     r.Accuracy,
+		PlayTime:     r.PlayTime,
+		PauseCount:   r.PauseCount,
+		SaveCount:    r.SaveCount,
+		LoadCount:    r.LoadCount,
+		RestartCount: r.RestartCount,
+	}
+}
+
+// 게임 세션을 시작
+// @Summary 게임 세션 시작
+// @Description 게임 플레이 세션을 시작하고 이벤트/점수 제출에 사용할 서명된 세션 토큰을 발급합니다.
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body StartSessionRequest true "세션 시작 정보"
+// @Success 201 {object} service.GameSessionStart
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/sessions [post]
+func (h *GameSessionHandler) StartSession(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	var req StartSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	started, err := h.sessionService.StartSession(userInfo.UserID, &service.StartSessionRequest{
+		GameID:      req.GameID,
+		GameMode:    req.GameMode,
+		PlayerCount: req.PlayerCount,
+		Platform:    req.Platform,
+		GameVersion: req.GameVersion,
+		IPAddress:   c.ClientIP(),
+		UserAgent:   c.Request.UserAgent(),
+	})
+	if err != nil {
+		c.JSON(gameSessionErrorStatus(err), ErrorResponse{
+			Error:   "게임 세션 시작에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, started)
+}
+
+// 게임 세션 이벤트를 기록
+// @Summary 게임 세션 이벤트
+// @Description 하트비트, 일시정지/재개, 저장/불러오기, 재시작 이벤트를 기록합니다.
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body SessionEventRequest true "세션 이벤트"
+// @Success 200 {object} model.GameSession
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 410 {object} ErrorResponse
+// @Router /api/sessions/events [post]
+func (h *GameSessionHandler) RecordEvent(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	var req SessionEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	session, err := h.sessionService.RecordEvent(req.Token, userInfo.UserID, model.GameSessionEvent(req.Event))
+	if err != nil {
+		c.JSON(gameSessionErrorStatus(err), ErrorResponse{
+			Error:   "세션 이벤트 기록에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+// 게임 세션을 종료하고 점수를 제출
+// @Summary 게임 세션 종료
+// @Description 세션을 종료하고 점수를 제출합니다. 제출 내용이 세션 기록과 일치해야 점수와 보상이 기록됩니다.
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body EndSessionRequest true "점수 제출 정보"
+// @Success 200 {object} service.GameSessionResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 410 {object} ErrorResponse
+// @Router /api/sessions/end [post]
+func (h *GameSessionHandler) EndSession(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	var req EndSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.sessionService.EndSession(req.Token, userInfo.UserID, req.toSubmission())
+	if err != nil {
+		c.JSON(gameSessionErrorStatus(err), ErrorResponse{
+			Error:   "점수 제출에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 게임 세션 서비스 에러를 HTTP 상태 코드로 변환
+func gameSessionErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrGameNotFound),
+		errors.Is(err, model.ErrSessionNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrInvalidSessionToken),
+		errors.Is(err, model.ErrLevelTooLow):
+		return http.StatusForbidden
+	case errors.Is(err, model.ErrSessionInProgress),
+		errors.Is(err, model.ErrSessionClosed),
+		errors.Is(err, model.ErrInvalidSessionEvent),
+		errors.Is(err, model.ErrGameNotPlayable):
+		return http.StatusConflict
+	case errors.Is(err, model.ErrSessionExpired):
+		return http.StatusGone
+	case errors.Is(err, model.ErrSessionMismatch),
+		errors.Is(err, model.ErrTooManyPlayers),
+		errors.Is(err, model.ErrInvalidScore),
+		errors.Is(err, model.ErrInvalidRewardSettings):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
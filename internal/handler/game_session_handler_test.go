@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 게임 세션 서비스
+type MockGameSessionService struct {
+	mock.Mock
+}
+
+func (m *MockGameSessionService) StartSession(userID uint, req *service.StartSessionRequest) (*service.GameSessionStart, error) {
+	args := m.Called(userID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.GameSessionStart), args.Error(1)
+}
+
+func (m *MockGameSessionService) RecordEvent(token string, userID uint, event model.GameSessionEvent) (*model.GameSession, error) {
+	args := m.Called(token, userID, event)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.GameSession), args.Error(1)
+}
+
+func (m *MockGameSessionService) EndSession(token string, userID uint, submission *service.ScoreSubmission) (*service.GameSessionResult, error) {
+	args := m.Called(token, userID, submission)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.GameSessionResult), args.Error(1)
+}
+
+// 테스트용 게임 세션 라우터 설정
+func setupGameSessionTestRouter() (*gin.Engine, *MockGameSessionService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockGameSessionService{}
+	handler := NewGameSessionHandler(mockService)
+
+	sessions := router.Group("/api/sessions")
+	{
+		sessions.POST("", handler.StartSession)
+		sessions.POST("/events", handler.RecordEvent)
+		sessions.POST("/end", handler.EndSession)
+	}
+
+	return router, mockService
+}
+
+// 세션 시작 테스트
+func TestGameSessionHandler_StartSession(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           map[string]interface{}
+		mockError      error
+		expectedStatus int
+	}{
+		{name: "성공", body: map[string]interface{}{"game_id": 1}, expectedStatus: http.StatusCreated},
+		{name: "게임 ID 누락", body: map[string]interface{}{}, expectedStatus: http.StatusBadRequest},
+		{name: "레벨 부족", body: map[string]interface{}{"game_id": 1}, mockError: model.ErrLevelTooLow, expectedStatus: http.StatusForbidden},
+		{name: "진행 중인 세션", body: map[string]interface{}{"game_id": 1}, mockError: model.ErrSessionInProgress, expectedStatus: http.StatusConflict},
+		{name: "없는 게임", body: map[string]interface{}{"game_id": 1}, mockError: model.ErrGameNotFound, expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupGameSessionTestRouter()
+			if tt.expectedStatus != http.StatusBadRequest {
+				call := mockService.On("StartSession", uint(1), mock.MatchedBy(func(req *service.StartSessionRequest) bool {
+					return req.GameID == 1
+				}))
+				if tt.mockError != nil {
+					call.Return(nil, tt.mockError)
+				} else {
+					call.Return(&service.GameSessionStart{Session: &model.GameSession{GameID: 1}, Token: "abc.def"}, nil)
+				}
+			}
+
+			body, _ := json.Marshal(tt.body)
+			req, _ := http.NewRequest("POST", "/api/sessions", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+			mockService.AssertExpectations(t)
+		})
+	}
+
+	router, _ := setupGameSessionTestRouter()
+	req, _ := http.NewRequest("POST", "/api/sessions", bytes.NewBufferString(`{"game_id":1}`))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+// 세션 이벤트 테스트
+func TestGameSessionHandler_RecordEvent(t *testing.T) {
+	router, mockService := setupGameSessionTestRouter()
+	mockService.On("RecordEvent", "abc.def", uint(1), model.GameSessionEventPause).
+		Return(&model.GameSession{Status: model.GameSessionPaused}, nil)
+	mockService.On("RecordEvent", "abc.def", uint(1), model.GameSessionEventHeartbeat).
+		Return(nil, model.ErrSessionExpired)
+
+	send := func(body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("POST", "/api/sessions/events", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+		return w
+	}
+
+	w := send(`{"token":"abc.def","event":"pause"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var session model.GameSession
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &session))
+	assert.Equal(t, model.GameSessionPaused, session.Status)
+
+	assert.Equal(t, http.StatusGone, send(`{"token":"abc.def","event":"heartbeat"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, send(`{"token":"abc.def","event":"jump"}`).Code)
+	mockService.AssertExpectations(t)
+}
+
+// 세션 종료(점수 제출) 테스트
+func TestGameSessionHandler_EndSession(t *testing.T) {
+	router, mockService := setupGameSessionTestRouter()
+	mockService.On("EndSession", "abc.def", uint(1), mock.MatchedBy(func(s *service.ScoreSubmission) bool {
+		return s.Score == 500 && s.Completed && s.SaveCount == 1
+	})).Return(&service.GameSessionResult{Score: &model.Score{Score: 500}, Gold: 50, IsHighScore: true}, nil)
+	mockService.On("EndSession", "abc.def", uint(1), mock.MatchedBy(func(s *service.ScoreSubmission) bool {
+		return s.Score == 999
+	})).Return(nil, model.ErrSessionMismatch)
+
+	send := func(body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("POST", "/api/sessions/end", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+		return w
+	}
+
+	w := send(`{"token":"abc.def","score":500,"completed":true,"save_count":1}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var result service.GameSessionResult
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(t, 50, result.Gold)
+	assert.True(t, result.IsHighScore)
+
+	assert.Equal(t, http.StatusBadRequest, send(`{"token":"abc.def","score":999}`).Code)
+	assert.Equal(t, http.StatusBadRequest, send(`{"score":500}`).Code, "토큰 없이 제출할 수 없어야 합니다")
+	assert.Equal(t, http.StatusBadRequest, send(`{"token":"abc.def","score":-1}`).Code)
+	mockService.AssertExpectations(t)
+}
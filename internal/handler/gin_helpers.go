@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"g_dev/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// 목록 조회 기본 개수
+	defaultPageLimit = 20
+
+	// 목록 조회 최대 개수
+	maxPageLimit = 100
+)
+
+// gin 핸들러에서 인증된 사용자 정보를 가져옴
+// JWT 미들웨어가 요청 컨텍스트에 저장한 사용자 정보를 사용하며, 없으면 401 응답을 작성
+func requireAuthUser(c *gin.Context) (*middleware.UserInfo, bool) {
+	userInfo, ok := middleware.GetUserFromContext(c.Request.Context())
+	if !ok {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error:   "인증이 필요합니다",
+			Message: "유효한 액세스 토큰이 필요합니다",
+		})
+		return nil, false
+	}
+	return userInfo, true
+}
+
+// 인증된 사용자가 관리자 또는 중재자인지 확인
+// 권한이 없으면 403 응답을 작성
+func requireStaffUser(c *gin.Context) (*middleware.UserInfo, bool) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return nil, false
+	}
+	if userInfo.Role != "admin" && userInfo.Role != "moderator" {
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Error:   "권한이 없습니다",
+			Message: "관리자 또는 중재자 권한이 필요합니다",
+		})
+		return nil, false
+	}
+	return userInfo, true
+}
+
+// 경로 파라미터를 uint ID로 파싱
+// 형식이 잘못되었으면 400 응답을 작성
+func parseIDParam(c *gin.Context, name string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 ID 형식입니다",
+			Message: err.Error(),
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// 선택적인 user_id 쿼리 파라미터를 파싱 (없으면 0)
+// 형식이 잘못되었으면 400 응답을 작성
+func parseUserIDQuery(c *gin.Context) (uint, bool) {
+	raw := c.Query("user_id")
+	if raw == "" {
+		return 0, true
+	}
+
+	userID, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 사용자 ID 형식입니다",
+			Message: err.Error(),
+		})
+		return 0, false
+	}
+	return uint(userID), true
+}
+
+// limit, offset 쿼리 파라미터를 파싱
+// 값이 없거나 잘못되었으면 기본값을 사용
+func parsePagination(c *gin.Context) (int, int) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultPageLimit)))
+	if err != nil || limit <= 0 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	return limit, offset
+}
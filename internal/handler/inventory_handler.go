@@ -9,14 +9,14 @@ import (
 )
 
 type InventoryServiceInterface interface {
-	CreateInventory(inventory *model.Inventory) error
+	CreateInventory(actorID uint, inventory *model.Inventory) error
 	GetInventoryByID(id uint) (*model.Inventory, error)
 	GetUserInventory(userID uint) ([]model.Inventory, error)
 	GetUserInventoryByType(userID uint, itemType string) ([]model.Inventory, error)
 	GetUserInventoryByRarity(userID uint, rarity string) ([]model.Inventory, error)
-	UpdateInventory(inventory *model.Inventory) error
-	DeleteInventory(id uint) error
-	AddItemQuantity(userID uint, itemID string, quantity int) error
+	UpdateInventory(actorID uint, inventory *model.Inventory) error
+	DeleteInventory(actorID, id uint) error
+	AddItemQuantity(actorID, userID uint, itemID string, quantity int) error
 	UseItem(userID uint, itemID string) error
 	GetUserInventoryStats(userID uint) (*service.InventoryStats, error)
 	ActivateItem(userID uint, itemID string) error
@@ -46,6 +46,7 @@ type CreateInventoryRequest struct {
 	Rarity   string `json:"rarity" binding:"required"`
 	Level    int    `json:"level" binding:"required,min=1"`
 	IsActive bool   `json:"is_active"`
+	IsBound  bool   `json:"is_bound"`
 }
 
 // 인벤토리 업데이터 요청
@@ -74,6 +75,7 @@ type InventoryResponse struct {
 	Rarity      string `json:"rarity"`
 	Level       int    `json:"level"`
 	IsActive    bool   `json:"is_active"`
+	IsBound     bool   `json:"is_bound"`
 	RarityColor string `json:"rarity_color"`
 }
 
@@ -100,16 +102,24 @@ type SuccessResponse struct {
 
 // 새로운 인벤토리 아이템을 생성
 // @Summary 인벤토리 아이템 생성
-// @Description 새로운 인벤토리 아이템을 생성합니다.
+// @Description 새로운 인벤토리 아이템을 생성합니다. (관리자/중재자)
 // @Tags Inventory
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param inventory body CreateInventoryRequest true "인벤토리 정보"
 // @Success 201 {object} InventoryResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /inventory [post]
 func (h *InventoryHandler) CreateInventory(c *gin.Context) {
+	admin, ok := requireStaffUser(c)
+	if !ok {
+		return
+	}
+
 	var req CreateInventoryRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
@@ -128,9 +138,10 @@ func (h *InventoryHandler) CreateInventory(c *gin.Context) {
 		Rarity:   req.Rarity,
 		Level:    req.Level,
 		IsActive: req.IsActive,
+		IsBound:  req.IsBound,
 	}
 
-	if err := h.inventoryService.CreateInventory(inventory); err != nil {
+	if err := h.inventoryService.CreateInventory(admin.UserID, inventory); err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error:   "인벤토리 생성에 실패했습니다",
 			Message: err.Error(),
@@ -148,6 +159,7 @@ func (h *InventoryHandler) CreateInventory(c *gin.Context) {
 		Rarity:      inventory.Rarity,
 		Level:       inventory.Level,
 		IsActive:    inventory.IsActive,
+		IsBound:     inventory.IsBound,
 		RarityColor: inventory.GetRarityColor(),
 	}
 
@@ -195,6 +207,7 @@ func (h *InventoryHandler) GetInventoryByID(c *gin.Context) {
 		Rarity:      inventory.Rarity,
 		Level:       inventory.Level,
 		IsActive:    inventory.IsActive,
+		IsBound:     inventory.IsBound,
 		RarityColor: inventory.GetRarityColor(),
 	}
 
@@ -247,6 +260,7 @@ func (h *InventoryHandler) GetUserInventory(c *gin.Context) {
 			Rarity:      inventory.Rarity,
 			Level:       inventory.Level,
 			IsActive:    inventory.IsActive,
+			IsBound:     inventory.IsBound,
 			RarityColor: inventory.GetRarityColor(),
 		}
 	}
@@ -310,6 +324,7 @@ func (h *InventoryHandler) GetUserInventoryByType(c *gin.Context) {
 			Rarity:      inventory.Rarity,
 			Level:       inventory.Level,
 			IsActive:    inventory.IsActive,
+			IsBound:     inventory.IsBound,
 			RarityColor: inventory.GetRarityColor(),
 		}
 	}
@@ -373,6 +388,7 @@ func (h *InventoryHandler) GetUserInventoryByRarity(c *gin.Context) {
 			Rarity:      inventory.Rarity,
 			Level:       inventory.Level,
 			IsActive:    inventory.IsActive,
+			IsBound:     inventory.IsBound,
 			RarityColor: inventory.GetRarityColor(),
 		}
 	}
@@ -382,18 +398,26 @@ func (h *InventoryHandler) GetUserInventoryByRarity(c *gin.Context) {
 
 // 인벤토리 아이템을 업데이트
 // @Summary 인벤토리 아이템 업데이트
-// @Description 인벤토리 아이템을 업데이트합니다.
+// @Description 인벤토리 아이템을 업데이트합니다. (관리자/중재자)
 // @Tags Inventory
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path int true "인벤토리 ID"
 // @Param inventory body UpdateInventoryRequest true "업데이트할 인벤토리 정보"
 // @Success 200 {object} InventoryResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /inventory/{id} [put]
 func (h *InventoryHandler) UpdateInventory(c *gin.Context) {
+	admin, ok := requireStaffUser(c)
+	if !ok {
+		return
+	}
+
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
@@ -441,7 +465,7 @@ func (h *InventoryHandler) UpdateInventory(c *gin.Context) {
 	}
 	existingInventory.IsActive = req.IsActive
 
-	if err := h.inventoryService.UpdateInventory(existingInventory); err != nil {
+	if err := h.inventoryService.UpdateInventory(admin.UserID, existingInventory); err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error:   "인벤토리 업데이트에 실패했습니다",
 			Message: err.Error(),
@@ -459,6 +483,7 @@ func (h *InventoryHandler) UpdateInventory(c *gin.Context) {
 		Rarity:      existingInventory.Rarity,
 		Level:       existingInventory.Level,
 		IsActive:    existingInventory.IsActive,
+		IsBound:     existingInventory.IsBound,
 		RarityColor: existingInventory.GetRarityColor(),
 	}
 
@@ -467,16 +492,24 @@ func (h *InventoryHandler) UpdateInventory(c *gin.Context) {
 
 // 인벤토리 아이템을 삭제
 // @Summary 인벤토리 아이템 삭제
-// @Description 인벤토리 아이템을 삭제합니다.
+// @Description 인벤토리 아이템을 삭제합니다. (관리자/중재자)
 // @Tags Inventory
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path int true "인벤토리 ID"
 // @Success 204 "No Content"
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /inventory/{id} [delete]
 func (h *InventoryHandler) DeleteInventory(c *gin.Context) {
+	admin, ok := requireStaffUser(c)
+	if !ok {
+		return
+	}
+
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
@@ -487,7 +520,7 @@ func (h *InventoryHandler) DeleteInventory(c *gin.Context) {
 		return
 	}
 
-	if err := h.inventoryService.DeleteInventory(uint(id)); err != nil {
+	if err := h.inventoryService.DeleteInventory(admin.UserID, uint(id)); err != nil {
 		c.JSON(http.StatusNotFound, ErrorResponse{
 			Error:   "삭제할 인벤토리 아이템을 찾을 수 없습니다",
 			Message: err.Error(),
@@ -500,19 +533,27 @@ func (h *InventoryHandler) DeleteInventory(c *gin.Context) {
 
 // 특정 아이템의 수량을 증가
 // @Summary 아이템 수량 추가
-// @Description 특정 아이템의 수량을 증가시킵니다.
+// @Description 특정 아이템의 수량을 증가시킵니다. (관리자/중재자)
 // @Tags Inventory
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param user_id path int true "사용자 ID"
 // @Param item_id path string true "아이템 ID"
 // @Param request body AddItemQuantityRequest true "추가할 수량"
 // @Success 200 {object} InventoryResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /inventory/user/{user_id}/item/{item_id}/add [post]
 func (h *InventoryHandler) AddItemQuantity(c *gin.Context) {
+	admin, ok := requireStaffUser(c)
+	if !ok {
+		return
+	}
+
 	userIDStr := c.Param("user_id")
 	userID, err := strconv.ParseUint(userIDStr, 10, 32)
 	if err != nil {
@@ -541,7 +582,7 @@ func (h *InventoryHandler) AddItemQuantity(c *gin.Context) {
 		return
 	}
 
-	if err := h.inventoryService.AddItemQuantity(uint(userID), itemID, req.Quantity); err != nil {
+	if err := h.inventoryService.AddItemQuantity(admin.UserID, uint(userID), itemID, req.Quantity); err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error:   "아이템 수량 추가에 실패했습니다",
 			Message: err.Error(),
@@ -782,6 +823,7 @@ func (h *InventoryHandler) GetActiveItems(c *gin.Context) {
 			Rarity:      inventory.Rarity,
 			Level:       inventory.Level,
 			IsActive:    inventory.IsActive,
+			IsBound:     inventory.IsBound,
 			RarityColor: inventory.GetRarityColor(),
 		}
 	}
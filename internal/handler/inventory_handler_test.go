@@ -18,8 +18,8 @@ type MockInventoryService struct {
 	mock.Mock
 }
 
-func (m *MockInventoryService) CreateInventory(inventory *model.Inventory) error {
-	args := m.Called(inventory)
+func (m *MockInventoryService) CreateInventory(actorID uint, inventory *model.Inventory) error {
+	args := m.Called(actorID, inventory)
 	return args.Error(0)
 }
 
@@ -43,18 +43,18 @@ func (m *MockInventoryService) GetUserInventoryByRarity(userID uint, rarity stri
 	return args.Get(0).([]model.Inventory), args.Error(1)
 }
 
-func (m *MockInventoryService) UpdateInventory(inventory *model.Inventory) error {
-	args := m.Called(inventory)
+func (m *MockInventoryService) UpdateInventory(actorID uint, inventory *model.Inventory) error {
+	args := m.Called(actorID, inventory)
 	return args.Error(0)
 }
 
-func (m *MockInventoryService) DeleteInventory(id uint) error {
-	args := m.Called(id)
+func (m *MockInventoryService) DeleteInventory(actorID, id uint) error {
+	args := m.Called(actorID, id)
 	return args.Error(0)
 }
 
-func (m *MockInventoryService) AddItemQuantity(userID uint, itemID string, quantity int) error {
-	args := m.Called(userID, itemID, quantity)
+func (m *MockInventoryService) AddItemQuantity(actorID, userID uint, itemID string, quantity int) error {
+	args := m.Called(actorID, userID, itemID, quantity)
 	return args.Error(0)
 }
 
@@ -83,6 +83,9 @@ func (m *MockInventoryService) GetActiveItems(userID uint) ([]model.Inventory, e
 	return args.Get(0).([]model.Inventory), args.Error(1)
 }
 
+// 인벤토리를 직접 변경하는 테스트 관리자 ID
+const testAdminID uint = 9
+
 // 테스트용 라우터 설정
 func setupTestRouter() (*gin.Engine, *MockInventoryService) {
 	gin.SetMode(gin.TestMode)
@@ -179,12 +182,13 @@ func TestInventoryHandler_CreateInventory(t *testing.T) {
 
 			// Mock 설정
 			if !tt.expectError {
-				mockService.On("CreateInventory", mock.AnythingOfType("*model.Inventory")).Return(nil)
+				mockService.On("CreateInventory", testAdminID, mock.AnythingOfType("*model.Inventory")).Return(nil)
 			}
 
 			// 요청 생성
 			jsonData, _ := json.Marshal(tt.requestBody)
 			req, _ := http.NewRequest("POST", "/inventory", bytes.NewBuffer(jsonData))
+			req = withAuthUser(req, testAdminID, "admin")
 			req.Header.Set("Content-Type", "application/json")
 
 			// 응답 기록
@@ -422,12 +426,13 @@ func TestInventoryHandler_AddItemQuantity(t *testing.T) {
 
 			// Mock 설정
 			if tt.mockError == nil && tt.expectedStatus == http.StatusOK {
-				mockService.On("AddItemQuantity", uint(1), tt.itemID, tt.requestBody.Quantity).Return(tt.mockError)
+				mockService.On("AddItemQuantity", testAdminID, uint(1), tt.itemID, tt.requestBody.Quantity).Return(tt.mockError)
 			}
 
 			// 요청 생성
 			jsonData, _ := json.Marshal(tt.requestBody)
 			req, _ := http.NewRequest("POST", "/inventory/user/"+tt.userID+"/item/"+tt.itemID+"/add", bytes.NewBuffer(jsonData))
+			req = withAuthUser(req, testAdminID, "admin")
 			req.Header.Set("Content-Type", "application/json")
 
 			// 응답 기록
@@ -550,13 +555,14 @@ func TestInventoryHandler_DeleteInventory(t *testing.T) {
 
 			// Mock 설정
 			if tt.inventoryID == "1" {
-				mockService.On("DeleteInventory", uint(1)).Return(tt.mockError)
+				mockService.On("DeleteInventory", testAdminID, uint(1)).Return(tt.mockError)
 			} else if tt.inventoryID == "999" {
-				mockService.On("DeleteInventory", uint(999)).Return(tt.mockError)
+				mockService.On("DeleteInventory", testAdminID, uint(999)).Return(tt.mockError)
 			}
 
 			// 요청 생성
 			req, _ := http.NewRequest("DELETE", "/inventory/"+tt.inventoryID, nil)
+			req = withAuthUser(req, testAdminID, "admin")
 
 			// 응답 기록
 			w := httptest.NewRecorder()
@@ -570,6 +576,24 @@ func TestInventoryHandler_DeleteInventory(t *testing.T) {
 	}
 }
 
+// 관리 API는 관리자/중재자만 호출할 수 있는지 테스트
+func TestInventoryHandler_RequiresStaff(t *testing.T) {
+	router, mockService := setupTestRouter()
+
+	req, _ := http.NewRequest("DELETE", "/inventory/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code, "인증 없이는 삭제할 수 없어야 합니다")
+
+	req, _ = http.NewRequest("DELETE", "/inventory/1", nil)
+	req = withAuthUser(req, 1, "user")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code, "일반 사용자는 삭제할 수 없어야 합니다")
+
+	mockService.AssertNotCalled(t, "DeleteInventory", mock.Anything, mock.Anything)
+}
+
 // 통합 테스트를 수행
 func TestInventoryHandler_Integration(t *testing.T) {
 	t.Run("전체 인벤토리 핸들러 생명주기 테스트", func(t *testing.T) {
@@ -587,10 +611,11 @@ func TestInventoryHandler_Integration(t *testing.T) {
 			IsActive: false,
 		}
 
-		mockService.On("CreateInventory", mock.AnythingOfType("*model.Inventory")).Return(nil)
+		mockService.On("CreateInventory", testAdminID, mock.AnythingOfType("*model.Inventory")).Return(nil)
 
 		jsonData, _ := json.Marshal(createRequest)
 		req, _ := http.NewRequest("POST", "/inventory", bytes.NewBuffer(jsonData))
+		req = withAuthUser(req, testAdminID, "admin")
 		req.Header.Set("Content-Type", "application/json")
 
 		w := httptest.NewRecorder()
@@ -621,10 +646,11 @@ func TestInventoryHandler_Integration(t *testing.T) {
 
 		// 3. 아이템 수량 추가
 		addRequest := AddItemQuantityRequest{Quantity: 5}
-		mockService.On("AddItemQuantity", uint(1), "test_sword", 5).Return(nil)
+		mockService.On("AddItemQuantity", testAdminID, uint(1), "test_sword", 5).Return(nil)
 
 		jsonData, _ = json.Marshal(addRequest)
 		req, _ = http.NewRequest("POST", "/inventory/user/1/item/test_sword/add", bytes.NewBuffer(jsonData))
+		req = withAuthUser(req, testAdminID, "admin")
 		req.Header.Set("Content-Type", "application/json")
 
 		w = httptest.NewRecorder()
@@ -642,9 +668,10 @@ func TestInventoryHandler_Integration(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code, "아이템 사용이 성공해야 합니다")
 
 		// 5. 인벤토리 삭제
-		mockService.On("DeleteInventory", uint(1)).Return(nil)
+		mockService.On("DeleteInventory", testAdminID, uint(1)).Return(nil)
 
 		req, _ = http.NewRequest("DELETE", "/inventory/1", nil)
+		req = withAuthUser(req, testAdminID, "admin")
 		w = httptest.NewRecorder()
 		router.ServeHTTP(w, req)
 
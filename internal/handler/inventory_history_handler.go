@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type InventoryHistoryServiceInterface interface {
+	GetHistory(userID uint, itemID string, limit, offset int) ([]model.InventoryLog, error)
+	RevertChange(logID, adminID uint) (*model.InventoryLog, error)
+	RestoreItem(inventoryID, adminID uint) (*model.Inventory, error)
+}
+
+// 인벤토리 변경 기록 관련 HTTP 요청을 처리하는 핸들러
+type InventoryHistoryHandler struct {
+	historyService InventoryHistoryServiceInterface
+}
+
+// 새로운 InventoryHistoryHandler 인스턴스를 생성
+func NewInventoryHistoryHandler(historyService InventoryHistoryServiceInterface) *InventoryHistoryHandler {
+	return &InventoryHistoryHandler{
+		historyService: historyService,
+	}
+}
+
+// 인벤토리 변경 기록 목록 응답
+type InventoryHistoryResponse struct {
+	Logs  []model.InventoryLog `json:"logs"`
+	Total int                  `json:"total"`
+}
+
+// 내 아이템 변경 기록을 조회
+// @Summary 아이템 변경 기록 조회
+// @Description 로그인한 사용자의 아이템 획득/소모 기록을 최신순으로 조회합니다.
+// @Tags Inventory
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param item_id query string false "아이템 ID"
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} InventoryHistoryResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/inventory/history [get]
+func (h *InventoryHistoryHandler) GetMyHistory(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	h.respondHistory(c, userInfo.UserID, c.Query("item_id"), limit, offset)
+}
+
+// 아이템 변경 기록을 조회 (관리자용)
+// @Summary 아이템 변경 기록 감사
+// @Description 전체 또는 특정 사용자의 아이템 변경 기록을 조회합니다. (관리자/중재자)
+// @Tags Inventory
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param user_id query int false "사용자 ID"
+// @Param item_id query string false "아이템 ID"
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} InventoryHistoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /api/admin/inventory/history [get]
+func (h *InventoryHistoryHandler) AdminGetHistory(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	userID, ok := parseUserIDQuery(c)
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	h.respondHistory(c, userID, c.Query("item_id"), limit, offset)
+}
+
+// 특정 변경을 되돌림 (관리자용)
+// @Summary 아이템 변경 되돌리기
+// @Description 기록된 아이템 변경을 반대로 적용합니다. 아이템 외 자산(골드 등)은 되돌리지 않습니다. (관리자/중재자)
+// @Tags Inventory
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "변경 기록 ID"
+// @Success 200 {object} model.InventoryLog
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/inventory/history/{id}/revert [post]
+func (h *InventoryHistoryHandler) AdminRevertChange(c *gin.Context) {
+	userInfo, ok := requireStaffUser(c)
+	if !ok {
+		return
+	}
+
+	logID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	entry, err := h.historyService.RevertChange(logID, userInfo.UserID)
+	if err != nil {
+		c.JSON(inventoryHistoryErrorStatus(err), ErrorResponse{
+			Error:   "변경 되돌리기에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, entry)
+}
+
+// 삭제된 아이템을 복구 (관리자용)
+// @Summary 아이템 복구
+// @Description 삭제된 인벤토리 아이템을 삭제 직전 수량으로 복구합니다. (관리자/중재자)
+// @Tags Inventory
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "인벤토리 ID"
+// @Success 200 {object} model.Inventory
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/inventory/items/{id}/restore [post]
+func (h *InventoryHistoryHandler) AdminRestoreItem(c *gin.Context) {
+	userInfo, ok := requireStaffUser(c)
+	if !ok {
+		return
+	}
+
+	inventoryID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	item, err := h.historyService.RestoreItem(inventoryID, userInfo.UserID)
+	if err != nil {
+		c.JSON(inventoryHistoryErrorStatus(err), ErrorResponse{
+			Error:   "아이템 복구에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+// 변경 기록 조회 결과를 응답으로 작성
+func (h *InventoryHistoryHandler) respondHistory(c *gin.Context, userID uint, itemID string, limit, offset int) {
+	logs, err := h.historyService.GetHistory(userID, itemID, limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "아이템 변경 기록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, InventoryHistoryResponse{
+		Logs:  logs,
+		Total: len(logs),
+	})
+}
+
+// 인벤토리 기록 서비스 에러를 HTTP 상태 코드로 변환
+func inventoryHistoryErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrInventoryLogNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrAlreadyReverted),
+		errors.Is(err, model.ErrRevertConflict),
+		errors.Is(err, model.ErrItemNotDeleted):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
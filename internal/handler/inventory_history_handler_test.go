@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"encoding/json"
+	"g_dev/internal/model"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 인벤토리 기록 서비스
+type MockInventoryHistoryService struct {
+	mock.Mock
+}
+
+func (m *MockInventoryHistoryService) GetHistory(userID uint, itemID string, limit, offset int) ([]model.InventoryLog, error) {
+	args := m.Called(userID, itemID, limit, offset)
+	return args.Get(0).([]model.InventoryLog), args.Error(1)
+}
+
+func (m *MockInventoryHistoryService) RevertChange(logID, adminID uint) (*model.InventoryLog, error) {
+	args := m.Called(logID, adminID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.InventoryLog), args.Error(1)
+}
+
+func (m *MockInventoryHistoryService) RestoreItem(inventoryID, adminID uint) (*model.Inventory, error) {
+	args := m.Called(inventoryID, adminID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Inventory), args.Error(1)
+}
+
+// 테스트용 인벤토리 기록 라우터 설정
+func setupInventoryHistoryTestRouter() (*gin.Engine, *MockInventoryHistoryService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockInventoryHistoryService{}
+	handler := NewInventoryHistoryHandler(mockService)
+
+	router.GET("/api/inventory/history", handler.GetMyHistory)
+	admin := router.Group("/api/admin/inventory")
+	{
+		admin.GET("/history", handler.AdminGetHistory)
+		admin.POST("/history/:id/revert", handler.AdminRevertChange)
+		admin.POST("/items/:id/restore", handler.AdminRestoreItem)
+	}
+
+	return router, mockService
+}
+
+// 내 변경 기록 조회 테스트
+func TestInventoryHistoryHandler_GetMyHistory(t *testing.T) {
+	router, mockService := setupInventoryHistoryTestRouter()
+	mockService.On("GetHistory", uint(1), "legend_sword", defaultPageLimit, 0).Return([]model.InventoryLog{
+		{ID: 1, UserID: 1, ItemID: "legend_sword", BeforeQuantity: 1, AfterQuantity: 0, Source: model.InventorySourceTrade},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/inventory/history?item_id=legend_sword", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response InventoryHistoryResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Total)
+	assert.Equal(t, model.InventorySourceTrade, response.Logs[0].Source)
+
+	req, _ = http.NewRequest("GET", "/api/inventory/history", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	mockService.AssertExpectations(t)
+}
+
+// 관리자 되돌리기 테스트
+func TestInventoryHistoryHandler_AdminRevertChange(t *testing.T) {
+	tests := []struct {
+		name           string
+		role           string
+		mockError      error
+		expectedStatus int
+	}{
+		{name: "성공", role: "admin", expectedStatus: http.StatusOK},
+		{name: "일반 사용자", role: "user", expectedStatus: http.StatusForbidden},
+		{name: "기록 없음", role: "admin", mockError: model.ErrInventoryLogNotFound, expectedStatus: http.StatusNotFound},
+		{name: "이미 되돌림", role: "moderator", mockError: model.ErrAlreadyReverted, expectedStatus: http.StatusConflict},
+		{name: "충돌", role: "admin", mockError: model.ErrRevertConflict, expectedStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupInventoryHistoryTestRouter()
+			if tt.role != "user" {
+				if tt.mockError != nil {
+					mockService.On("RevertChange", uint(5), uint(9)).Return(nil, tt.mockError)
+				} else {
+					mockService.On("RevertChange", uint(5), uint(9)).Return(&model.InventoryLog{ID: 6}, nil)
+				}
+			}
+
+			req, _ := http.NewRequest("POST", "/api/admin/inventory/history/5/revert", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, withAuthUser(req, 9, tt.role))
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+// 관리자 아이템 복구 테스트
+func TestInventoryHistoryHandler_AdminRestoreItem(t *testing.T) {
+	router, mockService := setupInventoryHistoryTestRouter()
+	mockService.On("RestoreItem", uint(3), uint(9)).Return(&model.Inventory{ID: 3, Quantity: 2}, nil)
+	mockService.On("RestoreItem", uint(4), uint(9)).Return(nil, model.ErrItemNotDeleted)
+
+	req, _ := http.NewRequest("POST", "/api/admin/inventory/items/3/restore", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("POST", "/api/admin/inventory/items/4/restore", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	mockService.AssertExpectations(t)
+}
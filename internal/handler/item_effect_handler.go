@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type ItemEffectServiceInterface interface {
+	UseItem(userID uint, itemID string) (*service.ItemUseResult, error)
+	GetItemEffects(itemID string) []model.ItemEffect
+	SetItemEffects(itemID string, effects []model.ItemEffect) ([]model.ItemEffect, error)
+	GetActiveBuffs(userID uint) ([]model.ActiveBuff, error)
+}
+
+// 소비 아이템 사용과 효과 관련 HTTP 요청을 처리하는 핸들러
+type ItemEffectHandler struct {
+	effectService ItemEffectServiceInterface
+}
+
+// 새로운 ItemEffectHandler 인스턴스를 생성
+func NewItemEffectHandler(effectService ItemEffectServiceInterface) *ItemEffectHandler {
+	return &ItemEffectHandler{
+		effectService: effectService,
+	}
+}
+
+// 상자 내용물 요청
+type ContainerDropRequest struct {
+	ItemID   string  `json:"item_id" binding:"required"`
+	ItemName string  `json:"item_name" binding:"required"`
+	ItemType string  `json:"item_type" binding:"required"`
+	Rarity   string  `json:"rarity" binding:"required,oneof=common rare epic legendary"`
+	Quantity int     `json:"quantity" binding:"min=0"`
+	Chance   float64 `json:"chance" binding:"min=0,max=1"`
+}
+
+// 아이템 효과 요청
+type ItemEffectRequest struct {
+	Type            string                 `json:"type" binding:"required"`
+	Amount          int                    `json:"amount" binding:"min=0"`
+	BuffStat        string                 `json:"buff_stat"`
+	BuffBonus       float64                `json:"buff_bonus" binding:"min=0"`
+	DurationSeconds int                    `json:"duration_seconds" binding:"min=0"`
+	Drops           []ContainerDropRequest `json:"drops" binding:"dive"`
+}
+
+// 아이템 효과 설정 요청
+type SetItemEffectsRequest struct {
+	Effects []ItemEffectRequest `json:"effects" binding:"dive"`
+}
+
+// 아이템 효과 목록 응답
+type ItemEffectListResponse struct {
+	ItemID  string             `json:"item_id"`
+	Effects []model.ItemEffect `json:"effects"`
+}
+
+// 버프 목록 응답
+type BuffListResponse struct {
+	Buffs []model.ActiveBuff `json:"buffs"`
+	Total int                `json:"total"`
+}
+
+// 소비 아이템을 사용
+// @Summary 소비 아이템 사용
+// @Description 보유한 아이템을 1회 사용하고 경험치/골드 지급, 버프, 상자 열기 등의 효과를 적용합니다.
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param item_id path string true "아이템 ID"
+// @Success 200 {object} service.ItemUseResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/items/{item_id}/use [post]
+func (h *ItemEffectHandler) UseItem(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.effectService.UseItem(userInfo.UserID, c.Param("item_id"))
+	if err != nil {
+		c.JSON(itemEffectErrorStatus(err), ErrorResponse{
+			Error:   "아이템 사용에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 아이템의 사용 효과를 조회
+// @Summary 아이템 효과 조회
+// @Description 아이템을 사용했을 때 적용되는 효과 목록을 조회합니다.
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param item_id path string true "아이템 ID"
+// @Success 200 {object} ItemEffectListResponse
+// @Router /api/items/{item_id}/effects [get]
+func (h *ItemEffectHandler) GetItemEffects(c *gin.Context) {
+	itemID := c.Param("item_id")
+	effects := h.effectService.GetItemEffects(itemID)
+	if effects == nil {
+		effects = []model.ItemEffect{}
+	}
+
+	c.JSON(http.StatusOK, ItemEffectListResponse{
+		ItemID:  itemID,
+		Effects: effects,
+	})
+}
+
+// 내게 적용 중인 버프를 조회
+// @Summary 활성 버프 조회
+// @Description 로그인한 사용자에게 적용 중인 시간제 버프 목록을 조회합니다.
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} BuffListResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/items/buffs [get]
+func (h *ItemEffectHandler) GetMyBuffs(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	buffs, err := h.effectService.GetActiveBuffs(userInfo.UserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "버프 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, BuffListResponse{
+		Buffs: buffs,
+		Total: len(buffs),
+	})
+}
+
+// 아이템의 사용 효과를 설정 (관리자용)
+// @Summary 아이템 효과 설정
+// @Description 아이템의 사용 효과 목록을 교체합니다. 빈 목록이면 효과를 제거합니다. (관리자/중재자)
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param item_id path string true "아이템 ID"
+// @Param request body SetItemEffectsRequest true "효과 목록"
+// @Success 200 {object} ItemEffectListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /api/admin/items/{item_id}/effects [put]
+func (h *ItemEffectHandler) AdminSetItemEffects(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	var req SetItemEffectsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	effects := make([]model.ItemEffect, len(req.Effects))
+	for i, effect := range req.Effects {
+		effects[i] = model.ItemEffect{
+			Type:            model.ItemEffectType(effect.Type),
+			Amount:          effect.Amount,
+			BuffStat:        effect.BuffStat,
+			BuffBonus:       effect.BuffBonus,
+			DurationSeconds: effect.DurationSeconds,
+		}
+		for _, drop := range effect.Drops {
+			quantity := drop.Quantity
+			if quantity == 0 {
+				quantity = 1
+			}
+			chance := drop.Chance
+			if chance == 0 {
+				chance = 1
+			}
+			effects[i].Drops = append(effects[i].Drops, model.ContainerDrop{
+				ItemID:   drop.ItemID,
+				ItemName: drop.ItemName,
+				ItemType: drop.ItemType,
+				Rarity:   drop.Rarity,
+				Quantity: quantity,
+				Chance:   chance,
+			})
+		}
+	}
+
+	itemID := c.Param("item_id")
+	saved, err := h.effectService.SetItemEffects(itemID, effects)
+	if err != nil {
+		c.JSON(itemEffectErrorStatus(err), ErrorResponse{
+			Error:   "아이템 효과 설정에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemEffectListResponse{
+		ItemID:  itemID,
+		Effects: saved,
+	})
+}
+
+// 아이템 효과 서비스 에러를 HTTP 상태 코드로 변환
+func itemEffectErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrItemNotOwned):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrUnknownEffect),
+		errors.Is(err, model.ErrInvalidEffect),
+		errors.Is(err, model.ErrInvalidItemID),
+		errors.Is(err, model.ErrInvalidQuantity),
+		errors.Is(err, model.ErrInsufficientQuantity),
+		errors.Is(err, model.ErrUsageExhausted):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
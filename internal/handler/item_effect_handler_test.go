@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 아이템 효과 서비스
+type MockItemEffectService struct {
+	mock.Mock
+}
+
+func (m *MockItemEffectService) UseItem(userID uint, itemID string) (*service.ItemUseResult, error) {
+	args := m.Called(userID, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.ItemUseResult), args.Error(1)
+}
+
+func (m *MockItemEffectService) GetItemEffects(itemID string) []model.ItemEffect {
+	args := m.Called(itemID)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]model.ItemEffect)
+}
+
+func (m *MockItemEffectService) SetItemEffects(itemID string, effects []model.ItemEffect) ([]model.ItemEffect, error) {
+	args := m.Called(itemID, effects)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.ItemEffect), args.Error(1)
+}
+
+func (m *MockItemEffectService) GetActiveBuffs(userID uint) ([]model.ActiveBuff, error) {
+	args := m.Called(userID)
+	return args.Get(0).([]model.ActiveBuff), args.Error(1)
+}
+
+// 테스트용 소비 아이템 라우터 설정
+func setupItemEffectTestRouter() (*gin.Engine, *MockItemEffectService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockItemEffectService{}
+	handler := NewItemEffectHandler(mockService)
+
+	items := router.Group("/api/items")
+	{
+		items.GET("/buffs", handler.GetMyBuffs)
+		items.GET("/:item_id/effects", handler.GetItemEffects)
+		items.POST("/:item_id/use", handler.UseItem)
+	}
+	router.PUT("/api/admin/items/:item_id/effects", handler.AdminSetItemEffects)
+
+	return router, mockService
+}
+
+// 아이템 사용 테스트
+func TestItemEffectHandler_UseItem(t *testing.T) {
+	tests := []struct {
+		name           string
+		itemID         string
+		mockError      error
+		expectedStatus int
+	}{
+		{name: "성공", itemID: "xp_scroll", expectedStatus: http.StatusOK},
+		{name: "보유하지 않은 아이템", itemID: "missing", mockError: fmt.Errorf("아이템 없음: %w", service.ErrItemNotOwned), expectedStatus: http.StatusNotFound},
+		{name: "사용 횟수 소진", itemID: "used_up", mockError: model.ErrUsageExhausted, expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupItemEffectTestRouter()
+			if tt.mockError != nil {
+				mockService.On("UseItem", uint(1), tt.itemID).Return(nil, tt.mockError)
+			} else {
+				mockService.On("UseItem", uint(1), tt.itemID).Return(&service.ItemUseResult{ItemID: tt.itemID, Experience: 100}, nil)
+			}
+
+			req, _ := http.NewRequest("POST", "/api/items/"+tt.itemID+"/use", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+			mockService.AssertExpectations(t)
+		})
+	}
+
+	router, _ := setupItemEffectTestRouter()
+	req, _ := http.NewRequest("POST", "/api/items/xp_scroll/use", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+// 효과 조회와 버프 조회 테스트
+func TestItemEffectHandler_GetEffectsAndBuffs(t *testing.T) {
+	router, mockService := setupItemEffectTestRouter()
+	mockService.On("GetItemEffects", "plain_rock").Return(nil)
+	mockService.On("GetActiveBuffs", uint(1)).Return([]model.ActiveBuff{{ID: 1, Stat: model.BuffStatXP, Bonus: 0.5}}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/items/plain_rock/effects", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"effects":[]`, "효과가 없으면 빈 배열을 반환해야 합니다")
+
+	req, _ = http.NewRequest("GET", "/api/items/buffs", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response BuffListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Total)
+	mockService.AssertExpectations(t)
+}
+
+// 관리자 효과 설정 테스트
+func TestItemEffectHandler_AdminSetItemEffects(t *testing.T) {
+	router, mockService := setupItemEffectTestRouter()
+	expected := []model.ItemEffect{{
+		Type:  model.ItemEffectOpenContainer,
+		Drops: []model.ContainerDrop{{ItemID: "gem", ItemName: "보석", ItemType: "material", Rarity: "rare", Quantity: 1, Chance: 1}},
+	}}
+	mockService.On("SetItemEffects", "box", expected).Return(expected, nil)
+	mockService.On("SetItemEffects", "bad", mock.Anything).Return(nil, model.ErrUnknownEffect)
+
+	body := `{"effects":[{"type":"open_container","drops":[{"item_id":"gem","item_name":"보석","item_type":"material","rarity":"rare"}]}]}`
+	req, _ := http.NewRequest("PUT", "/api/admin/items/box/effects", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("PUT", "/api/admin/items/bad/effects", bytes.NewBufferString(`{"effects":[{"type":"teleport"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	req, _ = http.NewRequest("PUT", "/api/admin/items/box/effects", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 2, "user"))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	mockService.AssertExpectations(t)
+}
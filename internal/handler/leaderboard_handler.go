@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type LeaderboardServiceInterface interface {
+	GetTop(gameID uint, difficulty model.GameDifficulty, window model.LeaderboardWindow, limit int) (*service.LeaderboardResult, error)
+	GetAround(gameID uint, difficulty model.GameDifficulty, window model.LeaderboardWindow, userID uint, k int) (*service.LeaderboardResult, error)
+	GetFriends(gameID uint, difficulty model.GameDifficulty, window model.LeaderboardWindow, userID uint) (*service.LeaderboardResult, error)
+	RebuildAll(gameID uint, at time.Time) (int, error)
+}
+
+const (
+	defaultLeaderboardAround = 5
+	maxLeaderboardAround     = 50
+)
+
+// 리더보드 관련 HTTP 요청을 처리하는 핸들러
+type LeaderboardHandler struct {
+	leaderboardService LeaderboardServiceInterface
+}
+
+// 새로운 LeaderboardHandler 인스턴스를 생성
+func NewLeaderboardHandler(leaderboardService LeaderboardServiceInterface) *LeaderboardHandler {
+	return &LeaderboardHandler{
+		leaderboardService: leaderboardService,
+	}
+}
+
+// 리더보드 재구축 요청 (game_id가 없으면 모든 게임)
+type LeaderboardRebuildRequest struct {
+	GameID uint `json:"game_id"`
+}
+
+// 리더보드 재구축 응답
+type LeaderboardRebuildResponse struct {
+	Boards int `json:"boards"`
+}
+
+// 상위 순위를 조회
+// @Summary 리더보드 상위 순위
+// @Description 게임/난이도/기간별 상위 N명을 조회합니다. 점수가 같으면 먼저 달성한 기록이 높은 순위입니다.
+// @Tags Leaderboards
+// @Accept json
+// @Produce json
+// @Param game_id path int true "게임 ID"
+// @Param difficulty query string false "난이도 (기본값 normal)"
+// @Param window query string false "기간 (all, daily, weekly, monthly, season / 기본값 all)"
+// @Param limit query int false "조회 개수"
+// @Success 200 {object} service.LeaderboardResult
+// @Failure 400 {object} ErrorResponse
+// @Router /api/leaderboards/{game_id} [get]
+func (h *LeaderboardHandler) GetTop(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "game_id")
+	if !ok {
+		return
+	}
+
+	difficulty, window := parseLeaderboardQuery(c)
+	limit, _ := parsePagination(c)
+	result, err := h.leaderboardService.GetTop(gameID, difficulty, window, limit)
+	if err != nil {
+		c.JSON(leaderboardErrorStatus(err), ErrorResponse{
+			Error:   "리더보드 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 내 순위와 주변 순위를 조회
+// @Summary 내 리더보드 순위
+// @Description 내 순위와 앞뒤 k명의 기록을 조회합니다.
+// @Tags Leaderboards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param game_id path int true "게임 ID"
+// @Param difficulty query string false "난이도 (기본값 normal)"
+// @Param window query string false "기간 (all, daily, weekly, monthly, season / 기본값 all)"
+// @Param k query int false "앞뒤로 조회할 인원 (기본값 5)"
+// @Success 200 {object} service.LeaderboardResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/leaderboards/{game_id}/me [get]
+func (h *LeaderboardHandler) GetMyRank(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	gameID, ok := parseIDParam(c, "game_id")
+	if !ok {
+		return
+	}
+
+	k, err := strconv.Atoi(c.DefaultQuery("k", strconv.Itoa(defaultLeaderboardAround)))
+	if err != nil || k < 0 {
+		k = defaultLeaderboardAround
+	}
+	if k > maxLeaderboardAround {
+		k = maxLeaderboardAround
+	}
+
+	difficulty, window := parseLeaderboardQuery(c)
+	result, err := h.leaderboardService.GetAround(gameID, difficulty, window, userInfo.UserID, k)
+	if err != nil {
+		c.JSON(leaderboardErrorStatus(err), ErrorResponse{
+			Error:   "순위 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 친구 리더보드를 조회
+// @Summary 친구 리더보드
+// @Description 나와 친구들의 기록만 순위순으로 조회합니다. 순위는 전체 리더보드 기준입니다.
+// @Tags Leaderboards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param game_id path int true "게임 ID"
+// @Param difficulty query string false "난이도 (기본값 normal)"
+// @Param window query string false "기간 (all, daily, weekly, monthly, season / 기본값 all)"
+// @Success 200 {object} service.LeaderboardResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/leaderboards/{game_id}/friends [get]
+func (h *LeaderboardHandler) GetFriends(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	gameID, ok := parseIDParam(c, "game_id")
+	if !ok {
+		return
+	}
+
+	difficulty, window := parseLeaderboardQuery(c)
+	result, err := h.leaderboardService.GetFriends(gameID, difficulty, window, userInfo.UserID)
+	if err != nil {
+		c.JSON(leaderboardErrorStatus(err), ErrorResponse{
+			Error:   "친구 리더보드 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 리더보드를 DB 기록으로 재구축
+// @Summary 리더보드 재구축
+// @Description 정상 점수 기록으로 현재 기간의 리더보드를 다시 만듭니다. (관리자/중재자)
+// @Tags Leaderboards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body LeaderboardRebuildRequest false "재구축 대상"
+// @Success 200 {object} LeaderboardRebuildResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /api/admin/leaderboards/rebuild [post]
+func (h *LeaderboardHandler) AdminRebuild(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	var req LeaderboardRebuildRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "잘못된 요청 형식입니다",
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
+	boards, err := h.leaderboardService.RebuildAll(req.GameID, time.Now())
+	if err != nil {
+		c.JSON(leaderboardErrorStatus(err), ErrorResponse{
+			Error:   "리더보드 재구축에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, LeaderboardRebuildResponse{Boards: boards})
+}
+
+// difficulty, window 쿼리 파라미터를 파싱 (검증은 서비스에서 수행)
+func parseLeaderboardQuery(c *gin.Context) (model.GameDifficulty, model.LeaderboardWindow) {
+	difficulty := model.GameDifficulty(c.DefaultQuery("difficulty", string(model.GameDifficultyNormal)))
+	window := model.LeaderboardWindow(c.DefaultQuery("window", string(model.LeaderboardAllTime)))
+	return difficulty, window
+}
+
+// 리더보드 서비스 에러를 HTTP 상태 코드로 변환
+func leaderboardErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrInvalidLeaderboard):
+		return http.StatusBadRequest
+	case errors.Is(err, model.ErrNotRanked),
+		errors.Is(err, model.ErrNoActiveSeason):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
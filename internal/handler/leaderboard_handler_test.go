@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// 테스트용 Mock 리더보드 서비스
+type MockLeaderboardService struct {
+	mock.Mock
+}
+
+func (m *MockLeaderboardService) GetTop(gameID uint, difficulty model.GameDifficulty, window model.LeaderboardWindow, limit int) (*service.LeaderboardResult, error) {
+	args := m.Called(gameID, difficulty, window, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.LeaderboardResult), args.Error(1)
+}
+
+func (m *MockLeaderboardService) GetAround(gameID uint, difficulty model.GameDifficulty, window model.LeaderboardWindow, userID uint, k int) (*service.LeaderboardResult, error) {
+	args := m.Called(gameID, difficulty, window, userID, k)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.LeaderboardResult), args.Error(1)
+}
+
+func (m *MockLeaderboardService) GetFriends(gameID uint, difficulty model.GameDifficulty, window model.LeaderboardWindow, userID uint) (*service.LeaderboardResult, error) {
+	args := m.Called(gameID, difficulty, window, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.LeaderboardResult), args.Error(1)
+}
+
+func (m *MockLeaderboardService) RebuildAll(gameID uint, at time.Time) (int, error) {
+	args := m.Called(gameID, at)
+	return args.Int(0), args.Error(1)
+}
+
+// 테스트용 리더보드 라우터 설정
+func setupLeaderboardTestRouter() (*gin.Engine, *MockLeaderboardService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockLeaderboardService{}
+	handler := NewLeaderboardHandler(mockService)
+
+	leaderboards := router.Group("/api/leaderboards")
+	{
+		leaderboards.GET("/:game_id", handler.GetTop)
+		leaderboards.GET("/:game_id/me", handler.GetMyRank)
+		leaderboards.GET("/:game_id/friends", handler.GetFriends)
+	}
+	router.POST("/api/admin/leaderboards/rebuild", handler.AdminRebuild)
+
+	return router, mockService
+}
+
+// 상위 순위 조회 테스트
+func TestLeaderboardHandler_GetTop(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		difficulty     model.GameDifficulty
+		window         model.LeaderboardWindow
+		mockError      error
+		expectedStatus int
+	}{
+		{name: "기본값", query: "", difficulty: model.GameDifficultyNormal, window: model.LeaderboardAllTime, expectedStatus: http.StatusOK},
+		{name: "주간 어려움", query: "?difficulty=hard&window=weekly", difficulty: model.GameDifficultyHard, window: model.LeaderboardWeekly, expectedStatus: http.StatusOK},
+		{name: "잘못된 기간", query: "?window=yearly", difficulty: model.GameDifficultyNormal, window: "yearly", mockError: model.ErrInvalidLeaderboard, expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupLeaderboardTestRouter()
+			if tt.mockError != nil {
+				mockService.On("GetTop", uint(3), tt.difficulty, tt.window, 20).Return(nil, tt.mockError)
+			} else {
+				mockService.On("GetTop", uint(3), tt.difficulty, tt.window, 20).
+					Return(&service.LeaderboardResult{Total: 1, Entries: []model.LeaderboardEntry{{Rank: 1, UserID: 5, Score: 900}}}, nil)
+			}
+
+			req, _ := http.NewRequest("GET", "/api/leaderboards/3"+tt.query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+// 내 순위와 친구 리더보드 조회 테스트
+func TestLeaderboardHandler_MyRankAndFriends(t *testing.T) {
+	router, mockService := setupLeaderboardTestRouter()
+
+	// 인증 없이 조회하면 401
+	req, _ := http.NewRequest("GET", "/api/leaderboards/3/me", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	mockService.On("GetAround", uint(3), model.GameDifficultyNormal, model.LeaderboardDaily, uint(5), 2).
+		Return(&service.LeaderboardResult{Entries: []model.LeaderboardEntry{{Rank: 4, UserID: 5}}}, nil)
+	req, _ = http.NewRequest("GET", "/api/leaderboards/3/me?window=daily&k=2", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 5, "user"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	mockService.On("GetAround", uint(3), model.GameDifficultyNormal, model.LeaderboardAllTime, uint(6), defaultLeaderboardAround).
+		Return(nil, model.ErrNotRanked)
+	req, _ = http.NewRequest("GET", "/api/leaderboards/3/me", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 6, "user"))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	mockService.On("GetFriends", uint(3), model.GameDifficultyNormal, model.LeaderboardAllTime, uint(5)).
+		Return(&service.LeaderboardResult{Entries: []model.LeaderboardEntry{}}, nil)
+	req, _ = http.NewRequest("GET", "/api/leaderboards/3/friends", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 5, "user"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	mockService.AssertExpectations(t)
+}
+
+// 리더보드 재구축 테스트
+func TestLeaderboardHandler_AdminRebuild(t *testing.T) {
+	router, mockService := setupLeaderboardTestRouter()
+	mockService.On("RebuildAll", uint(3), mock.AnythingOfType("time.Time")).Return(4, nil)
+
+	body, _ := json.Marshal(LeaderboardRebuildRequest{GameID: 3})
+	req, _ := http.NewRequest("POST", "/api/admin/leaderboards/rebuild", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response LeaderboardRebuildResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 4, response.Boards)
+	mockService.AssertExpectations(t)
+
+	req, _ = http.NewRequest("POST", "/api/admin/leaderboards/rebuild", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
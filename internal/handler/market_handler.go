@@ -0,0 +1,403 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type MarketServiceInterface interface {
+	CreateListing(input *service.CreateListingInput) (*model.MarketListing, error)
+	BuyListing(listingID, buyerID uint) (*model.MarketListing, error)
+	PlaceBid(listingID, bidderID uint, amount int) (*model.MarketListing, error)
+	CancelListing(listingID, sellerID uint) error
+	GetListingByID(id uint) (*model.MarketListing, error)
+	SearchListings(filter *service.ListingSearchFilter) ([]model.MarketListing, int64, error)
+	GetSellerListings(sellerID uint, limit, offset int) ([]model.MarketListing, error)
+	GetPriceHistory(itemID string, limit int) (*service.PriceHistory, error)
+}
+
+// 거래소(경매장) 관련 HTTP 요청을 처리하는 핸들러
+type MarketHandler struct {
+	marketService MarketServiceInterface
+}
+
+// 새로운 MarketHandler 인스턴스를 생성
+func NewMarketHandler(marketService MarketServiceInterface) *MarketHandler {
+	return &MarketHandler{
+		marketService: marketService,
+	}
+}
+
+// 판매 등록 요청
+type CreateListingRequest struct {
+	InventoryID   uint              `json:"inventory_id" binding:"required"`
+	Quantity      int               `json:"quantity" binding:"required,min=1"`
+	ListingType   model.ListingType `json:"listing_type" binding:"required,oneof=fixed auction"`
+	Price         int               `json:"price" binding:"min=0"`
+	StartingBid   int               `json:"starting_bid" binding:"min=0"`
+	DurationHours int               `json:"duration_hours" binding:"min=0"`
+}
+
+// 입찰 요청
+type PlaceBidRequest struct {
+	Amount int `json:"amount" binding:"required,min=1"`
+}
+
+// 판매 목록 응답
+type ListingListResponse struct {
+	Listings []model.MarketListing `json:"listings"`
+	Total    int64                 `json:"total"`
+}
+
+// 아이템을 거래소에 등록
+// @Summary 판매 등록
+// @Description 인벤토리 아이템을 고정가 또는 경매로 등록합니다. 등록 수수료가 차감되며 아이템은 거래소에 보관됩니다.
+// @Tags Market
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateListingRequest true "판매 등록 정보"
+// @Success 201 {object} model.MarketListing
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/market/listings [post]
+func (h *MarketHandler) CreateListing(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	var req CreateListingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	listing, err := h.marketService.CreateListing(&service.CreateListingInput{
+		SellerID:    userInfo.UserID,
+		InventoryID: req.InventoryID,
+		Quantity:    req.Quantity,
+		ListingType: req.ListingType,
+		Price:       req.Price,
+		StartingBid: req.StartingBid,
+		Duration:    time.Duration(req.DurationHours) * time.Hour,
+	})
+	if err != nil {
+		c.JSON(marketErrorStatus(err), ErrorResponse{
+			Error:   "판매 등록에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, listing)
+}
+
+// 판매 중인 물품을 검색
+// @Summary 판매 물품 검색
+// @Description 아이템 종류, 등급, 레벨 등으로 판매 중인 물품을 검색합니다.
+// @Tags Market
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param item_id query string false "아이템 ID"
+// @Param item_type query string false "아이템 타입"
+// @Param rarity query string false "희귀도"
+// @Param listing_type query string false "판매 방식 (fixed, auction)"
+// @Param min_level query int false "최소 레벨"
+// @Param max_level query int false "최대 레벨"
+// @Param sort query string false "정렬 (price_asc, price_desc, ending_soon, newest)"
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} ListingListResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/market/listings [get]
+func (h *MarketHandler) SearchListings(c *gin.Context) {
+	if _, ok := requireAuthUser(c); !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	minLevel, _ := strconv.Atoi(c.Query("min_level"))
+	maxLevel, _ := strconv.Atoi(c.Query("max_level"))
+
+	listings, total, err := h.marketService.SearchListings(&service.ListingSearchFilter{
+		ItemID:      c.Query("item_id"),
+		ItemType:    c.Query("item_type"),
+		Rarity:      c.Query("rarity"),
+		ListingType: model.ListingType(c.Query("listing_type")),
+		MinLevel:    minLevel,
+		MaxLevel:    maxLevel,
+		SortBy:      c.Query("sort"),
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "판매 물품 검색에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, ListingListResponse{
+		Listings: listings,
+		Total:    total,
+	})
+}
+
+// 판매 등록 상세 정보를 조회
+// @Summary 판매 등록 조회
+// @Description 판매 등록 상세 정보를 조회합니다.
+// @Tags Market
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "판매 등록 ID"
+// @Success 200 {object} model.MarketListing
+// @Failure 404 {object} ErrorResponse
+// @Router /api/market/listings/{id} [get]
+func (h *MarketHandler) GetListing(c *gin.Context) {
+	if _, ok := requireAuthUser(c); !ok {
+		return
+	}
+
+	listingID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	listing, err := h.marketService.GetListingByID(listingID)
+	if err != nil {
+		c.JSON(marketErrorStatus(err), ErrorResponse{
+			Error:   "판매 등록을 찾을 수 없습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, listing)
+}
+
+// 고정가 물품을 즉시 구매
+// @Summary 즉시 구매
+// @Description 고정가 물품을 구매합니다. 판매자에게는 판매세를 제외한 금액이 지급됩니다.
+// @Tags Market
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "판매 등록 ID"
+// @Success 200 {object} model.MarketListing
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/market/listings/{id}/buy [post]
+func (h *MarketHandler) BuyListing(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	listingID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	listing, err := h.marketService.BuyListing(listingID, userInfo.UserID)
+	if err != nil {
+		c.JSON(marketErrorStatus(err), ErrorResponse{
+			Error:   "구매에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, listing)
+}
+
+// 경매 물품에 입찰
+// @Summary 입찰
+// @Description 경매 물품에 입찰합니다. 입찰 골드는 에스크로에 보관되며 상위 입찰 시 반환됩니다.
+// @Tags Market
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "판매 등록 ID"
+// @Param request body PlaceBidRequest true "입찰 정보"
+// @Success 200 {object} model.MarketListing
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/market/listings/{id}/bid [post]
+func (h *MarketHandler) PlaceBid(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	listingID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req PlaceBidRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	listing, err := h.marketService.PlaceBid(listingID, userInfo.UserID, req.Amount)
+	if err != nil {
+		c.JSON(marketErrorStatus(err), ErrorResponse{
+			Error:   "입찰에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, listing)
+}
+
+// 판매 등록을 취소
+// @Summary 판매 취소
+// @Description 판매 등록을 취소하고 아이템을 돌려받습니다. 입찰이 있는 경매는 취소할 수 없으며 등록 수수료는 반환되지 않습니다.
+// @Tags Market
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "판매 등록 ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/market/listings/{id}/cancel [post]
+func (h *MarketHandler) CancelListing(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	listingID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.marketService.CancelListing(listingID, userInfo.UserID); err != nil {
+		c.JSON(marketErrorStatus(err), ErrorResponse{
+			Error:   "판매 취소에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "판매 등록이 취소되었습니다",
+		UserID:  userInfo.UserID,
+	})
+}
+
+// 내 판매 등록 내역을 조회
+// @Summary 내 판매 내역 조회
+// @Description 로그인한 사용자의 판매 등록 내역을 최신순으로 조회합니다.
+// @Tags Market
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} ListingListResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/market/me/listings [get]
+func (h *MarketHandler) GetMyListings(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	listings, err := h.marketService.GetSellerListings(userInfo.UserID, limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "판매 내역 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, ListingListResponse{
+		Listings: listings,
+		Total:    int64(len(listings)),
+	})
+}
+
+// 아이템 가격 이력을 조회
+// @Summary 가격 이력 조회
+// @Description 아이템의 최근 거래소 판매 가격 이력과 요약 통계를 조회합니다.
+// @Tags Market
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param item_id path string true "아이템 ID"
+// @Param limit query int false "조회 개수"
+// @Success 200 {object} service.PriceHistory
+// @Failure 500 {object} ErrorResponse
+// @Router /api/market/prices/{item_id} [get]
+func (h *MarketHandler) GetPriceHistory(c *gin.Context) {
+	if _, ok := requireAuthUser(c); !ok {
+		return
+	}
+
+	limit, _ := parsePagination(c)
+	history, err := h.marketService.GetPriceHistory(c.Param("item_id"), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "가격 이력 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+// 거래소 서비스 에러를 HTTP 상태 코드로 변환
+func marketErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrListingNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrNotListingSeller):
+		return http.StatusForbidden
+	case errors.Is(err, model.ErrListingNotActive),
+		errors.Is(err, model.ErrListingEnded),
+		errors.Is(err, model.ErrListingHasBids):
+		return http.StatusConflict
+	case errors.Is(err, model.ErrInvalidListingType),
+		errors.Is(err, model.ErrInvalidListingPrice),
+		errors.Is(err, model.ErrOwnListing),
+		errors.Is(err, model.ErrNotFixedListing),
+		errors.Is(err, model.ErrNotAuctionListing),
+		errors.Is(err, model.ErrBidTooLow),
+		errors.Is(err, model.ErrInvalidUserID),
+		errors.Is(err, model.ErrInvalidItemID),
+		errors.Is(err, model.ErrInvalidQuantity),
+		errors.Is(err, model.ErrInsufficientQuantity),
+		errors.Is(err, model.ErrItemNotTradeable),
+		errors.Is(err, service.ErrItemNotOwned),
+		errors.Is(err, service.ErrInsufficientGold):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 거래소 서비스
+type MockMarketService struct {
+	mock.Mock
+}
+
+func (m *MockMarketService) CreateListing(input *service.CreateListingInput) (*model.MarketListing, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.MarketListing), args.Error(1)
+}
+
+func (m *MockMarketService) BuyListing(listingID, buyerID uint) (*model.MarketListing, error) {
+	args := m.Called(listingID, buyerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.MarketListing), args.Error(1)
+}
+
+func (m *MockMarketService) PlaceBid(listingID, bidderID uint, amount int) (*model.MarketListing, error) {
+	args := m.Called(listingID, bidderID, amount)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.MarketListing), args.Error(1)
+}
+
+func (m *MockMarketService) CancelListing(listingID, sellerID uint) error {
+	args := m.Called(listingID, sellerID)
+	return args.Error(0)
+}
+
+func (m *MockMarketService) GetListingByID(id uint) (*model.MarketListing, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.MarketListing), args.Error(1)
+}
+
+func (m *MockMarketService) SearchListings(filter *service.ListingSearchFilter) ([]model.MarketListing, int64, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]model.MarketListing), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockMarketService) GetSellerListings(sellerID uint, limit, offset int) ([]model.MarketListing, error) {
+	args := m.Called(sellerID, limit, offset)
+	return args.Get(0).([]model.MarketListing), args.Error(1)
+}
+
+func (m *MockMarketService) GetPriceHistory(itemID string, limit int) (*service.PriceHistory, error) {
+	args := m.Called(itemID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.PriceHistory), args.Error(1)
+}
+
+// 테스트용 거래소 라우터 설정
+func setupMarketTestRouter() (*gin.Engine, *MockMarketService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockMarketService{}
+	handler := NewMarketHandler(mockService)
+
+	market := router.Group("/api/market")
+	{
+		market.GET("/listings", handler.SearchListings)
+		market.POST("/listings", handler.CreateListing)
+		market.GET("/listings/:id", handler.GetListing)
+		market.POST("/listings/:id/buy", handler.BuyListing)
+		market.POST("/listings/:id/bid", handler.PlaceBid)
+		market.POST("/listings/:id/cancel", handler.CancelListing)
+		market.GET("/me/listings", handler.GetMyListings)
+		market.GET("/prices/:item_id", handler.GetPriceHistory)
+	}
+
+	return router, mockService
+}
+
+// CreateListing 핸들러 테스트
+func TestMarketHandler_CreateListing(t *testing.T) {
+	t.Run("정상적인 판매 등록", func(t *testing.T) {
+		router, mockService := setupMarketTestRouter()
+		mockService.On("CreateListing", mock.MatchedBy(func(input *service.CreateListingInput) bool {
+			return input.SellerID == 1 && input.InventoryID == 7 && input.Price == 500
+		})).Return(&model.MarketListing{SellerID: 1, ItemID: "sword", Price: 500, Status: model.ListingStatusActive}, nil)
+
+		body, _ := json.Marshal(CreateListingRequest{InventoryID: 7, Quantity: 1, ListingType: model.ListingTypeFixed, Price: 500})
+		req, _ := http.NewRequest("POST", "/api/market/listings", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("잘못된 판매 방식", func(t *testing.T) {
+		router, mockService := setupMarketTestRouter()
+
+		body, _ := json.Marshal(CreateListingRequest{InventoryID: 7, Quantity: 1, ListingType: "barter", Price: 500})
+		req, _ := http.NewRequest("POST", "/api/market/listings", bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateListing", mock.Anything)
+	})
+}
+
+// SearchListings 핸들러 테스트
+func TestMarketHandler_SearchListings(t *testing.T) {
+	router, mockService := setupMarketTestRouter()
+	mockService.On("SearchListings", mock.MatchedBy(func(f *service.ListingSearchFilter) bool {
+		return f.Rarity == "epic" && f.MinLevel == 5 && f.SortBy == "price_asc" && f.Limit == defaultPageLimit
+	})).Return([]model.MarketListing{{ItemID: "axe", Rarity: "epic"}}, int64(1), nil)
+
+	req, _ := http.NewRequest("GET", "/api/market/listings?rarity=epic&min_level=5&sort=price_asc", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response ListingListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, int64(1), response.Total)
+	mockService.AssertExpectations(t)
+}
+
+// PlaceBid 핸들러 테스트
+func TestMarketHandler_PlaceBid(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockListing    *model.MarketListing
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "정상적인 입찰",
+			mockListing:    &model.MarketListing{ListingType: model.ListingTypeAuction, CurrentBid: 300},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "최소 입찰가 미만",
+			mockError:      model.ErrBidTooLow,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "골드 부족",
+			mockError:      service.ErrInsufficientGold,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "종료된 경매",
+			mockError:      model.ErrListingEnded,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "존재하지 않는 판매 등록",
+			mockError:      model.ErrListingNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupMarketTestRouter()
+			if tt.mockListing != nil {
+				mockService.On("PlaceBid", uint(1), uint(2), 300).Return(tt.mockListing, nil)
+			} else {
+				mockService.On("PlaceBid", uint(1), uint(2), 300).Return(nil, tt.mockError)
+			}
+
+			body, _ := json.Marshal(PlaceBidRequest{Amount: 300})
+			req, _ := http.NewRequest("POST", "/api/market/listings/1/bid", bytes.NewBuffer(body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, withAuthUser(req, 2, "user"))
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+// CancelListing 핸들러 테스트
+func TestMarketHandler_CancelListing(t *testing.T) {
+	router, mockService := setupMarketTestRouter()
+	mockService.On("CancelListing", uint(1), uint(1)).Return(nil)
+	mockService.On("CancelListing", uint(1), uint(2)).Return(model.ErrNotListingSeller)
+
+	req, _ := http.NewRequest("POST", "/api/market/listings/1/cancel", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("POST", "/api/market/listings/1/cancel", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 2, "user"))
+	assert.Equal(t, http.StatusForbidden, w.Code, "판매자가 아니면 취소할 수 없어야 합니다")
+
+	mockService.AssertExpectations(t)
+}
+
+// GetPriceHistory 핸들러 테스트
+func TestMarketHandler_GetPriceHistory(t *testing.T) {
+	router, mockService := setupMarketTestRouter()
+	mockService.On("GetPriceHistory", "sword", defaultPageLimit).Return(&service.PriceHistory{ItemID: "sword", TotalSales: 2, AverageUnit: 150}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/market/prices/sword", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var history service.PriceHistory
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &history))
+	assert.Equal(t, 2, history.TotalSales)
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type MatchmakingServiceInterface interface {
+	JoinQueue(userID, gameID uint, mode string, partyIDs []uint) (*model.MatchmakingTicket, error)
+	LeaveQueue(userID uint) error
+	GetStatus(userID uint) (*service.MatchmakingStatus, error)
+}
+
+// 멀티플레이 매치메이킹 HTTP 요청을 처리하는 핸들러
+type MatchmakingHandler struct {
+	matchmakingService MatchmakingServiceInterface
+}
+
+// 새로운 MatchmakingHandler 인스턴스를 생성
+func NewMatchmakingHandler(matchmakingService MatchmakingServiceInterface) *MatchmakingHandler {
+	return &MatchmakingHandler{
+		matchmakingService: matchmakingService,
+	}
+}
+
+// 매치메이킹 대기열 참가 요청
+type JoinQueueRequest struct {
+	GameID       uint   `json:"game_id" binding:"required"`
+	Mode         string `json:"mode" binding:"required,oneof=multiplayer tournament"`
+	PartyUserIDs []uint `json:"party_user_ids" binding:"max=16"`
+}
+
+// 매치메이킹 대기열에 참가
+// @Summary 매치메이킹 참가
+// @Description 게임/모드별 대기열에 참가합니다. 친구를 파티로 함께 참가시킬 수 있으며, 레이팅이 비슷한 상대를 찾아 게임 최대 인원이 모이면 참가자 전원의 게임 세션이 생성됩니다. 허용 레이팅 차이는 대기 시간에 따라 넓어집니다.
+// @Tags Matchmaking
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body JoinQueueRequest true "대기열 참가 정보"
+// @Success 201 {object} model.MatchmakingTicket
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/matchmaking/queue [post]
+func (h *MatchmakingHandler) JoinQueue(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	var req JoinQueueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ticket, err := h.matchmakingService.JoinQueue(userInfo.UserID, req.GameID, req.Mode, req.PartyUserIDs)
+	if err != nil {
+		c.JSON(matchmakingErrorStatus(err), ErrorResponse{
+			Error:   "매치메이킹 참가에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, ticket)
+}
+
+// 매치메이킹 대기열에서 나감
+// @Summary 매치메이킹 취소
+// @Description 대기 중인 매치메이킹을 취소합니다. 파티로 참가한 경우 파티 전체가 대기열에서 빠집니다.
+// @Tags Matchmaking
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/matchmaking/queue [delete]
+func (h *MatchmakingHandler) LeaveQueue(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.matchmakingService.LeaveQueue(userInfo.UserID); err != nil {
+		c.JSON(matchmakingErrorStatus(err), ErrorResponse{
+			Error:   "매치메이킹 취소에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "매치메이킹이 취소되었습니다",
+	})
+}
+
+// 내 매치메이킹 상태를 조회
+// @Summary 매치메이킹 상태
+// @Description 대기 여부와 대기 시간, 현재 허용 레이팅 차이를 조회합니다. 매치가 만들어졌으면 매치 정보와 함께 점수 제출에 사용할 게임 세션 토큰을 반환합니다. 룸 웹소켓에 연결되어 있으면 같은 내용을 match_found 메시지로도 받습니다.
+// @Tags Matchmaking
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} service.MatchmakingStatus
+// @Failure 401 {object} ErrorResponse
+// @Router /api/matchmaking/status [get]
+func (h *MatchmakingHandler) GetStatus(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	status, err := h.matchmakingService.GetStatus(userInfo.UserID)
+	if err != nil {
+		c.JSON(matchmakingErrorStatus(err), ErrorResponse{
+			Error:   "매치메이킹 상태 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+// 매치메이킹 서비스 에러를 HTTP 상태 코드로 변환
+func matchmakingErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrMatchmakingUnsupported), errors.Is(err, model.ErrInvalidParty):
+		return http.StatusBadRequest
+	case errors.Is(err, model.ErrGameNotFound), errors.Is(err, model.ErrNotQueued):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrLevelTooLow):
+		return http.StatusForbidden
+	case errors.Is(err, model.ErrAlreadyQueued), errors.Is(err, model.ErrGameNotPlayable):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
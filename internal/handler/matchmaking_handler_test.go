@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 매치메이킹 서비스
+type MockMatchmakingService struct {
+	mock.Mock
+}
+
+func (m *MockMatchmakingService) JoinQueue(userID, gameID uint, mode string, partyIDs []uint) (*model.MatchmakingTicket, error) {
+	args := m.Called(userID, gameID, mode, partyIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.MatchmakingTicket), args.Error(1)
+}
+
+func (m *MockMatchmakingService) LeaveQueue(userID uint) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+func (m *MockMatchmakingService) GetStatus(userID uint) (*service.MatchmakingStatus, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.MatchmakingStatus), args.Error(1)
+}
+
+// 테스트용 매치메이킹 라우터 설정
+func setupMatchmakingTestRouter() (*gin.Engine, *MockMatchmakingService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockMatchmakingService{}
+	handler := NewMatchmakingHandler(mockService)
+	router.POST("/api/matchmaking/queue", handler.JoinQueue)
+	router.DELETE("/api/matchmaking/queue", handler.LeaveQueue)
+	router.GET("/api/matchmaking/status", handler.GetStatus)
+
+	return router, mockService
+}
+
+// 대기열 참가 테스트
+func TestMatchmakingHandler_JoinQueue(t *testing.T) {
+	router, mockService := setupMatchmakingTestRouter()
+	mockService.On("JoinQueue", uint(5), uint(1), model.MatchModeMultiplayer, []uint{6}).
+		Return(&model.MatchmakingTicket{TicketID: "t1", GameID: 1, UserIDs: []uint{5, 6}, Rating: 1050}, nil)
+	mockService.On("JoinQueue", uint(5), uint(2), model.MatchModeMultiplayer, []uint(nil)).Return(nil, model.ErrAlreadyQueued)
+	mockService.On("JoinQueue", uint(5), uint(3), model.MatchModeTournament, []uint(nil)).Return(nil, model.ErrMatchmakingUnsupported)
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("POST", "/api/matchmaking/queue", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, withAuthUser(req, 5, "user"))
+		return w
+	}
+
+	w := post(`{"game_id":1,"mode":"multiplayer","party_user_ids":[6]}`)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	var ticket model.MatchmakingTicket
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &ticket))
+	assert.Equal(t, "t1", ticket.TicketID)
+	assert.Equal(t, 1050.0, ticket.Rating)
+
+	assert.Equal(t, http.StatusConflict, post(`{"game_id":2,"mode":"multiplayer"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, post(`{"game_id":3,"mode":"tournament"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, post(`{"game_id":1,"mode":"casual"}`).Code)
+
+	req, _ := http.NewRequest("POST", "/api/matchmaking/queue", bytes.NewBufferString(`{"game_id":1,"mode":"multiplayer"}`))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	mockService.AssertExpectations(t)
+}
+
+// 대기열 취소와 상태 조회 테스트
+func TestMatchmakingHandler_LeaveAndStatus(t *testing.T) {
+	router, mockService := setupMatchmakingTestRouter()
+	mockService.On("LeaveQueue", uint(5)).Return(nil)
+	mockService.On("LeaveQueue", uint(6)).Return(model.ErrNotQueued)
+	mockService.On("GetStatus", uint(5)).Return(&service.MatchmakingStatus{
+		State: service.MatchmakingMatched,
+		Match: &service.MatchFound{Match: &model.Match{ID: 3}, Session: &model.GameSession{SessionID: "s1"}, Token: "token"},
+	}, nil)
+
+	req, _ := http.NewRequest("DELETE", "/api/matchmaking/queue", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 5, "user"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("DELETE", "/api/matchmaking/queue", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 6, "user"))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	req, _ = http.NewRequest("GET", "/api/matchmaking/status", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 5, "user"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var status service.MatchmakingStatus
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &status))
+	assert.Equal(t, service.MatchmakingMatched, status.State)
+	assert.Equal(t, "token", status.Match.Token)
+	assert.Equal(t, uint(3), status.Match.Match.ID)
+
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,343 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type MissionServiceInterface interface {
+	GetUserMissions(userID uint) ([]service.PlayerMission, error)
+	ClaimReward(userID, missionID uint) (*service.MissionClaimResult, error)
+	CreateMission(mission *model.Mission) error
+	UpdateMission(id uint, mission *model.Mission) (*model.Mission, error)
+	DeleteMission(id uint) error
+	GetAllMissions(period model.MissionPeriod, limit, offset int) ([]model.Mission, error)
+}
+
+// 미션 관련 HTTP 요청을 처리하는 핸들러
+type MissionHandler struct {
+	missionService MissionServiceInterface
+}
+
+// 새로운 MissionHandler 인스턴스를 생성
+func NewMissionHandler(missionService MissionServiceInterface) *MissionHandler {
+	return &MissionHandler{
+		missionService: missionService,
+	}
+}
+
+// 미션 생성/수정 요청
+type MissionRequest struct {
+	Code             string                 `json:"code" binding:"required,max=50"`
+	Name             string                 `json:"name" binding:"required,max=100"`
+	Description      string                 `json:"description" binding:"max=500"`
+	Period           model.MissionPeriod    `json:"period" binding:"required,oneof=daily weekly event"`
+	Objective        model.MissionObjective `json:"objective" binding:"required,oneof=play_games earn_gold use_item reach_level"`
+	Category         model.GameCategory     `json:"category"`
+	ItemID           string                 `json:"item_id" binding:"max=50"`
+	Target           int                    `json:"target" binding:"required,gt=0"`
+	StartAt          *time.Time             `json:"start_at"`
+	EndAt            *time.Time             `json:"end_at"`
+	IsActive         *bool                  `json:"is_active"`
+	RewardGold       int                    `json:"reward_gold" binding:"min=0"`
+	RewardDiamond    int                    `json:"reward_diamond" binding:"min=0"`
+	RewardExperience int                    `json:"reward_experience" binding:"min=0"`
+	RewardItemID     string                 `json:"reward_item_id" binding:"max=50"`
+	RewardItemName   string                 `json:"reward_item_name" binding:"max=100"`
+	RewardItemType   string                 `json:"reward_item_type" binding:"max=20"`
+	RewardRarity     string                 `json:"reward_rarity"`
+	RewardQuantity   int                    `json:"reward_quantity" binding:"min=0"`
+}
+
+// 내 미션 목록 응답
+type MissionListResponse struct {
+	Missions []service.PlayerMission `json:"missions"`
+	Total    int                     `json:"total"`
+}
+
+// 미션 목록 응답 (관리자용)
+type AdminMissionListResponse struct {
+	Missions []model.Mission `json:"missions"`
+	Total    int             `json:"total"`
+}
+
+// 요청 정보를 미션 모델로 변환
+func (req *MissionRequest) toModel() *model.Mission {
+	mission := &model.Mission{
+		Code:             req.Code,
+		Name:             req.Name,
+		Description:      req.Description,
+		Period:           req.Period,
+		Objective:        req.Objective,
+		Category:         req.Category,
+		ItemID:           req.ItemID,
+		Target:           req.Target,
+		StartAt:          req.StartAt,
+		EndAt:            req.EndAt,
+		IsActive:         true,
+		RewardGold:       req.RewardGold,
+		RewardDiamond:    req.RewardDiamond,
+		RewardExperience: req.RewardExperience,
+		RewardItemID:     req.RewardItemID,
+		RewardItemName:   req.RewardItemName,
+		RewardItemType:   req.RewardItemType,
+		RewardRarity:     req.RewardRarity,
+		RewardQuantity:   req.RewardQuantity,
+	}
+	if req.IsActive != nil {
+		mission.IsActive = *req.IsActive
+	}
+	return mission
+}
+
+// 내 미션 목록을 조회
+// @Summary 내 미션 목록
+// @Description 현재 주기에 배정된 일간/주간 미션과 진행 중인 이벤트 퀘스트를 진행도, 초기화 시각과 함께 조회합니다. 주기는 사용자 시간대의 자정을 기준으로 초기화됩니다.
+// @Tags Missions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} MissionListResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/missions [get]
+func (h *MissionHandler) GetMissions(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	missions, err := h.missionService.GetUserMissions(userInfo.UserID)
+	if err != nil {
+		c.JSON(missionErrorStatus(err), ErrorResponse{
+			Error:   "미션 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, MissionListResponse{
+		Missions: missions,
+		Total:    len(missions),
+	})
+}
+
+// 완료한 미션의 보상을 수령
+// @Summary 미션 보상 수령
+// @Description 현재 주기에 완료한 미션의 보상을 받습니다. 주기가 초기화되면 받지 않은 보상은 사라집니다.
+// @Tags Missions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "미션 ID"
+// @Success 200 {object} service.MissionClaimResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/missions/{id}/claim [post]
+func (h *MissionHandler) ClaimReward(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	result, err := h.missionService.ClaimReward(userInfo.UserID, id)
+	if err != nil {
+		c.JSON(missionErrorStatus(err), ErrorResponse{
+			Error:   "미션 보상 수령에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 전체 미션 목록을 조회 (관리자용)
+// @Summary 미션 관리 목록
+// @Description 비활성/기간 외 미션을 포함한 전체 미션 목록을 조회합니다. (관리자/중재자)
+// @Tags Missions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param period query string false "주기 (daily, weekly, event)"
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} AdminMissionListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /api/admin/missions [get]
+func (h *MissionHandler) AdminListMissions(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	period := model.MissionPeriod(c.Query("period"))
+	switch period {
+	case "", model.MissionPeriodDaily, model.MissionPeriodWeekly, model.MissionPeriodEvent:
+	default:
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 미션 주기입니다",
+			Message: "period는 daily, weekly, event 중 하나여야 합니다",
+		})
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	missions, err := h.missionService.GetAllMissions(period, limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "미션 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, AdminMissionListResponse{
+		Missions: missions,
+		Total:    len(missions),
+	})
+}
+
+// 미션을 생성 (관리자용)
+// @Summary 미션 생성
+// @Description 새로운 미션 템플릿을 생성합니다. 이벤트 퀘스트는 start_at과 end_at으로 진행 기간을 예약합니다. (관리자/중재자)
+// @Tags Missions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body MissionRequest true "미션 정보"
+// @Success 201 {object} model.Mission
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/missions [post]
+func (h *MissionHandler) AdminCreateMission(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	var req MissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	mission := req.toModel()
+	if err := h.missionService.CreateMission(mission); err != nil {
+		c.JSON(missionErrorStatus(err), ErrorResponse{
+			Error:   "미션 생성에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mission)
+}
+
+// 미션을 수정 (관리자용)
+// @Summary 미션 수정
+// @Description 미션 목표, 기간과 보상을 수정합니다. 이미 쌓인 진행도와 지급된 보상은 바뀌지 않습니다. (관리자/중재자)
+// @Tags Missions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "미션 ID"
+// @Param request body MissionRequest true "미션 정보"
+// @Success 200 {object} model.Mission
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/admin/missions/{id} [put]
+func (h *MissionHandler) AdminUpdateMission(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req MissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	mission, err := h.missionService.UpdateMission(id, req.toModel())
+	if err != nil {
+		c.JSON(missionErrorStatus(err), ErrorResponse{
+			Error:   "미션 수정에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mission)
+}
+
+// 미션을 삭제 (관리자용)
+// @Summary 미션 삭제
+// @Description 미션을 삭제합니다. 삭제된 미션은 더 이상 배정되지 않습니다. (관리자/중재자)
+// @Tags Missions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "미션 ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/admin/missions/{id} [delete]
+func (h *MissionHandler) AdminDeleteMission(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.missionService.DeleteMission(id); err != nil {
+		c.JSON(missionErrorStatus(err), ErrorResponse{
+			Error:   "미션 삭제에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "미션이 삭제되었습니다",
+	})
+}
+
+// 미션 서비스 에러를 HTTP 상태 코드로 변환
+func missionErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrMissionNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrInvalidMission), errors.Is(err, model.ErrInvalidUserID):
+		return http.StatusBadRequest
+	case errors.Is(err, model.ErrMissionNotCompleted), errors.Is(err, model.ErrMissionAlreadyClaimed),
+		errors.Is(err, model.ErrDuplicateMissionCode):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
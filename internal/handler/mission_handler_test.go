@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// 테스트용 Mock 미션 서비스
+type MockMissionService struct {
+	mock.Mock
+}
+
+func (m *MockMissionService) GetUserMissions(userID uint) ([]service.PlayerMission, error) {
+	args := m.Called(userID)
+	return args.Get(0).([]service.PlayerMission), args.Error(1)
+}
+
+func (m *MockMissionService) ClaimReward(userID, missionID uint) (*service.MissionClaimResult, error) {
+	args := m.Called(userID, missionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.MissionClaimResult), args.Error(1)
+}
+
+func (m *MockMissionService) CreateMission(mission *model.Mission) error {
+	args := m.Called(mission)
+	return args.Error(0)
+}
+
+func (m *MockMissionService) UpdateMission(id uint, mission *model.Mission) (*model.Mission, error) {
+	args := m.Called(id, mission)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Mission), args.Error(1)
+}
+
+func (m *MockMissionService) DeleteMission(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockMissionService) GetAllMissions(period model.MissionPeriod, limit, offset int) ([]model.Mission, error) {
+	args := m.Called(period, limit, offset)
+	return args.Get(0).([]model.Mission), args.Error(1)
+}
+
+// 테스트용 미션 라우터 설정
+func setupMissionTestRouter() (*gin.Engine, *MockMissionService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockMissionService{}
+	handler := NewMissionHandler(mockService)
+
+	missions := router.Group("/api/missions")
+	{
+		missions.GET("", handler.GetMissions)
+		missions.POST("/:id/claim", handler.ClaimReward)
+	}
+	adminMissions := router.Group("/api/admin/missions")
+	{
+		adminMissions.GET("", handler.AdminListMissions)
+		adminMissions.POST("", handler.AdminCreateMission)
+		adminMissions.PUT("/:id", handler.AdminUpdateMission)
+		adminMissions.DELETE("/:id", handler.AdminDeleteMission)
+	}
+
+	return router, mockService
+}
+
+// 내 미션 조회와 보상 수령 테스트
+func TestMissionHandler_Player(t *testing.T) {
+	router, mockService := setupMissionTestRouter()
+	mockService.On("GetUserMissions", uint(5)).Return([]service.PlayerMission{{PeriodKey: "2025-03-10"}}, nil)
+	mockService.On("ClaimReward", uint(5), uint(1)).Return(&service.MissionClaimResult{MissionID: 1, Gold: 30}, nil)
+	mockService.On("ClaimReward", uint(5), uint(2)).Return(nil, model.ErrMissionNotCompleted)
+	mockService.On("ClaimReward", uint(5), uint(3)).Return(nil, model.ErrMissionNotFound)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		userID         uint
+		expectedStatus int
+	}{
+		{name: "내 미션", method: "GET", path: "/api/missions", userID: 5, expectedStatus: http.StatusOK},
+		{name: "인증 없는 조회", method: "GET", path: "/api/missions", expectedStatus: http.StatusUnauthorized},
+		{name: "보상 수령", method: "POST", path: "/api/missions/1/claim", userID: 5, expectedStatus: http.StatusOK},
+		{name: "미완료 미션", method: "POST", path: "/api/missions/2/claim", userID: 5, expectedStatus: http.StatusConflict},
+		{name: "배정되지 않은 미션", method: "POST", path: "/api/missions/3/claim", userID: 5, expectedStatus: http.StatusNotFound},
+		{name: "잘못된 미션 ID", method: "POST", path: "/api/missions/abc/claim", userID: 5, expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(tt.method, tt.path, nil)
+			if tt.userID != 0 {
+				req = withAuthUser(req, tt.userID, "user")
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+		})
+	}
+	mockService.AssertExpectations(t)
+}
+
+// 미션 관리 테스트
+func TestMissionHandler_Admin(t *testing.T) {
+	router, mockService := setupMissionTestRouter()
+	start := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 14)
+
+	mockService.On("GetAllMissions", model.MissionPeriodEvent, 20, 0).Return([]model.Mission{{Code: "spring"}}, nil)
+	mockService.On("CreateMission", mock.MatchedBy(func(mission *model.Mission) bool {
+		return mission.Code == "spring" && mission.IsActive && mission.StartAt.Equal(start) && mission.EndAt.Equal(end)
+	})).Return(nil)
+	mockService.On("UpdateMission", uint(2), mock.Anything).Return(nil, model.ErrDuplicateMissionCode)
+	mockService.On("DeleteMission", uint(1)).Return(nil)
+
+	req, _ := http.NewRequest("GET", "/api/admin/missions?period=event", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("GET", "/api/admin/missions?period=monthly", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	body, _ := json.Marshal(MissionRequest{
+		Code:          "spring",
+		Name:          "봄 이벤트",
+		Period:        model.MissionPeriodEvent,
+		Objective:     model.MissionPlayGames,
+		Target:        10,
+		StartAt:       &start,
+		EndAt:         &end,
+		RewardDiamond: 50,
+	})
+	req, _ = http.NewRequest("POST", "/api/admin/missions", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	// 알 수 없는 목표는 바인딩 단계에서 거부
+	body, _ = json.Marshal(MissionRequest{Code: "x", Name: "x", Period: model.MissionPeriodDaily, Objective: "login", Target: 1})
+	req, _ = http.NewRequest("POST", "/api/admin/missions", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	body, _ = json.Marshal(MissionRequest{Code: "dup", Name: "중복", Period: model.MissionPeriodDaily, Objective: model.MissionEarnGold, Target: 100})
+	req, _ = http.NewRequest("PUT", "/api/admin/missions/2", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	req, _ = http.NewRequest("DELETE", "/api/admin/missions/1", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "moderator"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("DELETE", "/api/admin/missions/1", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	mockService.AssertExpectations(t)
+}
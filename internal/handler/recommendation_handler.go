@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type RecommendationServiceInterface interface {
+	Recommend(userID uint, platform string, limit int) (*service.RecommendationResult, error)
+}
+
+// 개인화 게임 추천 HTTP 요청을 처리하는 핸들러
+type RecommendationHandler struct {
+	recommendationService RecommendationServiceInterface
+}
+
+// 새로운 RecommendationHandler 인스턴스를 생성
+func NewRecommendationHandler(recommendationService RecommendationServiceInterface) *RecommendationHandler {
+	return &RecommendationHandler{
+		recommendationService: recommendationService,
+	}
+}
+
+// 나에게 맞는 추천 게임 목록을 조회
+// @Summary 추천 게임
+// @Description 플레이 기록의 카테고리/난이도 선호도와 함께 플레이한 사용자가 많은 게임을 기준으로 게임을 추천합니다. 플레이한 게임과 최소 레벨에 못 미치는 게임은 제외하며, 플레이 기록이 없으면 인기 게임을 추천합니다.
+// @Tags Games
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param platform query string false "플랫폼 (web, mobile 등)"
+// @Param limit query int false "조회 개수"
+// @Success 200 {object} service.RecommendationResult
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/games/recommended [get]
+func (h *RecommendationHandler) GetRecommendations(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	limit, _ := parsePagination(c)
+	result, err := h.recommendationService.Recommend(userInfo.UserID, c.Query("platform"), limit)
+	if err != nil {
+		c.JSON(recommendationErrorStatus(err), ErrorResponse{
+			Error:   "추천 게임 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 추천 서비스 에러를 HTTP 상태 코드로 변환
+func recommendationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrInvalidUserID):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
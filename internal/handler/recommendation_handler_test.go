@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"encoding/json"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 추천 서비스
+type MockRecommendationService struct {
+	mock.Mock
+}
+
+func (m *MockRecommendationService) Recommend(userID uint, platform string, limit int) (*service.RecommendationResult, error) {
+	args := m.Called(userID, platform, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.RecommendationResult), args.Error(1)
+}
+
+// 테스트용 추천 라우터 설정
+func setupRecommendationTestRouter() (*gin.Engine, *MockRecommendationService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockRecommendationService{}
+	handler := NewRecommendationHandler(mockService)
+	router.GET("/api/games/recommended", handler.GetRecommendations)
+
+	return router, mockService
+}
+
+// 추천 게임 조회 테스트
+func TestRecommendationHandler_GetRecommendations(t *testing.T) {
+	router, mockService := setupRecommendationTestRouter()
+	mockService.On("Recommend", uint(5), "mobile", 5).Return(&service.RecommendationResult{
+		Recommendations: []service.GameRecommendation{{Game: model.Game{Name: "Chess"}, Score: 0.9, Reason: service.RecommendReasonSimilar}},
+	}, nil)
+	mockService.On("Recommend", uint(6), "", 20).Return(nil, model.ErrInvalidUserID)
+
+	req, _ := http.NewRequest("GET", "/api/games/recommended?platform=mobile&limit=5", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 5, "user"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response service.RecommendationResult
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Len(t, response.Recommendations, 1)
+	assert.Equal(t, service.RecommendReasonSimilar, response.Recommendations[0].Reason)
+
+	req, _ = http.NewRequest("GET", "/api/games/recommended", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 6, "user"))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	req, _ = http.NewRequest("GET", "/api/games/recommended", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"g_dev/internal/auth"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// 메시지 한 건 쓰기 제한 시간
+	roomWriteWait = 10 * time.Second
+
+	// 이 시간 동안 pong이 없으면 연결을 끊음
+	roomPongWait = 60 * time.Second
+
+	// ping 주기 (pong 대기 시간보다 짧아야 함)
+	roomPingPeriod = roomPongWait * 9 / 10
+
+	// 클라이언트 메시지 최대 크기
+	roomMaxMessageSize = 64 * 1024
+
+	// 연결별 보내기 대기열 크기 (가득 차면 느린 연결로 보고 끊음)
+	roomSendBuffer = 256
+)
+
+var (
+	errRoomConnClosed     = errors.New("룸 연결이 닫혔습니다")
+	errRoomSendBufferFull = errors.New("룸 메시지 대기열이 가득 찼습니다")
+)
+
+type RoomServiceInterface interface {
+	Connect(userID uint, conn service.RoomConnection)
+	Disconnect(userID uint, conn service.RoomConnection)
+	HandleMessage(userID uint, msg *model.RoomMessage) error
+}
+
+// 액세스 토큰 검증기 (auth.JWTAuth)
+type AccessTokenValidator interface {
+	ValidateAccessToken(tokenString string) (*auth.Claims, error)
+}
+
+// 실시간 멀티플레이 룸 웹소켓 요청을 처리하는 핸들러
+type RoomHandler struct {
+	roomService RoomServiceInterface
+	tokens      AccessTokenValidator
+	upgrader    websocket.Upgrader
+}
+
+// 새로운 RoomHandler 인스턴스를 생성
+// allowedOrigins에 없는 브라우저 Origin의 연결은 거부한다 ("*"이면 모두 허용, Origin이 없는 클라이언트는 허용).
+func NewRoomHandler(roomService RoomServiceInterface, tokens AccessTokenValidator, allowedOrigins []string) *RoomHandler {
+	origins := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins[origin] = true
+		}
+	}
+
+	return &RoomHandler{
+		roomService: roomService,
+		tokens:      tokens,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  4096,
+			WriteBufferSize: 4096,
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				return origin == "" || origins["*"] || origins[origin]
+			},
+		},
+	}
+}
+
+// 룸 웹소켓 연결
+// @Summary 실시간 룸 연결
+// @Description 웹소켓으로 실시간 멀티플레이 룸 게이트웨이에 연결합니다. 브라우저에서는 token 쿼리 파라미터로 액세스 토큰을 전달합니다. 연결 후 JSON 메시지(type: create, join, leave, ready, relay, input, ping)로 룸을 만들거나 참가하고, 서버는 room_state, member_joined, member_left, member_ready, game_started, tick, game_over, relay, error 등의 메시지를 보냅니다. 매치메이킹으로 매치가 만들어지면 룸과 관계없이 match_found 메시지(payload: 매치와 게임 세션 토큰)를 보냅니다. 연결이 끊겨도 재접속 유예 시간 안에 다시 연결하면 룸 자리가 유지됩니다.
+// @Tags Rooms
+// @Param token query string false "액세스 토큰 (Authorization 헤더 대신)"
+// @Success 101 {string} string "웹소켓 프로토콜 전환"
+// @Failure 401 {object} ErrorResponse
+// @Router /ws/rooms [get]
+func (h *RoomHandler) Connect(c *gin.Context) {
+	token := roomAccessToken(c.Request)
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error:   "인증이 필요합니다",
+			Message: "유효한 액세스 토큰이 필요합니다",
+		})
+		return
+	}
+	claims, err := h.tokens.ValidateAccessToken(token)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error:   "인증이 필요합니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// 업그레이드 실패 시 응답은 upgrader가 작성
+	ws, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+
+	conn := newRoomConnection(ws)
+	go conn.writePump()
+
+	h.roomService.Connect(claims.UserID, conn)
+	h.readPump(claims.UserID, conn)
+	h.roomService.Disconnect(claims.UserID, conn)
+	conn.Close()
+}
+
+// 클라이언트 메시지를 읽어 룸 서비스에 전달 (연결이 끊길 때까지 블로킹)
+func (h *RoomHandler) readPump(userID uint, conn *roomConnection) {
+	ws := conn.ws
+	ws.SetReadLimit(roomMaxMessageSize)
+	_ = ws.SetReadDeadline(time.Now().Add(roomPongWait))
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(roomPongWait))
+	})
+
+	for {
+		_, data, err := ws.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				log.Printf("룸 연결 읽기 실패 (user_id=%d): %v", userID, err)
+			}
+			return
+		}
+
+		var msg model.RoomMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			_ = conn.Send(roomErrorMessage(model.ErrInvalidRoomMessage))
+			continue
+		}
+		if err := h.roomService.HandleMessage(userID, &msg); err != nil {
+			errMsg := roomErrorMessage(err)
+			errMsg.RoomID = msg.RoomID
+			_ = conn.Send(errMsg)
+		}
+	}
+}
+
+// 요청 처리 실패를 알리는 메시지
+func roomErrorMessage(err error) *model.RoomMessage {
+	return &model.RoomMessage{Type: model.RoomMessageError, Error: err.Error(), SentAt: time.Now()}
+}
+
+// 요청에서 액세스 토큰 추출 (Authorization Bearer 헤더, 없으면 token 쿼리 파라미터)
+// 브라우저 웹소켓 API는 헤더를 설정할 수 없어 쿼리 파라미터도 허용한다.
+func roomAccessToken(r *http.Request) string {
+	if header := r.Header.Get("Authorization"); strings.HasPrefix(header, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+	}
+	return r.URL.Query().Get("token")
+}
+
+// 웹소켓 룸 연결
+// 보내기는 대기열에 넣기만 하고, 쓰기는 한 고루틴(writePump)에서만 처리한다.
+type roomConnection struct {
+	ws        *websocket.Conn
+	send      chan *model.RoomMessage
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newRoomConnection(ws *websocket.Conn) *roomConnection {
+	return &roomConnection{
+		ws:   ws,
+		send: make(chan *model.RoomMessage, roomSendBuffer),
+		done: make(chan struct{}),
+	}
+}
+
+// 메시지를 보내기 대기열에 넣음 (가득 차면 연결을 닫음)
+func (c *roomConnection) Send(msg *model.RoomMessage) error {
+	select {
+	case <-c.done:
+		return errRoomConnClosed
+	default:
+	}
+
+	select {
+	case c.send <- msg:
+		return nil
+	default:
+		c.Close()
+		return errRoomSendBufferFull
+	}
+}
+
+// 연결을 닫음 (여러 번 호출해도 안전)
+func (c *roomConnection) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+}
+
+// 대기열의 메시지와 ping을 웹소켓으로 씀 (연결이 닫히면 웹소켓도 닫음)
+func (c *roomConnection) writePump() {
+	ticker := time.NewTicker(roomPingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.ws.Close()
+	}()
+
+	for {
+		select {
+		case msg := <-c.send:
+			_ = c.ws.SetWriteDeadline(time.Now().Add(roomWriteWait))
+			if err := c.ws.WriteJSON(msg); err != nil {
+				c.Close()
+				return
+			}
+		case <-ticker.C:
+			_ = c.ws.SetWriteDeadline(time.Now().Add(roomWriteWait))
+			if err := c.ws.WriteMessage(websocket.PingMessage, nil); err != nil {
+				c.Close()
+				return
+			}
+		case <-c.done:
+			_ = c.ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(roomWriteWait))
+			return
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/auth"
+	"g_dev/internal/middleware"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 테스트용 룸 서비스 (ping에 pong으로 답하고 leave는 실패)
+type fakeRoomService struct {
+	mu           sync.Mutex
+	conns        map[uint]service.RoomConnection
+	disconnected []uint
+}
+
+func (s *fakeRoomService) Connect(userID uint, conn service.RoomConnection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.conns[userID] = conn
+}
+
+func (s *fakeRoomService) Disconnect(userID uint, conn service.RoomConnection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.disconnected = append(s.disconnected, userID)
+}
+
+func (s *fakeRoomService) HandleMessage(userID uint, msg *model.RoomMessage) error {
+	if msg.Type == model.RoomMessageLeave {
+		return model.ErrNotInRoom
+	}
+	s.mu.Lock()
+	conn := s.conns[userID]
+	s.mu.Unlock()
+	return conn.Send(&model.RoomMessage{Type: model.RoomMessagePong, UserID: userID})
+}
+
+func (s *fakeRoomService) disconnectedUsers() []uint {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]uint{}, s.disconnected...)
+}
+
+// 테스트용 토큰 검증기 ("good-token"만 5번 사용자로 인정)
+type fakeTokenValidator struct{}
+
+func (fakeTokenValidator) ValidateAccessToken(token string) (*auth.Claims, error) {
+	if token != "good-token" {
+		return nil, errors.New("invalid token")
+	}
+	return &auth.Claims{UserID: 5, Username: "alice", Role: "user"}, nil
+}
+
+// 테스트용 룸 웹소켓 서버 설정 (로깅 미들웨어를 거쳐 업그레이드)
+func setupRoomTestServer(t *testing.T) (*httptest.Server, *fakeRoomService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	roomService := &fakeRoomService{conns: map[uint]service.RoomConnection{}}
+	handler := NewRoomHandler(roomService, fakeTokenValidator{}, []string{"http://localhost:3000"})
+	router.GET("/ws/rooms", handler.Connect)
+
+	server := httptest.NewServer(middleware.SimpleLoggingMiddleware(router))
+	t.Cleanup(server.Close)
+	return server, roomService
+}
+
+func roomWebSocketURL(server *httptest.Server, query string) string {
+	return "ws" + strings.TrimPrefix(server.URL, "http") + "/ws/rooms" + query
+}
+
+// 토큰이 없거나 유효하지 않으면 업그레이드 전에 401 응답
+func TestRoomHandler_Unauthorized(t *testing.T) {
+	server, _ := setupRoomTestServer(t)
+
+	_, resp, err := websocket.DefaultDialer.Dial(roomWebSocketURL(server, ""), nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	_, resp, err = websocket.DefaultDialer.Dial(roomWebSocketURL(server, "?token=bad-token"), nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// 허용하지 않은 Origin 거부
+	header := http.Header{"Origin": {"http://evil.example"}}
+	_, resp, err = websocket.DefaultDialer.Dial(roomWebSocketURL(server, "?token=good-token"), header)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+// 연결 후 메시지 처리, 에러 메시지, 연결 해제 테스트
+func TestRoomHandler_Connect(t *testing.T) {
+	server, roomService := setupRoomTestServer(t)
+
+	// Authorization 헤더와 허용된 Origin으로 연결
+	header := http.Header{"Authorization": {"Bearer good-token"}, "Origin": {"http://localhost:3000"}}
+	ws, _, err := websocket.DefaultDialer.Dial(roomWebSocketURL(server, ""), header)
+	require.NoError(t, err)
+	defer ws.Close()
+	require.NoError(t, ws.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	var msg model.RoomMessage
+	require.NoError(t, ws.WriteJSON(model.RoomMessage{Type: model.RoomMessagePing}))
+	require.NoError(t, ws.ReadJSON(&msg))
+	assert.Equal(t, model.RoomMessagePong, msg.Type)
+	assert.Equal(t, uint(5), msg.UserID)
+
+	// 처리 실패는 에러 메시지로 응답
+	require.NoError(t, ws.WriteJSON(model.RoomMessage{Type: model.RoomMessageLeave, RoomID: "room-1"}))
+	msg = model.RoomMessage{}
+	require.NoError(t, ws.ReadJSON(&msg))
+	assert.Equal(t, model.RoomMessageError, msg.Type)
+	assert.Equal(t, "room-1", msg.RoomID)
+	assert.Equal(t, model.ErrNotInRoom.Error(), msg.Error)
+
+	// JSON이 아닌 메시지
+	require.NoError(t, ws.WriteMessage(websocket.TextMessage, []byte("not json")))
+	msg = model.RoomMessage{}
+	require.NoError(t, ws.ReadJSON(&msg))
+	assert.Equal(t, model.ErrInvalidRoomMessage.Error(), msg.Error)
+
+	// 연결을 닫으면 서비스에서 연결 해제
+	require.NoError(t, ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")))
+	assert.Eventually(t, func() bool {
+		return len(roomService.disconnectedUsers()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []uint{5}, roomService.disconnectedUsers())
+}
+
+// 쿼리 파라미터 토큰으로 연결 (브라우저)
+func TestRoomHandler_QueryToken(t *testing.T) {
+	server, _ := setupRoomTestServer(t)
+
+	ws, resp, err := websocket.DefaultDialer.Dial(roomWebSocketURL(server, "?token=good-token"), nil)
+	require.NoError(t, err)
+	defer ws.Close()
+	assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+}
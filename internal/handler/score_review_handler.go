@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type ScoreReviewServiceInterface interface {
+	GetReviewQueue(limit, offset int) ([]model.Score, int64, error)
+	ApproveScore(scoreID, moderatorID uint) (*model.Score, error)
+	RejectScore(scoreID, moderatorID uint) (*model.Score, error)
+	SetShadowBan(userID uint, banned bool) error
+}
+
+// 의심 점수 검토와 섀도우 밴 관련 HTTP 요청을 처리하는 핸들러 (관리자/중재자)
+type ScoreReviewHandler struct {
+	reviewService ScoreReviewServiceInterface
+}
+
+// 새로운 ScoreReviewHandler 인스턴스를 생성
+func NewScoreReviewHandler(reviewService ScoreReviewServiceInterface) *ScoreReviewHandler {
+	return &ScoreReviewHandler{
+		reviewService: reviewService,
+	}
+}
+
+// 검토 대기 점수 목록 응답
+type ScoreReviewQueueResponse struct {
+	Scores []model.Score `json:"scores"`
+	Total  int64         `json:"total"`
+}
+
+// 섀도우 밴 설정 요청
+type ShadowBanRequest struct {
+	Banned *bool `json:"banned" binding:"required"`
+}
+
+// 섀도우 밴 설정 응답
+type ShadowBanResponse struct {
+	UserID uint `json:"user_id"`
+	Banned bool `json:"banned"`
+}
+
+// 검토 대기 중인 점수를 조회
+// @Summary 점수 검토 대기열
+// @Description 부정행위 의심으로 격리된 점수와 의심 사유를 오래된 순으로 조회합니다. (관리자/중재자)
+// @Tags Scores
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} ScoreReviewQueueResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /api/admin/scores/review [get]
+func (h *ScoreReviewHandler) AdminGetReviewQueue(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	scores, total, err := h.reviewService.GetReviewQueue(limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "검토 대기열 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, ScoreReviewQueueResponse{
+		Scores: scores,
+		Total:  total,
+	})
+}
+
+// 격리된 점수를 승인
+// @Summary 점수 승인
+// @Description 격리된 점수를 정상으로 승인하고 보류된 보상을 지급합니다. (관리자/중재자)
+// @Tags Scores
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "점수 ID"
+// @Success 200 {object} model.Score
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/scores/{id}/approve [post]
+func (h *ScoreReviewHandler) AdminApproveScore(c *gin.Context) {
+	h.review(c, h.reviewService.ApproveScore, "점수 승인에 실패했습니다")
+}
+
+// 격리된 점수를 거부
+// @Summary 점수 거부
+// @Description 격리된 점수를 부정 점수로 확정합니다. 보상은 지급되지 않습니다. (관리자/중재자)
+// @Tags Scores
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "점수 ID"
+// @Success 200 {object} model.Score
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/scores/{id}/reject [post]
+func (h *ScoreReviewHandler) AdminRejectScore(c *gin.Context) {
+	h.review(c, h.reviewService.RejectScore, "점수 거부에 실패했습니다")
+}
+
+// 사용자의 섀도우 밴 여부를 설정
+// @Summary 섀도우 밴 설정
+// @Description 섀도우 밴된 사용자의 점수는 본인에게 알리지 않고 검토 대기열로 격리됩니다. (관리자/중재자)
+// @Tags Scores
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "사용자 ID"
+// @Param request body ShadowBanRequest true "섀도우 밴 여부"
+// @Success 200 {object} ShadowBanResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/admin/users/{id}/shadow-ban [put]
+func (h *ScoreReviewHandler) AdminSetShadowBan(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	userID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req ShadowBanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := h.reviewService.SetShadowBan(userID, *req.Banned); err != nil {
+		c.JSON(scoreReviewErrorStatus(err), ErrorResponse{
+			Error:   "섀도우 밴 설정에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, ShadowBanResponse{
+		UserID: userID,
+		Banned: *req.Banned,
+	})
+}
+
+// 점수 검토 결과를 반영
+func (h *ScoreReviewHandler) review(c *gin.Context, apply func(scoreID, moderatorID uint) (*model.Score, error), failure string) {
+	userInfo, ok := requireStaffUser(c)
+	if !ok {
+		return
+	}
+
+	scoreID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	score, err := apply(scoreID, userInfo.UserID)
+	if err != nil {
+		c.JSON(scoreReviewErrorStatus(err), ErrorResponse{
+			Error:   failure,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, score)
+}
+
+// 점수 검토 서비스 에러를 HTTP 상태 코드로 변환
+func scoreReviewErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrScoreNotFound),
+		errors.Is(err, model.ErrInvalidUserID):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrScoreNotInReview):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"g_dev/internal/model"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 점수 검토 서비스
+type MockScoreReviewService struct {
+	mock.Mock
+}
+
+func (m *MockScoreReviewService) GetReviewQueue(limit, offset int) ([]model.Score, int64, error) {
+	args := m.Called(limit, offset)
+	return args.Get(0).([]model.Score), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockScoreReviewService) ApproveScore(scoreID, moderatorID uint) (*model.Score, error) {
+	args := m.Called(scoreID, moderatorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Score), args.Error(1)
+}
+
+func (m *MockScoreReviewService) RejectScore(scoreID, moderatorID uint) (*model.Score, error) {
+	args := m.Called(scoreID, moderatorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Score), args.Error(1)
+}
+
+func (m *MockScoreReviewService) SetShadowBan(userID uint, banned bool) error {
+	args := m.Called(userID, banned)
+	return args.Error(0)
+}
+
+// 테스트용 점수 검토 라우터 설정
+func setupScoreReviewTestRouter() (*gin.Engine, *MockScoreReviewService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockScoreReviewService{}
+	handler := NewScoreReviewHandler(mockService)
+
+	scores := router.Group("/api/admin/scores")
+	{
+		scores.GET("/review", handler.AdminGetReviewQueue)
+		scores.POST("/:id/approve", handler.AdminApproveScore)
+		scores.POST("/:id/reject", handler.AdminRejectScore)
+	}
+	router.PUT("/api/admin/users/:id/shadow-ban", handler.AdminSetShadowBan)
+
+	return router, mockService
+}
+
+// 검토 대기열 조회 테스트
+func TestScoreReviewHandler_GetReviewQueue(t *testing.T) {
+	router, mockService := setupScoreReviewTestRouter()
+	mockService.On("GetReviewQueue", 20, 0).Return([]model.Score{{Score: 9999}}, int64(1), nil)
+
+	req, _ := http.NewRequest("GET", "/api/admin/scores/review", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "moderator"))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response ScoreReviewQueueResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, int64(1), response.Total)
+	mockService.AssertExpectations(t)
+
+	req, _ = http.NewRequest("GET", "/api/admin/scores/review", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+// 점수 승인/거부 테스트
+func TestScoreReviewHandler_Review(t *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		method         string
+		mockError      error
+		expectedStatus int
+	}{
+		{name: "승인", path: "/api/admin/scores/5/approve", method: "ApproveScore", expectedStatus: http.StatusOK},
+		{name: "거부", path: "/api/admin/scores/5/reject", method: "RejectScore", expectedStatus: http.StatusOK},
+		{name: "검토 대기가 아닌 점수", path: "/api/admin/scores/5/approve", method: "ApproveScore", mockError: model.ErrScoreNotInReview, expectedStatus: http.StatusConflict},
+		{name: "없는 점수", path: "/api/admin/scores/5/reject", method: "RejectScore", mockError: model.ErrScoreNotFound, expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupScoreReviewTestRouter()
+			if tt.mockError != nil {
+				mockService.On(tt.method, uint(5), uint(9)).Return(nil, tt.mockError)
+			} else {
+				mockService.On(tt.method, uint(5), uint(9)).Return(&model.Score{Score: 100}, nil)
+			}
+
+			req, _ := http.NewRequest("POST", tt.path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+// 섀도우 밴 설정 테스트
+func TestScoreReviewHandler_SetShadowBan(t *testing.T) {
+	router, mockService := setupScoreReviewTestRouter()
+	mockService.On("SetShadowBan", uint(3), true).Return(nil)
+	mockService.On("SetShadowBan", uint(4), true).Return(model.ErrInvalidUserID)
+
+	send := func(path, body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("PUT", path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+		return w
+	}
+
+	w := send("/api/admin/users/3/shadow-ban", `{"banned":true}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response ShadowBanResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.True(t, response.Banned)
+
+	assert.Equal(t, http.StatusNotFound, send("/api/admin/users/4/shadow-ban", `{"banned":true}`).Code)
+	assert.Equal(t, http.StatusBadRequest, send("/api/admin/users/3/shadow-ban", `{}`).Code)
+	mockService.AssertExpectations(t)
+}
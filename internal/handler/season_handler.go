@@ -0,0 +1,307 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type SeasonServiceInterface interface {
+	CreateSeason(season *model.Season) error
+	GetSeasons(gameID uint) ([]model.Season, error)
+	GetSeason(id uint) (*model.Season, error)
+	GetStandings(seasonID uint, difficulty model.GameDifficulty, limit, offset int) ([]model.SeasonStanding, int64, error)
+	GetUserStandings(userID, gameID uint) ([]model.SeasonStanding, error)
+	CloseSeason(id uint) (*service.SeasonCloseResult, error)
+}
+
+// 시즌 관련 HTTP 요청을 처리하는 핸들러
+type SeasonHandler struct {
+	seasonService SeasonServiceInterface
+}
+
+// 새로운 SeasonHandler 인스턴스를 생성
+func NewSeasonHandler(seasonService SeasonServiceInterface) *SeasonHandler {
+	return &SeasonHandler{
+		seasonService: seasonService,
+	}
+}
+
+// 시즌 생성 요청 (보상 구간이 없으면 기본 구간 사용)
+type SeasonRequest struct {
+	GameID  uint                 `json:"game_id" binding:"required"`
+	Name    string               `json:"name" binding:"required,max=100"`
+	StartAt time.Time            `json:"start_at" binding:"required"`
+	EndAt   time.Time            `json:"end_at" binding:"required"`
+	Rewards []model.SeasonReward `json:"rewards"`
+}
+
+// 요청을 시즌 모델로 변환
+func (req *SeasonRequest) toModel() *model.Season {
+	season := &model.Season{
+		GameID:  req.GameID,
+		Name:    req.Name,
+		StartAt: req.StartAt,
+		EndAt:   req.EndAt,
+		Rewards: req.Rewards,
+	}
+	for i := range season.Rewards {
+		season.Rewards[i].ID = 0
+		season.Rewards[i].SeasonID = 0
+	}
+	return season
+}
+
+// 시즌 최종 순위 응답
+type SeasonStandingsResponse struct {
+	Standings []model.SeasonStanding `json:"standings"`
+	Total     int64                  `json:"total"`
+}
+
+// 시즌 목록을 조회
+// @Summary 시즌 목록
+// @Description 시즌 목록을 최근 시작 순으로 조회합니다.
+// @Tags Seasons
+// @Accept json
+// @Produce json
+// @Param game_id query int false "게임 ID"
+// @Success 200 {array} model.Season
+// @Failure 400 {object} ErrorResponse
+// @Router /api/seasons [get]
+func (h *SeasonHandler) GetSeasons(c *gin.Context) {
+	gameID, ok := parseGameIDQuery(c)
+	if !ok {
+		return
+	}
+
+	seasons, err := h.seasonService.GetSeasons(gameID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "시즌 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, seasons)
+}
+
+// 시즌 정보를 조회
+// @Summary 시즌 상세
+// @Description 시즌 기간과 보상 구간을 조회합니다.
+// @Tags Seasons
+// @Accept json
+// @Produce json
+// @Param id path int true "시즌 ID"
+// @Success 200 {object} model.Season
+// @Failure 404 {object} ErrorResponse
+// @Router /api/seasons/{id} [get]
+func (h *SeasonHandler) GetSeason(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	season, err := h.seasonService.GetSeason(id)
+	if err != nil {
+		c.JSON(seasonErrorStatus(err), ErrorResponse{
+			Error:   "시즌 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, season)
+}
+
+// 시즌 최종 순위를 조회
+// @Summary 시즌 최종 순위
+// @Description 마감된 시즌의 난이도별 최종 순위와 지급된 보상을 조회합니다.
+// @Tags Seasons
+// @Accept json
+// @Produce json
+// @Param id path int true "시즌 ID"
+// @Param difficulty query string false "난이도 (기본값 normal)"
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} SeasonStandingsResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/seasons/{id}/standings [get]
+func (h *SeasonHandler) GetStandings(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	difficulty := model.GameDifficulty(c.DefaultQuery("difficulty", string(model.GameDifficultyNormal)))
+	limit, offset := parsePagination(c)
+	standings, total, err := h.seasonService.GetStandings(id, difficulty, limit, offset)
+	if err != nil {
+		c.JSON(seasonErrorStatus(err), ErrorResponse{
+			Error:   "시즌 순위 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SeasonStandingsResponse{
+		Standings: standings,
+		Total:     total,
+	})
+}
+
+// 사용자의 지난 시즌 기록을 조회
+// @Summary 시즌 기록
+// @Description 사용자의 지난 시즌 최종 순위와 보상을 최근 시즌 순으로 조회합니다. user_id가 없으면 내 기록을 조회합니다.
+// @Tags Seasons
+// @Accept json
+// @Produce json
+// @Param user_id query int false "사용자 ID"
+// @Param game_id query int false "게임 ID"
+// @Success 200 {array} model.SeasonStanding
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/seasons/history [get]
+func (h *SeasonHandler) GetHistory(c *gin.Context) {
+	userID, ok := parseUserIDQuery(c)
+	if !ok {
+		return
+	}
+	if userID == 0 {
+		userInfo, ok := requireAuthUser(c)
+		if !ok {
+			return
+		}
+		userID = userInfo.UserID
+	}
+
+	gameID, ok := parseGameIDQuery(c)
+	if !ok {
+		return
+	}
+
+	standings, err := h.seasonService.GetUserStandings(userID, gameID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "시즌 기록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, standings)
+}
+
+// 시즌을 생성 (관리자용)
+// @Summary 시즌 생성
+// @Description 게임의 시즌을 생성합니다. 같은 게임의 시즌 기간은 겹칠 수 없습니다. (관리자/중재자)
+// @Tags Seasons
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body SeasonRequest true "시즌 정보"
+// @Success 201 {object} model.Season
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/seasons [post]
+func (h *SeasonHandler) AdminCreateSeason(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	var req SeasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	season := req.toModel()
+	if err := h.seasonService.CreateSeason(season); err != nil {
+		c.JSON(seasonErrorStatus(err), ErrorResponse{
+			Error:   "시즌 생성에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, season)
+}
+
+// 종료된 시즌을 마감 (관리자용)
+// @Summary 시즌 마감
+// @Description 기간이 끝난 시즌의 최종 순위를 보관하고 보상을 지급한 뒤 시즌 리더보드를 초기화합니다. 종료된 시즌은 주기적으로 자동 마감됩니다. (관리자/중재자)
+// @Tags Seasons
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "시즌 ID"
+// @Success 200 {object} service.SeasonCloseResult
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/seasons/{id}/close [post]
+func (h *SeasonHandler) AdminCloseSeason(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	result, err := h.seasonService.CloseSeason(id)
+	if err != nil {
+		c.JSON(seasonErrorStatus(err), ErrorResponse{
+			Error:   "시즌 마감에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// 선택적인 game_id 쿼리 파라미터를 파싱 (없으면 0)
+func parseGameIDQuery(c *gin.Context) (uint, bool) {
+	raw := c.Query("game_id")
+	if raw == "" {
+		return 0, true
+	}
+
+	gameID, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 게임 ID 형식입니다",
+			Message: err.Error(),
+		})
+		return 0, false
+	}
+	return uint(gameID), true
+}
+
+// 시즌 서비스 에러를 HTTP 상태 코드로 변환
+func seasonErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrSeasonNotFound),
+		errors.Is(err, model.ErrGameNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrInvalidSeason):
+		return http.StatusBadRequest
+	case errors.Is(err, model.ErrSeasonOverlap),
+		errors.Is(err, model.ErrSeasonClosed),
+		errors.Is(err, model.ErrSeasonNotEnded):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
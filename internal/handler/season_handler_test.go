@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// 테스트용 Mock 시즌 서비스
+type MockSeasonService struct {
+	mock.Mock
+}
+
+func (m *MockSeasonService) CreateSeason(season *model.Season) error {
+	args := m.Called(season)
+	return args.Error(0)
+}
+
+func (m *MockSeasonService) GetSeasons(gameID uint) ([]model.Season, error) {
+	args := m.Called(gameID)
+	return args.Get(0).([]model.Season), args.Error(1)
+}
+
+func (m *MockSeasonService) GetSeason(id uint) (*model.Season, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Season), args.Error(1)
+}
+
+func (m *MockSeasonService) GetStandings(seasonID uint, difficulty model.GameDifficulty, limit, offset int) ([]model.SeasonStanding, int64, error) {
+	args := m.Called(seasonID, difficulty, limit, offset)
+	return args.Get(0).([]model.SeasonStanding), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockSeasonService) GetUserStandings(userID, gameID uint) ([]model.SeasonStanding, error) {
+	args := m.Called(userID, gameID)
+	return args.Get(0).([]model.SeasonStanding), args.Error(1)
+}
+
+func (m *MockSeasonService) CloseSeason(id uint) (*service.SeasonCloseResult, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.SeasonCloseResult), args.Error(1)
+}
+
+// 테스트용 시즌 라우터 설정
+func setupSeasonTestRouter() (*gin.Engine, *MockSeasonService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockSeasonService{}
+	handler := NewSeasonHandler(mockService)
+
+	seasons := router.Group("/api/seasons")
+	{
+		seasons.GET("", handler.GetSeasons)
+		seasons.GET("/history", handler.GetHistory)
+		seasons.GET("/:id", handler.GetSeason)
+		seasons.GET("/:id/standings", handler.GetStandings)
+	}
+	adminSeasons := router.Group("/api/admin/seasons")
+	{
+		adminSeasons.POST("", handler.AdminCreateSeason)
+		adminSeasons.POST("/:id/close", handler.AdminCloseSeason)
+	}
+
+	return router, mockService
+}
+
+// 시즌 조회와 플레이어 기록 조회 테스트
+func TestSeasonHandler_Queries(t *testing.T) {
+	router, mockService := setupSeasonTestRouter()
+	mockService.On("GetSeasons", uint(3)).Return([]model.Season{{Name: "시즌 1"}}, nil)
+	mockService.On("GetSeason", uint(7)).Return(nil, model.ErrSeasonNotFound)
+	mockService.On("GetStandings", uint(1), model.GameDifficultyHard, 20, 0).Return([]model.SeasonStanding{{Rank: 1}}, int64(1), nil)
+	mockService.On("GetUserStandings", uint(5), uint(0)).Return([]model.SeasonStanding{{Rank: 2}}, nil)
+	mockService.On("GetUserStandings", uint(8), uint(3)).Return([]model.SeasonStanding{}, nil)
+
+	tests := []struct {
+		name           string
+		path           string
+		userID         uint
+		expectedStatus int
+	}{
+		{name: "시즌 목록", path: "/api/seasons?game_id=3", expectedStatus: http.StatusOK},
+		{name: "잘못된 게임 ID", path: "/api/seasons?game_id=abc", expectedStatus: http.StatusBadRequest},
+		{name: "없는 시즌", path: "/api/seasons/7", expectedStatus: http.StatusNotFound},
+		{name: "최종 순위", path: "/api/seasons/1/standings?difficulty=hard", expectedStatus: http.StatusOK},
+		{name: "내 기록", path: "/api/seasons/history", userID: 5, expectedStatus: http.StatusOK},
+		{name: "다른 플레이어 기록", path: "/api/seasons/history?user_id=8&game_id=3", expectedStatus: http.StatusOK},
+		{name: "인증 없는 내 기록", path: "/api/seasons/history", expectedStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			if tt.userID != 0 {
+				req = withAuthUser(req, tt.userID, "user")
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+		})
+	}
+	mockService.AssertExpectations(t)
+}
+
+// 시즌 생성과 마감 테스트
+func TestSeasonHandler_Admin(t *testing.T) {
+	router, mockService := setupSeasonTestRouter()
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("CreateSeason", mock.MatchedBy(func(season *model.Season) bool {
+		return season.GameID == 3 && season.StartAt.Equal(start) && len(season.Rewards) == 1 && season.Rewards[0].ID == 0
+	})).Return(nil)
+	mockService.On("CloseSeason", uint(1)).Return(&service.SeasonCloseResult{Standings: 10, Rewarded: 2}, nil)
+	mockService.On("CloseSeason", uint(2)).Return(nil, model.ErrSeasonNotEnded)
+
+	body, _ := json.Marshal(SeasonRequest{
+		GameID:  3,
+		Name:    "시즌 1",
+		StartAt: start,
+		EndAt:   start.AddDate(0, 1, 0),
+		Rewards: []model.SeasonReward{{ID: 99, Name: "1위", MaxRank: 1, Gold: 100}},
+	})
+	req, _ := http.NewRequest("POST", "/api/admin/seasons", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	req, _ = http.NewRequest("POST", "/api/admin/seasons/1/close", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("POST", "/api/admin/seasons/2/close", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	req, _ = http.NewRequest("POST", "/api/admin/seasons/1/close", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type SkillRatingServiceInterface interface {
+	GetRating(userID, gameID uint) (*model.SkillRating, error)
+	GetHistory(userID, gameID uint, limit, offset int) ([]model.SkillRatingHistory, int64, error)
+	GetRankings(gameID uint, tier model.RankTier, limit, offset int) ([]model.SkillRating, int64, error)
+}
+
+// 스킬 레이팅 관련 HTTP 요청을 처리하는 핸들러
+type SkillRatingHandler struct {
+	ratingService SkillRatingServiceInterface
+}
+
+// 새로운 SkillRatingHandler 인스턴스를 생성
+func NewSkillRatingHandler(ratingService SkillRatingServiceInterface) *SkillRatingHandler {
+	return &SkillRatingHandler{
+		ratingService: ratingService,
+	}
+}
+
+// 스킬 레이팅 순위 응답
+type SkillRatingListResponse struct {
+	Ratings []model.SkillRating `json:"ratings"`
+	Total   int64               `json:"total"`
+}
+
+// 스킬 레이팅 변동 기록 응답
+type SkillRatingHistoryResponse struct {
+	History []model.SkillRatingHistory `json:"history"`
+	Total   int64                      `json:"total"`
+}
+
+// 게임의 스킬 레이팅 순위를 조회
+// @Summary 스킬 레이팅 순위
+// @Description 게임의 스킬 레이팅 순위를 조회합니다. 편차가 커서 배치 중인 플레이어는 제외합니다.
+// @Tags Ratings
+// @Accept json
+// @Produce json
+// @Param game_id path int true "게임 ID"
+// @Param tier query string false "랭크 티어 (bronze, silver, gold, platinum, diamond, master)"
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} SkillRatingListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/ratings/{game_id} [get]
+func (h *SkillRatingHandler) GetRankings(c *gin.Context) {
+	gameID, ok := parseIDParam(c, "game_id")
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	ratings, total, err := h.ratingService.GetRankings(gameID, model.RankTier(c.Query("tier")), limit, offset)
+	if err != nil {
+		c.JSON(skillRatingErrorStatus(err), ErrorResponse{
+			Error:   "스킬 레이팅 순위 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SkillRatingListResponse{
+		Ratings: ratings,
+		Total:   total,
+	})
+}
+
+// 내 스킬 레이팅을 조회
+// @Summary 내 스킬 레이팅
+// @Description 게임의 내 레이팅, 편차, 랭크 티어를 조회합니다. 매치 기록이 없으면 기본 레이팅을 반환합니다.
+// @Tags Ratings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param game_id path int true "게임 ID"
+// @Success 200 {object} model.SkillRating
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/ratings/{game_id}/me [get]
+func (h *SkillRatingHandler) GetMyRating(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+	gameID, ok := parseIDParam(c, "game_id")
+	if !ok {
+		return
+	}
+
+	rating, err := h.ratingService.GetRating(userInfo.UserID, gameID)
+	if err != nil {
+		c.JSON(skillRatingErrorStatus(err), ErrorResponse{
+			Error:   "스킬 레이팅 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, rating)
+}
+
+// 내 스킬 레이팅 변동 기록을 조회
+// @Summary 내 스킬 레이팅 기록
+// @Description 게임의 매치별 레이팅 변동 기록을 최신순으로 조회합니다.
+// @Tags Ratings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param game_id path int true "게임 ID"
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} SkillRatingHistoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/ratings/{game_id}/history [get]
+func (h *SkillRatingHandler) GetMyHistory(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+	gameID, ok := parseIDParam(c, "game_id")
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	history, total, err := h.ratingService.GetHistory(userInfo.UserID, gameID, limit, offset)
+	if err != nil {
+		c.JSON(skillRatingErrorStatus(err), ErrorResponse{
+			Error:   "스킬 레이팅 기록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SkillRatingHistoryResponse{
+		History: history,
+		Total:   total,
+	})
+}
+
+// 스킬 레이팅 서비스 에러를 HTTP 상태 코드로 변환
+func skillRatingErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrGameNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrInvalidRankTier):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
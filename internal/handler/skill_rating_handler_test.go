@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"encoding/json"
+	"g_dev/internal/model"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 스킬 레이팅 서비스
+type MockSkillRatingService struct {
+	mock.Mock
+}
+
+func (m *MockSkillRatingService) GetRating(userID, gameID uint) (*model.SkillRating, error) {
+	args := m.Called(userID, gameID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.SkillRating), args.Error(1)
+}
+
+func (m *MockSkillRatingService) GetHistory(userID, gameID uint, limit, offset int) ([]model.SkillRatingHistory, int64, error) {
+	args := m.Called(userID, gameID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]model.SkillRatingHistory), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockSkillRatingService) GetRankings(gameID uint, tier model.RankTier, limit, offset int) ([]model.SkillRating, int64, error) {
+	args := m.Called(gameID, tier, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]model.SkillRating), args.Get(1).(int64), args.Error(2)
+}
+
+// 테스트용 스킬 레이팅 라우터 설정
+func setupSkillRatingTestRouter() (*gin.Engine, *MockSkillRatingService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockSkillRatingService{}
+	handler := NewSkillRatingHandler(mockService)
+	router.GET("/api/ratings/:game_id", handler.GetRankings)
+	router.GET("/api/ratings/:game_id/me", handler.GetMyRating)
+	router.GET("/api/ratings/:game_id/history", handler.GetMyHistory)
+
+	return router, mockService
+}
+
+// 스킬 레이팅 순위 조회 테스트
+func TestSkillRatingHandler_GetRankings(t *testing.T) {
+	router, mockService := setupSkillRatingTestRouter()
+	mockService.On("GetRankings", uint(1), model.RankTierGold, 20, 0).
+		Return([]model.SkillRating{{UserID: 5, Rating: 1450, Tier: model.RankTierGold}}, int64(1), nil)
+	mockService.On("GetRankings", uint(1), model.RankTier("grandmaster"), 20, 0).Return(nil, int64(0), model.ErrInvalidRankTier)
+	mockService.On("GetRankings", uint(9), model.RankTier(""), 20, 0).Return(nil, int64(0), model.ErrGameNotFound)
+
+	req, _ := http.NewRequest("GET", "/api/ratings/1?tier=gold", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response SkillRatingListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, int64(1), response.Total)
+	assert.Equal(t, model.RankTierGold, response.Ratings[0].Tier)
+
+	req, _ = http.NewRequest("GET", "/api/ratings/1?tier=grandmaster", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	req, _ = http.NewRequest("GET", "/api/ratings/9", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	mockService.AssertExpectations(t)
+}
+
+// 내 스킬 레이팅과 변동 기록 조회 테스트
+func TestSkillRatingHandler_MyRating(t *testing.T) {
+	router, mockService := setupSkillRatingTestRouter()
+	mockService.On("GetRating", uint(5), uint(1)).Return(&model.SkillRating{UserID: 5, Rating: 1520.5, Deviation: 90, Tier: model.RankTierGold}, nil)
+	mockService.On("GetHistory", uint(5), uint(1), 10, 0).
+		Return([]model.SkillRatingHistory{{UserID: 5, Rating: 1520.5, RatingDelta: 20.5, Tier: model.RankTierGold}}, int64(3), nil)
+
+	req, _ := http.NewRequest("GET", "/api/ratings/1/me", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 5, "user"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var rating model.SkillRating
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &rating))
+	assert.Equal(t, 1520.5, rating.Rating)
+
+	req, _ = http.NewRequest("GET", "/api/ratings/1/history?limit=10", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 5, "user"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var history SkillRatingHistoryResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &history))
+	assert.Equal(t, int64(3), history.Total)
+	assert.Equal(t, 20.5, history.History[0].RatingDelta)
+
+	req, _ = http.NewRequest("GET", "/api/ratings/1/me", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	req, _ = http.NewRequest("GET", "/api/ratings/abc/history", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 5, "user"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	mockService.AssertExpectations(t)
+}
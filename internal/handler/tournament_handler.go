@@ -0,0 +1,645 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type TournamentServiceInterface interface {
+	CreateTournament(tournament *model.Tournament) error
+	GetTournaments(gameID uint, status model.TournamentStatus, limit, offset int) ([]model.Tournament, int64, error)
+	GetTournament(id uint) (*model.Tournament, error)
+	GetBracket(id uint) (*service.TournamentBracketView, error)
+	Register(userID, tournamentID uint) (*model.TournamentParticipant, error)
+	Withdraw(userID, tournamentID uint) error
+	StartTournament(id uint) (*model.Tournament, error)
+	CancelTournament(id uint) (*model.Tournament, error)
+	ReportResult(userID, tournamentID, matchID uint, myScore, opponentScore int) (*model.TournamentMatch, error)
+	DisputeResult(userID, tournamentID, matchID uint, reason string) (*model.TournamentDispute, error)
+	GetDisputes(status model.TournamentDisputeStatus, limit, offset int) ([]model.TournamentDispute, int64, error)
+	ResolveDispute(adminID, disputeID uint, score1, score2 int, resolution string) (*model.TournamentDispute, error)
+	SetMatchResult(adminID, tournamentID, matchID uint, score1, score2 int, resolution string) (*model.TournamentMatch, error)
+}
+
+// 토너먼트 관련 HTTP 요청을 처리하는 핸들러
+type TournamentHandler struct {
+	tournamentService TournamentServiceInterface
+}
+
+// 새로운 TournamentHandler 인스턴스를 생성
+func NewTournamentHandler(tournamentService TournamentServiceInterface) *TournamentHandler {
+	return &TournamentHandler{
+		tournamentService: tournamentService,
+	}
+}
+
+// 토너먼트 생성 요청
+type TournamentRequest struct {
+	GameID              uint                   `json:"game_id" binding:"required"`
+	Name                string                 `json:"name" binding:"required,max=100"`
+	Description         string                 `json:"description" binding:"max=1000"`
+	Format              model.TournamentFormat `json:"format" binding:"required,oneof=single_elimination double_elimination swiss"`
+	MinParticipants     int                    `json:"min_participants" binding:"omitempty,min=2"`
+	MaxParticipants     int                    `json:"max_participants" binding:"required,min=2"`
+	RegistrationStartAt time.Time              `json:"registration_start_at" binding:"required"`
+	RegistrationEndAt   time.Time              `json:"registration_end_at" binding:"required"`
+	EntryCurrency       string                 `json:"entry_currency" binding:"omitempty,oneof=gold diamond"`
+	EntryFee            int                    `json:"entry_fee" binding:"min=0"`
+	BasePrize           int                    `json:"base_prize" binding:"min=0"`
+	PrizeSplit          string                 `json:"prize_split" binding:"max=200"`
+	SwissRounds         int                    `json:"swiss_rounds" binding:"min=0"`
+}
+
+// 요청을 토너먼트 모델로 변환
+func (req *TournamentRequest) toModel(createdBy uint) *model.Tournament {
+	return &model.Tournament{
+		GameID:              req.GameID,
+		Name:                req.Name,
+		Description:         req.Description,
+		Format:              req.Format,
+		MinParticipants:     req.MinParticipants,
+		MaxParticipants:     req.MaxParticipants,
+		RegistrationStartAt: req.RegistrationStartAt,
+		RegistrationEndAt:   req.RegistrationEndAt,
+		EntryCurrency:       req.EntryCurrency,
+		EntryFee:            req.EntryFee,
+		BasePrize:           req.BasePrize,
+		PrizeSplit:          req.PrizeSplit,
+		SwissRounds:         req.SwissRounds,
+		CreatedBy:           createdBy,
+	}
+}
+
+// 매치 결과 보고 요청 (내 점수와 상대 점수, 무승부 불가)
+type ReportMatchRequest struct {
+	MyScore       *int `json:"my_score" binding:"required,min=0"`
+	OpponentScore *int `json:"opponent_score" binding:"required,min=0"`
+}
+
+// 매치 결과 이의 제기 요청
+type DisputeMatchRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// 이의 제기 판정 요청 (1번 자리와 2번 자리 참가자의 점수)
+type ResolveDisputeRequest struct {
+	Score1     *int   `json:"score1" binding:"required,min=0"`
+	Score2     *int   `json:"score2" binding:"required,min=0"`
+	Resolution string `json:"resolution" binding:"max=500"`
+}
+
+// 매치 결과 직접 확정 요청 (1번 자리와 2번 자리 참가자의 점수)
+type SetMatchResultRequest struct {
+	Score1     *int   `json:"score1" binding:"required,min=0"`
+	Score2     *int   `json:"score2" binding:"required,min=0"`
+	Resolution string `json:"resolution" binding:"max=500"`
+}
+
+// 토너먼트 목록 응답
+type TournamentListResponse struct {
+	Tournaments []model.Tournament `json:"tournaments"`
+	Total       int64              `json:"total"`
+}
+
+// 이의 제기 목록 응답
+type TournamentDisputeListResponse struct {
+	Disputes []model.TournamentDispute `json:"disputes"`
+	Total    int64                     `json:"total"`
+}
+
+// 토너먼트 목록을 조회
+// @Summary 토너먼트 목록
+// @Description 토너먼트 목록을 참가 신청 마감이 늦은 순으로 조회합니다.
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Param game_id query int false "게임 ID"
+// @Param status query string false "상태 (registration, in_progress, completed, cancelled)"
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} TournamentListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/tournaments [get]
+func (h *TournamentHandler) GetTournaments(c *gin.Context) {
+	gameID, ok := parseGameIDQuery(c)
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	tournaments, total, err := h.tournamentService.GetTournaments(gameID, model.TournamentStatus(c.Query("status")), limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "토너먼트 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, TournamentListResponse{
+		Tournaments: tournaments,
+		Total:       total,
+	})
+}
+
+// 토너먼트 정보를 조회
+// @Summary 토너먼트 상세
+// @Description 토너먼트 방식, 참가 신청 기간, 참가비, 상금을 조회합니다.
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Param id path int true "토너먼트 ID"
+// @Success 200 {object} model.Tournament
+// @Failure 404 {object} ErrorResponse
+// @Router /api/tournaments/{id} [get]
+func (h *TournamentHandler) GetTournament(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	tournament, err := h.tournamentService.GetTournament(id)
+	if err != nil {
+		c.JSON(tournamentErrorStatus(err), ErrorResponse{
+			Error:   "토너먼트 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, tournament)
+}
+
+// 토너먼트 대진표를 조회
+// @Summary 토너먼트 대진표
+// @Description 참가자 시드와 매치별 대진, 결과를 조회합니다.
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Param id path int true "토너먼트 ID"
+// @Success 200 {object} service.TournamentBracketView
+// @Failure 404 {object} ErrorResponse
+// @Router /api/tournaments/{id}/bracket [get]
+func (h *TournamentHandler) GetBracket(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	bracket, err := h.tournamentService.GetBracket(id)
+	if err != nil {
+		c.JSON(tournamentErrorStatus(err), ErrorResponse{
+			Error:   "토너먼트 대진표 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, bracket)
+}
+
+// 토너먼트에 참가 신청
+// @Summary 토너먼트 참가 신청
+// @Description 참가 신청 기간에 토너먼트에 참가합니다. 참가비는 바로 차감되어 상금에 더해집니다.
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "토너먼트 ID"
+// @Success 201 {object} model.TournamentParticipant
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/tournaments/{id}/register [post]
+func (h *TournamentHandler) Register(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	participant, err := h.tournamentService.Register(userInfo.UserID, id)
+	if err != nil {
+		c.JSON(tournamentErrorStatus(err), ErrorResponse{
+			Error:   "토너먼트 참가 신청에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, participant)
+}
+
+// 토너먼트 참가를 취소
+// @Summary 토너먼트 참가 취소
+// @Description 시작 전인 토너먼트의 참가를 취소하고 참가비를 환불받습니다.
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "토너먼트 ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/tournaments/{id}/register [delete]
+func (h *TournamentHandler) Withdraw(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.tournamentService.Withdraw(userInfo.UserID, id); err != nil {
+		c.JSON(tournamentErrorStatus(err), ErrorResponse{
+			Error:   "토너먼트 참가 취소에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "토너먼트 참가가 취소되었습니다",
+	})
+}
+
+// 매치 결과를 보고
+// @Summary 토너먼트 매치 결과 보고
+// @Description 내 점수와 상대 점수를 보고합니다. 상대가 같은 결과를 보고하면 확정되어 다음 매치로 진출하고, 다른 결과를 보고하면 이의 제기로 넘어갑니다.
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "토너먼트 ID"
+// @Param match_id path int true "매치 ID"
+// @Param request body ReportMatchRequest true "매치 결과"
+// @Success 200 {object} model.TournamentMatch
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/tournaments/{id}/matches/{match_id}/report [post]
+func (h *TournamentHandler) ReportResult(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	matchID, ok := parseIDParam(c, "match_id")
+	if !ok {
+		return
+	}
+
+	var req ReportMatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	match, err := h.tournamentService.ReportResult(userInfo.UserID, id, matchID, *req.MyScore, *req.OpponentScore)
+	if err != nil {
+		c.JSON(tournamentErrorStatus(err), ErrorResponse{
+			Error:   "매치 결과 보고에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, match)
+}
+
+// 상대가 보고한 매치 결과에 이의를 제기
+// @Summary 토너먼트 매치 이의 제기
+// @Description 상대가 보고한 결과에 이의를 제기합니다. 관리자가 판정할 때까지 매치는 진행되지 않습니다.
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "토너먼트 ID"
+// @Param match_id path int true "매치 ID"
+// @Param request body DisputeMatchRequest true "이의 제기 사유"
+// @Success 201 {object} model.TournamentDispute
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/tournaments/{id}/matches/{match_id}/dispute [post]
+func (h *TournamentHandler) DisputeResult(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	matchID, ok := parseIDParam(c, "match_id")
+	if !ok {
+		return
+	}
+
+	var req DisputeMatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	dispute, err := h.tournamentService.DisputeResult(userInfo.UserID, id, matchID, req.Reason)
+	if err != nil {
+		c.JSON(tournamentErrorStatus(err), ErrorResponse{
+			Error:   "이의 제기에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dispute)
+}
+
+// 토너먼트를 생성 (관리자용)
+// @Summary 토너먼트 생성
+// @Description 참가 신청 기간, 방식, 참가비와 순위별 상금 비율(예: "50,30,20")을 정해 토너먼트를 생성합니다. (관리자/중재자)
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body TournamentRequest true "토너먼트 정보"
+// @Success 201 {object} model.Tournament
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/admin/tournaments [post]
+func (h *TournamentHandler) AdminCreateTournament(c *gin.Context) {
+	userInfo, ok := requireStaffUser(c)
+	if !ok {
+		return
+	}
+
+	var req TournamentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	tournament := req.toModel(userInfo.UserID)
+	if err := h.tournamentService.CreateTournament(tournament); err != nil {
+		c.JSON(tournamentErrorStatus(err), ErrorResponse{
+			Error:   "토너먼트 생성에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, tournament)
+}
+
+// 토너먼트를 바로 시작 (관리자용)
+// @Summary 토너먼트 시작
+// @Description 참가 신청을 마감하고 스킬 레이팅 순으로 시드를 정해 대진을 생성합니다. 마감 시각이 지난 토너먼트는 자동으로 시작됩니다. (관리자/중재자)
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "토너먼트 ID"
+// @Success 200 {object} model.Tournament
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/tournaments/{id}/start [post]
+func (h *TournamentHandler) AdminStartTournament(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	tournament, err := h.tournamentService.StartTournament(id)
+	if err != nil {
+		c.JSON(tournamentErrorStatus(err), ErrorResponse{
+			Error:   "토너먼트 시작에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, tournament)
+}
+
+// 토너먼트를 취소 (관리자용)
+// @Summary 토너먼트 취소
+// @Description 완료되지 않은 토너먼트를 취소하고 참가비를 환불합니다. (관리자/중재자)
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "토너먼트 ID"
+// @Success 200 {object} model.Tournament
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/tournaments/{id}/cancel [post]
+func (h *TournamentHandler) AdminCancelTournament(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	tournament, err := h.tournamentService.CancelTournament(id)
+	if err != nil {
+		c.JSON(tournamentErrorStatus(err), ErrorResponse{
+			Error:   "토너먼트 취소에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, tournament)
+}
+
+// 이의 제기 목록을 조회 (관리자용)
+// @Summary 토너먼트 이의 제기 목록
+// @Description 매치 결과 이의 제기를 오래된 순으로 조회합니다. (관리자/중재자)
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "상태 (open, resolved)"
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} TournamentDisputeListResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /api/admin/tournaments/disputes [get]
+func (h *TournamentHandler) AdminGetDisputes(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	disputes, total, err := h.tournamentService.GetDisputes(model.TournamentDisputeStatus(c.Query("status")), limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "이의 제기 목록 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, TournamentDisputeListResponse{
+		Disputes: disputes,
+		Total:    total,
+	})
+}
+
+// 이의 제기를 판정 (관리자용)
+// @Summary 토너먼트 이의 제기 판정
+// @Description 이의 제기된 매치의 결과를 확정하고 승자를 다음 매치로 진출시킵니다. (관리자/중재자)
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "이의 제기 ID"
+// @Param request body ResolveDisputeRequest true "판정 결과"
+// @Success 200 {object} model.TournamentDispute
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/tournaments/disputes/{id}/resolve [post]
+func (h *TournamentHandler) AdminResolveDispute(c *gin.Context) {
+	userInfo, ok := requireStaffUser(c)
+	if !ok {
+		return
+	}
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req ResolveDisputeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	dispute, err := h.tournamentService.ResolveDispute(userInfo.UserID, id, *req.Score1, *req.Score2, req.Resolution)
+	if err != nil {
+		c.JSON(tournamentErrorStatus(err), ErrorResponse{
+			Error:   "이의 제기 판정에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dispute)
+}
+
+// 매치 결과를 직접 확정 (관리자용)
+// @Summary 토너먼트 매치 결과 확정
+// @Description 보고되지 않았거나 이의 제기된 매치의 결과를 확정합니다. 불참이나 기권은 상대 승리 점수(예: 1:0)로 몰수패 처리합니다. (관리자/중재자)
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "토너먼트 ID"
+// @Param match_id path int true "매치 ID"
+// @Param request body SetMatchResultRequest true "확정 결과"
+// @Success 200 {object} model.TournamentMatch
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/admin/tournaments/{id}/matches/{match_id}/result [post]
+func (h *TournamentHandler) AdminSetMatchResult(c *gin.Context) {
+	userInfo, ok := requireStaffUser(c)
+	if !ok {
+		return
+	}
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	matchID, ok := parseIDParam(c, "match_id")
+	if !ok {
+		return
+	}
+
+	var req SetMatchResultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	match, err := h.tournamentService.SetMatchResult(userInfo.UserID, id, matchID, *req.Score1, *req.Score2, req.Resolution)
+	if err != nil {
+		c.JSON(tournamentErrorStatus(err), ErrorResponse{
+			Error:   "매치 결과 확정에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, match)
+}
+
+// 토너먼트 서비스 에러를 HTTP 상태 코드로 변환
+func tournamentErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrTournamentNotFound),
+		errors.Is(err, model.ErrTournamentMatchNotFound),
+		errors.Is(err, model.ErrDisputeNotFound),
+		errors.Is(err, model.ErrNotRegistered),
+		errors.Is(err, model.ErrGameNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrInvalidTournament),
+		errors.Is(err, model.ErrInvalidMatchResult),
+		errors.Is(err, service.ErrInsufficientGold),
+		errors.Is(err, service.ErrInsufficientDiamond):
+		return http.StatusBadRequest
+	case errors.Is(err, model.ErrNotMatchParticipant):
+		return http.StatusForbidden
+	case errors.Is(err, model.ErrRegistrationClosed),
+		errors.Is(err, model.ErrTournamentFull),
+		errors.Is(err, model.ErrAlreadyRegistered),
+		errors.Is(err, model.ErrTournamentNotStartable),
+		errors.Is(err, model.ErrMatchNotReportable):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,372 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// 테스트용 Mock 토너먼트 서비스
+type MockTournamentService struct {
+	mock.Mock
+}
+
+func (m *MockTournamentService) CreateTournament(tournament *model.Tournament) error {
+	args := m.Called(tournament)
+	return args.Error(0)
+}
+
+func (m *MockTournamentService) GetTournaments(gameID uint, status model.TournamentStatus, limit, offset int) ([]model.Tournament, int64, error) {
+	args := m.Called(gameID, status, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]model.Tournament), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTournamentService) GetTournament(id uint) (*model.Tournament, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Tournament), args.Error(1)
+}
+
+func (m *MockTournamentService) GetBracket(id uint) (*service.TournamentBracketView, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.TournamentBracketView), args.Error(1)
+}
+
+func (m *MockTournamentService) Register(userID, tournamentID uint) (*model.TournamentParticipant, error) {
+	args := m.Called(userID, tournamentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.TournamentParticipant), args.Error(1)
+}
+
+func (m *MockTournamentService) Withdraw(userID, tournamentID uint) error {
+	args := m.Called(userID, tournamentID)
+	return args.Error(0)
+}
+
+func (m *MockTournamentService) StartTournament(id uint) (*model.Tournament, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Tournament), args.Error(1)
+}
+
+func (m *MockTournamentService) CancelTournament(id uint) (*model.Tournament, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Tournament), args.Error(1)
+}
+
+func (m *MockTournamentService) ReportResult(userID, tournamentID, matchID uint, myScore, opponentScore int) (*model.TournamentMatch, error) {
+	args := m.Called(userID, tournamentID, matchID, myScore, opponentScore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.TournamentMatch), args.Error(1)
+}
+
+func (m *MockTournamentService) DisputeResult(userID, tournamentID, matchID uint, reason string) (*model.TournamentDispute, error) {
+	args := m.Called(userID, tournamentID, matchID, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.TournamentDispute), args.Error(1)
+}
+
+func (m *MockTournamentService) GetDisputes(status model.TournamentDisputeStatus, limit, offset int) ([]model.TournamentDispute, int64, error) {
+	args := m.Called(status, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]model.TournamentDispute), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTournamentService) ResolveDispute(adminID, disputeID uint, score1, score2 int, resolution string) (*model.TournamentDispute, error) {
+	args := m.Called(adminID, disputeID, score1, score2, resolution)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.TournamentDispute), args.Error(1)
+}
+
+func (m *MockTournamentService) SetMatchResult(adminID, tournamentID, matchID uint, score1, score2 int, resolution string) (*model.TournamentMatch, error) {
+	args := m.Called(adminID, tournamentID, matchID, score1, score2, resolution)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.TournamentMatch), args.Error(1)
+}
+
+// 테스트용 토너먼트 라우터 설정
+func setupTournamentTestRouter() (*gin.Engine, *MockTournamentService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockTournamentService{}
+	handler := NewTournamentHandler(mockService)
+	tournaments := router.Group("/api/tournaments")
+	{
+		tournaments.GET("", handler.GetTournaments)
+		tournaments.GET("/:id", handler.GetTournament)
+		tournaments.GET("/:id/bracket", handler.GetBracket)
+		tournaments.POST("/:id/register", handler.Register)
+		tournaments.DELETE("/:id/register", handler.Withdraw)
+		tournaments.POST("/:id/matches/:match_id/report", handler.ReportResult)
+		tournaments.POST("/:id/matches/:match_id/dispute", handler.DisputeResult)
+	}
+	adminTournaments := router.Group("/api/admin/tournaments")
+	{
+		adminTournaments.POST("", handler.AdminCreateTournament)
+		adminTournaments.POST("/:id/start", handler.AdminStartTournament)
+		adminTournaments.POST("/:id/cancel", handler.AdminCancelTournament)
+		adminTournaments.POST("/:id/matches/:match_id/result", handler.AdminSetMatchResult)
+		adminTournaments.GET("/disputes", handler.AdminGetDisputes)
+		adminTournaments.POST("/disputes/:id/resolve", handler.AdminResolveDispute)
+	}
+
+	return router, mockService
+}
+
+// 토너먼트 목록, 상세, 대진표 조회 테스트
+func TestTournamentHandler_Queries(t *testing.T) {
+	router, mockService := setupTournamentTestRouter()
+	mockService.On("GetTournaments", uint(3), model.TournamentRegistration, 20, 0).
+		Return([]model.Tournament{{Name: "봄 컵", ParticipantCount: 4}}, int64(1), nil)
+	mockService.On("GetTournament", uint(1)).Return(&model.Tournament{Name: "봄 컵"}, nil)
+	mockService.On("GetTournament", uint(7)).Return(nil, model.ErrTournamentNotFound)
+	mockService.On("GetBracket", uint(1)).Return(&service.TournamentBracketView{
+		Tournament: &model.Tournament{Name: "봄 컵"},
+		Matches:    []model.TournamentMatch{{Bracket: model.BracketWinners, Round: 1, Position: 1}},
+	}, nil)
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedStatus int
+	}{
+		{name: "토너먼트 목록", path: "/api/tournaments?game_id=3&status=registration", expectedStatus: http.StatusOK},
+		{name: "잘못된 게임 ID", path: "/api/tournaments?game_id=abc", expectedStatus: http.StatusBadRequest},
+		{name: "토너먼트 상세", path: "/api/tournaments/1", expectedStatus: http.StatusOK},
+		{name: "없는 토너먼트", path: "/api/tournaments/7", expectedStatus: http.StatusNotFound},
+		{name: "대진표", path: "/api/tournaments/1/bracket", expectedStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+		})
+	}
+
+	req, _ := http.NewRequest("GET", "/api/tournaments/1/bracket", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	var bracket service.TournamentBracketView
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &bracket))
+	assert.Len(t, bracket.Matches, 1)
+
+	mockService.AssertExpectations(t)
+}
+
+// 참가 신청과 취소 테스트
+func TestTournamentHandler_Registration(t *testing.T) {
+	router, mockService := setupTournamentTestRouter()
+	mockService.On("Register", uint(5), uint(1)).Return(&model.TournamentParticipant{TournamentID: 1, UserID: 5, EntryPaid: 100}, nil)
+	mockService.On("Register", uint(5), uint(2)).Return(nil, model.ErrRegistrationClosed)
+	mockService.On("Register", uint(6), uint(1)).Return(nil, service.ErrInsufficientGold)
+	mockService.On("Withdraw", uint(5), uint(1)).Return(nil)
+	mockService.On("Withdraw", uint(5), uint(3)).Return(model.ErrNotRegistered)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		userID         uint
+		expectedStatus int
+	}{
+		{name: "참가 신청", method: "POST", path: "/api/tournaments/1/register", userID: 5, expectedStatus: http.StatusCreated},
+		{name: "신청 기간 아님", method: "POST", path: "/api/tournaments/2/register", userID: 5, expectedStatus: http.StatusConflict},
+		{name: "골드 부족", method: "POST", path: "/api/tournaments/1/register", userID: 6, expectedStatus: http.StatusBadRequest},
+		{name: "인증 없음", method: "POST", path: "/api/tournaments/1/register", expectedStatus: http.StatusUnauthorized},
+		{name: "참가 취소", method: "DELETE", path: "/api/tournaments/1/register", userID: 5, expectedStatus: http.StatusOK},
+		{name: "신청하지 않은 토너먼트", method: "DELETE", path: "/api/tournaments/3/register", userID: 5, expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(tt.method, tt.path, nil)
+			if tt.userID != 0 {
+				req = withAuthUser(req, tt.userID, "user")
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+		})
+	}
+	mockService.AssertExpectations(t)
+}
+
+// 매치 결과 보고와 이의 제기 테스트
+func TestTournamentHandler_Matches(t *testing.T) {
+	router, mockService := setupTournamentTestRouter()
+	mockService.On("ReportResult", uint(5), uint(1), uint(10), 3, 0).Return(&model.TournamentMatch{ID: 10, Status: model.TournamentMatchReported}, nil)
+	mockService.On("ReportResult", uint(8), uint(1), uint(10), 3, 0).Return(nil, model.ErrNotMatchParticipant)
+	mockService.On("ReportResult", uint(5), uint(1), uint(10), 1, 1).Return(nil, model.ErrInvalidMatchResult)
+	mockService.On("DisputeResult", uint(6), uint(1), uint(10), "점수가 다릅니다").Return(&model.TournamentDispute{ID: 2, MatchID: 10, Status: model.DisputeOpen}, nil)
+	mockService.On("DisputeResult", uint(6), uint(1), uint(11), "점수가 다릅니다").Return(nil, model.ErrMatchNotReportable)
+
+	tests := []struct {
+		name           string
+		path           string
+		body           interface{}
+		userID         uint
+		expectedStatus int
+	}{
+		{name: "결과 보고", path: "/api/tournaments/1/matches/10/report", body: map[string]int{"my_score": 3, "opponent_score": 0}, userID: 5, expectedStatus: http.StatusOK},
+		{name: "참가자가 아님", path: "/api/tournaments/1/matches/10/report", body: map[string]int{"my_score": 3, "opponent_score": 0}, userID: 8, expectedStatus: http.StatusForbidden},
+		{name: "무승부", path: "/api/tournaments/1/matches/10/report", body: map[string]int{"my_score": 1, "opponent_score": 1}, userID: 5, expectedStatus: http.StatusBadRequest},
+		{name: "점수 누락", path: "/api/tournaments/1/matches/10/report", body: map[string]int{"my_score": 3}, userID: 5, expectedStatus: http.StatusBadRequest},
+		{name: "인증 없음", path: "/api/tournaments/1/matches/10/report", body: map[string]int{"my_score": 3, "opponent_score": 0}, expectedStatus: http.StatusUnauthorized},
+		{name: "이의 제기", path: "/api/tournaments/1/matches/10/dispute", body: DisputeMatchRequest{Reason: "점수가 다릅니다"}, userID: 6, expectedStatus: http.StatusCreated},
+		{name: "이의 제기 불가", path: "/api/tournaments/1/matches/11/dispute", body: DisputeMatchRequest{Reason: "점수가 다릅니다"}, userID: 6, expectedStatus: http.StatusConflict},
+		{name: "사유 누락", path: "/api/tournaments/1/matches/10/dispute", body: DisputeMatchRequest{}, userID: 6, expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.body)
+			req, _ := http.NewRequest("POST", tt.path, bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.userID != 0 {
+				req = withAuthUser(req, tt.userID, "user")
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+		})
+	}
+	mockService.AssertExpectations(t)
+}
+
+// 토너먼트 생성, 시작, 취소, 이의 제기 판정과 매치 결과 확정 테스트
+func TestTournamentHandler_Admin(t *testing.T) {
+	router, mockService := setupTournamentTestRouter()
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("CreateTournament", mock.MatchedBy(func(tournament *model.Tournament) bool {
+		return tournament.GameID == 3 && tournament.CreatedBy == 9 && tournament.Format == model.TournamentDoubleElimination && tournament.PrizeSplit == "60,40"
+	})).Return(nil)
+	mockService.On("StartTournament", uint(1)).Return(&model.Tournament{Status: model.TournamentInProgress}, nil)
+	mockService.On("StartTournament", uint(2)).Return(nil, model.ErrTournamentNotStartable)
+	mockService.On("CancelTournament", uint(1)).Return(&model.Tournament{Status: model.TournamentCancelled}, nil)
+	mockService.On("GetDisputes", model.DisputeOpen, 20, 0).Return([]model.TournamentDispute{{ID: 2}}, int64(1), nil)
+	mockService.On("ResolveDispute", uint(9), uint(2), 0, 3, "영상 확인").Return(&model.TournamentDispute{ID: 2, Status: model.DisputeResolved}, nil)
+	mockService.On("SetMatchResult", uint(9), uint(1), uint(4), 1, 0, "2번 자리 불참").Return(&model.TournamentMatch{ID: 4, Status: model.TournamentMatchCompleted}, nil)
+	mockService.On("SetMatchResult", uint(9), uint(1), uint(5), 1, 0, "").Return(nil, model.ErrMatchNotReportable)
+
+	body, _ := json.Marshal(TournamentRequest{
+		GameID:              3,
+		Name:                "더블 컵",
+		Format:              model.TournamentDoubleElimination,
+		MaxParticipants:     16,
+		RegistrationStartAt: start,
+		RegistrationEndAt:   start.Add(48 * time.Hour),
+		EntryFee:            50,
+		PrizeSplit:          "60,40",
+	})
+	req, _ := http.NewRequest("POST", "/api/admin/tournaments", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	body, _ = json.Marshal(map[string]interface{}{"game_id": 3, "name": "리그", "format": "league", "max_participants": 4})
+	req, _ = http.NewRequest("POST", "/api/admin/tournaments", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	req, _ = http.NewRequest("POST", "/api/admin/tournaments/1/start", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("POST", "/api/admin/tournaments/2/start", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	req, _ = http.NewRequest("POST", "/api/admin/tournaments/1/cancel", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("GET", "/api/admin/tournaments/disputes?status=open", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var disputes TournamentDisputeListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &disputes))
+	assert.Equal(t, int64(1), disputes.Total)
+
+	body, _ = json.Marshal(map[string]interface{}{"score1": 0, "score2": 3, "resolution": "영상 확인"})
+	req, _ = http.NewRequest("POST", "/api/admin/tournaments/disputes/2/resolve", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// 보고되지 않은 매치 몰수패 확정
+	body, _ = json.Marshal(map[string]interface{}{"score1": 1, "score2": 0, "resolution": "2번 자리 불참"})
+	req, _ = http.NewRequest("POST", "/api/admin/tournaments/1/matches/4/result", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	body, _ = json.Marshal(map[string]interface{}{"score1": 1, "score2": 0})
+	req, _ = http.NewRequest("POST", "/api/admin/tournaments/1/matches/5/result", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	body, _ = json.Marshal(map[string]interface{}{"score1": 1})
+	req, _ = http.NewRequest("POST", "/api/admin/tournaments/1/matches/4/result", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	req, _ = http.NewRequest("POST", "/api/admin/tournaments/1/start", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	mockService.AssertExpectations(t)
+}
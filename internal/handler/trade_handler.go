@@ -0,0 +1,346 @@
+package handler
+
+import (
+	"errors"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type TradeServiceInterface interface {
+	ProposeTrade(proposal *service.TradeProposal) (*model.Trade, error)
+	ConfirmTrade(tradeID, userID uint) (*model.Trade, error)
+	CancelTrade(tradeID, userID uint) error
+	GetTradeByID(id uint) (*model.Trade, error)
+	GetTradeHistory(userID uint, limit, offset int) ([]model.Trade, error)
+	GetFlaggedTrades(limit, offset int) ([]model.Trade, error)
+	ReviewTrade(tradeID, reviewerID uint) error
+}
+
+// 플레이어 간 거래 관련 HTTP 요청을 처리하는 핸들러
+type TradeHandler struct {
+	tradeService TradeServiceInterface
+}
+
+// 새로운 TradeHandler 인스턴스를 생성
+func NewTradeHandler(tradeService TradeServiceInterface) *TradeHandler {
+	return &TradeHandler{
+		tradeService: tradeService,
+	}
+}
+
+// 거래 제안 요청
+type ProposeTradeRequest struct {
+	TargetID       uint                       `json:"target_id" binding:"required"`
+	OfferedItems   []service.TradeItemRequest `json:"offered_items"`
+	OfferedGold    int                        `json:"offered_gold" binding:"min=0"`
+	RequestedItems []service.TradeItemRequest `json:"requested_items"`
+	RequestedGold  int                        `json:"requested_gold" binding:"min=0"`
+	Message        string                     `json:"message" binding:"max=200"`
+	ExpiresInSec   int                        `json:"expires_in_sec" binding:"min=0"`
+}
+
+// 거래 목록 응답
+type TradeListResponse struct {
+	Trades []model.Trade `json:"trades"`
+	Total  int           `json:"total"`
+}
+
+// 새로운 거래를 제안
+// @Summary 거래 제안
+// @Description 다른 플레이어에게 아이템/골드 거래를 제안합니다. 제안자의 자산은 에스크로에 보관됩니다.
+// @Tags Trade
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ProposeTradeRequest true "거래 제안 정보"
+// @Success 201 {object} model.Trade
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/trades [post]
+func (h *TradeHandler) ProposeTrade(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	var req ProposeTradeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "잘못된 요청 형식입니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	trade, err := h.tradeService.ProposeTrade(&service.TradeProposal{
+		ProposerID:     userInfo.UserID,
+		TargetID:       req.TargetID,
+		OfferedItems:   req.OfferedItems,
+		OfferedGold:    req.OfferedGold,
+		RequestedItems: req.RequestedItems,
+		RequestedGold:  req.RequestedGold,
+		Message:        req.Message,
+		ExpiresIn:      time.Duration(req.ExpiresInSec) * time.Second,
+	})
+	if err != nil {
+		c.JSON(tradeErrorStatus(err), ErrorResponse{
+			Error:   "거래 제안에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, trade)
+}
+
+// 거래를 확인
+// @Summary 거래 확인
+// @Description 거래를 확인합니다. 양측이 모두 확인하면 즉시 정산됩니다.
+// @Tags Trade
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "거래 ID"
+// @Success 200 {object} model.Trade
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/trades/{id}/confirm [post]
+func (h *TradeHandler) ConfirmTrade(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	tradeID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	trade, err := h.tradeService.ConfirmTrade(tradeID, userInfo.UserID)
+	if err != nil {
+		c.JSON(tradeErrorStatus(err), ErrorResponse{
+			Error:   "거래 확인에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, trade)
+}
+
+// 거래를 취소
+// @Summary 거래 취소
+// @Description 진행 중인 거래를 취소하고 에스크로된 자산을 반환합니다.
+// @Tags Trade
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "거래 ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/trades/{id}/cancel [post]
+func (h *TradeHandler) CancelTrade(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	tradeID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.tradeService.CancelTrade(tradeID, userInfo.UserID); err != nil {
+		c.JSON(tradeErrorStatus(err), ErrorResponse{
+			Error:   "거래 취소에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "거래가 취소되었습니다",
+		UserID:  userInfo.UserID,
+	})
+}
+
+// 거래 상세 정보를 조회
+// @Summary 거래 조회
+// @Description 거래 당사자가 거래 상세 정보를 조회합니다.
+// @Tags Trade
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "거래 ID"
+// @Success 200 {object} model.Trade
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/trades/{id} [get]
+func (h *TradeHandler) GetTrade(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	tradeID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	trade, err := h.tradeService.GetTradeByID(tradeID)
+	if err != nil {
+		c.JSON(tradeErrorStatus(err), ErrorResponse{
+			Error:   "거래를 찾을 수 없습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if !trade.IsParticipant(userInfo.UserID) {
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Error:   "거래를 조회할 수 없습니다",
+			Message: model.ErrNotTradeParticipant.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, trade)
+}
+
+// 내 거래 내역을 조회
+// @Summary 거래 내역 조회
+// @Description 로그인한 사용자의 거래 내역을 최신순으로 조회합니다.
+// @Tags Trade
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} TradeListResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/trades [get]
+func (h *TradeHandler) GetTradeHistory(c *gin.Context) {
+	userInfo, ok := requireAuthUser(c)
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	trades, err := h.tradeService.GetTradeHistory(userInfo.UserID, limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "거래 내역 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, TradeListResponse{
+		Trades: trades,
+		Total:  len(trades),
+	})
+}
+
+// 검토가 필요한 의심 거래 목록을 조회
+// @Summary 의심 거래 조회
+// @Description 양측 가치 차이가 커서 검토가 필요한 거래 목록을 조회합니다. (관리자/중재자)
+// @Tags Trade
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "조회 개수"
+// @Param offset query int false "시작 위치"
+// @Success 200 {object} TradeListResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/admin/trades/flagged [get]
+func (h *TradeHandler) GetFlaggedTrades(c *gin.Context) {
+	if _, ok := requireStaffUser(c); !ok {
+		return
+	}
+
+	limit, offset := parsePagination(c)
+	trades, err := h.tradeService.GetFlaggedTrades(limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "의심 거래 조회에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, TradeListResponse{
+		Trades: trades,
+		Total:  len(trades),
+	})
+}
+
+// 의심 거래를 검토 완료로 표시
+// @Summary 의심 거래 검토
+// @Description 의심 거래를 검토 완료로 표시합니다. (관리자/중재자)
+// @Tags Trade
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "거래 ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/admin/trades/{id}/review [post]
+func (h *TradeHandler) ReviewTrade(c *gin.Context) {
+	userInfo, ok := requireStaffUser(c)
+	if !ok {
+		return
+	}
+
+	tradeID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.tradeService.ReviewTrade(tradeID, userInfo.UserID); err != nil {
+		c.JSON(tradeErrorStatus(err), ErrorResponse{
+			Error:   "거래 검토 처리에 실패했습니다",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "거래 검토가 완료되었습니다",
+	})
+}
+
+// 거래 서비스 에러를 HTTP 상태 코드로 변환
+func tradeErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrTradeNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrNotTradeParticipant):
+		return http.StatusForbidden
+	case errors.Is(err, model.ErrTradeNotPending),
+		errors.Is(err, model.ErrTradeExpired),
+		errors.Is(err, model.ErrAlreadyConfirmed):
+		return http.StatusConflict
+	case errors.Is(err, model.ErrTradeSelf),
+		errors.Is(err, model.ErrEmptyTrade),
+		errors.Is(err, model.ErrInvalidTradeGold),
+		errors.Is(err, model.ErrInvalidUserID),
+		errors.Is(err, model.ErrInvalidQuantity),
+		errors.Is(err, model.ErrInsufficientQuantity),
+		errors.Is(err, model.ErrItemNotTradeable),
+		errors.Is(err, service.ErrItemNotOwned),
+		errors.Is(err, service.ErrInsufficientGold):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
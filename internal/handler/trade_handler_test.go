@@ -0,0 +1,254 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"g_dev/internal/middleware"
+	"g_dev/internal/model"
+	"g_dev/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 테스트용 Mock 거래 서비스
+type MockTradeService struct {
+	mock.Mock
+}
+
+func (m *MockTradeService) ProposeTrade(proposal *service.TradeProposal) (*model.Trade, error) {
+	args := m.Called(proposal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Trade), args.Error(1)
+}
+
+func (m *MockTradeService) ConfirmTrade(tradeID, userID uint) (*model.Trade, error) {
+	args := m.Called(tradeID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Trade), args.Error(1)
+}
+
+func (m *MockTradeService) CancelTrade(tradeID, userID uint) error {
+	args := m.Called(tradeID, userID)
+	return args.Error(0)
+}
+
+func (m *MockTradeService) GetTradeByID(id uint) (*model.Trade, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Trade), args.Error(1)
+}
+
+func (m *MockTradeService) GetTradeHistory(userID uint, limit, offset int) ([]model.Trade, error) {
+	args := m.Called(userID, limit, offset)
+	return args.Get(0).([]model.Trade), args.Error(1)
+}
+
+func (m *MockTradeService) GetFlaggedTrades(limit, offset int) ([]model.Trade, error) {
+	args := m.Called(limit, offset)
+	return args.Get(0).([]model.Trade), args.Error(1)
+}
+
+func (m *MockTradeService) ReviewTrade(tradeID, reviewerID uint) error {
+	args := m.Called(tradeID, reviewerID)
+	return args.Error(0)
+}
+
+// 요청 컨텍스트에 인증된 사용자 정보를 추가 (JWT 미들웨어 대체)
+func withAuthUser(req *http.Request, userID uint, role string) *http.Request {
+	userInfo := &middleware.UserInfo{
+		UserID:   userID,
+		Username: "tester",
+		Role:     role,
+	}
+	return req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey, userInfo))
+}
+
+// 테스트용 거래 라우터 설정
+func setupTradeTestRouter() (*gin.Engine, *MockTradeService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	mockService := &MockTradeService{}
+	handler := NewTradeHandler(mockService)
+
+	trades := router.Group("/api/trades")
+	{
+		trades.POST("", handler.ProposeTrade)
+		trades.GET("", handler.GetTradeHistory)
+		trades.GET("/:id", handler.GetTrade)
+		trades.POST("/:id/confirm", handler.ConfirmTrade)
+		trades.POST("/:id/cancel", handler.CancelTrade)
+	}
+	admin := router.Group("/api/admin/trades")
+	{
+		admin.GET("/flagged", handler.GetFlaggedTrades)
+		admin.POST("/:id/review", handler.ReviewTrade)
+	}
+
+	return router, mockService
+}
+
+// ProposeTrade 핸들러 테스트
+func TestTradeHandler_ProposeTrade(t *testing.T) {
+	t.Run("정상적인 거래 제안", func(t *testing.T) {
+		router, mockService := setupTradeTestRouter()
+		mockService.On("ProposeTrade", mock.MatchedBy(func(p *service.TradeProposal) bool {
+			return p.ProposerID == 1 && p.TargetID == 2 && p.OfferedGold == 100
+		})).Return(&model.Trade{ProposerID: 1, TargetID: 2, ProposerGold: 100, Status: model.TradeStatusPending}, nil)
+
+		body, _ := json.Marshal(ProposeTradeRequest{TargetID: 2, OfferedGold: 100})
+		req, _ := http.NewRequest("POST", "/api/trades", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var trade model.Trade
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &trade))
+		assert.Equal(t, uint(2), trade.TargetID)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("인증 없음", func(t *testing.T) {
+		router, mockService := setupTradeTestRouter()
+
+		body, _ := json.Marshal(ProposeTradeRequest{TargetID: 2, OfferedGold: 100})
+		req, _ := http.NewRequest("POST", "/api/trades", bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		mockService.AssertNotCalled(t, "ProposeTrade", mock.Anything)
+	})
+
+	t.Run("거래 불가 아이템", func(t *testing.T) {
+		router, mockService := setupTradeTestRouter()
+		mockService.On("ProposeTrade", mock.Anything).Return(nil, model.ErrItemNotTradeable)
+
+		body, _ := json.Marshal(ProposeTradeRequest{
+			TargetID:     2,
+			OfferedItems: []service.TradeItemRequest{{InventoryID: 3, Quantity: 1}},
+		})
+		req, _ := http.NewRequest("POST", "/api/trades", bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+// ConfirmTrade 핸들러 테스트
+func TestTradeHandler_ConfirmTrade(t *testing.T) {
+	tests := []struct {
+		name           string
+		tradeID        string
+		mockTrade      *model.Trade
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "정상적인 거래 확인",
+			tradeID:        "1",
+			mockTrade:      &model.Trade{ProposerID: 1, TargetID: 2, Status: model.TradeStatusCompleted},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "존재하지 않는 거래",
+			tradeID:        "1",
+			mockError:      model.ErrTradeNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "당사자가 아닌 사용자",
+			tradeID:        "1",
+			mockError:      model.ErrNotTradeParticipant,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "만료된 거래",
+			tradeID:        "1",
+			mockError:      model.ErrTradeExpired,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "잘못된 ID 형식",
+			tradeID:        "invalid",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupTradeTestRouter()
+			if tt.tradeID == "1" {
+				if tt.mockTrade != nil {
+					mockService.On("ConfirmTrade", uint(1), uint(2)).Return(tt.mockTrade, nil)
+				} else {
+					mockService.On("ConfirmTrade", uint(1), uint(2)).Return(nil, tt.mockError)
+				}
+			}
+
+			req, _ := http.NewRequest("POST", "/api/trades/"+tt.tradeID+"/confirm", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, withAuthUser(req, 2, "user"))
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "HTTP 상태 코드가 일치해야 합니다")
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+// GetTrade 핸들러 테스트
+func TestTradeHandler_GetTrade(t *testing.T) {
+	router, mockService := setupTradeTestRouter()
+	mockService.On("GetTradeByID", uint(1)).Return(&model.Trade{ProposerID: 1, TargetID: 2}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/trades/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusOK, w.Code, "당사자는 거래를 조회할 수 있어야 합니다")
+
+	req, _ = http.NewRequest("GET", "/api/trades/1", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 3, "user"))
+	assert.Equal(t, http.StatusForbidden, w.Code, "제3자는 거래를 조회할 수 없어야 합니다")
+}
+
+// 관리자 의심 거래 API 테스트
+func TestTradeHandler_FlaggedTrades(t *testing.T) {
+	router, mockService := setupTradeTestRouter()
+	mockService.On("GetFlaggedTrades", defaultPageLimit, 0).Return([]model.Trade{{ProposerID: 1, TargetID: 2, IsFlagged: true}}, nil)
+	mockService.On("ReviewTrade", uint(5), uint(9)).Return(nil)
+
+	req, _ := http.NewRequest("GET", "/api/admin/trades/flagged", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 1, "user"))
+	assert.Equal(t, http.StatusForbidden, w.Code, "일반 사용자는 접근할 수 없어야 합니다")
+
+	req, _ = http.NewRequest("GET", "/api/admin/trades/flagged", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "moderator"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response TradeListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Total)
+
+	req, _ = http.NewRequest("POST", "/api/admin/trades/5/review", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, withAuthUser(req, 9, "admin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	mockService.AssertExpectations(t)
+}
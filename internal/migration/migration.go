@@ -4,9 +4,31 @@ import (
 	"fmt"
 	"g_dev/internal/database"
 	"g_dev/internal/model"
+	"gorm.io/gorm"
 	"log"
+	"time"
 )
 
+// 스키마 마이그레이션 이후 한 번만 실행되는 데이터 마이그레이션
+type DataMigration struct {
+	// 마이그레이션 이름 (실행 여부를 기록하는 키)
+	Name string
+
+	// 실행 함수 (트랜잭션 밖에서 호출되므로 여러 번 실행되어도 안전해야 함)
+	Run func(db *gorm.DB) error
+}
+
+// 실행이 완료된 데이터 마이그레이션 기록
+type DataMigrationRecord struct {
+	Name      string    `gorm:"primaryKey;size:100"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+// DataMigrationRecord 모델의 테이블 이름 반환
+func (DataMigrationRecord) TableName() string {
+	return "data_migrations"
+}
+
 // 데이터베이스 마이그레이션을 관리
 type MigrationManager struct {
 	// 데이터베이스 인스턴스
@@ -15,6 +37,9 @@ type MigrationManager struct {
 	// 등록된 모델들
 	Models []interface{}
 
+	// 등록된 데이터 마이그레이션들 (등록 순서대로 실행)
+	DataMigrations []DataMigration
+
 	// 마이그레이션 상태
 	IsMigrated bool
 }
@@ -33,6 +58,11 @@ func (m *MigrationManager) RegisterModel(model interface{}) {
 	m.Models = append(m.Models, model)
 }
 
+// 데이터 마이그레이션 등록
+func (m *MigrationManager) RegisterDataMigration(name string, run func(db *gorm.DB) error) {
+	m.DataMigrations = append(m.DataMigrations, DataMigration{Name: name, Run: run})
+}
+
 // 기본 모델들을 등록
 func (m *MigrationManager) RegisterDefaultModels() {
 	// 사용자 관련 모델
@@ -43,11 +73,103 @@ func (m *MigrationManager) RegisterDefaultModels() {
 
 	// 점수 관련 모델
 	m.RegisterModel(&model.Score{})
+	m.RegisterModel(&model.GameSession{})
+	m.RegisterModel(&model.ScoreFlag{})
+	m.RegisterModel(&model.Friendship{})
+	m.RegisterModel(&model.Season{})
+	m.RegisterModel(&model.SeasonReward{})
+	m.RegisterModel(&model.SeasonStanding{})
+
+	// 업적 관련 모델
+	m.RegisterModel(&model.Achievement{})
+	m.RegisterModel(&model.UserAchievement{})
+
+	// 미션 관련 모델
+	m.RegisterModel(&model.Mission{})
+	m.RegisterModel(&model.UserMission{})
+
+	// 배틀패스 관련 모델
+	m.RegisterModel(&model.BattlePass{})
+	m.RegisterModel(&model.BattlePassReward{})
+	m.RegisterModel(&model.UserBattlePass{})
+	m.RegisterModel(&model.BattlePassClaim{})
+
+	// 게임 리뷰 관련 모델
+	m.RegisterModel(&model.GameReview{})
+	m.RegisterModel(&model.ReviewVote{})
+	m.RegisterModel(&model.ReviewReport{})
+
+	// 게임 활동 집계 모델
+	m.RegisterModel(&model.GameActivityBucket{})
+
+	// 게임 추천 관련 모델
+	m.RegisterModel(&model.GameSimilarity{})
+
+	// 매치메이킹 관련 모델
+	m.RegisterModel(&model.Match{})
+	m.RegisterModel(&model.MatchPlayer{})
+
+	// 스킬 레이팅 관련 모델
+	m.RegisterModel(&model.SkillRating{})
+	m.RegisterModel(&model.SkillRatingHistory{})
+
+	// 토너먼트 관련 모델
+	m.RegisterModel(&model.Tournament{})
+	m.RegisterModel(&model.TournamentParticipant{})
+	m.RegisterModel(&model.TournamentMatch{})
+	m.RegisterModel(&model.TournamentDispute{})
 
 	// 인벤토리 관련 모델
 	m.RegisterModel(&model.Inventory{})
+	m.RegisterModel(&model.ItemExpiryRule{})
+	m.RegisterModel(&model.InventoryLog{})
+	m.RegisterModel(&model.ItemEffect{})
+	m.RegisterModel(&model.ContainerDrop{})
+	m.RegisterModel(&model.ActiveBuff{})
+
+	// 거래 관련 모델
+	m.RegisterModel(&model.Trade{})
+	m.RegisterModel(&model.TradeItem{})
+
+	// 거래소 관련 모델
+	m.RegisterModel(&model.MarketListing{})
+	m.RegisterModel(&model.MarketBid{})
+
+	// 제작 관련 모델
+	m.RegisterModel(&model.Recipe{})
+	m.RegisterModel(&model.RecipeMaterial{})
+	m.RegisterModel(&model.UserRecipe{})
+
+	// 강화 관련 모델
+	m.RegisterModel(&model.EnhancementLog{})
+
+	// 가챠 관련 모델
+	m.RegisterModel(&model.GachaBanner{})
+	m.RegisterModel(&model.GachaEntry{})
+	m.RegisterModel(&model.GachaPity{})
+	m.RegisterModel(&model.GachaPull{})
+
+	// 게임 태그/언어/플랫폼 모델
+	m.RegisterModel(&model.GameTag{})
+	m.RegisterModel(&model.GameTagLink{})
+	m.RegisterModel(&model.GameLanguage{})
+	m.RegisterModel(&model.GamePlatform{})
 
 	// 추가 모델 등록
+
+	// 데이터 마이그레이션
+	m.RegisterDataMigration("backfill_game_attributes", func(db *gorm.DB) error {
+		count, err := model.BackfillGameAttributes(db)
+		if err == nil {
+			log.Printf("    - 게임 %d개의 태그/언어/플랫폼을 정규화했습니다", count)
+		}
+		return err
+	})
+	m.RegisterDataMigration("backfill_review_rated_at", func(db *gorm.DB) error {
+		// 기존 리뷰는 updated_at이 투표/신고로 밀렸을 수 있으므로 작성 시각으로 채움
+		return db.Model(&model.GameReview{}).Where("rated_at IS NULL").
+			UpdateColumn("rated_at", gorm.Expr("created_at")).Error
+	})
 }
 
 // 등록된 모든 모델 마이그레이션
@@ -67,12 +189,47 @@ func (m *MigrationManager) Migrate() error {
 		}
 	}
 
+	if err := m.runDataMigrations(); err != nil {
+		return err
+	}
+
 	m.IsMigrated = true
 	log.Printf("데이터베이스 마이그레이션 완료: %d개 모델", len(m.Models))
 
 	return nil
 }
 
+// 아직 실행되지 않은 데이터 마이그레이션을 실행하고 기록
+func (m *MigrationManager) runDataMigrations() error {
+	if len(m.DataMigrations) == 0 {
+		return nil
+	}
+
+	db := m.DB.GetDB()
+	if err := db.AutoMigrate(&DataMigrationRecord{}); err != nil {
+		return fmt.Errorf("데이터 마이그레이션 기록 테이블 생성 실패: %v", err)
+	}
+
+	for _, migration := range m.DataMigrations {
+		var count int64
+		if err := db.Model(&DataMigrationRecord{}).Where("name = ?", migration.Name).Count(&count).Error; err != nil {
+			return fmt.Errorf("데이터 마이그레이션 기록 조회 실패: %v", err)
+		}
+		if count > 0 {
+			continue
+		}
+
+		log.Printf("    - 데이터 마이그레이션 실행 중: %s", migration.Name)
+		if err := migration.Run(db); err != nil {
+			return fmt.Errorf("데이터 마이그레이션 실패 (%s): %v", migration.Name, err)
+		}
+		if err := db.Create(&DataMigrationRecord{Name: migration.Name, AppliedAt: time.Now()}).Error; err != nil {
+			return fmt.Errorf("데이터 마이그레이션 기록 실패 (%s): %v", migration.Name, err)
+		}
+	}
+	return nil
+}
+
 // 등록된 모델 목록 반환
 func (m *MigrationManager) GetRegisteredModels() []interface{} {
 	return m.Models
@@ -0,0 +1,289 @@
+package model
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// 업적 달성 조건 종류
+type AchievementCondition string
+
+const (
+	AchievementFirstCompletion AchievementCondition = "first_completion" // 게임 첫 완료
+	AchievementScore           AchievementCondition = "score"            // 점수(보너스 포함 총점) Target 이상
+	AchievementAccuracy        AchievementCondition = "accuracy"         // 정확도 Target% 이상
+	AchievementPlays           AchievementCondition = "plays"            // 누적 플레이 Target회
+	AchievementStreak          AchievementCondition = "streak"           // Target일 연속 플레이
+	AchievementEventCount      AchievementCondition = "event"            // 지정한 이벤트 누적 Target회
+)
+
+// 업적 평가를 일으키는 이벤트 종류
+type AchievementEventType string
+
+const (
+	AchievementEventScore AchievementEventType = "score" // 정상 점수 확정 (세션 종료 또는 검토 승인)
+	AchievementEventCraft AchievementEventType = "craft" // 제작 성공
+)
+
+// 업적 정의
+// 조건에 맞는 이벤트가 발생할 때마다 사용자별 진행도를 갱신하고, 진행도가 Target에 도달하면 달성 처리와 보상 지급을 한다.
+type Achievement struct {
+	BaseModel
+
+	// 업적 고유 코드
+	Code string `json:"code" gorm:"uniqueIndex;size:50;not null"`
+
+	Name        string `json:"name" gorm:"size:100;not null"`
+	Description string `json:"description" gorm:"size:500"`
+
+	// 대상 게임 (nil이면 모든 게임)
+	GameID *uint `json:"game_id" gorm:"index"`
+
+	// 달성 조건 (condition은 MySQL 예약어라 condition_type 컬럼 사용)
+	Condition AchievementCondition `json:"condition" gorm:"column:condition_type;size:20;not null"`
+
+	// 점수 조건의 난이도 (비어 있으면 모든 난이도)
+	Difficulty GameDifficulty `json:"difficulty,omitempty" gorm:"size:20"`
+
+	// event 조건에서 집계할 이벤트 종류
+	EventType AchievementEventType `json:"event_type,omitempty" gorm:"size:50"`
+
+	// 달성 기준값 (점수, 정확도, 횟수, 일수)
+	Target float64 `json:"target" gorm:"not null"`
+
+	// 숨김 업적은 달성하기 전까지 목록에 보이지 않음
+	IsHidden bool `json:"is_hidden" gorm:"not null;default:false"`
+
+	// 비활성 업적은 평가하지 않음
+	IsActive bool `json:"is_active" gorm:"not null;index"`
+
+	// 달성 보상
+	Gold       int `json:"gold" gorm:"not null;default:0"`
+	Diamond    int `json:"diamond" gorm:"not null;default:0"`
+	Experience int `json:"experience" gorm:"not null;default:0"`
+
+	// 지급 아이템 (ItemID가 비어 있으면 없음)
+	ItemID   string `json:"item_id" gorm:"size:50"`
+	ItemName string `json:"item_name" gorm:"size:100"`
+	ItemType string `json:"item_type" gorm:"size:20"`
+	Rarity   string `json:"rarity" gorm:"size:20"`
+	Quantity int    `json:"quantity" gorm:"not null;default:0"`
+}
+
+// 사용자별 업적 진행도
+type UserAchievement struct {
+	ID            uint `json:"id" gorm:"primaryKey"`
+	UserID        uint `json:"user_id" gorm:"not null;uniqueIndex:idx_user_achievement"`
+	AchievementID uint `json:"achievement_id" gorm:"not null;uniqueIndex:idx_user_achievement;index"`
+
+	// 현재 진행도 (누적 조건은 합계, 기록 조건은 최고 기록)
+	Progress float64 `json:"progress" gorm:"not null;default:0"`
+
+	// 마지막으로 진행도가 갱신된 이벤트 시각 (연속 플레이 판정에 사용)
+	LastEventAt *time.Time `json:"last_event_at"`
+
+	// 달성 시각 (nil이면 진행 중)
+	UnlockedAt *time.Time `json:"unlocked_at" gorm:"index"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Achievement *Achievement `json:"achievement,omitempty" gorm:"foreignKey:AchievementID"`
+}
+
+// 업적 평가 이벤트
+type AchievementEvent struct {
+	UserID uint
+	Type   AchievementEventType
+
+	// 이벤트가 발생한 게임 (게임과 무관하면 0)
+	GameID uint
+
+	// score 이벤트의 점수 기록
+	Score *Score
+
+	// 누적 횟수 (0이면 1회)
+	Count int
+
+	At time.Time
+}
+
+// Achievement 모델의 테이블 이름 반환
+func (Achievement) TableName() string {
+	return "achievements"
+}
+
+// UserAchievement 모델의 테이블 이름 반환
+func (UserAchievement) TableName() string {
+	return "user_achievements"
+}
+
+// 점수 확정 이벤트를 생성
+// 검토 후 승인된 점수도 연속 플레이가 플레이한 날짜로 판정되도록 게임 종료 시각을 사용한다.
+func NewScoreAchievementEvent(score *Score) AchievementEvent {
+	at := score.CreatedAt
+	if score.EndedAt != nil {
+		at = *score.EndedAt
+	}
+	return AchievementEvent{UserID: score.UserID, Type: AchievementEventScore, GameID: score.GameID, Score: score, At: at}
+}
+
+// 업적 데이터 유효성 검사
+func (a *Achievement) Validate() error {
+	if a.Code == "" || a.Name == "" || a.Target <= 0 {
+		return ErrInvalidAchievement
+	}
+	switch a.Condition {
+	case AchievementFirstCompletion:
+		if a.Target != 1 {
+			return ErrInvalidAchievement
+		}
+	case AchievementAccuracy:
+		if a.Target > 100 {
+			return ErrInvalidAchievement
+		}
+	case AchievementScore:
+	case AchievementPlays, AchievementStreak:
+		if a.Target != math.Trunc(a.Target) {
+			return ErrInvalidAchievement
+		}
+	case AchievementEventCount:
+		if a.EventType == "" || a.Target != math.Trunc(a.Target) {
+			return ErrInvalidAchievement
+		}
+	default:
+		return ErrInvalidAchievement
+	}
+	if a.Difficulty != "" && !isValidGameDifficulty(a.Difficulty) {
+		return ErrInvalidAchievement
+	}
+	if a.Gold < 0 || a.Diamond < 0 || a.Experience < 0 {
+		return ErrInvalidAchievement
+	}
+	if a.ItemID != "" && (a.ItemName == "" || a.ItemType == "" || RarityRank(a.Rarity) < 0 || a.Quantity <= 0) {
+		return ErrInvalidAchievement
+	}
+	return nil
+}
+
+// 이벤트가 업적 평가 대상인지 확인
+func (a *Achievement) Matches(event *AchievementEvent) bool {
+	if a.GameID != nil && *a.GameID != event.GameID {
+		return false
+	}
+	if a.Condition == AchievementEventCount {
+		return event.Type == a.EventType
+	}
+	if event.Type != AchievementEventScore || event.Score == nil {
+		return false
+	}
+	return a.Difficulty == "" || a.Difficulty == event.Score.Difficulty
+}
+
+// 이벤트로 진행도를 갱신하고 이번에 달성했는지 반환
+// 이미 달성한 업적이나 대상이 아닌 이벤트는 진행도를 바꾸지 않는다.
+// 연속 플레이 일수는 사용자 시간대(loc) 날짜로 계산한다.
+func (a *Achievement) Apply(progress *UserAchievement, event *AchievementEvent, loc *time.Location) bool {
+	if progress.IsUnlocked() || !a.Matches(event) {
+		return false
+	}
+
+	switch a.Condition {
+	case AchievementFirstCompletion:
+		if event.Score.Completed {
+			progress.Progress = 1
+		}
+	case AchievementScore:
+		progress.Progress = math.Max(progress.Progress, float64(event.Score.GetTotalScore()))
+	case AchievementAccuracy:
+		progress.Progress = math.Max(progress.Progress, event.Score.Accuracy)
+	case AchievementPlays:
+		progress.Progress++
+	case AchievementStreak:
+		progress.Progress = nextStreak(progress, event.At, loc)
+	case AchievementEventCount:
+		count := event.Count
+		if count <= 0 {
+			count = 1
+		}
+		progress.Progress += float64(count)
+	}
+	// 늦게 도착한 이벤트가 마지막 이벤트 시각을 되돌리지 않도록 함
+	at := event.At
+	if progress.LastEventAt == nil || at.After(*progress.LastEventAt) {
+		progress.LastEventAt = &at
+	}
+
+	if progress.Progress < a.Target {
+		return false
+	}
+	progress.UnlockedAt = &at
+	return true
+}
+
+// 진행도를 0~100 비율로 반환
+func (a *Achievement) Percent(progress float64) float64 {
+	if progress >= a.Target {
+		return 100
+	}
+	return math.Max(progress, 0) / a.Target * 100
+}
+
+// 보상 아이템이 있는지 확인
+func (a *Achievement) HasItemReward() bool {
+	return a.ItemID != ""
+}
+
+// 지급 아이템 정보를 인벤토리 템플릿으로 변환
+func (a *Achievement) ToInventory(userID uint) *Inventory {
+	return &Inventory{
+		UserID:   userID,
+		ItemID:   a.ItemID,
+		ItemName: a.ItemName,
+		ItemType: a.ItemType,
+		Rarity:   a.Rarity,
+		Level:    1,
+		Quantity: a.Quantity,
+		IsBound:  true,
+	}
+}
+
+// 달성 여부 확인
+func (p *UserAchievement) IsUnlocked() bool {
+	return p.UnlockedAt != nil
+}
+
+// 연속 플레이 일수 계산 (사용자 시간대 날짜 기준)
+// 같은 날 플레이는 유지, 다음 날이면 1 증가, 하루 이상 빠지면 1부터 다시 센다.
+func nextStreak(progress *UserAchievement, at time.Time, loc *time.Location) float64 {
+	if progress.LastEventAt == nil {
+		return 1
+	}
+	last := calendarDay(*progress.LastEventAt, loc)
+	day := calendarDay(at, loc)
+	switch {
+	case !day.After(last):
+		return math.Max(progress.Progress, 1)
+	case day.AddDate(0, 0, -1).Equal(last):
+		return progress.Progress + 1
+	default:
+		return 1
+	}
+}
+
+// 시간대 날짜를 비교용 UTC 자정으로 변환 (일광 절약 시간에도 하루 차이가 일정하도록)
+func calendarDay(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	year, month, day := t.In(loc).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// 에러 정의
+var (
+	ErrAchievementNotFound      = errors.New("업적을 찾을 수 없습니다")
+	ErrInvalidAchievement       = errors.New("업적 정보가 유효하지 않습니다")
+	ErrDuplicateAchievementCode = errors.New("이미 사용 중인 업적 코드입니다")
+)
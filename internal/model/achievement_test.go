@@ -0,0 +1,119 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// 조건별 업적 진행도 갱신과 달성 판정 테스트
+func TestAchievement_Apply(t *testing.T) {
+	day := time.Date(2025, 3, 10, 20, 0, 0, 0, time.UTC)
+	scoreEvent := func(score *Score, at time.Time) *AchievementEvent {
+		return &AchievementEvent{UserID: 1, Type: AchievementEventScore, GameID: 3, Score: score, At: at}
+	}
+
+	t.Run("첫 완료", func(t *testing.T) {
+		achievement := &Achievement{Condition: AchievementFirstCompletion, Target: 1}
+		progress := &UserAchievement{}
+		assert.False(t, achievement.Apply(progress, scoreEvent(&Score{Completed: false}, day), time.UTC))
+		assert.True(t, achievement.Apply(progress, scoreEvent(&Score{Completed: true}, day), time.UTC))
+		assert.False(t, achievement.Apply(progress, scoreEvent(&Score{Completed: true}, day), time.UTC), "이미 달성한 업적은 다시 달성하지 않아야 합니다")
+	})
+
+	t.Run("어려움 난이도 점수", func(t *testing.T) {
+		achievement := &Achievement{Condition: AchievementScore, Difficulty: GameDifficultyHard, Target: 1000}
+		progress := &UserAchievement{}
+		assert.False(t, achievement.Apply(progress, scoreEvent(&Score{Score: 5000, Difficulty: GameDifficultyNormal}, day), time.UTC))
+		assert.Zero(t, progress.Progress, "다른 난이도 점수는 반영하지 않아야 합니다")
+		assert.False(t, achievement.Apply(progress, scoreEvent(&Score{Score: 800, Difficulty: GameDifficultyHard}, day), time.UTC))
+		assert.False(t, achievement.Apply(progress, scoreEvent(&Score{Score: 500, Difficulty: GameDifficultyHard}, day), time.UTC))
+		assert.Equal(t, 800.0, progress.Progress, "진행도는 최고 기록이어야 합니다")
+		assert.True(t, achievement.Apply(progress, scoreEvent(&Score{Score: 900, BonusScore: 100, Difficulty: GameDifficultyHard}, day), time.UTC))
+	})
+
+	t.Run("누적 플레이", func(t *testing.T) {
+		achievement := &Achievement{Condition: AchievementPlays, Target: 3}
+		progress := &UserAchievement{}
+		assert.False(t, achievement.Apply(progress, scoreEvent(&Score{}, day), time.UTC))
+		assert.False(t, achievement.Apply(progress, scoreEvent(&Score{}, day), time.UTC))
+		assert.True(t, achievement.Apply(progress, scoreEvent(&Score{}, day), time.UTC))
+		assert.Equal(t, 100.0, achievement.Percent(progress.Progress))
+	})
+
+	t.Run("연속 플레이", func(t *testing.T) {
+		achievement := &Achievement{Condition: AchievementStreak, Target: 3}
+		progress := &UserAchievement{}
+		achievement.Apply(progress, scoreEvent(&Score{}, day), time.UTC)
+		achievement.Apply(progress, scoreEvent(&Score{}, day.Add(5*time.Hour)), time.UTC)
+		assert.Equal(t, 2.0, progress.Progress, "자정을 넘기면 다음 날로 계산해야 합니다")
+		achievement.Apply(progress, scoreEvent(&Score{}, day.AddDate(0, 0, 3)), time.UTC)
+		assert.Equal(t, 1.0, progress.Progress, "하루 이상 빠지면 처음부터 다시 세야 합니다")
+		achievement.Apply(progress, scoreEvent(&Score{}, day.AddDate(0, 0, 4)), time.UTC)
+		assert.True(t, achievement.Apply(progress, scoreEvent(&Score{}, day.AddDate(0, 0, 5)), time.UTC))
+	})
+
+	t.Run("사용자 시간대 기준 연속 플레이", func(t *testing.T) {
+		seoul := time.FixedZone("KST", 9*60*60)
+		achievement := &Achievement{Condition: AchievementStreak, Target: 3}
+		progress := &UserAchievement{}
+		// UTC로는 3월 10일과 11일이지만 서울 시간으로는 모두 3월 11일
+		achievement.Apply(progress, scoreEvent(&Score{}, day), seoul)
+		achievement.Apply(progress, scoreEvent(&Score{}, day.Add(18*time.Hour)), seoul)
+		assert.Equal(t, 1.0, progress.Progress, "사용자 시간대로 같은 날이면 유지해야 합니다")
+		achievement.Apply(progress, scoreEvent(&Score{}, day.Add(20*time.Hour)), seoul)
+		assert.Equal(t, 2.0, progress.Progress, "사용자 시간대로 다음 날이면 증가해야 합니다")
+	})
+
+	t.Run("늦게 도착한 이벤트", func(t *testing.T) {
+		achievement := &Achievement{Condition: AchievementStreak, Target: 5}
+		progress := &UserAchievement{}
+		achievement.Apply(progress, scoreEvent(&Score{}, day), time.UTC)
+		achievement.Apply(progress, scoreEvent(&Score{}, day.AddDate(0, 0, 1)), time.UTC)
+		achievement.Apply(progress, scoreEvent(&Score{}, day.Add(-time.Hour)), time.UTC)
+		assert.Equal(t, day.AddDate(0, 0, 1), *progress.LastEventAt, "이전 시각의 이벤트는 마지막 이벤트 시각을 되돌리지 않아야 합니다")
+		assert.Equal(t, 2.0, progress.Progress)
+		achievement.Apply(progress, scoreEvent(&Score{}, day.AddDate(0, 0, 2)), time.UTC)
+		assert.Equal(t, 3.0, progress.Progress, "연속 기록이 끊기지 않아야 합니다")
+	})
+
+	t.Run("게임과 이벤트 종류", func(t *testing.T) {
+		gameID := uint(4)
+		perGame := &Achievement{Condition: AchievementAccuracy, GameID: &gameID, Target: 95}
+		assert.False(t, perGame.Apply(&UserAchievement{}, scoreEvent(&Score{Accuracy: 99}, day), time.UTC), "다른 게임 점수는 반영하지 않아야 합니다")
+
+		crafting := &Achievement{Condition: AchievementEventCount, EventType: AchievementEventCraft, Target: 5}
+		progress := &UserAchievement{}
+		assert.False(t, crafting.Apply(progress, scoreEvent(&Score{}, day), time.UTC))
+		assert.True(t, crafting.Apply(progress, &AchievementEvent{Type: AchievementEventCraft, Count: 5, At: day}, time.UTC))
+	})
+}
+
+// 업적 유효성 검사 테스트
+func TestAchievement_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		achievement Achievement
+		valid       bool
+	}{
+		{name: "정확도", achievement: Achievement{Code: "sharp", Name: "명사수", Condition: AchievementAccuracy, Target: 95}, valid: true},
+		{name: "정확도 100 초과", achievement: Achievement{Code: "sharp", Name: "명사수", Condition: AchievementAccuracy, Target: 120}},
+		{name: "첫 완료 기준값", achievement: Achievement{Code: "first", Name: "첫 완료", Condition: AchievementFirstCompletion, Target: 2}},
+		{name: "소수 플레이 횟수", achievement: Achievement{Code: "plays", Name: "단골", Condition: AchievementPlays, Target: 1.5}},
+		{name: "이벤트 종류 없음", achievement: Achievement{Code: "crafter", Name: "장인", Condition: AchievementEventCount, Target: 10}},
+		{name: "알 수 없는 조건", achievement: Achievement{Code: "x", Name: "x", Condition: "login", Target: 1}},
+		{name: "잘못된 난이도", achievement: Achievement{Code: "hard", Name: "고수", Condition: AchievementScore, Difficulty: "insane", Target: 1000}},
+		{name: "아이템 정보 부족", achievement: Achievement{Code: "hard", Name: "고수", Condition: AchievementScore, Target: 1000, ItemID: "badge"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.achievement.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidAchievement)
+			}
+		})
+	}
+}
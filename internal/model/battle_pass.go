@@ -0,0 +1,218 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// 배틀패스 보상 트랙
+type BattlePassTrack string
+
+const (
+	BattlePassFree    BattlePassTrack = "free"    // 모든 사용자
+	BattlePassPremium BattlePassTrack = "premium" // 프리미엄 패스 구매자
+)
+
+// 시즌 배틀패스
+// 게임 플레이와 미션으로 얻은 패스 경험치로 티어를 올리고, 도달한 티어의 무료/프리미엄 보상을 수령한다.
+type BattlePass struct {
+	BaseModel
+
+	Name string `json:"name" gorm:"size:100;not null"`
+
+	// 패스 기간 [StartAt, EndAt) (기간이 겹치는 패스는 만들 수 없음)
+	StartAt time.Time `json:"start_at" gorm:"not null;index"`
+	EndAt   time.Time `json:"end_at" gorm:"not null;index"`
+
+	// 최고 티어와 티어당 필요한 패스 경험치
+	MaxTier   int `json:"max_tier" gorm:"not null"`
+	XPPerTier int `json:"xp_per_tier" gorm:"not null"`
+
+	// 정상 점수 1회, 미션 보상 수령 1회당 지급하는 패스 경험치
+	SessionXP int `json:"session_xp" gorm:"not null;default:0"`
+	MissionXP int `json:"mission_xp" gorm:"not null;default:0"`
+
+	// 프리미엄 해금 가격과 티어 1개 건너뛰기 가격 (다이아몬드)
+	PremiumPrice  int `json:"premium_price" gorm:"not null"`
+	TierSkipPrice int `json:"tier_skip_price" gorm:"not null"`
+
+	// 티어별 트랙 보상
+	Rewards []BattlePassReward `json:"rewards" gorm:"foreignKey:PassID"`
+}
+
+// 배틀패스 티어 보상
+type BattlePassReward struct {
+	ID     uint            `json:"id" gorm:"primaryKey"`
+	PassID uint            `json:"pass_id" gorm:"not null;uniqueIndex:idx_battle_pass_reward"`
+	Tier   int             `json:"tier" gorm:"not null;uniqueIndex:idx_battle_pass_reward"`
+	Track  BattlePassTrack `json:"track" gorm:"size:20;not null;uniqueIndex:idx_battle_pass_reward"`
+
+	Gold       int `json:"gold" gorm:"not null;default:0"`
+	Diamond    int `json:"diamond" gorm:"not null;default:0"`
+	Experience int `json:"experience" gorm:"not null;default:0"`
+
+	// 지급 아이템 (ItemID가 비어 있으면 없음)
+	ItemID   string `json:"item_id" gorm:"size:50"`
+	ItemName string `json:"item_name" gorm:"size:100"`
+	ItemType string `json:"item_type" gorm:"size:20"`
+	Rarity   string `json:"rarity" gorm:"size:20"`
+	Quantity int    `json:"quantity" gorm:"not null;default:0"`
+}
+
+// 사용자별 배틀패스 진행도
+type UserBattlePass struct {
+	ID     uint `json:"id" gorm:"primaryKey"`
+	UserID uint `json:"user_id" gorm:"not null;uniqueIndex:idx_user_battle_pass"`
+	PassID uint `json:"pass_id" gorm:"not null;uniqueIndex:idx_user_battle_pass;index"`
+
+	XP int `json:"xp" gorm:"not null;default:0"`
+
+	IsPremium bool       `json:"is_premium" gorm:"not null;default:false"`
+	PremiumAt *time.Time `json:"premium_at"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// 배틀패스 보상 수령 기록
+type BattlePassClaim struct {
+	ID     uint            `json:"id" gorm:"primaryKey"`
+	UserID uint            `json:"user_id" gorm:"not null;uniqueIndex:idx_battle_pass_claim"`
+	PassID uint            `json:"pass_id" gorm:"not null;uniqueIndex:idx_battle_pass_claim"`
+	Tier   int             `json:"tier" gorm:"not null;uniqueIndex:idx_battle_pass_claim"`
+	Track  BattlePassTrack `json:"track" gorm:"size:20;not null;uniqueIndex:idx_battle_pass_claim"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BattlePass 모델의 테이블 이름 반환
+func (BattlePass) TableName() string {
+	return "battle_passes"
+}
+
+// BattlePassReward 모델의 테이블 이름 반환
+func (BattlePassReward) TableName() string {
+	return "battle_pass_rewards"
+}
+
+// UserBattlePass 모델의 테이블 이름 반환
+func (UserBattlePass) TableName() string {
+	return "user_battle_passes"
+}
+
+// BattlePassClaim 모델의 테이블 이름 반환
+func (BattlePassClaim) TableName() string {
+	return "battle_pass_claims"
+}
+
+// 배틀패스 데이터 유효성 검사
+func (p *BattlePass) Validate() error {
+	if p.Name == "" || !p.EndAt.After(p.StartAt) {
+		return ErrInvalidBattlePass
+	}
+	if p.MaxTier <= 0 || p.XPPerTier <= 0 {
+		return ErrInvalidBattlePass
+	}
+	if p.SessionXP < 0 || p.MissionXP < 0 || p.PremiumPrice < 0 || p.TierSkipPrice < 0 {
+		return ErrInvalidBattlePass
+	}
+
+	type slot struct {
+		tier  int
+		track BattlePassTrack
+	}
+	seen := make(map[slot]bool)
+	for i := range p.Rewards {
+		reward := &p.Rewards[i]
+		if err := reward.Validate(); err != nil {
+			return err
+		}
+		if reward.Tier > p.MaxTier {
+			return ErrInvalidBattlePass
+		}
+		// 같은 티어와 트랙에는 보상 하나만
+		key := slot{reward.Tier, reward.Track}
+		if seen[key] {
+			return ErrInvalidBattlePass
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// 진행 중인 패스인지 확인
+func (p *BattlePass) IsActive(now time.Time) bool {
+	return !now.Before(p.StartAt) && now.Before(p.EndAt)
+}
+
+// 패스 경험치로 도달한 티어를 반환 (최고 티어에서 멈춤)
+func (p *BattlePass) TierFor(xp int) int {
+	tier := xp / p.XPPerTier
+	if tier > p.MaxTier {
+		return p.MaxTier
+	}
+	return tier
+}
+
+// 티어에 도달하는 데 필요한 누적 패스 경험치
+func (p *BattlePass) RequiredXP(tier int) int {
+	return tier * p.XPPerTier
+}
+
+// 티어와 트랙의 보상을 반환 (없으면 nil)
+func (p *BattlePass) RewardFor(tier int, track BattlePassTrack) *BattlePassReward {
+	for i := range p.Rewards {
+		if p.Rewards[i].Tier == tier && p.Rewards[i].Track == track {
+			return &p.Rewards[i]
+		}
+	}
+	return nil
+}
+
+// 보상 유효성 검사
+func (r *BattlePassReward) Validate() error {
+	if r.Tier <= 0 || !r.Track.IsValid() {
+		return ErrInvalidBattlePass
+	}
+	if r.Gold < 0 || r.Diamond < 0 || r.Experience < 0 {
+		return ErrInvalidBattlePass
+	}
+	if r.ItemID != "" && (r.ItemName == "" || r.ItemType == "" || RarityRank(r.Rarity) < 0 || r.Quantity <= 0) {
+		return ErrInvalidBattlePass
+	}
+	return nil
+}
+
+// 지급 아이템 정보를 인벤토리 템플릿으로 변환
+func (r *BattlePassReward) ToInventory(userID uint) *Inventory {
+	return &Inventory{
+		UserID:   userID,
+		ItemID:   r.ItemID,
+		ItemName: r.ItemName,
+		ItemType: r.ItemType,
+		Rarity:   r.Rarity,
+		Level:    1,
+		Quantity: r.Quantity,
+		IsBound:  true,
+	}
+}
+
+// 유효한 트랙인지 확인
+func (t BattlePassTrack) IsValid() bool {
+	return t == BattlePassFree || t == BattlePassPremium
+}
+
+// 에러 정의
+var (
+	ErrBattlePassNotFound        = errors.New("배틀패스를 찾을 수 없습니다")
+	ErrInvalidBattlePass         = errors.New("배틀패스 정보가 유효하지 않습니다")
+	ErrBattlePassOverlap         = errors.New("기간이 겹치는 배틀패스가 있습니다")
+	ErrBattlePassNotActive       = errors.New("진행 중인 배틀패스가 아닙니다")
+	ErrBattlePassPremiumOwned    = errors.New("이미 프리미엄 패스를 보유하고 있습니다")
+	ErrBattlePassPremiumRequired = errors.New("프리미엄 패스가 필요한 보상입니다")
+	ErrBattlePassTierLocked      = errors.New("아직 도달하지 않은 티어입니다")
+	ErrBattlePassRewardNotFound  = errors.New("배틀패스 보상을 찾을 수 없습니다")
+	ErrBattlePassRewardClaimed   = errors.New("이미 수령한 배틀패스 보상입니다")
+	ErrBattlePassNothingToClaim  = errors.New("수령할 배틀패스 보상이 없습니다")
+	ErrInvalidTierSkip           = errors.New("건너뛸 티어 수가 유효하지 않습니다")
+)
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// 패스 경험치로 도달한 티어 계산 테스트
+func TestBattlePass_TierFor(t *testing.T) {
+	pass := &BattlePass{MaxTier: 3, XPPerTier: 100}
+
+	assert.Equal(t, 0, pass.TierFor(99))
+	assert.Equal(t, 1, pass.TierFor(100))
+	assert.Equal(t, 2, pass.TierFor(250))
+	assert.Equal(t, 3, pass.TierFor(10000), "최고 티어에서 멈춰야 합니다")
+	assert.Equal(t, 300, pass.RequiredXP(3))
+}
+
+// 배틀패스 유효성 검사 테스트
+func TestBattlePass_Validate(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	valid := func() BattlePass {
+		return BattlePass{
+			Name: "시즌 1", StartAt: start, EndAt: start.AddDate(0, 1, 0), MaxTier: 10, XPPerTier: 100, PremiumPrice: 500, TierSkipPrice: 50,
+			Rewards: []BattlePassReward{
+				{Tier: 1, Track: BattlePassFree, Gold: 100},
+				{Tier: 1, Track: BattlePassPremium, Diamond: 10},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(p *BattlePass)
+		valid  bool
+	}{
+		{name: "정상", modify: func(p *BattlePass) {}, valid: true},
+		{name: "기간 역전", modify: func(p *BattlePass) { p.EndAt = start }},
+		{name: "티어 경험치 없음", modify: func(p *BattlePass) { p.XPPerTier = 0 }},
+		{name: "음수 가격", modify: func(p *BattlePass) { p.TierSkipPrice = -1 }},
+		{name: "최고 티어 초과 보상", modify: func(p *BattlePass) { p.Rewards[0].Tier = 11 }},
+		{name: "중복 보상", modify: func(p *BattlePass) { p.Rewards[1].Track = BattlePassFree }},
+		{name: "알 수 없는 트랙", modify: func(p *BattlePass) { p.Rewards[1].Track = "vip" }},
+		{name: "아이템 정보 부족", modify: func(p *BattlePass) { p.Rewards[0].ItemID = "skin" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pass := valid()
+			tt.modify(&pass)
+			err := pass.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidBattlePass)
+			}
+		})
+	}
+}
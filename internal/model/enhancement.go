@@ -0,0 +1,165 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// 강화 재료 아이템 ID
+const EnhanceStoneItemID = "enhance_stone"
+
+// 강화 보호 주문서 아이템 ID (실패 시 하락/파괴 방지)
+const ProtectionScrollItemID = "protection_scroll"
+
+// 천장(pity) 규칙: 연속 실패 1회당 추가되는 성공 확률
+const PityBonusPerFailure = 0.05
+
+// 천장(pity) 규칙: 이 횟수만큼 연속 실패하면 다음 강화는 반드시 성공
+const PityGuaranteeStreak = 10
+
+// 강화 시도 결과
+type EnhanceOutcome string
+
+const (
+	EnhanceOutcomeSuccess   EnhanceOutcome = "success"   // 레벨 상승
+	EnhanceOutcomeNoChange  EnhanceOutcome = "no_change" // 실패, 변화 없음
+	EnhanceOutcomeDowngrade EnhanceOutcome = "downgrade" // 실패, 레벨 하락
+	EnhanceOutcomeDestroyed EnhanceOutcome = "destroyed" // 실패, 아이템 파괴
+	EnhanceOutcomeProtected EnhanceOutcome = "protected" // 실패, 보호 주문서로 하락/파괴 방지
+)
+
+// 희귀도별 강화 규칙
+type EnhancementRule struct {
+	// 최대 강화 레벨
+	MaxLevel int
+
+	// 레벨 1 기준 강화 비용 (현재 레벨에 비례하여 증가)
+	BaseGoldCost int
+
+	// 1레벨 강화 성공 확률
+	BaseSuccessRate float64
+
+	// 레벨당 성공 확률 감소폭
+	SuccessRateDecay float64
+
+	// 최소 성공 확률
+	MinSuccessRate float64
+
+	// 이 레벨 이상에서 실패하면 레벨 하락 (0이면 하락 없음)
+	DowngradeFromLevel int
+
+	// 이 레벨 이상에서 실패하면 아이템 파괴 (0이면 파괴 없음)
+	DestroyFromLevel int
+}
+
+// 희귀도별 강화 규칙 테이블
+var enhancementRules = map[string]EnhancementRule{
+	"common":    {MaxLevel: 10, BaseGoldCost: 50, BaseSuccessRate: 0.95, SuccessRateDecay: 0.05, MinSuccessRate: 0.3, DowngradeFromLevel: 6},
+	"rare":      {MaxLevel: 12, BaseGoldCost: 100, BaseSuccessRate: 0.9, SuccessRateDecay: 0.06, MinSuccessRate: 0.2, DowngradeFromLevel: 5, DestroyFromLevel: 10},
+	"epic":      {MaxLevel: 15, BaseGoldCost: 200, BaseSuccessRate: 0.85, SuccessRateDecay: 0.06, MinSuccessRate: 0.1, DowngradeFromLevel: 4, DestroyFromLevel: 8},
+	"legendary": {MaxLevel: 20, BaseGoldCost: 500, BaseSuccessRate: 0.8, SuccessRateDecay: 0.05, MinSuccessRate: 0.05, DowngradeFromLevel: 3, DestroyFromLevel: 7},
+}
+
+// 강화 가능한 아이템 타입
+var enhanceableItemTypes = map[string]bool{
+	"weapon": true,
+	"armor":  true,
+}
+
+// 강화 시도 기록
+// 모든 강화 시도는 난수 값과 함께 기록되어 확률 감사와 천장 계산에 사용된다.
+type EnhancementLog struct {
+	ID          uint   `json:"id" gorm:"primaryKey"`
+	UserID      uint   `json:"user_id" gorm:"not null;index:idx_enhance_user_item"`
+	InventoryID uint   `json:"inventory_id" gorm:"not null;index:idx_enhance_user_item"`
+	ItemID      string `json:"item_id" gorm:"size:50;not null"`
+	Rarity      string `json:"rarity" gorm:"size:20;not null"`
+
+	// 강화 전/후 레벨
+	FromLevel int `json:"from_level" gorm:"not null"`
+	ToLevel   int `json:"to_level" gorm:"not null"`
+
+	// 적용된 성공 확률 (천장 보정 포함)과 실제 난수 값
+	SuccessRate float64 `json:"success_rate" gorm:"not null"`
+	Roll        float64 `json:"roll" gorm:"not null"`
+
+	// 시도 직전 연속 실패 횟수
+	FailStreak int `json:"fail_streak" gorm:"not null;default:0"`
+
+	Outcome   EnhanceOutcome `json:"outcome" gorm:"size:20;not null;index"`
+	Protected bool           `json:"protected" gorm:"not null;default:false"`
+
+	// 소모된 비용
+	GoldCost     int `json:"gold_cost" gorm:"not null;default:0"`
+	MaterialCost int `json:"material_cost" gorm:"not null;default:0"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"index"`
+}
+
+// EnhancementLog 모델의 테이블 이름 반환
+func (EnhancementLog) TableName() string {
+	return "enhancement_logs"
+}
+
+// 희귀도에 해당하는 강화 규칙 반환 (알 수 없는 희귀도는 common 규칙 사용)
+func EnhancementRuleFor(rarity string) EnhancementRule {
+	if rule, ok := enhancementRules[rarity]; ok {
+		return rule
+	}
+	return enhancementRules["common"]
+}
+
+// 강화 가능한 아이템 타입인지 확인
+func IsEnhanceable(itemType string) bool {
+	return enhanceableItemTypes[itemType]
+}
+
+// 현재 레벨에서 강화 시 필요한 골드
+func (r EnhancementRule) GoldCost(level int) int {
+	return r.BaseGoldCost * level
+}
+
+// 현재 레벨에서 강화 시 필요한 강화석 수량
+func (r EnhancementRule) MaterialCost(level int) int {
+	return 1 + level/5
+}
+
+// 현재 레벨과 연속 실패 횟수에 따른 성공 확률
+func (r EnhancementRule) SuccessRate(level, failStreak int) float64 {
+	if failStreak >= PityGuaranteeStreak {
+		return 1
+	}
+
+	rate := r.BaseSuccessRate - r.SuccessRateDecay*float64(level-1)
+	if rate < r.MinSuccessRate {
+		rate = r.MinSuccessRate
+	}
+
+	rate += PityBonusPerFailure * float64(failStreak)
+	if rate > 1 {
+		rate = 1
+	}
+	return rate
+}
+
+// 현재 레벨에서 실패했을 때의 결과
+func (r EnhancementRule) FailureOutcome(level int) EnhanceOutcome {
+	if r.DestroyFromLevel > 0 && level >= r.DestroyFromLevel {
+		return EnhanceOutcomeDestroyed
+	}
+	if r.DowngradeFromLevel > 0 && level >= r.DowngradeFromLevel {
+		return EnhanceOutcomeDowngrade
+	}
+	return EnhanceOutcomeNoChange
+}
+
+// 강화 성공 여부 확인
+func (l *EnhancementLog) IsSuccess() bool {
+	return l.Outcome == EnhanceOutcomeSuccess
+}
+
+// 에러 정의
+var (
+	ErrItemNotEnhanceable = errors.New("강화할 수 없는 아이템입니다")
+	ErrMaxEnhanceLevel    = errors.New("이미 최대 강화 레벨입니다")
+)
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// 강화 성공 확률 계산 테스트
+func TestEnhancementRule_SuccessRate(t *testing.T) {
+	rule := EnhancementRuleFor("rare")
+
+	assert.InDelta(t, 0.9, rule.SuccessRate(1, 0), 0.0001, "1레벨은 기본 확률이어야 합니다")
+	assert.InDelta(t, 0.78, rule.SuccessRate(3, 0), 0.0001, "레벨이 오를수록 확률이 감소해야 합니다")
+	assert.InDelta(t, 0.63, rule.SuccessRate(8, 3), 0.0001, "연속 실패 시 천장 보정이 적용되어야 합니다")
+	assert.Equal(t, 1.0, rule.SuccessRate(11, PityGuaranteeStreak), "천장에 도달하면 반드시 성공해야 합니다")
+
+	epic := EnhancementRuleFor("epic")
+	assert.InDelta(t, 0.1, epic.SuccessRate(14, 0), 0.0001, "최소 확률 아래로 내려가지 않아야 합니다")
+}
+
+// 희귀도별 실패 결과 테스트
+func TestEnhancementRule_FailureOutcome(t *testing.T) {
+	tests := []struct {
+		rarity   string
+		level    int
+		expected EnhanceOutcome
+	}{
+		{"common", 1, EnhanceOutcomeNoChange},
+		{"common", 9, EnhanceOutcomeDowngrade},
+		{"rare", 4, EnhanceOutcomeNoChange},
+		{"rare", 5, EnhanceOutcomeDowngrade},
+		{"rare", 10, EnhanceOutcomeDestroyed},
+		{"legendary", 3, EnhanceOutcomeDowngrade},
+		{"legendary", 7, EnhanceOutcomeDestroyed},
+		{"unknown", 1, EnhanceOutcomeNoChange},
+	}
+
+	for _, tt := range tests {
+		rule := EnhancementRuleFor(tt.rarity)
+		assert.Equal(t, tt.expected, rule.FailureOutcome(tt.level), "%s 등급 %d레벨 실패 결과", tt.rarity, tt.level)
+	}
+}
+
+// 강화 비용 계산 테스트
+func TestEnhancementRule_Costs(t *testing.T) {
+	rule := EnhancementRuleFor("epic")
+
+	assert.Equal(t, 200, rule.GoldCost(1))
+	assert.Equal(t, 1000, rule.GoldCost(5))
+	assert.Equal(t, 1, rule.MaterialCost(4))
+	assert.Equal(t, 2, rule.MaterialCost(5))
+
+	assert.True(t, IsEnhanceable("weapon"))
+	assert.False(t, IsEnhanceable("consumable"))
+}
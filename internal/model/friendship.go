@@ -0,0 +1,36 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// 친구 관계 상태
+type FriendshipStatus string
+
+const (
+	FriendshipPending  FriendshipStatus = "pending"  // 요청 대기
+	FriendshipAccepted FriendshipStatus = "accepted" // 친구
+)
+
+// 친구 관계 (요청한 사용자 기준 한 방향, 수락되면 양방향 모두 accepted)
+type Friendship struct {
+	ID        uint             `json:"id" gorm:"primaryKey"`
+	UserID    uint             `json:"user_id" gorm:"not null;uniqueIndex:idx_friendships_pair"`
+	FriendID  uint             `json:"friend_id" gorm:"not null;uniqueIndex:idx_friendships_pair;index"`
+	Status    FriendshipStatus `json:"status" gorm:"size:20;not null"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+// Friendship 모델의 테이블 이름 반환
+func (Friendship) TableName() string {
+	return "friendships"
+}
+
+// 에러 정의
+var (
+	ErrCannotFriendSelf    = errors.New("자기 자신에게 친구 요청을 보낼 수 없습니다")
+	ErrFriendshipNotFound  = errors.New("친구 관계를 찾을 수 없습니다")
+	ErrFriendRequestExists = errors.New("이미 친구 요청을 보냈습니다")
+)
@@ -0,0 +1,362 @@
+package model
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// 10연차 뽑기 횟수
+const GachaBundleSize = 10
+
+// 희귀도 순위 (낮은 값일수록 흔한 등급)
+var rarityRanks = map[string]int{
+	"common":    0,
+	"rare":      1,
+	"epic":      2,
+	"legendary": 3,
+}
+
+// 희귀도 목록 (흔한 등급부터)
+var Rarities = []string{"common", "rare", "epic", "legendary"}
+
+// 가챠 배너 모델
+// 등급별 가중치로 먼저 등급을 뽑고, 해당 등급의 아이템 가중치로 아이템을 뽑는 2단계 드롭 테이블을 사용한다.
+type GachaBanner struct {
+	BaseModel
+
+	// 배너 고유 코드
+	Code string `json:"code" gorm:"uniqueIndex;size:50;not null"`
+
+	// 배너 이름과 설명
+	Name        string `json:"name" gorm:"size:100;not null"`
+	Description string `json:"description" gorm:"size:500"`
+
+	// 1회 뽑기 비용 (다이아몬드)
+	CostDiamond int `json:"cost_diamond" gorm:"not null"`
+
+	// 10연차 비용 (다이아몬드, 0이면 1회 비용 x 10)
+	BundleCostDiamond int `json:"bundle_cost_diamond" gorm:"not null;default:0"`
+
+	// 등급별 가중치
+	CommonWeight    int `json:"common_weight" gorm:"not null;default:0"`
+	RareWeight      int `json:"rare_weight" gorm:"not null;default:0"`
+	EpicWeight      int `json:"epic_weight" gorm:"not null;default:0"`
+	LegendaryWeight int `json:"legendary_weight" gorm:"not null;default:0"`
+
+	// 천장: 이 횟수 동안 legendary가 나오지 않으면 다음 뽑기는 legendary 확정 (0이면 천장 없음)
+	PityThreshold int `json:"pity_threshold" gorm:"not null;default:0"`
+
+	// 10연차 최소 보장 등급 (비어 있으면 보장 없음)
+	BundleGuarantee string `json:"bundle_guarantee" gorm:"size:20"`
+
+	// 배너 운영 기간 (nil이면 제한 없음)
+	StartsAt *time.Time `json:"starts_at"`
+	EndsAt   *time.Time `json:"ends_at"`
+
+	// 배너 활성화 여부
+	IsActive bool `json:"is_active" gorm:"not null;index"`
+
+	// 드롭 아이템 목록
+	Entries []GachaEntry `json:"entries" gorm:"foreignKey:BannerID"`
+}
+
+// 배너 드롭 아이템
+type GachaEntry struct {
+	ID       uint   `json:"id" gorm:"primaryKey"`
+	BannerID uint   `json:"banner_id" gorm:"not null;index"`
+	Rarity   string `json:"rarity" gorm:"size:20;not null"`
+	ItemID   string `json:"item_id" gorm:"size:50;not null"`
+	ItemName string `json:"item_name" gorm:"size:100;not null"`
+	ItemType string `json:"item_type" gorm:"size:20;not null"`
+	Quantity int    `json:"quantity" gorm:"not null;default:1"`
+
+	// 같은 등급 내 가중치
+	Weight int `json:"weight" gorm:"not null"`
+}
+
+// 사용자별 배너 천장 카운터
+type GachaPity struct {
+	ID       uint `json:"id" gorm:"primaryKey"`
+	UserID   uint `json:"user_id" gorm:"not null;uniqueIndex:idx_gacha_pity"`
+	BannerID uint `json:"banner_id" gorm:"not null;uniqueIndex:idx_gacha_pity"`
+
+	// 마지막 legendary 이후 뽑기 횟수
+	PullsSinceLegendary int `json:"pulls_since_legendary" gorm:"not null;default:0"`
+
+	// 누적 뽑기 횟수
+	TotalPulls int `json:"total_pulls" gorm:"not null;default:0"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// 뽑기 기록 (감사용)
+type GachaPull struct {
+	ID       uint `json:"id" gorm:"primaryKey"`
+	UserID   uint `json:"user_id" gorm:"not null;index"`
+	BannerID uint `json:"banner_id" gorm:"not null;index"`
+
+	// 같은 요청(10연차 등)에서 나온 뽑기를 묶는 식별자
+	// "사용자ID-배너ID-나노초" 형식으로 최대 61자가 될 수 있다.
+	BatchID string `json:"batch_id" gorm:"size:64;not null;index"`
+
+	Rarity   string `json:"rarity" gorm:"size:20;not null"`
+	ItemID   string `json:"item_id" gorm:"size:50;not null"`
+	Quantity int    `json:"quantity" gorm:"not null"`
+
+	// 뽑기 직전 천장 카운터
+	PityCount int `json:"pity_count" gorm:"not null"`
+
+	// 천장으로 확정된 뽑기인지 여부
+	IsPity bool `json:"is_pity" gorm:"not null;default:false"`
+
+	// 10연차 보장으로 확정된 뽑기인지 여부
+	IsGuaranteed bool `json:"is_guaranteed" gorm:"not null;default:false"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"index"`
+}
+
+// 공개용 드롭 확률 정보
+type DropRate struct {
+	Rarity      string  `json:"rarity"`
+	ItemID      string  `json:"item_id,omitempty"`
+	ItemName    string  `json:"item_name,omitempty"`
+	Probability float64 `json:"probability"`
+}
+
+// GachaBanner 모델의 테이블 이름 반환
+func (GachaBanner) TableName() string {
+	return "gacha_banners"
+}
+
+// GachaEntry 모델의 테이블 이름 반환
+func (GachaEntry) TableName() string {
+	return "gacha_entries"
+}
+
+// GachaPity 모델의 테이블 이름 반환
+func (GachaPity) TableName() string {
+	return "gacha_pities"
+}
+
+// GachaPull 모델의 테이블 이름 반환
+func (GachaPull) TableName() string {
+	return "gacha_pulls"
+}
+
+// 희귀도 순위 반환 (알 수 없는 등급은 -1)
+func RarityRank(rarity string) int {
+	if rank, ok := rarityRanks[rarity]; ok {
+		return rank
+	}
+	return -1
+}
+
+// 배너 데이터 유효성 검사
+func (b *GachaBanner) Validate() error {
+	if b.Code == "" || b.Name == "" {
+		return ErrInvalidBanner
+	}
+	if b.CostDiamond <= 0 || b.BundleCostDiamond < 0 || b.PityThreshold < 0 {
+		return ErrInvalidBanner
+	}
+	if b.BundleGuarantee != "" && RarityRank(b.BundleGuarantee) < 0 {
+		return ErrInvalidRarity
+	}
+	if len(b.Entries) == 0 {
+		return ErrEmptyDropTable
+	}
+
+	entryWeights := make(map[string]int)
+	for _, entry := range b.Entries {
+		if RarityRank(entry.Rarity) < 0 {
+			return ErrInvalidRarity
+		}
+		if entry.ItemID == "" || entry.ItemName == "" || entry.ItemType == "" {
+			return ErrInvalidItemID
+		}
+		if entry.Quantity <= 0 {
+			return ErrInvalidQuantity
+		}
+		if entry.Weight <= 0 {
+			return ErrInvalidDropWeight
+		}
+		entryWeights[entry.Rarity] += entry.Weight
+	}
+
+	total := 0
+	for _, rarity := range Rarities {
+		weight := b.RarityWeight(rarity)
+		if weight < 0 {
+			return ErrInvalidDropWeight
+		}
+		// 가중치가 있는 등급에는 드롭 아이템이 있어야 한다
+		if weight > 0 && entryWeights[rarity] == 0 {
+			return ErrEmptyDropTable
+		}
+		total += weight
+	}
+	if total == 0 {
+		return ErrInvalidDropWeight
+	}
+	if b.PityThreshold > 0 && b.LegendaryWeight == 0 {
+		return ErrEmptyDropTable
+	}
+
+	return nil
+}
+
+// 등급별 가중치 반환
+func (b *GachaBanner) RarityWeight(rarity string) int {
+	switch rarity {
+	case "common":
+		return b.CommonWeight
+	case "rare":
+		return b.RareWeight
+	case "epic":
+		return b.EpicWeight
+	case "legendary":
+		return b.LegendaryWeight
+	default:
+		return 0
+	}
+}
+
+// 뽑기 횟수에 따른 비용 반환
+func (b *GachaBanner) Cost(count int) int {
+	if count == GachaBundleSize && b.BundleCostDiamond > 0 {
+		return b.BundleCostDiamond
+	}
+	return b.CostDiamond * count
+}
+
+// 현재 뽑기가 가능한 기간인지 확인
+func (b *GachaBanner) IsOpen(now time.Time) bool {
+	if !b.IsActive {
+		return false
+	}
+	if b.StartsAt != nil && now.Before(*b.StartsAt) {
+		return false
+	}
+	if b.EndsAt != nil && !now.Before(*b.EndsAt) {
+		return false
+	}
+	return true
+}
+
+// 최소 등급 이상에서 가중치에 따라 등급을 뽑음
+func (b *GachaBanner) DrawRarity(rng *rand.Rand, minRarity string) string {
+	minRank := RarityRank(minRarity)
+
+	total := 0
+	for _, rarity := range Rarities {
+		if RarityRank(rarity) >= minRank {
+			total += b.RarityWeight(rarity)
+		}
+	}
+	if total == 0 {
+		return Rarities[len(Rarities)-1]
+	}
+
+	roll := rng.Intn(total)
+	for _, rarity := range Rarities {
+		if RarityRank(rarity) < minRank {
+			continue
+		}
+		roll -= b.RarityWeight(rarity)
+		if roll < 0 {
+			return rarity
+		}
+	}
+	return Rarities[len(Rarities)-1]
+}
+
+// 해당 등급의 드롭 아이템 중 가중치에 따라 하나를 뽑음
+func (b *GachaBanner) DrawEntry(rng *rand.Rand, rarity string) *GachaEntry {
+	total := 0
+	for _, entry := range b.Entries {
+		if entry.Rarity == rarity {
+			total += entry.Weight
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+
+	roll := rng.Intn(total)
+	for i := range b.Entries {
+		if b.Entries[i].Rarity != rarity {
+			continue
+		}
+		roll -= b.Entries[i].Weight
+		if roll < 0 {
+			return &b.Entries[i]
+		}
+	}
+	return nil
+}
+
+// 공개용 드롭 확률 계산
+// 등급별 확률과 아이템별 확률(등급 확률 x 등급 내 비중)을 함께 반환
+func (b *GachaBanner) DropRates() []DropRate {
+	total := 0
+	for _, rarity := range Rarities {
+		total += b.RarityWeight(rarity)
+	}
+	if total == 0 {
+		return []DropRate{}
+	}
+
+	rates := make([]DropRate, 0, len(Rarities)+len(b.Entries))
+	for _, rarity := range Rarities {
+		weight := b.RarityWeight(rarity)
+		if weight == 0 {
+			continue
+		}
+		rarityProb := float64(weight) / float64(total)
+		rates = append(rates, DropRate{Rarity: rarity, Probability: rarityProb})
+
+		entryTotal := 0
+		for _, entry := range b.Entries {
+			if entry.Rarity == rarity {
+				entryTotal += entry.Weight
+			}
+		}
+		for _, entry := range b.Entries {
+			if entry.Rarity != rarity || entryTotal == 0 {
+				continue
+			}
+			rates = append(rates, DropRate{
+				Rarity:      rarity,
+				ItemID:      entry.ItemID,
+				ItemName:    entry.ItemName,
+				Probability: rarityProb * float64(entry.Weight) / float64(entryTotal),
+			})
+		}
+	}
+	return rates
+}
+
+// 드롭 아이템을 인벤토리 형태로 변환
+func (e *GachaEntry) ToInventory(userID uint) *Inventory {
+	return &Inventory{
+		UserID:   userID,
+		ItemID:   e.ItemID,
+		ItemName: e.ItemName,
+		ItemType: e.ItemType,
+		Rarity:   e.Rarity,
+		Level:    1,
+		Quantity: e.Quantity,
+	}
+}
+
+// 에러 정의
+var (
+	ErrBannerNotFound      = errors.New("배너를 찾을 수 없습니다")
+	ErrDuplicateBannerCode = errors.New("이미 사용 중인 배너 코드입니다")
+	ErrInvalidBanner       = errors.New("배너 정보가 유효하지 않습니다")
+	ErrEmptyDropTable      = errors.New("드롭 테이블에 아이템이 없습니다")
+	ErrInvalidDropWeight   = errors.New("드롭 가중치가 유효하지 않습니다")
+	ErrBannerClosed        = errors.New("현재 뽑기를 진행할 수 없는 배너입니다")
+	ErrInvalidPullCount    = errors.New("뽑기 횟수는 1회 또는 10회만 가능합니다")
+)
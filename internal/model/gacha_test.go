@@ -0,0 +1,121 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// 테스트용 기본 배너 생성
+func newTestBanner() *GachaBanner {
+	return &GachaBanner{
+		Code:            "starter",
+		Name:            "스타터 배너",
+		CostDiamond:     100,
+		CommonWeight:    70,
+		RareWeight:      25,
+		EpicWeight:      4,
+		LegendaryWeight: 1,
+		PityThreshold:   50,
+		BundleGuarantee: "rare",
+		IsActive:        true,
+		Entries: []GachaEntry{
+			{Rarity: "common", ItemID: "potion", ItemName: "포션", ItemType: "consumable", Quantity: 1, Weight: 3},
+			{Rarity: "common", ItemID: "ore", ItemName: "광석", ItemType: "material", Quantity: 2, Weight: 1},
+			{Rarity: "rare", ItemID: "sword", ItemName: "검", ItemType: "weapon", Quantity: 1, Weight: 1},
+			{Rarity: "epic", ItemID: "armor", ItemName: "갑옷", ItemType: "armor", Quantity: 1, Weight: 1},
+			{Rarity: "legendary", ItemID: "blade", ItemName: "전설검", ItemType: "weapon", Quantity: 1, Weight: 1},
+		},
+	}
+}
+
+// 배너 유효성 검사 테스트
+func TestGachaBanner_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(b *GachaBanner)
+		expectedErr error
+	}{
+		{"정상적인 배너", func(b *GachaBanner) {}, nil},
+		{"비용 없음", func(b *GachaBanner) { b.CostDiamond = 0 }, ErrInvalidBanner},
+		{"드롭 아이템 없음", func(b *GachaBanner) { b.Entries = nil }, ErrEmptyDropTable},
+		{"가중치가 있는 등급에 아이템 없음", func(b *GachaBanner) { b.Entries = b.Entries[:4] }, ErrEmptyDropTable},
+		{"알 수 없는 등급", func(b *GachaBanner) { b.Entries[0].Rarity = "mythic" }, ErrInvalidRarity},
+		{"아이템 가중치 0", func(b *GachaBanner) { b.Entries[0].Weight = 0 }, ErrInvalidDropWeight},
+		{"등급 가중치 합계 0", func(b *GachaBanner) {
+			b.CommonWeight, b.RareWeight, b.EpicWeight, b.LegendaryWeight = 0, 0, 0, 0
+			b.PityThreshold = 0
+		}, ErrInvalidDropWeight},
+		{"잘못된 10연차 보장 등급", func(b *GachaBanner) { b.BundleGuarantee = "mythic" }, ErrInvalidRarity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			banner := newTestBanner()
+			tt.modify(banner)
+			assert.Equal(t, tt.expectedErr, banner.Validate(), "유효성 검사 결과가 일치해야 합니다")
+		})
+	}
+}
+
+// 공개 드롭 확률 계산 테스트
+func TestGachaBanner_DropRates(t *testing.T) {
+	banner := newTestBanner()
+	rates := banner.DropRates()
+
+	rarityTotal, itemTotal := 0.0, 0.0
+	for _, rate := range rates {
+		if rate.ItemID == "" {
+			rarityTotal += rate.Probability
+		} else {
+			itemTotal += rate.Probability
+		}
+		if rate.ItemID == "potion" {
+			assert.InDelta(t, 0.525, rate.Probability, 0.0001, "아이템 확률은 등급 확률 x 등급 내 비중이어야 합니다")
+		}
+	}
+	assert.InDelta(t, 1.0, rarityTotal, 0.0001, "등급 확률의 합은 1이어야 합니다")
+	assert.InDelta(t, 1.0, itemTotal, 0.0001, "아이템 확률의 합은 1이어야 합니다")
+}
+
+// 가중치 기반 뽑기 테스트
+func TestGachaBanner_Draw(t *testing.T) {
+	banner := newTestBanner()
+	rng := rand.New(rand.NewSource(42))
+
+	counts := make(map[string]int)
+	for i := 0; i < 10000; i++ {
+		counts[banner.DrawRarity(rng, "")]++
+	}
+	assert.InDelta(t, 7000, counts["common"], 300, "common 비율은 가중치에 근접해야 합니다")
+	assert.InDelta(t, 2500, counts["rare"], 300, "rare 비율은 가중치에 근접해야 합니다")
+
+	for i := 0; i < 100; i++ {
+		rarity := banner.DrawRarity(rng, "epic")
+		assert.GreaterOrEqual(t, RarityRank(rarity), RarityRank("epic"), "최소 등급 이상만 나와야 합니다")
+	}
+
+	entry := banner.DrawEntry(rng, "legendary")
+	assert.Equal(t, "blade", entry.ItemID)
+	assert.Nil(t, banner.DrawEntry(rng, "mythic"))
+
+	first := banner.DrawRarity(rand.New(rand.NewSource(7)), "")
+	second := banner.DrawRarity(rand.New(rand.NewSource(7)), "")
+	assert.Equal(t, first, second, "같은 시드는 같은 결과를 내야 합니다")
+}
+
+// 비용 및 운영 기간 테스트
+func TestGachaBanner_CostAndWindow(t *testing.T) {
+	banner := newTestBanner()
+	assert.Equal(t, 100, banner.Cost(1))
+	assert.Equal(t, 1000, banner.Cost(GachaBundleSize))
+	banner.BundleCostDiamond = 900
+	assert.Equal(t, 900, banner.Cost(GachaBundleSize), "10연차 할인 가격이 적용되어야 합니다")
+
+	now := time.Now()
+	assert.True(t, banner.IsOpen(now))
+	ended := now.Add(-time.Hour)
+	banner.EndsAt = &ended
+	assert.False(t, banner.IsOpen(now), "종료된 배너는 뽑을 수 없어야 합니다")
+}
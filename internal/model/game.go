@@ -2,6 +2,7 @@ package model
 
 import (
 	"errors"
+	"math"
 	"strings"
 	"time"
 )
@@ -162,6 +163,9 @@ const (
 	GameDifficultyExpert GameDifficulty = "expert" // 전문가
 )
 
+// 모든 게임 난이도
+var GameDifficulties = []GameDifficulty{GameDifficultyEasy, GameDifficultyNormal, GameDifficultyHard, GameDifficultyExpert}
+
 // 게임 상태
 type GameStatus string
 
@@ -253,6 +257,25 @@ func (g *Game) Validate() error {
 		return errors.New("discount rate must be between 0 and 100")
 	}
 
+	// 태그 길이 검증
+	for _, tag := range NormalizeGameAttributes(g.GetTags()) {
+		if len(tag) > MaxGameTagLength {
+			return errors.New("game tag cannot exceed 50 characters")
+		}
+	}
+
+	// 지원 언어/플랫폼 길이 검증
+	for _, language := range NormalizeGameAttributes(g.GetSupportedLanguages()) {
+		if len(language) > MaxGameLanguageLength {
+			return errors.New("supported language cannot exceed 10 characters")
+		}
+	}
+	for _, platform := range NormalizeGameAttributes(g.GetSupportedPlatforms()) {
+		if len(platform) > MaxGamePlatformLength {
+			return errors.New("supported platform cannot exceed 20 characters")
+		}
+	}
+
 	return nil
 }
 
@@ -261,6 +284,11 @@ func (g *Game) IsActive() bool {
 	return g.Status == GameStatusActive
 }
 
+// 게임이 공개 목록에 노출되는지 확인 (비활성 게임은 제외)
+func (g *Game) IsListed() bool {
+	return g.Status != GameStatusInactive
+}
+
 // 게임을 플레이할 수 있는지 확인
 func (g *Game) IsPlayable() bool {
 	return g.IsActive() && g.PlayURL != ""
@@ -295,24 +323,21 @@ func (g *Game) GetDiscountedPrice() int {
 }
 
 // 게임 플레이 기록을 추가
+// 인기도와 트렌딩 점수는 주기적인 집계 작업이 최근 활동으로 다시 계산한다.
 func (g *Game) AddPlay(playTime int) {
 	g.TotalPlays++
 	g.TotalPlayTime += playTime
-	g.updatePopularityScore()
 }
 
-// 게임 평점을 추가
-func (g *Game) AddRating(rating float64) error {
-	if rating < 1 || rating > 5 {
-		return errors.New("rating must be between 1 and 5")
+// 리뷰 평점 합계와 개수로 평균 평점과 총 평가 수를 갱신
+// 평점은 사용자당 하나인 리뷰 기록에서 다시 계산하며, 소수점 둘째 자리까지 유지한다.
+func (g *Game) SetRatingStats(ratingSum, count int) {
+	g.TotalRatings = count
+	if count == 0 {
+		g.AverageRating = 0
+		return
 	}
-
-	// 평균 평점 업데이트
-	totalRating := g.AverageRating * float64(g.TotalRatings)
-	g.TotalRatings++
-	g.AverageRating = (totalRating + rating) / float64(g.TotalRatings)
-
-	return nil
+	g.AverageRating = math.Round(float64(ratingSum)/float64(count)*100) / 100
 }
 
 // 게임 태그를 슬라이스로 반환
@@ -362,16 +387,6 @@ func (g *Game) GetSupportedPlatforms() []string {
 	return platforms
 }
 
-// 인기도 점수를 업데이트
-func (g *Game) updatePopularityScore() {
-	// 간단한 인기도 계산: 플레이 수 + 평점 + 최근 활동
-	playScore := float64(g.TotalPlays) * 0.1
-	ratingScore := g.AverageRating * float64(g.TotalRatings) * 0.2
-	timeScore := float64(g.TotalPlayTime) * 0.001
-
-	g.PopularityScore = playScore + ratingScore + timeScore
-}
-
 // 게임 카테고리가 유효한지 확인
 func isValidGameCategory(category GameCategory) bool {
 	validCategories := []GameCategory{
@@ -392,11 +407,7 @@ func isValidGameCategory(category GameCategory) bool {
 
 // 게임 난이도가 유효한지 확인
 func isValidGameDifficulty(difficulty GameDifficulty) bool {
-	validDifficulties := []GameDifficulty{
-		GameDifficultyEasy, GameDifficultyNormal, GameDifficultyHard, GameDifficultyExpert,
-	}
-
-	for _, valid := range validDifficulties {
+	for _, valid := range GameDifficulties {
 		if difficulty == valid {
 			return true
 		}
@@ -418,3 +429,10 @@ func isValidGameStatus(status GameStatus) bool {
 	}
 	return false
 }
+
+// 에러 정의
+var (
+	ErrGameNotFound      = errors.New("게임을 찾을 수 없습니다")
+	ErrInvalidGame       = errors.New("게임 정보가 유효하지 않습니다")
+	ErrDuplicateGameName = errors.New("이미 존재하는 게임 이름입니다")
+)
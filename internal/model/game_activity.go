@@ -0,0 +1,99 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// 게임 활동 집계 단위
+const GameActivityBucketSize = time.Hour
+
+// 게임별 시간 단위 활동 집계
+// 트렌딩 점수 계산 작업이 최근 점수 기록과 리뷰로 채우며, 집계 기간이 지난 버킷은 삭제한다.
+type GameActivityBucket struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	GameID      uint      `json:"game_id" gorm:"not null;uniqueIndex:idx_game_activity_bucket"`
+	BucketStart time.Time `json:"bucket_start" gorm:"not null;uniqueIndex:idx_game_activity_bucket;index"`
+
+	// 정상 점수로 끝난 플레이 수와 플레이 시간 합계 (초)
+	Plays       int `json:"plays" gorm:"not null;default:0"`
+	PlaySeconds int `json:"play_seconds" gorm:"not null;default:0"`
+
+	// 작성/수정된 공개 리뷰 수와 평점 합계
+	Ratings   int `json:"ratings" gorm:"not null;default:0"`
+	RatingSum int `json:"rating_sum" gorm:"not null;default:0"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GameActivityBucket 모델의 테이블 이름 반환
+func (GameActivityBucket) TableName() string {
+	return "game_activity_buckets"
+}
+
+// 시각이 속한 버킷의 시작 시각을 반환
+func ActivityBucketStart(t time.Time) time.Time {
+	return t.Truncate(GameActivityBucketSize)
+}
+
+// 트렌딩/인기도 점수 가중치
+type TrendingWeights struct {
+	// 플레이 1회, 플레이 시간 1분, 5점 만점 평점 1개당 활동 점수
+	Play       float64
+	PlayMinute float64
+	Rating     float64
+
+	// 활동 점수가 절반으로 줄어드는 시간
+	HalfLife time.Duration
+
+	// 인기도에 반영하는 누적 통계 비율 (인기도 = 누적 활동 * Lifetime + 트렌딩)
+	Lifetime float64
+}
+
+// 기본 가중치
+func DefaultTrendingWeights() TrendingWeights {
+	return TrendingWeights{
+		Play:       1,
+		PlayMinute: 0.05,
+		Rating:     2,
+		HalfLife:   24 * time.Hour,
+		Lifetime:   0.1,
+	}
+}
+
+// 활동 수치를 가중치로 합산
+// 평점은 5점 만점 기준 비율로 반영해 낮은 평점은 적게 기여한다.
+func (w TrendingWeights) Activity(plays int, playMinutes float64, ratingSum int) float64 {
+	return float64(plays)*w.Play + playMinutes*w.PlayMinute + float64(ratingSum)/5*w.Rating
+}
+
+// 버킷의 활동 점수
+func (w TrendingWeights) BucketActivity(b *GameActivityBucket) float64 {
+	return w.Activity(b.Plays, float64(b.PlaySeconds)/60, b.RatingSum)
+}
+
+// 경과 시간에 따른 감쇠 계수 (반감기 기준 지수 감쇠)
+func (w TrendingWeights) Decay(age time.Duration) float64 {
+	if age <= 0 || w.HalfLife <= 0 {
+		return 1
+	}
+	return math.Pow(0.5, age.Hours()/w.HalfLife.Hours())
+}
+
+// 버킷들의 감쇠 활동 점수 합계로 트렌딩 점수를 계산
+// 버킷의 나이는 버킷이 끝난 시각 기준이며 진행 중인 버킷은 감쇠하지 않는다.
+func (w TrendingWeights) TrendingScore(buckets []GameActivityBucket, now time.Time) float64 {
+	score := 0.0
+	for i := range buckets {
+		age := now.Sub(buckets[i].BucketStart.Add(GameActivityBucketSize))
+		score += w.BucketActivity(&buckets[i]) * w.Decay(age)
+	}
+	return math.Round(score*100) / 100
+}
+
+// 게임의 누적 통계와 트렌딩 점수로 인기도를 계산
+func (w TrendingWeights) PopularityScore(g *Game, trending float64) float64 {
+	ratingSum := int(math.Round(g.AverageRating * float64(g.TotalRatings)))
+	lifetime := w.Activity(g.TotalPlays, float64(g.TotalPlayTime), ratingSum)
+	return math.Round((lifetime*w.Lifetime+trending)*100) / 100
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// 반감기 기준 지수 감쇠 테스트
+func TestTrendingWeights_Decay(t *testing.T) {
+	weights := DefaultTrendingWeights()
+	assert.Equal(t, 1.0, weights.Decay(-time.Hour))
+	assert.Equal(t, 1.0, weights.Decay(0))
+	assert.InDelta(t, 0.5, weights.Decay(24*time.Hour), 1e-9)
+	assert.InDelta(t, 0.25, weights.Decay(48*time.Hour), 1e-9)
+}
+
+// 버킷 활동 점수와 트렌딩/인기도 점수 계산 테스트
+func TestTrendingWeights_Scores(t *testing.T) {
+	weights := DefaultTrendingWeights()
+	now := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	current := GameActivityBucket{BucketStart: ActivityBucketStart(now.Add(-30 * time.Minute)), Plays: 2, PlaySeconds: 120, Ratings: 1, RatingSum: 5}
+	old := GameActivityBucket{BucketStart: now.Add(-49 * time.Hour), Plays: 4}
+	assert.InDelta(t, 4.1, weights.BucketActivity(&current), 1e-9)
+
+	// 진행이 끝난 지 48시간 지난 버킷은 1/4만 반영
+	assert.InDelta(t, 5.1, weights.TrendingScore([]GameActivityBucket{current, old}, now), 0.01)
+	assert.Equal(t, 0.0, weights.TrendingScore(nil, now))
+
+	game := &Game{TotalPlays: 10, TotalPlayTime: 20, AverageRating: 4, TotalRatings: 5}
+	assert.InDelta(t, 1.9+5.1, weights.PopularityScore(game, 5.1), 0.01)
+}
+
+// 버킷 시작 시각 계산 테스트
+func TestActivityBucketStart(t *testing.T) {
+	at := time.Date(2025, 5, 1, 12, 34, 56, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC), ActivityBucketStart(at))
+}
@@ -0,0 +1,151 @@
+package model
+
+import (
+	"fmt"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+// 태그/지원 언어/지원 플랫폼 최대 길이 (정규화 테이블 컬럼 크기와 같음)
+const (
+	MaxGameTagLength      = 50
+	MaxGameLanguageLength = 10
+	MaxGamePlatformLength = 20
+)
+
+// 게임 태그
+type GameTag struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	Name      string    `json:"name" gorm:"size:50;not null;uniqueIndex"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// 게임-태그 연결
+type GameTagLink struct {
+	GameID uint `json:"game_id" gorm:"primaryKey;autoIncrement:false"`
+	TagID  uint `json:"tag_id" gorm:"primaryKey;autoIncrement:false;index"`
+}
+
+// 게임 지원 언어
+type GameLanguage struct {
+	GameID   uint   `json:"game_id" gorm:"primaryKey;autoIncrement:false"`
+	Language string `json:"language" gorm:"primaryKey;size:10;index"`
+}
+
+// 게임 지원 플랫폼
+type GamePlatform struct {
+	GameID   uint   `json:"game_id" gorm:"primaryKey;autoIncrement:false"`
+	Platform string `json:"platform" gorm:"primaryKey;size:20;index"`
+}
+
+// GameTag 모델의 테이블 이름 반환
+func (GameTag) TableName() string {
+	return "game_tags"
+}
+
+// GameTagLink 모델의 테이블 이름 반환
+func (GameTagLink) TableName() string {
+	return "game_tag_links"
+}
+
+// GameLanguage 모델의 테이블 이름 반환
+func (GameLanguage) TableName() string {
+	return "game_languages"
+}
+
+// GamePlatform 모델의 테이블 이름 반환
+func (GamePlatform) TableName() string {
+	return "game_platforms"
+}
+
+// 태그/언어/플랫폼 값을 검색용으로 정규화
+// 앞뒤 공백을 제거하고 소문자로 변환하며, 빈 값과 중복은 제외한다.
+func NormalizeGameAttributes(values []string) []string {
+	normalized := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		normalized = append(normalized, value)
+	}
+	return normalized
+}
+
+// 기존 게임의 태그/언어/플랫폼 문자열 컬럼으로 정규화 테이블을 채움
+// 이미 채워진 게임도 다시 동기화하므로 여러 번 실행해도 결과가 같다.
+// 데이터 마이그레이션과 게임 서비스에서 함께 사용한다.
+func BackfillGameAttributes(db *gorm.DB) (int, error) {
+	count := 0
+	var games []Game
+	result := db.FindInBatches(&games, 100, func(batch *gorm.DB, _ int) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			for i := range games {
+				if err := SyncGameAttributes(tx, &games[i]); err != nil {
+					return err
+				}
+				count++
+			}
+			return nil
+		})
+	})
+	if result.Error != nil {
+		return count, fmt.Errorf("게임 속성 백필 중 오류 발생: %w", result.Error)
+	}
+	return count, nil
+}
+
+// 게임의 태그/언어/플랫폼 문자열 컬럼을 정규화 테이블에 반영
+// 문자열 컬럼이 원본이며, 정규화 테이블은 검색과 집계에 사용한다.
+func SyncGameAttributes(tx *gorm.DB, game *Game) error {
+	if err := tx.Where("game_id = ?", game.ID).Delete(&GameTagLink{}).Error; err != nil {
+		return fmt.Errorf("게임 태그 삭제 중 오류 발생: %w", err)
+	}
+	if err := tx.Where("game_id = ?", game.ID).Delete(&GameLanguage{}).Error; err != nil {
+		return fmt.Errorf("게임 지원 언어 삭제 중 오류 발생: %w", err)
+	}
+	if err := tx.Where("game_id = ?", game.ID).Delete(&GamePlatform{}).Error; err != nil {
+		return fmt.Errorf("게임 지원 플랫폼 삭제 중 오류 발생: %w", err)
+	}
+
+	tags := NormalizeGameAttributes(game.GetTags())
+	if len(tags) > 0 {
+		links := make([]GameTagLink, 0, len(tags))
+		for _, name := range tags {
+			tag := GameTag{Name: name}
+			if err := tx.Where("name = ?", name).FirstOrCreate(&tag).Error; err != nil {
+				return fmt.Errorf("태그 생성 중 오류 발생: %w", err)
+			}
+			links = append(links, GameTagLink{GameID: game.ID, TagID: tag.ID})
+		}
+		if err := tx.Create(&links).Error; err != nil {
+			return fmt.Errorf("게임 태그 연결 중 오류 발생: %w", err)
+		}
+	}
+
+	languages := NormalizeGameAttributes(game.GetSupportedLanguages())
+	if len(languages) > 0 {
+		rows := make([]GameLanguage, len(languages))
+		for i, language := range languages {
+			rows[i] = GameLanguage{GameID: game.ID, Language: language}
+		}
+		if err := tx.Create(&rows).Error; err != nil {
+			return fmt.Errorf("게임 지원 언어 저장 중 오류 발생: %w", err)
+		}
+	}
+
+	platforms := NormalizeGameAttributes(game.GetSupportedPlatforms())
+	if len(platforms) > 0 {
+		rows := make([]GamePlatform, len(platforms))
+		for i, platform := range platforms {
+			rows[i] = GamePlatform{GameID: game.ID, Platform: platform}
+		}
+		if err := tx.Create(&rows).Error; err != nil {
+			return fmt.Errorf("게임 지원 플랫폼 저장 중 오류 발생: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+// 태그/언어/플랫폼 정규화 테스트
+func TestNormalizeGameAttributes(t *testing.T) {
+	assert.Equal(t, []string{"rpg", "multiplayer"}, NormalizeGameAttributes([]string{" RPG", "", "multiplayer", "rpg "}))
+	assert.Empty(t, NormalizeGameAttributes(nil))
+
+	game := &Game{Tags: "Puzzle, brain"}
+	assert.Equal(t, []string{"puzzle", "brain"}, NormalizeGameAttributes(game.GetTags()))
+}
+
+// 태그/지원 언어/지원 플랫폼 길이 검사 테스트
+func TestGame_ValidateAttributeLength(t *testing.T) {
+	game := &Game{Name: "Chess", Category: GameCategoryBoard, Difficulty: GameDifficultyHard, Status: GameStatusActive, MinLevel: 1, MaxPlayers: 2}
+	game.SetTags([]string{"classic"})
+	assert.NoError(t, game.Validate())
+
+	game.SetTags([]string{strings.Repeat("a", MaxGameTagLength+1)})
+	assert.Error(t, game.Validate())
+	game.SetTags([]string{"classic"})
+
+	game.SupportedLanguages = " KO ," + strings.Repeat("a", MaxGameLanguageLength)
+	game.SupportedPlatforms = strings.Repeat("a", MaxGamePlatformLength)
+	assert.NoError(t, game.Validate())
+
+	game.SupportedLanguages = strings.Repeat("a", MaxGameLanguageLength+1)
+	assert.Error(t, game.Validate())
+	game.SupportedLanguages = "ko"
+
+	game.SupportedPlatforms = strings.Repeat("a", MaxGamePlatformLength+1)
+	assert.Error(t, game.Validate())
+}
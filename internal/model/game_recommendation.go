@@ -0,0 +1,34 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// 게임 간 함께 플레이한 사용자 기반 유사도 (아이템-아이템 협업 필터링)
+// 오프라인 배치 작업이 점수 기록으로 다시 만들며, 게임마다 유사도가 높은 게임 일부만 보관한다.
+type GameSimilarity struct {
+	GameID        uint `json:"game_id" gorm:"primaryKey;autoIncrement:false"`
+	SimilarGameID uint `json:"similar_game_id" gorm:"primaryKey;autoIncrement:false"`
+
+	// 코사인 유사도 (0~1)
+	Score float64 `json:"score" gorm:"not null"`
+
+	// 두 게임을 모두 플레이한 사용자 수
+	CoPlayers int `json:"co_players" gorm:"not null"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GameSimilarity 모델의 테이블 이름 반환
+func (GameSimilarity) TableName() string {
+	return "game_similarities"
+}
+
+// 함께 플레이한 사용자 수와 각 게임의 플레이어 수로 코사인 유사도를 계산
+func CoPlaySimilarity(coPlayers, playersA, playersB int) float64 {
+	if coPlayers <= 0 || playersA <= 0 || playersB <= 0 {
+		return 0
+	}
+	return float64(coPlayers) / math.Sqrt(float64(playersA)*float64(playersB))
+}
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// 공동 플레이 코사인 유사도 계산 테스트
+func TestCoPlaySimilarity(t *testing.T) {
+	assert.InDelta(t, 1.0, CoPlaySimilarity(3, 3, 3), 1e-9)
+	assert.InDelta(t, 0.5, CoPlaySimilarity(2, 4, 4), 1e-9)
+	assert.InDelta(t, 0.7071, CoPlaySimilarity(2, 4, 2), 1e-4)
+	assert.Equal(t, 0.0, CoPlaySimilarity(0, 4, 2))
+	assert.Equal(t, 0.0, CoPlaySimilarity(1, 0, 2))
+}
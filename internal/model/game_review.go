@@ -0,0 +1,97 @@
+package model
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+)
+
+// 리뷰 본문 최대 길이 (문자 수)
+const MaxReviewContentLength = 2000
+
+// 게임 리뷰 (사용자당 게임별 하나)
+// 게임의 평균 평점과 총 평가 수는 숨김 처리되지 않은 리뷰로 다시 계산한다.
+type GameReview struct {
+	ID     uint `json:"id" gorm:"primaryKey"`
+	UserID uint `json:"user_id" gorm:"not null;uniqueIndex:idx_reviews_user_game"`
+	GameID uint `json:"game_id" gorm:"not null;uniqueIndex:idx_reviews_user_game;index"`
+
+	// 평점 (1~5점)
+	Rating int `json:"rating" gorm:"not null"`
+
+	// 리뷰 본문 (선택)
+	Content string `json:"content" gorm:"size:2000"`
+
+	// 도움이 됐어요 수와 신고 수
+	HelpfulCount int `json:"helpful_count" gorm:"not null;default:0;index"`
+	ReportCount  int `json:"report_count" gorm:"not null;default:0"`
+
+	// 중재자 숨김 처리 (숨긴 리뷰는 목록과 평점에서 제외)
+	IsHidden     bool       `json:"is_hidden" gorm:"not null;default:false;index"`
+	HiddenBy     *uint      `json:"hidden_by,omitempty"`
+	HiddenAt     *time.Time `json:"hidden_at,omitempty"`
+	HiddenReason string     `json:"hidden_reason,omitempty" gorm:"size:200"`
+
+	// 평점을 처음 매기거나 바꾼 시각 (투표/신고/숨김 처리로는 바뀌지 않으며 트렌딩 집계 기준)
+	RatedAt time.Time `json:"rated_at" gorm:"index"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// 작성자 닉네임 (조회 시 채움)
+	Nickname string `json:"nickname,omitempty" gorm:"-"`
+}
+
+// 리뷰 도움 투표 (사용자당 리뷰별 하나)
+type ReviewVote struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	ReviewID  uint      `json:"review_id" gorm:"not null;uniqueIndex:idx_review_votes_entry"`
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_review_votes_entry"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// 리뷰 신고 (사용자당 리뷰별 하나)
+type ReviewReport struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	ReviewID  uint      `json:"review_id" gorm:"not null;uniqueIndex:idx_review_reports_entry"`
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_review_reports_entry"`
+	Reason    string    `json:"reason" gorm:"size:200;not null"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GameReview 모델의 테이블 이름 반환
+func (GameReview) TableName() string {
+	return "reviews"
+}
+
+// ReviewVote 모델의 테이블 이름 반환
+func (ReviewVote) TableName() string {
+	return "review_votes"
+}
+
+// ReviewReport 모델의 테이블 이름 반환
+func (ReviewReport) TableName() string {
+	return "review_reports"
+}
+
+// 리뷰 데이터 유효성 검사
+func (r *GameReview) Validate() error {
+	if r.Rating < 1 || r.Rating > 5 {
+		return ErrInvalidReview
+	}
+	if utf8.RuneCountInString(r.Content) > MaxReviewContentLength {
+		return ErrInvalidReview
+	}
+	return nil
+}
+
+// 에러 정의
+var (
+	ErrReviewNotFound        = errors.New("리뷰를 찾을 수 없습니다")
+	ErrInvalidReview         = errors.New("리뷰 정보가 유효하지 않습니다")
+	ErrReviewNotEligible     = errors.New("게임을 완료한 플레이어만 리뷰를 작성할 수 있습니다")
+	ErrOwnReview             = errors.New("자신의 리뷰에는 투표하거나 신고할 수 없습니다")
+	ErrReviewAlreadyVoted    = errors.New("이미 도움이 됐다고 표시한 리뷰입니다")
+	ErrReviewAlreadyReported = errors.New("이미 신고한 리뷰입니다")
+	ErrReviewHidden          = errors.New("숨김 처리된 리뷰는 삭제할 수 없습니다")
+)
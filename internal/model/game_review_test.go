@@ -0,0 +1,29 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+// 리뷰 평점 범위와 본문 길이 검사 테스트
+func TestGameReview_Validate(t *testing.T) {
+	assert.NoError(t, (&GameReview{Rating: 1}).Validate())
+	assert.NoError(t, (&GameReview{Rating: 5, Content: strings.Repeat("가", MaxReviewContentLength)}).Validate())
+
+	assert.ErrorIs(t, (&GameReview{Rating: 0}).Validate(), ErrInvalidReview)
+	assert.ErrorIs(t, (&GameReview{Rating: 6}).Validate(), ErrInvalidReview)
+	assert.ErrorIs(t, (&GameReview{Rating: 3, Content: strings.Repeat("가", MaxReviewContentLength+1)}).Validate(), ErrInvalidReview)
+}
+
+// 게임 평균 평점 계산 테스트
+func TestGame_SetRatingStats(t *testing.T) {
+	game := &Game{}
+	game.SetRatingStats(11, 3)
+	assert.Equal(t, 3, game.TotalRatings)
+	assert.Equal(t, 3.67, game.AverageRating)
+
+	game.SetRatingStats(0, 0)
+	assert.Equal(t, 0, game.TotalRatings)
+	assert.Equal(t, 0.0, game.AverageRating)
+}
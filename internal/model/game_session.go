@@ -0,0 +1,217 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// 게임 세션 상태
+type GameSessionStatus string
+
+const (
+	GameSessionActive  GameSessionStatus = "active"  // 진행 중
+	GameSessionPaused  GameSessionStatus = "paused"  // 일시정지
+	GameSessionEnded   GameSessionStatus = "ended"   // 점수 제출 완료
+	GameSessionExpired GameSessionStatus = "expired" // 하트비트 중단으로 만료
+)
+
+// 게임 세션 이벤트
+type GameSessionEvent string
+
+const (
+	GameSessionEventHeartbeat GameSessionEvent = "heartbeat"
+	GameSessionEventPause     GameSessionEvent = "pause"
+	GameSessionEventResume    GameSessionEvent = "resume"
+	GameSessionEventSave      GameSessionEvent = "save"
+	GameSessionEventLoad      GameSessionEvent = "load"
+	GameSessionEventRestart   GameSessionEvent = "restart"
+)
+
+// 하트비트가 이 시간 이상 없으면 세션이 만료된다
+const GameSessionHeartbeatTimeout = 2 * time.Minute
+
+// 서버가 관리하는 게임 플레이 세션
+// 점수는 진행 중인 세션을 종료하면서만 제출할 수 있으며, 플레이 시간과 이벤트 횟수는 서버 기록을 사용한다.
+type GameSession struct {
+	ID        uint   `json:"id" gorm:"primaryKey"`
+	SessionID string `json:"session_id" gorm:"size:64;not null;uniqueIndex"`
+	UserID    uint   `json:"user_id" gorm:"not null;index:idx_game_sessions_user_game"`
+	GameID    uint   `json:"game_id" gorm:"not null;index:idx_game_sessions_user_game"`
+
+	Status      GameSessionStatus `json:"status" gorm:"size:20;not null;index"`
+	GameMode    string            `json:"game_mode" gorm:"size:50;not null"`
+	PlayerCount int               `json:"player_count" gorm:"not null;default:1"`
+	Platform    string            `json:"platform" gorm:"size:20"`
+	GameVersion string            `json:"game_version" gorm:"size:20"`
+
+	StartedAt       time.Time  `json:"started_at" gorm:"not null"`
+	LastHeartbeatAt time.Time  `json:"last_heartbeat_at" gorm:"not null;index"`
+	PausedAt        *time.Time `json:"paused_at,omitempty"`
+	EndedAt         *time.Time `json:"ended_at,omitempty"`
+
+	// 누적 일시정지 시간 (초)
+	PausedSeconds int `json:"paused_seconds" gorm:"not null;default:0"`
+
+	HeartbeatCount int `json:"heartbeat_count" gorm:"not null;default:0"`
+	PauseCount     int `json:"pause_count" gorm:"not null;default:0"`
+	SaveCount      int `json:"save_count" gorm:"not null;default:0"`
+	LoadCount      int `json:"load_count" gorm:"not null;default:0"`
+	RestartCount   int `json:"restart_count" gorm:"not null;default:0"`
+
+	// 종료 시 생성된 점수 기록 ID
+	ScoreID *uint `json:"score_id,omitempty"`
+
+	IPAddress string `json:"-" gorm:"size:45"`
+	UserAgent string `json:"-" gorm:"size:500"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// 게임 보상 설정 (Game.RewardSettings JSON)
+type GameRewardSettings struct {
+	GoldPerPoint         float64 `json:"gold_per_point"`
+	ExperiencePerPoint   float64 `json:"experience_per_point"`
+	CompletionGold       int     `json:"completion_gold"`
+	CompletionExperience int     `json:"completion_experience"`
+
+	// 1회 플레이 보상 상한 (0이면 제한 없음)
+	MaxGold       int `json:"max_gold"`
+	MaxExperience int `json:"max_experience"`
+}
+
+// GameSession 모델의 테이블 이름 반환
+func (GameSession) TableName() string {
+	return "game_sessions"
+}
+
+// 점수를 제출하거나 이벤트를 받을 수 있는 상태인지 확인
+func (s *GameSession) IsOpen() bool {
+	return s.Status == GameSessionActive || s.Status == GameSessionPaused
+}
+
+// 하트비트가 끊겨 만료되었는지 확인
+func (s *GameSession) IsStale(now time.Time) bool {
+	return now.Sub(s.LastHeartbeatAt) >= GameSessionHeartbeatTimeout
+}
+
+// 일시정지 시간을 제외한 실제 플레이 시간(초)
+func (s *GameSession) ActivePlaySeconds(now time.Time) int {
+	paused := s.PausedSeconds
+	if s.PausedAt != nil {
+		paused += int(now.Sub(*s.PausedAt).Seconds())
+	}
+	active := int(now.Sub(s.StartedAt).Seconds()) - paused
+	if active < 0 {
+		return 0
+	}
+	return active
+}
+
+// 세션 이벤트를 적용
+func (s *GameSession) Apply(event GameSessionEvent, now time.Time) error {
+	if !s.IsOpen() {
+		return ErrSessionClosed
+	}
+
+	switch event {
+	case GameSessionEventHeartbeat:
+		s.HeartbeatCount++
+	case GameSessionEventPause:
+		if s.Status == GameSessionPaused {
+			return ErrInvalidSessionEvent
+		}
+		s.Status = GameSessionPaused
+		s.PausedAt = &now
+		s.PauseCount++
+	case GameSessionEventResume:
+		if s.Status != GameSessionPaused {
+			return ErrInvalidSessionEvent
+		}
+		s.resume(now)
+	case GameSessionEventSave:
+		s.SaveCount++
+	case GameSessionEventLoad:
+		s.LoadCount++
+	case GameSessionEventRestart:
+		s.RestartCount++
+	default:
+		return ErrInvalidSessionEvent
+	}
+
+	s.LastHeartbeatAt = now
+	return nil
+}
+
+// 세션을 종료 상태로 변경
+func (s *GameSession) End(now time.Time) {
+	if s.Status == GameSessionPaused {
+		s.resume(now)
+	}
+	s.Status = GameSessionEnded
+	s.EndedAt = &now
+}
+
+// 일시정지 해제 (일시정지 시간을 누적)
+func (s *GameSession) resume(now time.Time) {
+	if s.PausedAt != nil {
+		s.PausedSeconds += int(now.Sub(*s.PausedAt).Seconds())
+	}
+	s.PausedAt = nil
+	s.Status = GameSessionActive
+}
+
+// 게임의 보상 설정을 파싱
+func (g *Game) GetRewardSettings() (*GameRewardSettings, error) {
+	settings := &GameRewardSettings{}
+	if g.RewardSettings == "" {
+		return settings, nil
+	}
+	if err := json.Unmarshal([]byte(g.RewardSettings), settings); err != nil {
+		return nil, ErrInvalidRewardSettings
+	}
+	return settings, nil
+}
+
+// 점수에 대한 기본 보상(버프 적용 전)을 계산
+func (r *GameRewardSettings) Calculate(score int, completed bool) (gold, experience int) {
+	gold = int(float64(score) * r.GoldPerPoint)
+	experience = int(float64(score) * r.ExperiencePerPoint)
+	if completed {
+		gold += r.CompletionGold
+		experience += r.CompletionExperience
+	}
+	return r.capGold(gold), r.capExperience(experience)
+}
+
+// 골드 보상 상한 적용
+func (r *GameRewardSettings) capGold(gold int) int {
+	if r.MaxGold > 0 && gold > r.MaxGold {
+		return r.MaxGold
+	}
+	return gold
+}
+
+// 경험치 보상 상한 적용
+func (r *GameRewardSettings) capExperience(experience int) int {
+	if r.MaxExperience > 0 && experience > r.MaxExperience {
+		return r.MaxExperience
+	}
+	return experience
+}
+
+// 에러 정의
+var (
+	ErrSessionNotFound       = errors.New("게임 세션을 찾을 수 없습니다")
+	ErrInvalidSessionToken   = errors.New("유효하지 않은 세션 토큰입니다")
+	ErrSessionClosed         = errors.New("이미 종료된 게임 세션입니다")
+	ErrSessionExpired        = errors.New("응답이 없어 만료된 게임 세션입니다")
+	ErrSessionInProgress     = errors.New("이미 진행 중인 게임 세션이 있습니다")
+	ErrInvalidSessionEvent   = errors.New("현재 세션 상태에서 처리할 수 없는 이벤트입니다")
+	ErrSessionMismatch       = errors.New("제출한 점수가 세션 정보와 일치하지 않습니다")
+	ErrGameNotPlayable       = errors.New("현재 플레이할 수 없는 게임입니다")
+	ErrTooManyPlayers        = errors.New("게임의 최대 플레이어 수를 초과했습니다")
+	ErrInvalidScore          = errors.New("점수 정보가 유효하지 않습니다")
+	ErrInvalidRewardSettings = errors.New("게임 보상 설정이 유효하지 않습니다")
+)
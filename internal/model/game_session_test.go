@@ -0,0 +1,68 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// 세션 이벤트 적용과 플레이 시간 계산 테스트
+func TestGameSession_Apply(t *testing.T) {
+	start := time.Now()
+	session := &GameSession{Status: GameSessionActive, StartedAt: start, LastHeartbeatAt: start}
+
+	require.NoError(t, session.Apply(GameSessionEventHeartbeat, start.Add(10*time.Second)))
+	require.NoError(t, session.Apply(GameSessionEventPause, start.Add(20*time.Second)))
+	assert.Equal(t, GameSessionPaused, session.Status)
+	assert.ErrorIs(t, session.Apply(GameSessionEventPause, start.Add(25*time.Second)), ErrInvalidSessionEvent)
+	assert.Equal(t, 20, session.ActivePlaySeconds(start.Add(50*time.Second)), "일시정지 중인 시간은 제외해야 합니다")
+
+	require.NoError(t, session.Apply(GameSessionEventResume, start.Add(50*time.Second)))
+	assert.Equal(t, 30, session.PausedSeconds)
+	assert.ErrorIs(t, session.Apply(GameSessionEventResume, start.Add(55*time.Second)), ErrInvalidSessionEvent)
+	assert.ErrorIs(t, session.Apply("jump", start.Add(55*time.Second)), ErrInvalidSessionEvent)
+
+	require.NoError(t, session.Apply(GameSessionEventSave, start.Add(60*time.Second)))
+	assert.Equal(t, 1, session.HeartbeatCount)
+	assert.Equal(t, 1, session.PauseCount)
+	assert.Equal(t, 1, session.SaveCount)
+	assert.Equal(t, start.Add(60*time.Second), session.LastHeartbeatAt)
+
+	assert.False(t, session.IsStale(start.Add(60*time.Second+GameSessionHeartbeatTimeout-time.Second)))
+	assert.True(t, session.IsStale(start.Add(60*time.Second+GameSessionHeartbeatTimeout)))
+
+	require.NoError(t, session.Apply(GameSessionEventPause, start.Add(70*time.Second)))
+	session.End(start.Add(80 * time.Second))
+	assert.Equal(t, GameSessionEnded, session.Status)
+	assert.Equal(t, 40, session.PausedSeconds, "일시정지 상태로 종료하면 남은 시간도 누적해야 합니다")
+	assert.Equal(t, 40, session.ActivePlaySeconds(*session.EndedAt))
+	assert.ErrorIs(t, session.Apply(GameSessionEventHeartbeat, start.Add(90*time.Second)), ErrSessionClosed)
+}
+
+// 게임 보상 설정 파싱과 계산 테스트
+func TestGameRewardSettings_Calculate(t *testing.T) {
+	game := &Game{RewardSettings: `{"gold_per_point":0.5,"experience_per_point":2,"completion_gold":20,"completion_experience":50,"max_gold":100}`}
+	settings, err := game.GetRewardSettings()
+	require.NoError(t, err)
+
+	gold, experience := settings.Calculate(100, false)
+	assert.Equal(t, 50, gold)
+	assert.Equal(t, 200, experience)
+
+	gold, experience = settings.Calculate(100, true)
+	assert.Equal(t, 70, gold)
+	assert.Equal(t, 250, experience)
+
+	gold, _ = settings.Calculate(1000, true)
+	assert.Equal(t, 100, gold, "상한을 넘으면 상한으로 제한해야 합니다")
+
+	empty, err := (&Game{}).GetRewardSettings()
+	require.NoError(t, err)
+	gold, experience = empty.Calculate(1000, true)
+	assert.Zero(t, gold)
+	assert.Zero(t, experience)
+
+	_, err = (&Game{RewardSettings: "not json"}).GetRewardSettings()
+	assert.ErrorIs(t, err, ErrInvalidRewardSettings)
+}
@@ -17,19 +17,90 @@ type Inventory struct {
 	Rarity    string         `json:"rarity" gorm:"not null;size:20"`    // common, rare, epic, legendary
 	Level     int            `json:"level" gorm:"not null;default:1"`
 	IsActive  bool           `json:"is_active" gorm:"not null;default:false"`
+	IsBound   bool           `json:"is_bound" gorm:"not null;default:false"` // 귀속 아이템은 거래 불가
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index"`
 
+	// 만료 시각 (nil이면 영구 아이템)
+	ExpiresAt *time.Time `json:"expires_at,omitempty" gorm:"index"`
+
+	// 만료 임박 알림을 보낸 시각
+	ExpiryNotifiedAt *time.Time `json:"-"`
+
+	// 사용 횟수 제한 (0이면 제한 없음)과 현재까지 사용한 횟수
+	UsageLimit int `json:"usage_limit" gorm:"not null;default:0"`
+	UsageCount int `json:"usage_count" gorm:"not null;default:0"`
+
 	// 관계 설정
 	User User `json:"user,omitempty" gorm:"foreignKey:UserID"`
 }
 
+// 아이템 만료 시 보상 규칙
+// 기간제 아이템이 만료되어 정리될 때 수량 1개당 지급할 골드 또는 대체 아이템을 정의한다.
+type ItemExpiryRule struct {
+	ID     uint   `json:"id" gorm:"primaryKey"`
+	ItemID string `json:"item_id" gorm:"uniqueIndex;size:50;not null"`
+
+	// 수량 1개당 지급할 골드
+	ConsolationGold int `json:"consolation_gold" gorm:"not null;default:0"`
+
+	// 수량 1개당 지급할 대체 아이템 (비어 있으면 지급하지 않음)
+	ConsolationItemID       string `json:"consolation_item_id" gorm:"size:50"`
+	ConsolationItemName     string `json:"consolation_item_name" gorm:"size:100"`
+	ConsolationItemType     string `json:"consolation_item_type" gorm:"size:20"`
+	ConsolationItemRarity   string `json:"consolation_item_rarity" gorm:"size:20"`
+	ConsolationItemQuantity int    `json:"consolation_item_quantity" gorm:"not null;default:0"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 // GORM에서 사용할 테이블 이름을 지정
 func (Inventory) TableName() string {
 	return "inventories"
 }
 
+// ItemExpiryRule 모델의 테이블 이름 반환
+func (ItemExpiryRule) TableName() string {
+	return "item_expiry_rules"
+}
+
+// 만료 보상 규칙 유효성 검사
+func (r *ItemExpiryRule) Validate() error {
+	if r.ItemID == "" {
+		return ErrInvalidItemID
+	}
+	if r.ConsolationGold < 0 || r.ConsolationItemQuantity < 0 {
+		return ErrInvalidQuantity
+	}
+	if r.ConsolationItemID != "" {
+		if r.ConsolationItemName == "" || r.ConsolationItemType == "" || r.ConsolationItemRarity == "" {
+			return ErrInvalidItemID
+		}
+		if r.ConsolationItemQuantity == 0 {
+			return ErrInvalidQuantity
+		}
+	}
+	return nil
+}
+
+// 대체 아이템을 인벤토리 형태로 변환 (대체 아이템이 없으면 nil)
+func (r *ItemExpiryRule) ConsolationInventory(userID uint) *Inventory {
+	if r.ConsolationItemID == "" {
+		return nil
+	}
+	return &Inventory{
+		UserID:   userID,
+		ItemID:   r.ConsolationItemID,
+		ItemName: r.ConsolationItemName,
+		ItemType: r.ConsolationItemType,
+		Rarity:   r.ConsolationItemRarity,
+		Level:    1,
+		Quantity: r.ConsolationItemQuantity,
+	}
+}
+
 // GORM 훅으로, 레코드 생성 전에 실행
 func (i *Inventory) BeforeCreate(tx *gorm.DB) error {
 	if i.CreatedAt.IsZero() {
@@ -70,9 +141,43 @@ func (i *Inventory) Validate() error {
 	if i.Level <= 0 {
 		return ErrInvalidLevel
 	}
+	if i.UsageLimit < 0 || i.UsageCount < 0 || (i.UsageLimit > 0 && i.UsageCount > i.UsageLimit) {
+		return ErrInvalidUsageLimit
+	}
 	return nil
 }
 
+// 기간제 또는 사용 횟수 제한이 있는 아이템인지 확인
+func (i *Inventory) IsTimeLimited() bool {
+	return i.ExpiresAt != nil || i.UsageLimit > 0
+}
+
+// 주어진 시각 기준으로 만료되었는지 확인
+func (i *Inventory) IsExpired(now time.Time) bool {
+	return i.ExpiresAt != nil && !now.Before(*i.ExpiresAt)
+}
+
+// 남은 사용 횟수 반환 (제한이 없으면 -1)
+func (i *Inventory) RemainingUses() int {
+	if i.UsageLimit == 0 {
+		return -1
+	}
+	return i.UsageLimit - i.UsageCount
+}
+
+// 사용 횟수 차감
+// 제한이 없는 아이템은 아무 동작도 하지 않으며, 모두 사용했으면 true를 반환
+func (i *Inventory) ConsumeUse() (bool, error) {
+	if i.UsageLimit == 0 {
+		return false, nil
+	}
+	if i.UsageCount >= i.UsageLimit {
+		return true, ErrUsageExhausted
+	}
+	i.UsageCount++
+	return i.UsageCount >= i.UsageLimit, nil
+}
+
 // 아이템 사용
 func (i *Inventory) UseItem() error {
 	if i.Quantity <= 0 {
@@ -107,6 +212,38 @@ func (i *Inventory) IsCommon() bool {
 	return i.Rarity == "common"
 }
 
+// 아이템을 다른 플레이어와 거래할 수 있는지 확인
+// 기간제 아이템은 만료 정보가 옮겨지지 않으므로 거래 불가
+func (i *Inventory) CanTrade() bool {
+	return !i.IsBound && !i.IsTimeLimited()
+}
+
+// 아이템의 추정 가치 (골드 환산)
+func (i *Inventory) EstimatedValue() int {
+	return EstimateItemValue(i.Rarity, i.Level, i.Quantity)
+}
+
+// 등급별 아이템 기본 가치 (골드 환산)
+var rarityBaseValues = map[string]int{
+	"common":    10,
+	"rare":      100,
+	"epic":      1000,
+	"legendary": 10000,
+}
+
+// 등급, 레벨, 수량으로 아이템의 추정 가치를 계산
+// 알 수 없는 등급은 common 과 같은 가치로 취급
+func EstimateItemValue(rarity string, level, quantity int) int {
+	base, ok := rarityBaseValues[rarity]
+	if !ok {
+		base = rarityBaseValues["common"]
+	}
+	if level < 1 {
+		level = 1
+	}
+	return base * level * quantity
+}
+
 // 등급에 따른 색상 반환
 func (i *Inventory) GetRarityColor() string {
 	switch i.Rarity {
@@ -133,4 +270,7 @@ var (
 	ErrInvalidRarity        = errors.New("등급이 유효하지 않습니다")
 	ErrInvalidLevel         = errors.New("레벨이 유효하지 않습니다")
 	ErrInsufficientQuantity = errors.New("수량이 부족합니다")
+	ErrInvalidUsageLimit    = errors.New("사용 횟수 제한이 유효하지 않습니다")
+	ErrUsageExhausted       = errors.New("사용 횟수를 모두 소진한 아이템입니다")
+	ErrItemExpired          = errors.New("만료된 아이템입니다")
 )
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// 인벤토리 변경 출처
+type InventorySource string
+
+const (
+	InventorySourcePurchase    InventorySource = "purchase"    // 거래소 구매
+	InventorySourceMarket      InventorySource = "market"      // 거래소 등록/반환
+	InventorySourceTrade       InventorySource = "trade"       // 플레이어 간 거래
+	InventorySourceCraft       InventorySource = "craft"       // 제작
+	InventorySourceEnhance     InventorySource = "enhance"     // 강화
+	InventorySourceGacha       InventorySource = "gacha"       // 뽑기
+	InventorySourceUse         InventorySource = "use"         // 아이템 사용
+	InventorySourceExpire      InventorySource = "expire"      // 기간 만료
+	InventorySourceAdmin       InventorySource = "admin"       // 관리자 직접 수정
+	InventorySourceRollback    InventorySource = "rollback"    // 변경 되돌리기/복구
+	InventorySourceSeason      InventorySource = "season"      // 시즌 보상
+	InventorySourceAchievement InventorySource = "achievement" // 업적 보상
+	InventorySourceMission     InventorySource = "mission"     // 미션 보상
+	InventorySourceBattlePass  InventorySource = "battle_pass" // 배틀패스 보상
+)
+
+// 인벤토리 변경 정보
+// 자산 변경 헬퍼에 전달되어 변경 기록의 출처와 수행자를 남기는 데 사용된다.
+type InventoryChange struct {
+	Source InventorySource
+
+	// 변경을 수행한 사용자 ID (0이면 시스템)
+	ActorID uint
+
+	// 관련 객체 참조 (예: "trade:12", "listing:3")
+	Reference string
+}
+
+// 지정한 출처의 변경 정보를 생성
+func NewInventoryChange(source InventorySource, actorID uint, refType string, refID uint) InventoryChange {
+	change := InventoryChange{Source: source, ActorID: actorID}
+	if refType != "" {
+		change.Reference = fmt.Sprintf("%s:%d", refType, refID)
+	}
+	return change
+}
+
+// 인벤토리 변경 기록 모델
+// 모든 아이템 수량/레벨 변경 전후 값을 남겨 고객 지원 시 추적과 복구에 사용한다.
+type InventoryLog struct {
+	ID          uint `json:"id" gorm:"primaryKey"`
+	UserID      uint `json:"user_id" gorm:"not null;index:idx_inventory_logs_user_item"`
+	InventoryID uint `json:"inventory_id" gorm:"not null;index"`
+
+	// 변경 시점의 아이템 정보
+	ItemID   string `json:"item_id" gorm:"size:50;not null;index:idx_inventory_logs_user_item"`
+	ItemName string `json:"item_name" gorm:"size:100;not null"`
+	ItemType string `json:"item_type" gorm:"size:20;not null"`
+	Rarity   string `json:"rarity" gorm:"size:20;not null"`
+
+	// 변경 전후 수량 (0이면 아이템 없음/삭제)
+	BeforeQuantity int `json:"before_quantity" gorm:"not null"`
+	AfterQuantity  int `json:"after_quantity" gorm:"not null"`
+
+	// 변경 전후 레벨
+	BeforeLevel int `json:"before_level" gorm:"not null"`
+	AfterLevel  int `json:"after_level" gorm:"not null"`
+
+	Source    InventorySource `json:"source" gorm:"size:20;not null;index"`
+	ActorID   uint            `json:"actor_id" gorm:"not null;default:0"`
+	Reference string          `json:"reference" gorm:"size:50"`
+
+	// 되돌려진 경우 되돌린 기록 ID
+	RevertedByID *uint `json:"reverted_by_id,omitempty"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"index"`
+}
+
+// InventoryLog 모델의 테이블 이름 반환
+func (InventoryLog) TableName() string {
+	return "inventory_logs"
+}
+
+// 수량 변화량 반환
+func (l *InventoryLog) QuantityDelta() int {
+	return l.AfterQuantity - l.BeforeQuantity
+}
+
+// 변경으로 아이템이 삭제되었는지 확인
+func (l *InventoryLog) IsRemoval() bool {
+	return l.BeforeQuantity > 0 && l.AfterQuantity == 0
+}
+
+// 이미 되돌려진 기록인지 확인
+func (l *InventoryLog) IsReverted() bool {
+	return l.RevertedByID != nil
+}
+
+// 에러 정의
+var (
+	ErrInventoryLogNotFound = errors.New("인벤토리 변경 기록을 찾을 수 없습니다")
+	ErrAlreadyReverted      = errors.New("이미 되돌린 변경 기록입니다")
+	ErrRevertConflict       = errors.New("현재 아이템 상태로는 변경을 되돌릴 수 없습니다")
+	ErrItemNotDeleted       = errors.New("삭제된 아이템이 아닙니다")
+)
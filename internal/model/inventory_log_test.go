@@ -0,0 +1,43 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// 변경 정보 생성 테스트
+func TestNewInventoryChange(t *testing.T) {
+	change := NewInventoryChange(InventorySourceTrade, 3, "trade", 12)
+	assert.Equal(t, InventorySourceTrade, change.Source)
+	assert.Equal(t, uint(3), change.ActorID)
+	assert.Equal(t, "trade:12", change.Reference)
+
+	change = NewInventoryChange(InventorySourceAdmin, 0, "", 0)
+	assert.Empty(t, change.Reference, "참조 타입이 없으면 참조를 남기지 않아야 합니다")
+}
+
+// 변경 기록 계산 메서드 테스트
+func TestInventoryLog_Delta(t *testing.T) {
+	tests := []struct {
+		name      string
+		log       InventoryLog
+		delta     int
+		isRemoval bool
+	}{
+		{name: "획득", log: InventoryLog{BeforeQuantity: 0, AfterQuantity: 3}, delta: 3},
+		{name: "일부 소모", log: InventoryLog{BeforeQuantity: 5, AfterQuantity: 2}, delta: -3},
+		{name: "삭제", log: InventoryLog{BeforeQuantity: 1, AfterQuantity: 0}, delta: -1, isRemoval: true},
+		{name: "레벨만 변경", log: InventoryLog{BeforeQuantity: 1, AfterQuantity: 1, BeforeLevel: 3, AfterLevel: 4}, delta: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.delta, tt.log.QuantityDelta())
+			assert.Equal(t, tt.isRemoval, tt.log.IsRemoval())
+		})
+	}
+
+	id := uint(9)
+	assert.False(t, (&InventoryLog{}).IsReverted())
+	assert.True(t, (&InventoryLog{RevertedByID: &id}).IsReverted())
+}
@@ -572,3 +572,81 @@ func TestInventory_EdgeCases(t *testing.T) {
 		assert.NoError(t, err, "특수 문자가 포함된 아이템도 유효해야 합니다")
 	})
 }
+
+// 기간제 아이템 만료 여부 테스트
+func TestInventory_IsExpired(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+
+	assert.False(t, (&Inventory{}).IsExpired(now), "만료 시각이 없으면 만료되지 않아야 합니다")
+	assert.True(t, (&Inventory{ExpiresAt: &past}).IsExpired(now), "지난 시각이면 만료되어야 합니다")
+	assert.True(t, (&Inventory{ExpiresAt: &now}).IsExpired(now), "만료 시각과 같으면 만료되어야 합니다")
+	assert.False(t, (&Inventory{ExpiresAt: &future}).IsExpired(now), "미래 시각이면 만료되지 않아야 합니다")
+}
+
+// 사용 횟수 제한 테스트
+func TestInventory_ConsumeUse(t *testing.T) {
+	t.Run("제한 없는 아이템", func(t *testing.T) {
+		inventory := &Inventory{}
+		exhausted, err := inventory.ConsumeUse()
+		assert.NoError(t, err)
+		assert.False(t, exhausted)
+		assert.Equal(t, -1, inventory.RemainingUses())
+	})
+
+	t.Run("횟수 소진", func(t *testing.T) {
+		inventory := &Inventory{UsageLimit: 2}
+
+		exhausted, err := inventory.ConsumeUse()
+		assert.NoError(t, err)
+		assert.False(t, exhausted)
+		assert.Equal(t, 1, inventory.RemainingUses())
+
+		exhausted, err = inventory.ConsumeUse()
+		assert.NoError(t, err)
+		assert.True(t, exhausted, "마지막 사용 후에는 소진되어야 합니다")
+
+		_, err = inventory.ConsumeUse()
+		assert.Equal(t, ErrUsageExhausted, err)
+		assert.Equal(t, 2, inventory.UsageCount, "소진 후에는 횟수가 늘어나면 안됩니다")
+	})
+
+	t.Run("잘못된 사용 횟수", func(t *testing.T) {
+		inventory := &Inventory{UserID: 1, ItemID: "rental", ItemName: "대여 검", Quantity: 1, ItemType: "weapon", Rarity: "rare", Level: 1, UsageLimit: 1, UsageCount: 2}
+		assert.Equal(t, ErrInvalidUsageLimit, inventory.Validate())
+	})
+}
+
+// 기간제 아이템 거래 제한 테스트
+func TestInventory_CanTradeTimeLimited(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+
+	assert.True(t, (&Inventory{}).CanTrade())
+	assert.False(t, (&Inventory{ExpiresAt: &expiresAt}).CanTrade(), "기간제 아이템은 거래할 수 없어야 합니다")
+	assert.False(t, (&Inventory{UsageLimit: 3}).CanTrade(), "횟수제 아이템은 거래할 수 없어야 합니다")
+}
+
+// 만료 보상 규칙 유효성 검사 테스트
+func TestItemExpiryRule_Validate(t *testing.T) {
+	assert.NoError(t, (&ItemExpiryRule{ItemID: "event_sword", ConsolationGold: 100}).Validate())
+	assert.Equal(t, ErrInvalidItemID, (&ItemExpiryRule{}).Validate())
+	assert.Equal(t, ErrInvalidQuantity, (&ItemExpiryRule{ItemID: "event_sword", ConsolationGold: -1}).Validate())
+	assert.Equal(t, ErrInvalidItemID, (&ItemExpiryRule{ItemID: "event_sword", ConsolationItemID: "token", ConsolationItemQuantity: 1}).Validate())
+
+	rule := &ItemExpiryRule{
+		ItemID:                  "event_sword",
+		ConsolationItemID:       "event_token",
+		ConsolationItemName:     "이벤트 토큰",
+		ConsolationItemType:     "material",
+		ConsolationItemRarity:   "common",
+		ConsolationItemQuantity: 3,
+	}
+	assert.NoError(t, rule.Validate())
+
+	template := rule.ConsolationInventory(7)
+	assert.Equal(t, uint(7), template.UserID)
+	assert.Equal(t, "event_token", template.ItemID)
+	assert.Equal(t, 3, template.Quantity)
+	assert.Nil(t, (&ItemExpiryRule{ItemID: "event_sword"}).ConsolationInventory(7))
+}
@@ -0,0 +1,141 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// 소비 아이템 효과 타입
+type ItemEffectType string
+
+const (
+	ItemEffectGrantXP       ItemEffectType = "grant_xp"       // 경험치 지급
+	ItemEffectGrantGold     ItemEffectType = "grant_gold"     // 골드 지급
+	ItemEffectBuff          ItemEffectType = "buff"           // 시간제 버프 적용
+	ItemEffectOpenContainer ItemEffectType = "open_container" // 상자 열기 (내용물 지급)
+)
+
+// 버프가 적용되는 보상 종류
+const (
+	BuffStatXP    = "xp"
+	BuffStatGold  = "gold"
+	BuffStatScore = "score"
+)
+
+// 소비 아이템 효과 정의
+// 같은 아이템에 여러 효과를 등록하면 등록 순서대로 모두 적용된다.
+type ItemEffect struct {
+	ID     uint           `json:"id" gorm:"primaryKey"`
+	ItemID string         `json:"item_id" gorm:"size:50;not null;index"`
+	Type   ItemEffectType `json:"type" gorm:"size:30;not null"`
+
+	// 지급량 (경험치/골드)
+	Amount int `json:"amount" gorm:"not null;default:0"`
+
+	// 버프 대상 보상 종류와 보너스 비율 (0.5 = +50%)
+	BuffStat  string  `json:"buff_stat,omitempty" gorm:"size:20"`
+	BuffBonus float64 `json:"buff_bonus,omitempty" gorm:"not null;default:0"`
+
+	// 버프 지속 시간 (초)
+	DurationSeconds int `json:"duration_seconds,omitempty" gorm:"not null;default:0"`
+
+	// 상자 내용물
+	Drops []ContainerDrop `json:"drops,omitempty" gorm:"foreignKey:EffectID"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// 상자 내용물
+type ContainerDrop struct {
+	ID       uint   `json:"id" gorm:"primaryKey"`
+	EffectID uint   `json:"effect_id" gorm:"not null;index"`
+	ItemID   string `json:"item_id" gorm:"size:50;not null"`
+	ItemName string `json:"item_name" gorm:"size:100;not null"`
+	ItemType string `json:"item_type" gorm:"size:20;not null"`
+	Rarity   string `json:"rarity" gorm:"size:20;not null"`
+	Quantity int    `json:"quantity" gorm:"not null;default:1"`
+
+	// 획득 확률 (0~1, 1이면 항상 지급)
+	Chance float64 `json:"chance" gorm:"not null;default:1"`
+}
+
+// 사용자에게 적용 중인 시간제 버프
+type ActiveBuff struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	UserID       uint      `json:"user_id" gorm:"not null;index:idx_active_buffs_user_stat"`
+	Stat         string    `json:"stat" gorm:"size:20;not null;index:idx_active_buffs_user_stat"`
+	Bonus        float64   `json:"bonus" gorm:"not null"`
+	SourceItemID string    `json:"source_item_id" gorm:"size:50;not null"`
+	ExpiresAt    time.Time `json:"expires_at" gorm:"not null;index"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// ItemEffect 모델의 테이블 이름 반환
+func (ItemEffect) TableName() string {
+	return "item_effects"
+}
+
+// ContainerDrop 모델의 테이블 이름 반환
+func (ContainerDrop) TableName() string {
+	return "container_drops"
+}
+
+// ActiveBuff 모델의 테이블 이름 반환
+func (ActiveBuff) TableName() string {
+	return "active_buffs"
+}
+
+// 버프 지속 시간 반환
+func (e *ItemEffect) Duration() time.Duration {
+	return time.Duration(e.DurationSeconds) * time.Second
+}
+
+// 효과 공통 필드 유효성 검사
+// 타입별 세부 검사는 효과 처리기가 담당한다.
+func (e *ItemEffect) Validate() error {
+	if e.ItemID == "" {
+		return ErrInvalidItemID
+	}
+	if e.Type == "" {
+		return ErrUnknownEffect
+	}
+	return nil
+}
+
+// 상자 내용물 유효성 검사
+func (d *ContainerDrop) Validate() error {
+	if d.ItemID == "" || d.ItemName == "" || d.ItemType == "" || d.Rarity == "" {
+		return ErrInvalidItemID
+	}
+	if d.Quantity <= 0 {
+		return ErrInvalidQuantity
+	}
+	if d.Chance <= 0 || d.Chance > 1 {
+		return ErrInvalidEffect
+	}
+	return nil
+}
+
+// 상자 내용물을 인벤토리 형태로 변환
+func (d *ContainerDrop) ToInventory(userID uint) *Inventory {
+	return &Inventory{
+		UserID:   userID,
+		ItemID:   d.ItemID,
+		ItemName: d.ItemName,
+		ItemType: d.ItemType,
+		Rarity:   d.Rarity,
+		Level:    1,
+		Quantity: d.Quantity,
+	}
+}
+
+// 버프가 주어진 시각에 유효한지 확인
+func (b *ActiveBuff) IsActive(now time.Time) bool {
+	return now.Before(b.ExpiresAt)
+}
+
+// 에러 정의
+var (
+	ErrUnknownEffect = errors.New("알 수 없는 아이템 효과입니다")
+	ErrInvalidEffect = errors.New("아이템 효과 설정이 유효하지 않습니다")
+)
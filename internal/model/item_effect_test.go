@@ -0,0 +1,46 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// 효과 공통 필드 검사 테스트
+func TestItemEffect_Validate(t *testing.T) {
+	assert.NoError(t, (&ItemEffect{ItemID: "potion", Type: ItemEffectGrantXP}).Validate())
+	assert.ErrorIs(t, (&ItemEffect{Type: ItemEffectGrantXP}).Validate(), ErrInvalidItemID)
+	assert.ErrorIs(t, (&ItemEffect{ItemID: "potion"}).Validate(), ErrUnknownEffect)
+	assert.Equal(t, 90*time.Second, (&ItemEffect{DurationSeconds: 90}).Duration())
+}
+
+// 상자 내용물 검사 테스트
+func TestContainerDrop_Validate(t *testing.T) {
+	drop := ContainerDrop{ItemID: "gem", ItemName: "보석", ItemType: "material", Rarity: "rare", Quantity: 2, Chance: 0.3}
+	assert.NoError(t, drop.Validate())
+
+	inventory := drop.ToInventory(7)
+	assert.Equal(t, uint(7), inventory.UserID)
+	assert.Equal(t, 2, inventory.Quantity)
+	assert.Equal(t, 1, inventory.Level)
+
+	invalid := drop
+	invalid.Quantity = 0
+	assert.ErrorIs(t, invalid.Validate(), ErrInvalidQuantity)
+
+	invalid = drop
+	invalid.Chance = 1.5
+	assert.ErrorIs(t, invalid.Validate(), ErrInvalidEffect)
+
+	invalid = drop
+	invalid.ItemID = ""
+	assert.ErrorIs(t, invalid.Validate(), ErrInvalidItemID)
+}
+
+// 버프 유효 시간 테스트
+func TestActiveBuff_IsActive(t *testing.T) {
+	now := time.Now()
+	buff := ActiveBuff{ExpiresAt: now.Add(time.Minute)}
+	assert.True(t, buff.IsActive(now))
+	assert.False(t, buff.IsActive(now.Add(time.Minute)))
+}
@@ -0,0 +1,128 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// 리더보드 집계 기간
+type LeaderboardWindow string
+
+const (
+	LeaderboardAllTime LeaderboardWindow = "all"     // 전체 기간
+	LeaderboardDaily   LeaderboardWindow = "daily"   // 일간
+	LeaderboardWeekly  LeaderboardWindow = "weekly"  // 주간 (ISO 주, 월요일 시작)
+	LeaderboardMonthly LeaderboardWindow = "monthly" // 월간
+	LeaderboardSeason  LeaderboardWindow = "season"  // 진행 중인 시즌
+)
+
+// 모든 집계 기간 (시즌 제외)
+var LeaderboardWindows = []LeaderboardWindow{LeaderboardAllTime, LeaderboardDaily, LeaderboardWeekly, LeaderboardMonthly}
+
+// 리더보드 항목
+type LeaderboardEntry struct {
+	Rank       int64     `json:"rank"`
+	UserID     uint      `json:"user_id"`
+	Nickname   string    `json:"nickname,omitempty"`
+	Score      int       `json:"score"`
+	AchievedAt time.Time `json:"achieved_at"`
+}
+
+// 특정 게임/난이도/기간의 리더보드
+type LeaderboardBoard struct {
+	GameID     uint              `json:"game_id"`
+	Difficulty GameDifficulty    `json:"difficulty"`
+	Window     LeaderboardWindow `json:"window"`
+
+	// 기간 식별자 (all, 2025-01-31, 2025-W05, 2025-01, 시즌이면 s12)
+	Period string `json:"period"`
+
+	// 기간 범위 (전체 기간이면 nil)
+	Start *time.Time `json:"start,omitempty"`
+	End   *time.Time `json:"end,omitempty"`
+}
+
+// 기준 시각이 속한 리더보드를 반환 (기간은 UTC 기준)
+func NewLeaderboardBoard(gameID uint, difficulty GameDifficulty, window LeaderboardWindow, at time.Time) (*LeaderboardBoard, error) {
+	if gameID == 0 {
+		return nil, ErrInvalidLeaderboard
+	}
+	if !isValidGameDifficulty(difficulty) {
+		return nil, ErrInvalidLeaderboard
+	}
+
+	// 기간 경계는 서버 시간대와 관계없이 UTC 기준
+	at = at.UTC()
+	board := &LeaderboardBoard{GameID: gameID, Difficulty: difficulty, Window: window}
+	var start, end time.Time
+	switch window {
+	case LeaderboardAllTime:
+		board.Period = "all"
+		return board, nil
+	case LeaderboardDaily:
+		start = time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, at.Location())
+		end = start.AddDate(0, 0, 1)
+		board.Period = start.Format("2006-01-02")
+	case LeaderboardWeekly:
+		offset := (int(at.Weekday()) + 6) % 7
+		start = time.Date(at.Year(), at.Month(), at.Day()-offset, 0, 0, 0, 0, at.Location())
+		end = start.AddDate(0, 0, 7)
+		year, week := start.ISOWeek()
+		board.Period = fmt.Sprintf("%d-W%02d", year, week)
+	case LeaderboardMonthly:
+		start = time.Date(at.Year(), at.Month(), 1, 0, 0, 0, 0, at.Location())
+		end = start.AddDate(0, 1, 0)
+		board.Period = start.Format("2006-01")
+	default:
+		return nil, ErrInvalidLeaderboard
+	}
+
+	board.Start = &start
+	board.End = &end
+	return board, nil
+}
+
+// 시즌 리더보드를 반환
+func NewSeasonLeaderboardBoard(season *Season, difficulty GameDifficulty) (*LeaderboardBoard, error) {
+	if season.ID == 0 || !isValidGameDifficulty(difficulty) {
+		return nil, ErrInvalidLeaderboard
+	}
+
+	start, end := season.StartAt, season.EndAt
+	return &LeaderboardBoard{
+		GameID:     season.GameID,
+		Difficulty: difficulty,
+		Window:     LeaderboardSeason,
+		Period:     fmt.Sprintf("s%d", season.ID),
+		Start:      &start,
+		End:        &end,
+	}, nil
+}
+
+// 리더보드 저장소 키
+func (b *LeaderboardBoard) Key() string {
+	return fmt.Sprintf("leaderboard:%d:%s:%s:%s", b.GameID, b.Difficulty, b.Window, b.Period)
+}
+
+// 시각이 리더보드 기간에 포함되는지 확인
+func (b *LeaderboardBoard) Contains(t time.Time) bool {
+	if b.Start == nil || b.End == nil {
+		return true
+	}
+	return !t.Before(*b.Start) && t.Before(*b.End)
+}
+
+// 기간이 끝난 뒤 리더보드를 보관할 시간 (전체 기간이면 0)
+func (b *LeaderboardBoard) Retention(now time.Time) time.Duration {
+	if b.End == nil {
+		return 0
+	}
+	return b.End.Sub(now) + b.End.Sub(*b.Start)
+}
+
+// 에러 정의
+var (
+	ErrInvalidLeaderboard = errors.New("리더보드 조건이 유효하지 않습니다")
+	ErrNotRanked          = errors.New("리더보드에 기록이 없습니다")
+)
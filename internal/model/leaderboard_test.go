@@ -0,0 +1,58 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// 기간별 리더보드 키와 범위 테스트
+func TestNewLeaderboardBoard(t *testing.T) {
+	// 2025-01-01은 수요일, ISO 기준 2025년 1주차
+	at := time.Date(2025, 1, 1, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		window LeaderboardWindow
+		key    string
+		start  time.Time
+		end    time.Time
+	}{
+		{window: LeaderboardDaily, key: "leaderboard:3:hard:daily:2025-01-01", start: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), end: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{window: LeaderboardWeekly, key: "leaderboard:3:hard:weekly:2025-W01", start: time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), end: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)},
+		{window: LeaderboardMonthly, key: "leaderboard:3:hard:monthly:2025-01", start: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), end: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.window), func(t *testing.T) {
+			board, err := NewLeaderboardBoard(3, GameDifficultyHard, tt.window, at)
+			require.NoError(t, err)
+			assert.Equal(t, tt.key, board.Key())
+			assert.Equal(t, tt.start, *board.Start)
+			assert.Equal(t, tt.end, *board.End)
+			assert.True(t, board.Contains(at))
+			assert.False(t, board.Contains(tt.end))
+			assert.Equal(t, tt.end.Sub(at)+tt.end.Sub(tt.start), board.Retention(at))
+		})
+	}
+
+	allTime, err := NewLeaderboardBoard(3, GameDifficultyHard, LeaderboardAllTime, at)
+	require.NoError(t, err)
+	assert.Equal(t, "leaderboard:3:hard:all:all", allTime.Key())
+	assert.Nil(t, allTime.Start)
+	assert.True(t, allTime.Contains(at.AddDate(-10, 0, 0)))
+	assert.Zero(t, allTime.Retention(at))
+
+	// 다른 시간대의 시각도 UTC 기간으로 변환
+	seoul := time.FixedZone("KST", 9*60*60)
+	board, err := NewLeaderboardBoard(3, GameDifficultyHard, LeaderboardDaily, time.Date(2025, 1, 2, 8, 0, 0, 0, seoul))
+	require.NoError(t, err)
+	assert.Equal(t, "2025-01-01", board.Period)
+
+	_, err = NewLeaderboardBoard(0, GameDifficultyHard, LeaderboardDaily, at)
+	assert.ErrorIs(t, err, ErrInvalidLeaderboard)
+	_, err = NewLeaderboardBoard(3, "nightmare", LeaderboardDaily, at)
+	assert.ErrorIs(t, err, ErrInvalidLeaderboard)
+	_, err = NewLeaderboardBoard(3, GameDifficultyHard, "yearly", at)
+	assert.ErrorIs(t, err, ErrInvalidLeaderboard)
+}
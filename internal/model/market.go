@@ -0,0 +1,201 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// 거래소 판매 등록 모델
+// 판매자가 인벤토리 아이템을 고정가 또는 경매 방식으로 등록하며,
+// 등록된 아이템은 판매 종료 시까지 거래소가 보관한다.
+type MarketListing struct {
+	BaseModel
+
+	// 판매자 ID
+	SellerID uint `json:"seller_id" gorm:"not null;index"`
+
+	// 판매 아이템 정보 (등록 시점 스냅샷)
+	ItemID   string `json:"item_id" gorm:"not null;size:50;index"`
+	ItemName string `json:"item_name" gorm:"not null;size:100"`
+	ItemType string `json:"item_type" gorm:"not null;size:20;index"`
+	Rarity   string `json:"rarity" gorm:"not null;size:20;index"`
+	Level    int    `json:"level" gorm:"not null;default:1"`
+	Quantity int    `json:"quantity" gorm:"not null"`
+
+	// 판매 방식 (fixed, auction)
+	ListingType ListingType `json:"listing_type" gorm:"size:20;not null;index"`
+
+	// 고정가 판매 가격
+	Price int `json:"price" gorm:"not null;default:0"`
+
+	// 경매 시작가
+	StartingBid int `json:"starting_bid" gorm:"not null;default:0"`
+
+	// 현재 최고 입찰가
+	CurrentBid int `json:"current_bid" gorm:"not null;default:0"`
+
+	// 현재 최고 입찰자 ID
+	CurrentBidderID *uint `json:"current_bidder_id"`
+
+	// 입찰 횟수
+	BidCount int `json:"bid_count" gorm:"not null;default:0"`
+
+	// 등록 수수료 (등록 시 차감되며 환불되지 않음)
+	ListingFee int `json:"listing_fee" gorm:"not null;default:0"`
+
+	// 판매 상태 (active, sold, expired, cancelled)
+	Status ListingStatus `json:"status" gorm:"size:20;not null;default:'active';index"`
+
+	// 판매 종료 시간
+	EndsAt time.Time `json:"ends_at" gorm:"not null;index"`
+
+	// 구매자(낙찰자) ID
+	BuyerID *uint `json:"buyer_id"`
+
+	// 최종 판매 가격
+	SoldPrice int `json:"sold_price" gorm:"not null;default:0"`
+
+	// 판매세 (판매 대금에서 차감)
+	TaxAmount int `json:"tax_amount" gorm:"not null;default:0"`
+
+	// 판매 완료 시간
+	SoldAt *time.Time `json:"sold_at" gorm:"index"`
+}
+
+// 경매 입찰 기록
+type MarketBid struct {
+	ID        uint `json:"id" gorm:"primaryKey"`
+	ListingID uint `json:"listing_id" gorm:"not null;index"`
+	BidderID  uint `json:"bidder_id" gorm:"not null;index"`
+	Amount    int  `json:"amount" gorm:"not null"`
+
+	// 상위 입찰로 인해 골드가 반환되었는지 여부
+	Refunded bool `json:"refunded" gorm:"not null;default:false"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// 판매 방식
+type ListingType string
+
+const (
+	ListingTypeFixed   ListingType = "fixed"   // 고정가
+	ListingTypeAuction ListingType = "auction" // 경매
+)
+
+// 판매 상태
+type ListingStatus string
+
+const (
+	ListingStatusActive    ListingStatus = "active"    // 판매 중
+	ListingStatusSold      ListingStatus = "sold"      // 판매 완료
+	ListingStatusExpired   ListingStatus = "expired"   // 기간 만료 (미판매)
+	ListingStatusCancelled ListingStatus = "cancelled" // 판매자 취소
+)
+
+// MarketListing 모델의 테이블 이름 반환
+func (MarketListing) TableName() string {
+	return "market_listings"
+}
+
+// MarketBid 모델의 테이블 이름 반환
+func (MarketBid) TableName() string {
+	return "market_bids"
+}
+
+// 판매 등록 데이터 유효성 검사
+func (l *MarketListing) Validate() error {
+	if l.SellerID == 0 {
+		return ErrInvalidUserID
+	}
+	if l.ItemID == "" {
+		return ErrInvalidItemID
+	}
+	if l.Quantity <= 0 {
+		return ErrInvalidQuantity
+	}
+
+	switch l.ListingType {
+	case ListingTypeFixed:
+		if l.Price <= 0 {
+			return ErrInvalidListingPrice
+		}
+	case ListingTypeAuction:
+		if l.StartingBid <= 0 {
+			return ErrInvalidListingPrice
+		}
+	default:
+		return ErrInvalidListingType
+	}
+
+	return nil
+}
+
+// 판매 중인지 확인
+func (l *MarketListing) IsActive() bool {
+	return l.Status == ListingStatusActive
+}
+
+// 경매 방식인지 확인
+func (l *MarketListing) IsAuction() bool {
+	return l.ListingType == ListingTypeAuction
+}
+
+// 판매 기간이 끝났는지 확인
+func (l *MarketListing) IsEnded(now time.Time) bool {
+	return !now.Before(l.EndsAt)
+}
+
+// 입찰이 있는지 확인
+func (l *MarketListing) HasBids() bool {
+	return l.CurrentBidderID != nil && l.BidCount > 0
+}
+
+// 다음 입찰에 필요한 최소 금액
+// 입찰이 없으면 시작가, 있으면 현재가에서 최소 5% (최소 1골드) 이상 높아야 한다.
+func (l *MarketListing) MinimumNextBid() int {
+	if !l.HasBids() {
+		return l.StartingBid
+	}
+	increment := l.CurrentBid / 20
+	if increment < 1 {
+		increment = 1
+	}
+	return l.CurrentBid + increment
+}
+
+// 단위 가격 반환 (가격 이력 비교용)
+func (l *MarketListing) UnitPrice() float64 {
+	if l.Quantity == 0 {
+		return 0
+	}
+	return float64(l.SoldPrice) / float64(l.Quantity)
+}
+
+// 판매 아이템을 인벤토리 형태로 변환 (구매자 지급 및 판매자 반환 시 사용)
+func (l *MarketListing) ToInventory(userID uint) *Inventory {
+	return &Inventory{
+		UserID:   userID,
+		ItemID:   l.ItemID,
+		ItemName: l.ItemName,
+		ItemType: l.ItemType,
+		Rarity:   l.Rarity,
+		Level:    l.Level,
+		Quantity: l.Quantity,
+	}
+}
+
+// 에러 정의
+var (
+	ErrListingNotFound     = errors.New("판매 등록을 찾을 수 없습니다")
+	ErrInvalidListingType  = errors.New("판매 방식이 유효하지 않습니다")
+	ErrInvalidListingPrice = errors.New("판매 가격이 유효하지 않습니다")
+	ErrListingNotActive    = errors.New("판매 중인 물품이 아닙니다")
+	ErrListingEnded        = errors.New("판매 기간이 종료되었습니다")
+	ErrOwnListing          = errors.New("자신이 등록한 물품은 구매하거나 입찰할 수 없습니다")
+	ErrNotListingSeller    = errors.New("판매자만 처리할 수 있습니다")
+	ErrListingHasBids      = errors.New("입찰이 있는 경매는 취소할 수 없습니다")
+	ErrNotFixedListing     = errors.New("고정가 판매 물품이 아닙니다")
+	ErrNotAuctionListing   = errors.New("경매 물품이 아닙니다")
+	ErrBidTooLow           = errors.New("입찰 금액이 최소 입찰가보다 낮습니다")
+)
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// 판매 등록 유효성 검사 테스트
+func TestMarketListing_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		listing     *MarketListing
+		expectedErr error
+	}{
+		{
+			name:        "정상적인 고정가 등록",
+			listing:     &MarketListing{SellerID: 1, ItemID: "sword", Quantity: 1, ListingType: ListingTypeFixed, Price: 100},
+			expectedErr: nil,
+		},
+		{
+			name:        "정상적인 경매 등록",
+			listing:     &MarketListing{SellerID: 1, ItemID: "sword", Quantity: 1, ListingType: ListingTypeAuction, StartingBid: 50},
+			expectedErr: nil,
+		},
+		{
+			name:        "가격이 없는 고정가 등록",
+			listing:     &MarketListing{SellerID: 1, ItemID: "sword", Quantity: 1, ListingType: ListingTypeFixed},
+			expectedErr: ErrInvalidListingPrice,
+		},
+		{
+			name:        "시작가가 없는 경매 등록",
+			listing:     &MarketListing{SellerID: 1, ItemID: "sword", Quantity: 1, ListingType: ListingTypeAuction, Price: 100},
+			expectedErr: ErrInvalidListingPrice,
+		},
+		{
+			name:        "알 수 없는 판매 방식",
+			listing:     &MarketListing{SellerID: 1, ItemID: "sword", Quantity: 1, ListingType: "barter", Price: 100},
+			expectedErr: ErrInvalidListingType,
+		},
+		{
+			name:        "수량이 0인 등록",
+			listing:     &MarketListing{SellerID: 1, ItemID: "sword", ListingType: ListingTypeFixed, Price: 100},
+			expectedErr: ErrInvalidQuantity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.listing.Validate()
+			assert.Equal(t, tt.expectedErr, err, "유효성 검사 결과가 일치해야 합니다")
+		})
+	}
+}
+
+// 최소 입찰가 계산 테스트
+func TestMarketListing_MinimumNextBid(t *testing.T) {
+	listing := &MarketListing{ListingType: ListingTypeAuction, StartingBid: 100}
+	assert.Equal(t, 100, listing.MinimumNextBid(), "입찰이 없으면 시작가여야 합니다")
+
+	bidderID := uint(2)
+	listing.CurrentBidderID = &bidderID
+	listing.BidCount = 1
+	listing.CurrentBid = 200
+	assert.Equal(t, 210, listing.MinimumNextBid(), "현재가에서 5% 이상 높아야 합니다")
+
+	listing.CurrentBid = 10
+	assert.Equal(t, 11, listing.MinimumNextBid(), "최소 1골드 이상 높아야 합니다")
+}
+
+// 판매 상태 확인 테스트
+func TestMarketListing_StateHelpers(t *testing.T) {
+	now := time.Now()
+	listing := &MarketListing{
+		ListingType: ListingTypeAuction,
+		Status:      ListingStatusActive,
+		Quantity:    4,
+		SoldPrice:   200,
+		EndsAt:      now.Add(time.Hour),
+	}
+
+	assert.True(t, listing.IsActive())
+	assert.True(t, listing.IsAuction())
+	assert.False(t, listing.HasBids())
+	assert.False(t, listing.IsEnded(now), "종료 시간 이전에는 종료되지 않아야 합니다")
+	assert.True(t, listing.IsEnded(now.Add(2*time.Hour)), "종료 시간 이후에는 종료되어야 합니다")
+	assert.Equal(t, 50.0, listing.UnitPrice())
+}
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// 매치메이킹을 지원하는 게임 모드
+const (
+	MatchModeMultiplayer = "multiplayer"
+	MatchModeTournament  = "tournament"
+)
+
+// 매치메이킹 레이팅 기준 (레이팅은 게임별 스킬 레이팅을 사용)
+const (
+	// 대기 시작 시 허용 레이팅 차이와 초당 증가량, 최대 허용 차이
+	MatchBaseTolerance      = 100
+	MatchTolerancePerSecond = 5
+	MatchMaxTolerance       = 1000
+
+	// 레이팅 변동 계수 (Elo K)
+	MatchRatingK = 32
+)
+
+// 매치 상태
+type MatchStatus string
+
+const (
+	MatchInProgress MatchStatus = "in_progress" // 참가자 게임 진행 중
+	MatchCompleted  MatchStatus = "completed"   // 모든 참가자 점수 제출 (레이팅 반영 완료)
+)
+
+// 매치메이킹 대기열 티켓 (Redis에 보관)
+// 파티는 티켓 하나로 함께 대기하며, 파티 레이팅은 구성원 레이팅의 평균이다.
+type MatchmakingTicket struct {
+	TicketID string    `json:"ticket_id"`
+	GameID   uint      `json:"game_id"`
+	Mode     string    `json:"mode"`
+	LeaderID uint      `json:"leader_id"`
+	UserIDs  []uint    `json:"user_ids"`
+	Rating   float64   `json:"rating"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// 매치 (매칭된 플레이어 그룹)
+type Match struct {
+	ID     uint        `json:"id" gorm:"primaryKey"`
+	GameID uint        `json:"game_id" gorm:"not null;index"`
+	Mode   string      `json:"mode" gorm:"size:50;not null"`
+	Status MatchStatus `json:"status" gorm:"size:20;not null;index"`
+
+	Players []MatchPlayer `json:"players" gorm:"foreignKey:MatchID"`
+
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// 매치 참가자
+type MatchPlayer struct {
+	ID      uint `json:"id" gorm:"primaryKey"`
+	MatchID uint `json:"match_id" gorm:"not null;uniqueIndex:idx_match_players_entry"`
+	UserID  uint `json:"user_id" gorm:"not null;uniqueIndex:idx_match_players_entry"`
+
+	// 파티 티켓 ID (같은 파티 구성원은 같은 값)
+	TicketID string `json:"ticket_id" gorm:"size:64;not null"`
+
+	// 매치용으로 생성된 게임 세션
+	SessionID string `json:"session_id" gorm:"size:64;not null;uniqueIndex"`
+
+	// 매치 시작 시 스킬 레이팅과 매치 후 변동량
+	Rating      float64 `json:"rating" gorm:"not null"`
+	RatingDelta float64 `json:"rating_delta" gorm:"not null;default:0"`
+
+	// 제출한 점수 (제출 전에는 nil)
+	Score *int `json:"score,omitempty"`
+
+	Nickname string `json:"nickname,omitempty" gorm:"-"`
+}
+
+// Match 모델의 테이블 이름 반환
+func (Match) TableName() string {
+	return "matches"
+}
+
+// MatchPlayer 모델의 테이블 이름 반환
+func (MatchPlayer) TableName() string {
+	return "match_players"
+}
+
+// 매치메이킹을 지원하는 모드인지 확인
+func IsMatchMode(mode string) bool {
+	return mode == MatchModeMultiplayer || mode == MatchModeTournament
+}
+
+// 대기 시간에 따라 넓어지는 허용 레이팅 차이
+func (t *MatchmakingTicket) Tolerance(now time.Time) float64 {
+	waited := now.Sub(t.JoinedAt).Seconds()
+	if waited < 0 {
+		waited = 0
+	}
+	return math.Min(MatchBaseTolerance+waited*MatchTolerancePerSecond, MatchMaxTolerance)
+}
+
+// 파티 인원 수
+func (t *MatchmakingTicket) Size() int {
+	return len(t.UserIDs)
+}
+
+// 모든 참가자가 점수를 제출했는지 확인
+func (m *Match) AllScored() bool {
+	for i := range m.Players {
+		if m.Players[i].Score == nil {
+			return false
+		}
+	}
+	return len(m.Players) > 0
+}
+
+// 에러 정의
+var (
+	ErrMatchmakingUnsupported = errors.New("매치메이킹을 지원하지 않는 게임 또는 모드입니다")
+	ErrAlreadyQueued          = errors.New("이미 매치메이킹 대기 중입니다")
+	ErrNotQueued              = errors.New("매치메이킹 대기 중이 아닙니다")
+	ErrInvalidParty           = errors.New("파티 구성이 유효하지 않습니다")
+	ErrMatchNotFound          = errors.New("매치를 찾을 수 없습니다")
+)
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// 대기 시간에 따른 허용 레이팅 차이 테스트
+func TestMatchmakingTicket_Tolerance(t *testing.T) {
+	joined := time.Date(2025, 3, 12, 12, 0, 0, 0, time.UTC)
+	ticket := &MatchmakingTicket{JoinedAt: joined, UserIDs: []uint{1, 2}}
+
+	assert.Equal(t, 2, ticket.Size())
+	assert.Equal(t, 100.0, ticket.Tolerance(joined.Add(-time.Second)))
+	assert.Equal(t, 100.0, ticket.Tolerance(joined))
+	assert.Equal(t, 250.0, ticket.Tolerance(joined.Add(30*time.Second)))
+	assert.Equal(t, 1000.0, ticket.Tolerance(joined.Add(time.Hour)), "최대 허용 차이를 넘지 않아야 합니다")
+}
+
+// 참가자 전원 점수 제출 여부 테스트
+func TestMatch_AllScored(t *testing.T) {
+	score := func(v int) *int { return &v }
+
+	match := &Match{Players: []MatchPlayer{
+		{Rating: 1500, Score: score(500)},
+		{Rating: 1500},
+	}}
+	assert.False(t, match.AllScored())
+
+	match.Players[1].Score = score(0)
+	assert.True(t, match.AllScored(), "0점도 제출한 것으로 봐야 합니다")
+
+	assert.False(t, (&Match{}).AllScored())
+}
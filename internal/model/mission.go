@@ -0,0 +1,261 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// 미션 주기
+type MissionPeriod string
+
+const (
+	MissionPeriodDaily  MissionPeriod = "daily"  // 매일 사용자 현지 자정에 초기화
+	MissionPeriodWeekly MissionPeriod = "weekly" // 매주 월요일 사용자 현지 자정에 초기화
+	MissionPeriodEvent  MissionPeriod = "event"  // 관리자가 지정한 기간 동안 한 번 진행하는 이벤트 퀘스트
+)
+
+// 미션 목표 종류
+type MissionObjective string
+
+const (
+	MissionPlayGames  MissionObjective = "play_games"  // 게임 N회 플레이 (카테고리 조건 선택)
+	MissionEarnGold   MissionObjective = "earn_gold"   // 게임 보상으로 골드 N 획득
+	MissionUseItem    MissionObjective = "use_item"    // 아이템 N회 사용 (아이템 조건 선택)
+	MissionReachLevel MissionObjective = "reach_level" // 레벨 N 달성
+)
+
+// 미션 템플릿
+// 일간/주간 미션은 같은 주기의 활성 템플릿 중 일부가 주기마다 돌아가며 배정되고, 이벤트 미션은 기간 동안 모든 사용자에게 배정된다.
+type Mission struct {
+	BaseModel
+
+	// 미션 고유 코드
+	Code string `json:"code" gorm:"uniqueIndex;size:50;not null"`
+
+	Name        string `json:"name" gorm:"size:100;not null"`
+	Description string `json:"description" gorm:"size:500"`
+
+	Period    MissionPeriod    `json:"period" gorm:"size:20;not null;index"`
+	Objective MissionObjective `json:"objective" gorm:"size:20;not null"`
+
+	// play_games 목표의 게임 카테고리 (비어 있으면 모든 게임)
+	Category GameCategory `json:"category,omitempty" gorm:"size:50"`
+
+	// use_item 목표의 아이템 (비어 있으면 모든 아이템)
+	ItemID string `json:"item_id,omitempty" gorm:"size:50"`
+
+	// 목표 수치 (횟수, 골드, 레벨)
+	Target int `json:"target" gorm:"not null"`
+
+	// 배정 기간 (이벤트 미션은 필수, 일간/주간 미션은 선택)
+	StartAt *time.Time `json:"start_at"`
+	EndAt   *time.Time `json:"end_at"`
+
+	// 비활성 미션은 배정하지 않음
+	IsActive bool `json:"is_active" gorm:"not null;index"`
+
+	// 완료 보상
+	RewardGold       int `json:"reward_gold" gorm:"not null;default:0"`
+	RewardDiamond    int `json:"reward_diamond" gorm:"not null;default:0"`
+	RewardExperience int `json:"reward_experience" gorm:"not null;default:0"`
+
+	// 지급 아이템 (RewardItemID가 비어 있으면 없음)
+	RewardItemID   string `json:"reward_item_id" gorm:"size:50"`
+	RewardItemName string `json:"reward_item_name" gorm:"size:100"`
+	RewardItemType string `json:"reward_item_type" gorm:"size:20"`
+	RewardRarity   string `json:"reward_rarity" gorm:"size:20"`
+	RewardQuantity int    `json:"reward_quantity" gorm:"not null;default:0"`
+}
+
+// 사용자별 미션 진행도 (주기마다 새로 생성)
+type UserMission struct {
+	ID        uint `json:"id" gorm:"primaryKey"`
+	UserID    uint `json:"user_id" gorm:"not null;uniqueIndex:idx_user_mission_period"`
+	MissionID uint `json:"mission_id" gorm:"not null;uniqueIndex:idx_user_mission_period;index"`
+
+	// 진행 주기 (일간은 현지 날짜, 주간은 W+주 시작일, 이벤트는 event)
+	PeriodKey string `json:"period_key" gorm:"size:20;not null;uniqueIndex:idx_user_mission_period"`
+
+	Progress int `json:"progress" gorm:"not null;default:0"`
+
+	CompletedAt *time.Time `json:"completed_at"`
+	ClaimedAt   *time.Time `json:"claimed_at"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// 미션 진행 이벤트
+type MissionEvent struct {
+	Objective MissionObjective
+
+	// play_games 이벤트의 게임 카테고리
+	Category GameCategory
+
+	// use_item 이벤트의 아이템
+	ItemID string
+
+	// 증가량 (플레이 횟수, 획득 골드, 사용 횟수) 또는 reach_level의 현재 레벨
+	Amount int
+}
+
+// Mission 모델의 테이블 이름 반환
+func (Mission) TableName() string {
+	return "missions"
+}
+
+// UserMission 모델의 테이블 이름 반환
+func (UserMission) TableName() string {
+	return "user_missions"
+}
+
+// 미션 데이터 유효성 검사
+func (m *Mission) Validate() error {
+	if m.Code == "" || m.Name == "" || m.Target <= 0 {
+		return ErrInvalidMission
+	}
+	switch m.Period {
+	case MissionPeriodDaily, MissionPeriodWeekly:
+	case MissionPeriodEvent:
+		if m.StartAt == nil || m.EndAt == nil {
+			return ErrInvalidMission
+		}
+	default:
+		return ErrInvalidMission
+	}
+	if m.StartAt != nil && m.EndAt != nil && !m.EndAt.After(*m.StartAt) {
+		return ErrInvalidMission
+	}
+	switch m.Objective {
+	case MissionPlayGames:
+		if m.Category != "" && !isValidGameCategory(m.Category) {
+			return ErrInvalidMission
+		}
+	case MissionEarnGold, MissionUseItem, MissionReachLevel:
+	default:
+		return ErrInvalidMission
+	}
+	if m.RewardGold < 0 || m.RewardDiamond < 0 || m.RewardExperience < 0 {
+		return ErrInvalidMission
+	}
+	if m.RewardItemID != "" && (m.RewardItemName == "" || m.RewardItemType == "" || RarityRank(m.RewardRarity) < 0 || m.RewardQuantity <= 0) {
+		return ErrInvalidMission
+	}
+	return nil
+}
+
+// 배정 기간 안인지 확인
+func (m *Mission) IsAvailable(now time.Time) bool {
+	if m.StartAt != nil && now.Before(*m.StartAt) {
+		return false
+	}
+	if m.EndAt != nil && !now.Before(*m.EndAt) {
+		return false
+	}
+	return true
+}
+
+// 사용자 현지 시간 기준 현재 진행 주기 키와 초기화 시각을 반환
+func (m *Mission) Window(now time.Time, loc *time.Location) (string, time.Time) {
+	local := now.In(loc)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+
+	switch m.Period {
+	case MissionPeriodDaily:
+		return today.Format("2006-01-02"), today.AddDate(0, 0, 1)
+	case MissionPeriodWeekly:
+		monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+		return "W" + monday.Format("2006-01-02"), monday.AddDate(0, 0, 7)
+	default:
+		var resetAt time.Time
+		if m.EndAt != nil {
+			resetAt = *m.EndAt
+		}
+		return string(MissionPeriodEvent), resetAt
+	}
+}
+
+// 이벤트가 미션 진행 대상인지 확인
+func (m *Mission) Matches(event *MissionEvent) bool {
+	if m.Objective != event.Objective {
+		return false
+	}
+	switch m.Objective {
+	case MissionPlayGames:
+		return m.Category == "" || m.Category == event.Category
+	case MissionUseItem:
+		return m.ItemID == "" || m.ItemID == event.ItemID
+	}
+	return true
+}
+
+// 이벤트로 진행도를 갱신하고 이번에 완료했는지 반환
+func (m *Mission) Apply(progress *UserMission, event *MissionEvent, now time.Time) bool {
+	if progress.IsCompleted() || !m.Matches(event) || event.Amount <= 0 {
+		return false
+	}
+
+	if m.Objective == MissionReachLevel {
+		if event.Amount > progress.Progress {
+			progress.Progress = event.Amount
+		}
+	} else {
+		progress.Progress += event.Amount
+	}
+	if progress.Progress < m.Target {
+		return false
+	}
+	progress.Progress = m.Target
+	progress.CompletedAt = &now
+	return true
+}
+
+// 보상 아이템이 있는지 확인
+func (m *Mission) HasItemReward() bool {
+	return m.RewardItemID != ""
+}
+
+// 지급 아이템 정보를 인벤토리 템플릿으로 변환
+func (m *Mission) ToInventory(userID uint) *Inventory {
+	return &Inventory{
+		UserID:   userID,
+		ItemID:   m.RewardItemID,
+		ItemName: m.RewardItemName,
+		ItemType: m.RewardItemType,
+		Rarity:   m.RewardRarity,
+		Level:    1,
+		Quantity: m.RewardQuantity,
+		IsBound:  true,
+	}
+}
+
+// 완료 여부 확인
+func (p *UserMission) IsCompleted() bool {
+	return p.CompletedAt != nil
+}
+
+// 보상 수령 여부 확인
+func (p *UserMission) IsClaimed() bool {
+	return p.ClaimedAt != nil
+}
+
+// 사용자의 시간대를 반환 (잘못된 시간대면 UTC)
+func UserLocation(user *User) *time.Location {
+	if user.TimeZone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(user.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// 에러 정의
+var (
+	ErrMissionNotFound       = errors.New("진행 중인 미션을 찾을 수 없습니다")
+	ErrInvalidMission        = errors.New("미션 정보가 유효하지 않습니다")
+	ErrDuplicateMissionCode  = errors.New("이미 사용 중인 미션 코드입니다")
+	ErrMissionNotCompleted   = errors.New("아직 완료하지 않은 미션입니다")
+	ErrMissionAlreadyClaimed = errors.New("이미 보상을 받은 미션입니다")
+)
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// 사용자 현지 시간 기준 주기 키와 초기화 시각 테스트
+func TestMission_Window(t *testing.T) {
+	seoul := time.FixedZone("KST", 9*60*60)
+	// UTC 일요일 16시는 서울 기준 월요일 01시
+	now := time.Date(2025, 3, 16, 16, 0, 0, 0, time.UTC)
+
+	daily := &Mission{Period: MissionPeriodDaily}
+	key, resetAt := daily.Window(now, time.UTC)
+	assert.Equal(t, "2025-03-16", key)
+	assert.True(t, resetAt.Equal(time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)))
+
+	key, resetAt = daily.Window(now, seoul)
+	assert.Equal(t, "2025-03-17", key, "현지 날짜로 주기를 나눠야 합니다")
+	assert.True(t, resetAt.Equal(time.Date(2025, 3, 18, 0, 0, 0, 0, seoul)))
+
+	weekly := &Mission{Period: MissionPeriodWeekly}
+	key, resetAt = weekly.Window(now, time.UTC)
+	assert.Equal(t, "W2025-03-10", key, "주간 미션은 월요일부터 시작해야 합니다")
+	assert.True(t, resetAt.Equal(time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)))
+
+	key, _ = weekly.Window(now, seoul)
+	assert.Equal(t, "W2025-03-17", key)
+
+	end := now.AddDate(0, 0, 3)
+	event := &Mission{Period: MissionPeriodEvent, EndAt: &end}
+	key, resetAt = event.Window(now, seoul)
+	assert.Equal(t, "event", key)
+	assert.True(t, resetAt.Equal(end))
+}
+
+// 목표별 진행도 갱신과 완료 판정 테스트
+func TestMission_Apply(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("카테고리 플레이", func(t *testing.T) {
+		mission := &Mission{Objective: MissionPlayGames, Category: GameCategoryPuzzle, Target: 2}
+		progress := &UserMission{}
+		assert.False(t, mission.Apply(progress, &MissionEvent{Objective: MissionPlayGames, Category: GameCategoryAction, Amount: 1}, now))
+		assert.Zero(t, progress.Progress, "다른 카테고리 플레이는 반영하지 않아야 합니다")
+		assert.False(t, mission.Apply(progress, &MissionEvent{Objective: MissionPlayGames, Category: GameCategoryPuzzle, Amount: 1}, now))
+		assert.True(t, mission.Apply(progress, &MissionEvent{Objective: MissionPlayGames, Category: GameCategoryPuzzle, Amount: 1}, now))
+		assert.False(t, mission.Apply(progress, &MissionEvent{Objective: MissionPlayGames, Category: GameCategoryPuzzle, Amount: 1}, now), "완료한 미션은 다시 완료하지 않아야 합니다")
+	})
+
+	t.Run("골드 누적", func(t *testing.T) {
+		mission := &Mission{Objective: MissionEarnGold, Target: 100}
+		progress := &UserMission{}
+		assert.False(t, mission.Apply(progress, &MissionEvent{Objective: MissionEarnGold, Amount: 60}, now))
+		assert.True(t, mission.Apply(progress, &MissionEvent{Objective: MissionEarnGold, Amount: 70}, now))
+		assert.Equal(t, 100, progress.Progress, "진행도는 목표 수치를 넘지 않아야 합니다")
+		assert.Equal(t, now, *progress.CompletedAt)
+	})
+
+	t.Run("레벨 달성", func(t *testing.T) {
+		mission := &Mission{Objective: MissionReachLevel, Target: 5}
+		progress := &UserMission{}
+		assert.False(t, mission.Apply(progress, &MissionEvent{Objective: MissionReachLevel, Amount: 3}, now))
+		assert.False(t, mission.Apply(progress, &MissionEvent{Objective: MissionReachLevel, Amount: 3}, now))
+		assert.Equal(t, 3, progress.Progress, "레벨은 누적하지 않고 현재 레벨을 반영해야 합니다")
+		assert.True(t, mission.Apply(progress, &MissionEvent{Objective: MissionReachLevel, Amount: 6}, now))
+	})
+
+	t.Run("아이템 사용", func(t *testing.T) {
+		mission := &Mission{Objective: MissionUseItem, ItemID: "potion", Target: 1}
+		assert.False(t, mission.Apply(&UserMission{}, &MissionEvent{Objective: MissionUseItem, ItemID: "scroll", Amount: 1}, now))
+		assert.True(t, mission.Apply(&UserMission{}, &MissionEvent{Objective: MissionUseItem, ItemID: "potion", Amount: 1}, now))
+	})
+}
+
+// 미션 유효성 검사 테스트
+func TestMission_Validate(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+
+	tests := []struct {
+		name    string
+		mission Mission
+		valid   bool
+	}{
+		{name: "일간 플레이", mission: Mission{Code: "play3", Name: "3판 플레이", Period: MissionPeriodDaily, Objective: MissionPlayGames, Target: 3}, valid: true},
+		{name: "이벤트 퀘스트", mission: Mission{Code: "spring", Name: "봄 이벤트", Period: MissionPeriodEvent, Objective: MissionEarnGold, Target: 500, StartAt: &start, EndAt: &end}, valid: true},
+		{name: "이벤트 기간 없음", mission: Mission{Code: "spring", Name: "봄 이벤트", Period: MissionPeriodEvent, Objective: MissionEarnGold, Target: 500}},
+		{name: "종료가 시작보다 빠름", mission: Mission{Code: "spring", Name: "봄 이벤트", Period: MissionPeriodEvent, Objective: MissionEarnGold, Target: 500, StartAt: &end, EndAt: &start}},
+		{name: "알 수 없는 주기", mission: Mission{Code: "x", Name: "x", Period: "monthly", Objective: MissionPlayGames, Target: 1}},
+		{name: "알 수 없는 목표", mission: Mission{Code: "x", Name: "x", Period: MissionPeriodDaily, Objective: "login", Target: 1}},
+		{name: "잘못된 카테고리", mission: Mission{Code: "x", Name: "x", Period: MissionPeriodDaily, Objective: MissionPlayGames, Category: "unknown", Target: 1}},
+		{name: "아이템 정보 부족", mission: Mission{Code: "x", Name: "x", Period: MissionPeriodWeekly, Objective: MissionUseItem, Target: 1, RewardItemID: "badge"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.mission.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidMission)
+			}
+		})
+	}
+}
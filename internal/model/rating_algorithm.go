@@ -0,0 +1,196 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+// 레이팅 알고리즘 이름
+const (
+	RatingAlgorithmGlicko2 = "glicko2"
+	RatingAlgorithmElo     = "elo"
+)
+
+// 레이팅 계산에 쓰는 값 (레이팅, 편차, 변동성)
+type RatingState struct {
+	Rating     float64 `json:"rating"`
+	Deviation  float64 `json:"deviation"`
+	Volatility float64 `json:"volatility"`
+}
+
+// 한 상대와의 대결 결과 (Score: 승 1, 무 0.5, 패 0)
+type RatingOutcome struct {
+	Opponent RatingState
+	Score    float64
+}
+
+// 스킬 레이팅 계산 알고리즘
+// 같은 입력에는 항상 같은 결과를 반환해야 한다.
+type RatingAlgorithm interface {
+	// 알고리즘 이름
+	Name() string
+
+	// 한 번의 레이팅 기간(매치) 결과로 새 레이팅을 계산
+	Update(player RatingState, outcomes []RatingOutcome) RatingState
+
+	// 경기가 없던 레이팅 기간만큼 편차를 늘림
+	Decay(player RatingState, periods int) RatingState
+}
+
+// 이름으로 레이팅 알고리즘을 생성
+func NewRatingAlgorithm(name string) (RatingAlgorithm, error) {
+	switch name {
+	case RatingAlgorithmGlicko2:
+		return &Glicko2Rating{Tau: Glicko2DefaultTau}, nil
+	case RatingAlgorithmElo:
+		return &EloRating{K: MatchRatingK}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownRatingAlgorithm, name)
+	}
+}
+
+// 두 점수를 비교한 대결 결과
+func OutcomeScore(score, opponentScore int) float64 {
+	switch {
+	case score > opponentScore:
+		return 1
+	case score < opponentScore:
+		return 0
+	default:
+		return 0.5
+	}
+}
+
+// Elo 레이팅
+// 편차와 변동성은 사용하지 않으며, 여러 상대와의 결과는 상대 수로 나눠 반영한다.
+type EloRating struct {
+	K float64
+}
+
+func (e *EloRating) Name() string {
+	return RatingAlgorithmElo
+}
+
+func (e *EloRating) Update(player RatingState, outcomes []RatingOutcome) RatingState {
+	if len(outcomes) == 0 {
+		return player
+	}
+
+	delta := 0.0
+	for _, outcome := range outcomes {
+		expected := 1 / (1 + math.Pow(10, (outcome.Opponent.Rating-player.Rating)/400))
+		delta += e.K * (outcome.Score - expected)
+	}
+	player.Rating += delta / float64(len(outcomes))
+	return player
+}
+
+func (e *EloRating) Decay(player RatingState, periods int) RatingState {
+	return player
+}
+
+// Glicko-2 기준값
+const (
+	// 시스템 상수 (변동성이 바뀌는 정도, 0.3~1.2)
+	Glicko2DefaultTau = 0.5
+
+	// Glicko 척도와 Glicko-2 척도 변환 계수
+	glicko2Scale = 173.7178
+
+	// 변동성 계산 수렴 기준
+	glicko2Epsilon = 0.000001
+)
+
+// Glicko-2 레이팅 (Glickman, 2012)
+// 매치 하나를 레이팅 기간 하나로 보고 계산하며, 편차는 최소/최대 편차 사이로 유지한다.
+type Glicko2Rating struct {
+	Tau float64
+}
+
+func (g *Glicko2Rating) Name() string {
+	return RatingAlgorithmGlicko2
+}
+
+func (g *Glicko2Rating) Update(player RatingState, outcomes []RatingOutcome) RatingState {
+	if len(outcomes) == 0 {
+		return g.Decay(player, 1)
+	}
+
+	mu := (player.Rating - DefaultSkillRating) / glicko2Scale
+	phi := player.Deviation / glicko2Scale
+	sigma := player.Volatility
+
+	// 추정 분산(v)과 추정 향상도(delta)
+	variance, improvement := 0.0, 0.0
+	for _, outcome := range outcomes {
+		opponentMu := (outcome.Opponent.Rating - DefaultSkillRating) / glicko2Scale
+		opponentPhi := outcome.Opponent.Deviation / glicko2Scale
+		weight := 1 / math.Sqrt(1+3*opponentPhi*opponentPhi/(math.Pi*math.Pi))
+		expected := 1 / (1 + math.Exp(-weight*(mu-opponentMu)))
+		variance += weight * weight * expected * (1 - expected)
+		improvement += weight * (outcome.Score - expected)
+	}
+	variance = 1 / variance
+	delta := variance * improvement
+
+	sigma = g.volatility(phi, sigma, variance, delta)
+	preRating := math.Sqrt(phi*phi + sigma*sigma)
+	phi = 1 / math.Sqrt(1/(preRating*preRating)+1/variance)
+	mu += phi * phi * improvement
+
+	return RatingState{
+		Rating:     mu*glicko2Scale + DefaultSkillRating,
+		Deviation:  clampDeviation(phi * glicko2Scale),
+		Volatility: sigma,
+	}
+}
+
+func (g *Glicko2Rating) Decay(player RatingState, periods int) RatingState {
+	if periods <= 0 {
+		return player
+	}
+	phi := player.Deviation / glicko2Scale
+	phi = math.Sqrt(phi*phi + float64(periods)*player.Volatility*player.Volatility)
+	player.Deviation = clampDeviation(phi * glicko2Scale)
+	return player
+}
+
+// 새 변동성을 Illinois 방식으로 계산
+func (g *Glicko2Rating) volatility(phi, sigma, variance, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + variance + ex
+		return ex*(delta*delta-phi*phi-variance-ex)/(2*d*d) - (x-a)/(g.Tau*g.Tau)
+	}
+
+	lower := a
+	var upper float64
+	if delta*delta > phi*phi+variance {
+		upper = math.Log(delta*delta - phi*phi - variance)
+	} else {
+		k := 1.0
+		for f(a-k*g.Tau) < 0 {
+			k++
+		}
+		upper = a - k*g.Tau
+	}
+
+	fLower, fUpper := f(lower), f(upper)
+	for math.Abs(upper-lower) > glicko2Epsilon {
+		next := lower + (lower-upper)*fLower/(fUpper-fLower)
+		fNext := f(next)
+		if fNext*fUpper <= 0 {
+			lower, fLower = upper, fUpper
+		} else {
+			fLower /= 2
+		}
+		upper, fUpper = next, fNext
+	}
+	return math.Exp(lower / 2)
+}
+
+// 편차를 최소/최대 편차 사이로 맞춤
+func clampDeviation(deviation float64) float64 {
+	return math.Max(MinSkillDeviation, math.Min(deviation, DefaultSkillDeviation))
+}
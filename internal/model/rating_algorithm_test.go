@@ -0,0 +1,78 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+// Glicko-2 논문 예제 (1500/200 플레이어가 세 상대와 1승 2패) 테스트
+func TestGlicko2Rating_Update(t *testing.T) {
+	algorithm, err := NewRatingAlgorithm(RatingAlgorithmGlicko2)
+	require.NoError(t, err)
+	assert.Equal(t, RatingAlgorithmGlicko2, algorithm.Name())
+
+	player := RatingState{Rating: 1500, Deviation: 200, Volatility: 0.06}
+	updated := algorithm.Update(player, []RatingOutcome{
+		{Opponent: RatingState{Rating: 1400, Deviation: 30, Volatility: 0.06}, Score: 1},
+		{Opponent: RatingState{Rating: 1550, Deviation: 100, Volatility: 0.06}, Score: 0},
+		{Opponent: RatingState{Rating: 1700, Deviation: 300, Volatility: 0.06}, Score: 0},
+	})
+	assert.InDelta(t, 1464.06, updated.Rating, 0.01)
+	assert.InDelta(t, 151.52, updated.Deviation, 0.01)
+	assert.InDelta(t, 0.05999, updated.Volatility, 0.00001)
+
+	again := algorithm.Update(player, []RatingOutcome{
+		{Opponent: RatingState{Rating: 1400, Deviation: 30, Volatility: 0.06}, Score: 1},
+		{Opponent: RatingState{Rating: 1550, Deviation: 100, Volatility: 0.06}, Score: 0},
+		{Opponent: RatingState{Rating: 1700, Deviation: 300, Volatility: 0.06}, Score: 0},
+	})
+	assert.Equal(t, updated, again, "같은 입력에는 같은 결과를 반환해야 합니다")
+
+	// 편차는 최소 편차 아래로 내려가지 않음
+	draws := make([]RatingOutcome, 20)
+	for i := range draws {
+		draws[i] = RatingOutcome{Opponent: RatingState{Rating: 1500, Deviation: 30, Volatility: 0.06}, Score: 0.5}
+	}
+	settled := algorithm.Update(RatingState{Rating: 1500, Deviation: 30, Volatility: 0.06}, draws)
+	assert.Equal(t, float64(MinSkillDeviation), settled.Deviation)
+}
+
+// Glicko-2 비활동 편차 증가 테스트
+func TestGlicko2Rating_Decay(t *testing.T) {
+	algorithm := &Glicko2Rating{Tau: Glicko2DefaultTau}
+	player := RatingState{Rating: 1600, Deviation: 200, Volatility: 0.06}
+
+	assert.Equal(t, player, algorithm.Decay(player, 0))
+
+	decayed := algorithm.Decay(player, 2)
+	phi := 200 / glicko2Scale
+	assert.InDelta(t, math.Sqrt(phi*phi+2*0.06*0.06)*glicko2Scale, decayed.Deviation, 1e-9)
+	assert.Equal(t, 1600.0, decayed.Rating, "편차만 늘어나야 합니다")
+
+	assert.Equal(t, float64(DefaultSkillDeviation), algorithm.Decay(player, 100000).Deviation, "최대 편차를 넘지 않아야 합니다")
+}
+
+// Elo 레이팅 계산과 알고리즘 선택 테스트
+func TestEloRating(t *testing.T) {
+	algorithm, err := NewRatingAlgorithm(RatingAlgorithmElo)
+	require.NoError(t, err)
+	assert.Equal(t, RatingAlgorithmElo, algorithm.Name())
+
+	player := RatingState{Rating: 1500, Deviation: 350, Volatility: 0.06}
+	updated := algorithm.Update(player, []RatingOutcome{
+		{Opponent: RatingState{Rating: 1500}, Score: 1},
+		{Opponent: RatingState{Rating: 1500}, Score: 0.5},
+	})
+	assert.Equal(t, 1508.0, updated.Rating, "상대 수로 나눠 반영해야 합니다")
+	assert.Equal(t, 350.0, updated.Deviation)
+	assert.Equal(t, player, algorithm.Decay(player, 3), "Elo는 편차를 사용하지 않아야 합니다")
+
+	_, err = NewRatingAlgorithm("trueskill")
+	assert.ErrorIs(t, err, ErrUnknownRatingAlgorithm)
+
+	assert.Equal(t, 1.0, OutcomeScore(10, 5))
+	assert.Equal(t, 0.5, OutcomeScore(5, 5))
+	assert.Equal(t, 0.0, OutcomeScore(1, 5))
+}
@@ -0,0 +1,166 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// 제작 레시피 모델
+// 재료 아이템과 골드를 소모하여 결과 아이템을 만들며, 제작은 성공 확률에 따라 실패할 수 있다.
+// 숨김 레시피는 플레이어가 발견(또는 관리자가 해금)해야 사용할 수 있다.
+type Recipe struct {
+	BaseModel
+
+	// 레시피 고유 코드
+	Code string `json:"code" gorm:"uniqueIndex;size:50;not null"`
+
+	// 레시피 이름
+	Name string `json:"name" gorm:"size:100;not null"`
+
+	// 레시피 설명
+	Description string `json:"description" gorm:"size:500"`
+
+	// 결과 아이템 정보
+	OutputItemID   string `json:"output_item_id" gorm:"size:50;not null"`
+	OutputItemName string `json:"output_item_name" gorm:"size:100;not null"`
+	OutputItemType string `json:"output_item_type" gorm:"size:20;not null"`
+	OutputRarity   string `json:"output_rarity" gorm:"size:20;not null"`
+	OutputLevel    int    `json:"output_level" gorm:"not null;default:1"`
+	OutputQuantity int    `json:"output_quantity" gorm:"not null;default:1"`
+
+	// 제작 1회당 골드 비용
+	GoldCost int `json:"gold_cost" gorm:"not null;default:0"`
+
+	// 최소 요구 레벨 (0이면 제한 없음)
+	RequiredLevel int `json:"required_level" gorm:"not null;default:0"`
+
+	// 제작 성공 확률 (0 초과 1 이하)
+	SuccessRate float64 `json:"success_rate" gorm:"not null;default:1"`
+
+	// 숨김 레시피 여부 (발견 또는 해금 필요)
+	IsHidden bool `json:"is_hidden" gorm:"not null;default:false"`
+
+	// 레시피 활성화 여부
+	IsActive bool `json:"is_active" gorm:"not null;index"`
+
+	// 필요한 재료 목록
+	Materials []RecipeMaterial `json:"materials" gorm:"foreignKey:RecipeID"`
+}
+
+// 레시피 재료
+type RecipeMaterial struct {
+	ID       uint   `json:"id" gorm:"primaryKey"`
+	RecipeID uint   `json:"recipe_id" gorm:"not null;index"`
+	ItemID   string `json:"item_id" gorm:"size:50;not null"`
+	Quantity int    `json:"quantity" gorm:"not null"`
+}
+
+// 플레이어별 레시피 해금 상태와 제작 기록
+type UserRecipe struct {
+	ID       uint `json:"id" gorm:"primaryKey"`
+	UserID   uint `json:"user_id" gorm:"not null;uniqueIndex:idx_user_recipe"`
+	RecipeID uint `json:"recipe_id" gorm:"not null;uniqueIndex:idx_user_recipe"`
+
+	// 해금 경로 (discovered, granted, crafted)
+	Source string `json:"source" gorm:"size:20;not null"`
+
+	// 제작 시도 횟수
+	CraftCount int `json:"craft_count" gorm:"not null;default:0"`
+
+	// 제작 성공 횟수
+	SuccessCount int `json:"success_count" gorm:"not null;default:0"`
+
+	UnlockedAt time.Time `json:"unlocked_at"`
+}
+
+// 레시피 해금 경로
+const (
+	RecipeSourceDiscovered = "discovered" // 재료를 모두 보유하여 발견
+	RecipeSourceGranted    = "granted"    // 관리자 해금
+	RecipeSourceCrafted    = "crafted"    // 공개 레시피 첫 제작
+)
+
+// Recipe 모델의 테이블 이름 반환
+func (Recipe) TableName() string {
+	return "recipes"
+}
+
+// RecipeMaterial 모델의 테이블 이름 반환
+func (RecipeMaterial) TableName() string {
+	return "recipe_materials"
+}
+
+// UserRecipe 모델의 테이블 이름 반환
+func (UserRecipe) TableName() string {
+	return "user_recipes"
+}
+
+// 레시피 데이터 유효성 검사
+func (r *Recipe) Validate() error {
+	if r.Code == "" || r.Name == "" {
+		return ErrInvalidRecipe
+	}
+	if r.OutputItemID == "" || r.OutputItemName == "" || r.OutputItemType == "" || r.OutputRarity == "" {
+		return ErrInvalidRecipeOutput
+	}
+	if r.OutputQuantity <= 0 || r.OutputLevel <= 0 {
+		return ErrInvalidRecipeOutput
+	}
+	if r.GoldCost < 0 || r.RequiredLevel < 0 {
+		return ErrInvalidRecipe
+	}
+	if r.SuccessRate <= 0 || r.SuccessRate > 1 {
+		return ErrInvalidSuccessRate
+	}
+	if len(r.Materials) == 0 {
+		return ErrRecipeNoMaterials
+	}
+
+	seen := make(map[string]bool, len(r.Materials))
+	for _, material := range r.Materials {
+		if material.ItemID == "" {
+			return ErrInvalidItemID
+		}
+		if material.Quantity <= 0 {
+			return ErrInvalidQuantity
+		}
+		if seen[material.ItemID] {
+			return ErrDuplicateMaterial
+		}
+		seen[material.ItemID] = true
+	}
+
+	return nil
+}
+
+// 해당 레벨의 사용자가 제작할 수 있는지 확인
+func (r *Recipe) MeetsLevel(level int) bool {
+	return level >= r.RequiredLevel
+}
+
+// 결과 아이템을 인벤토리 형태로 변환
+func (r *Recipe) OutputInventory(userID uint) *Inventory {
+	return &Inventory{
+		UserID:   userID,
+		ItemID:   r.OutputItemID,
+		ItemName: r.OutputItemName,
+		ItemType: r.OutputItemType,
+		Rarity:   r.OutputRarity,
+		Level:    r.OutputLevel,
+		Quantity: r.OutputQuantity,
+	}
+}
+
+// 에러 정의
+var (
+	ErrRecipeNotFound      = errors.New("레시피를 찾을 수 없습니다")
+	ErrDuplicateRecipeCode = errors.New("이미 사용 중인 레시피 코드입니다")
+	ErrInvalidRecipe       = errors.New("레시피 정보가 유효하지 않습니다")
+	ErrInvalidRecipeOutput = errors.New("레시피 결과 아이템이 유효하지 않습니다")
+	ErrInvalidSuccessRate  = errors.New("성공 확률은 0보다 크고 1 이하여야 합니다")
+	ErrRecipeNoMaterials   = errors.New("레시피에는 최소 하나의 재료가 필요합니다")
+	ErrDuplicateMaterial   = errors.New("같은 재료가 중복되었습니다")
+	ErrRecipeLocked        = errors.New("해금되지 않은 레시피입니다")
+	ErrRecipeInactive      = errors.New("비활성화된 레시피입니다")
+	ErrLevelTooLow         = errors.New("요구 레벨이 부족합니다")
+)
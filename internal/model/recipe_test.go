@@ -0,0 +1,100 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// 테스트용 기본 레시피 생성
+func newTestRecipe() *Recipe {
+	return &Recipe{
+		Code:           "iron_sword",
+		Name:           "철검 제작",
+		OutputItemID:   "iron_sword",
+		OutputItemName: "철검",
+		OutputItemType: "weapon",
+		OutputRarity:   "rare",
+		OutputLevel:    1,
+		OutputQuantity: 1,
+		GoldCost:       100,
+		SuccessRate:    0.8,
+		Materials: []RecipeMaterial{
+			{ItemID: "iron_ore", Quantity: 3},
+			{ItemID: "wood", Quantity: 1},
+		},
+	}
+}
+
+// 레시피 유효성 검사 테스트
+func TestRecipe_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(r *Recipe)
+		expectedErr error
+	}{
+		{
+			name:        "정상적인 레시피",
+			modify:      func(r *Recipe) {},
+			expectedErr: nil,
+		},
+		{
+			name:        "코드 없음",
+			modify:      func(r *Recipe) { r.Code = "" },
+			expectedErr: ErrInvalidRecipe,
+		},
+		{
+			name:        "결과 아이템 없음",
+			modify:      func(r *Recipe) { r.OutputItemID = "" },
+			expectedErr: ErrInvalidRecipeOutput,
+		},
+		{
+			name:        "성공 확률 0",
+			modify:      func(r *Recipe) { r.SuccessRate = 0 },
+			expectedErr: ErrInvalidSuccessRate,
+		},
+		{
+			name:        "성공 확률 1 초과",
+			modify:      func(r *Recipe) { r.SuccessRate = 1.5 },
+			expectedErr: ErrInvalidSuccessRate,
+		},
+		{
+			name:        "재료 없음",
+			modify:      func(r *Recipe) { r.Materials = nil },
+			expectedErr: ErrRecipeNoMaterials,
+		},
+		{
+			name: "중복 재료",
+			modify: func(r *Recipe) {
+				r.Materials = append(r.Materials, RecipeMaterial{ItemID: "wood", Quantity: 2})
+			},
+			expectedErr: ErrDuplicateMaterial,
+		},
+		{
+			name:        "재료 수량 0",
+			modify:      func(r *Recipe) { r.Materials[0].Quantity = 0 },
+			expectedErr: ErrInvalidQuantity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipe := newTestRecipe()
+			tt.modify(recipe)
+			assert.Equal(t, tt.expectedErr, recipe.Validate(), "유효성 검사 결과가 일치해야 합니다")
+		})
+	}
+}
+
+// 요구 레벨 및 결과 아이템 변환 테스트
+func TestRecipe_Helpers(t *testing.T) {
+	recipe := newTestRecipe()
+	recipe.RequiredLevel = 5
+
+	assert.False(t, recipe.MeetsLevel(4))
+	assert.True(t, recipe.MeetsLevel(5))
+
+	output := recipe.OutputInventory(7)
+	assert.Equal(t, uint(7), output.UserID)
+	assert.Equal(t, "iron_sword", output.ItemID)
+	assert.NoError(t, output.Validate(), "결과 아이템은 유효한 인벤토리여야 합니다")
+}
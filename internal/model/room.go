@@ -0,0 +1,173 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// 실시간 룸 상태
+type RoomStatus string
+
+const (
+	RoomWaiting RoomStatus = "waiting" // 참가자 모집과 준비 대기
+	RoomPlaying RoomStatus = "playing" // 모든 참가자가 준비되어 게임 진행 중 (새 참가 불가)
+)
+
+// 룸 메시지 종류
+type RoomMessageType string
+
+// 클라이언트가 보내는 메시지
+const (
+	RoomMessageCreate RoomMessageType = "create" // 룸 생성 (game_id)
+	RoomMessageJoin   RoomMessageType = "join"   // 룸 참가 (room_id, 없으면 game_id로 빠른 참가)
+	RoomMessageLeave  RoomMessageType = "leave"  // 룸 나가기
+	RoomMessageReady  RoomMessageType = "ready"  // 준비 상태 변경 (payload: {"ready": true})
+	RoomMessageRelay  RoomMessageType = "relay"  // 다른 참가자에게 그대로 전달 (target_user_id가 있으면 한 명에게만)
+	RoomMessageInput  RoomMessageType = "input"  // 게임 진행 중 입력 (다음 틱 훅에 전달)
+	RoomMessagePing   RoomMessageType = "ping"   // 연결 확인
+)
+
+// 서버가 보내는 메시지
+const (
+	RoomMessageState        RoomMessageType = "room_state"          // 룸 전체 상태 (참가, 재접속 시)
+	RoomMessageMemberJoined RoomMessageType = "member_joined"       // 참가자 입장
+	RoomMessageMemberLeft   RoomMessageType = "member_left"         // 참가자 퇴장 (재접속 유예 만료 포함)
+	RoomMessageMemberReady  RoomMessageType = "member_ready"        // 참가자 준비 상태 변경
+	RoomMessageDisconnected RoomMessageType = "member_disconnected" // 참가자 연결 끊김 (재접속 유예 중)
+	RoomMessageReconnected  RoomMessageType = "member_reconnected"  // 참가자 재접속
+	RoomMessageGameStarted  RoomMessageType = "game_started"        // 모든 참가자 준비 완료
+	RoomMessageTick         RoomMessageType = "tick"                // 서버 틱 결과
+	RoomMessageGameOver     RoomMessageType = "game_over"           // 틱 훅이 게임 종료를 알림
+	RoomMessageError        RoomMessageType = "error"               // 요청 처리 실패
+	RoomMessagePong         RoomMessageType = "pong"                // ping 응답
+	RoomMessageMatchFound   RoomMessageType = "match_found"         // 매치메이킹 매칭 완료 (payload: 매치와 게임 세션 토큰, 룸과 무관)
+)
+
+// 룸 참가자
+type RoomMember struct {
+	UserID   uint   `json:"user_id"`
+	Nickname string `json:"nickname"`
+	Ready    bool   `json:"ready"`
+
+	// 연결이 끊긴 참가자는 재접속 유예 시간 동안 자리를 유지
+	Connected      bool       `json:"connected"`
+	DisconnectedAt *time.Time `json:"disconnected_at,omitempty"`
+
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// 실시간 멀티플레이 룸 (서버 메모리에만 보관)
+type Room struct {
+	ID         string       `json:"id"`
+	GameID     uint         `json:"game_id"`
+	HostID     uint         `json:"host_id"`
+	MaxPlayers int          `json:"max_players"`
+	Status     RoomStatus   `json:"status"`
+	Members    []RoomMember `json:"members"`
+
+	// 게임 시작 후 진행한 틱 수
+	Tick uint64 `json:"tick"`
+
+	CreatedAt time.Time  `json:"created_at"`
+	StartedAt *time.Time `json:"started_at,omitempty"`
+}
+
+// 룸 메시지 (클라이언트와 서버가 주고받는 JSON 한 건)
+type RoomMessage struct {
+	Type         RoomMessageType `json:"type"`
+	RoomID       string          `json:"room_id,omitempty"`
+	GameID       uint            `json:"game_id,omitempty"`
+	UserID       uint            `json:"user_id,omitempty"`
+	TargetUserID uint            `json:"target_user_id,omitempty"`
+	Payload      json.RawMessage `json:"payload,omitempty"`
+	Room         *Room           `json:"room,omitempty"`
+	Tick         uint64          `json:"tick,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	SentAt       time.Time       `json:"sent_at"`
+}
+
+// 게임 진행 중 참가자 입력
+type RoomInput struct {
+	UserID     uint            `json:"user_id"`
+	Payload    json.RawMessage `json:"payload"`
+	ReceivedAt time.Time       `json:"received_at"`
+}
+
+// 준비 상태 변경 요청 내용
+type RoomReadyPayload struct {
+	Ready bool `json:"ready"`
+}
+
+// 참가자를 찾음 (없으면 nil)
+func (r *Room) Member(userID uint) *RoomMember {
+	for i := range r.Members {
+		if r.Members[i].UserID == userID {
+			return &r.Members[i]
+		}
+	}
+	return nil
+}
+
+// 참가자를 제거하고 제거했는지 반환 (방장이 나가면 가장 먼저 들어온 참가자가 방장)
+func (r *Room) RemoveMember(userID uint) bool {
+	for i := range r.Members {
+		if r.Members[i].UserID != userID {
+			continue
+		}
+		r.Members = append(r.Members[:i], r.Members[i+1:]...)
+		if r.HostID == userID && len(r.Members) > 0 {
+			r.HostID = r.Members[0].UserID
+		}
+		return true
+	}
+	return false
+}
+
+// 새 참가자가 들어올 수 있는지 확인
+func (r *Room) IsJoinable() bool {
+	return r.Status == RoomWaiting && len(r.Members) < r.MaxPlayers
+}
+
+// 두 명 이상이고 연결된 모든 참가자가 준비되었는지 확인 (연결이 끊긴 참가자가 있으면 시작하지 않음)
+func (r *Room) AllReady() bool {
+	if len(r.Members) < 2 {
+		return false
+	}
+	for _, member := range r.Members {
+		if !member.Ready || !member.Connected {
+			return false
+		}
+	}
+	return true
+}
+
+// 참가자 ID 목록
+func (r *Room) MemberIDs() []uint {
+	ids := make([]uint, len(r.Members))
+	for i, member := range r.Members {
+		ids[i] = member.UserID
+	}
+	return ids
+}
+
+// 다른 고루틴에 넘겨도 안전한 복사본
+func (r *Room) Snapshot() *Room {
+	snapshot := *r
+	snapshot.Members = make([]RoomMember, len(r.Members))
+	copy(snapshot.Members, r.Members)
+	return &snapshot
+}
+
+// 에러 정의
+var (
+	ErrRoomNotFound       = errors.New("룸을 찾을 수 없습니다")
+	ErrRoomFull           = errors.New("룸 인원이 가득 찼습니다")
+	ErrRoomPlaying        = errors.New("이미 게임이 진행 중인 룸입니다")
+	ErrAlreadyInRoom      = errors.New("이미 다른 룸에 참가 중입니다")
+	ErrNotInRoom          = errors.New("룸에 참가하고 있지 않습니다")
+	ErrRoomNotPlaying     = errors.New("게임이 진행 중인 룸이 아닙니다")
+	ErrRoomUnsupported    = errors.New("멀티플레이 룸을 지원하지 않는 게임입니다")
+	ErrInvalidRoomMessage = errors.New("유효하지 않은 룸 메시지입니다")
+	ErrRoomGameOver       = errors.New("게임이 종료되었습니다")
+)
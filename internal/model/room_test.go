@@ -0,0 +1,71 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newTestRoom() *Room {
+	return &Room{
+		ID:         "room-1",
+		GameID:     1,
+		HostID:     1,
+		MaxPlayers: 3,
+		Status:     RoomWaiting,
+		Members: []RoomMember{
+			{UserID: 1, Connected: true},
+			{UserID: 2, Connected: true},
+		},
+	}
+}
+
+// 참가 가능 여부와 전원 준비 확인 테스트
+func TestRoom_JoinableAndReady(t *testing.T) {
+	room := newTestRoom()
+	assert.True(t, room.IsJoinable())
+	assert.False(t, room.AllReady())
+
+	room.Member(1).Ready = true
+	room.Member(2).Ready = true
+	assert.True(t, room.AllReady())
+
+	// 연결이 끊긴 참가자가 있으면 시작하지 않음
+	room.Member(2).Connected = false
+	assert.False(t, room.AllReady())
+
+	// 혼자서는 시작하지 않음
+	room.Members = room.Members[:1]
+	assert.False(t, room.AllReady())
+
+	room.Status = RoomPlaying
+	assert.False(t, room.IsJoinable())
+
+	room.Status = RoomWaiting
+	room.MaxPlayers = 1
+	assert.False(t, room.IsJoinable())
+}
+
+// 참가자 제거와 방장 이전 테스트
+func TestRoom_RemoveMember(t *testing.T) {
+	room := newTestRoom()
+	assert.False(t, room.RemoveMember(99))
+
+	assert.True(t, room.RemoveMember(1))
+	assert.Equal(t, uint(2), room.HostID)
+	assert.Equal(t, []uint{2}, room.MemberIDs())
+	assert.Nil(t, room.Member(1))
+
+	assert.True(t, room.RemoveMember(2))
+	assert.Empty(t, room.Members)
+}
+
+// 복사본 수정이 원본에 영향을 주지 않는지 테스트
+func TestRoom_Snapshot(t *testing.T) {
+	room := newTestRoom()
+	snapshot := room.Snapshot()
+	snapshot.Member(1).Ready = true
+	snapshot.Status = RoomPlaying
+
+	assert.False(t, room.Member(1).Ready)
+	assert.Equal(t, RoomWaiting, room.Status)
+}
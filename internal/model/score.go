@@ -112,9 +112,18 @@ type Score struct {
 	// 게임 메타데이터 (JSON 형태로 저장)
 	Metadata string `json:"metadata" gorm:"size:2000;default:'{}'"`
 
+	// 부정행위 검사 결과 (accepted만 랭킹과 보상에 반영)
+	// 섀도우 밴 사용자에게 드러나지 않도록 응답에는 포함하지 않는다.
+	ReviewStatus ScoreReviewStatus `json:"-" gorm:"size:20;default:'accepted';not null;index"`
+
+	// 검토한 중재자 ID와 검토 시간
+	ReviewedBy *uint      `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+
 	// 관계 정의
-	User User `json:"user" gorm:"foreignKey:UserID"`
-	Game Game `json:"game" gorm:"foreignKey:GameID"`
+	User  User        `json:"user" gorm:"foreignKey:UserID"`
+	Game  Game        `json:"game" gorm:"foreignKey:GameID"`
+	Flags []ScoreFlag `json:"flags,omitempty" gorm:"foreignKey:ScoreID"`
 }
 
 // TableName은 Score 모델의 테이블 이름을 반환
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// 점수 검토 상태
+type ScoreReviewStatus string
+
+const (
+	ScoreAccepted    ScoreReviewStatus = "accepted"    // 정상 (랭킹 반영)
+	ScoreQuarantined ScoreReviewStatus = "quarantined" // 의심 점수 (검토 대기)
+	ScoreRejected    ScoreReviewStatus = "rejected"    // 부정 점수로 확정
+)
+
+// 부정행위 검사 규칙 이름
+const (
+	ScoreRulePlayTime      = "play_time"      // 예상 플레이 시간 대비 너무 짧은 완료
+	ScoreRuleScoreRate     = "score_rate"     // 분당 점수 이상치
+	ScoreRuleAccuracySpeed = "accuracy_speed" // 불가능한 정확도/속도
+	ScoreRuleIPRate        = "ip_rate"        // 같은 IP의 과도한 제출
+	ScoreRuleSessionReplay = "session_replay" // 세션 ID 재사용
+	ScoreRuleShadowBan     = "shadow_ban"     // 섀도우 밴 사용자
+)
+
+// 점수에 대한 부정행위 의심 기록
+type ScoreFlag struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	ScoreID   uint      `json:"score_id" gorm:"not null;index"`
+	Rule      string    `json:"rule" gorm:"size:50;not null"`
+	Reason    string    `json:"reason" gorm:"size:500"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ScoreFlag 모델의 테이블 이름 반환
+func (ScoreFlag) TableName() string {
+	return "score_flags"
+}
+
+// 점수가 랭킹과 보상에 반영되는지 확인
+func (s *Score) IsRanked() bool {
+	return s.ReviewStatus == "" || s.ReviewStatus == ScoreAccepted
+}
+
+// 에러 정의
+var (
+	ErrScoreNotFound    = errors.New("점수 기록을 찾을 수 없습니다")
+	ErrScoreNotInReview = errors.New("검토 대기 중인 점수가 아닙니다")
+)
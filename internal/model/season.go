@@ -0,0 +1,192 @@
+package model
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// 게임별 경쟁 시즌
+// 시즌 기간 동안의 정상 점수로 난이도별 시즌 리더보드를 만들고, 종료 시 최종 순위를 보관하고 보상을 지급한다.
+type Season struct {
+	BaseModel
+
+	GameID uint   `json:"game_id" gorm:"not null;index"`
+	Name   string `json:"name" gorm:"size:100;not null"`
+
+	// 시즌 기간 [StartAt, EndAt)
+	StartAt time.Time `json:"start_at" gorm:"not null;index"`
+	EndAt   time.Time `json:"end_at" gorm:"not null;index"`
+
+	// 시즌 마감(순위 보관과 보상 지급) 시각 (nil이면 아직 마감 전)
+	ClosedAt *time.Time `json:"closed_at"`
+
+	// 순위 구간별 보상 (먼저 등록한 구간이 우선)
+	Rewards []SeasonReward `json:"rewards" gorm:"foreignKey:SeasonID"`
+}
+
+// 시즌 순위 구간 보상
+// MaxRank와 TopPercent 중 하나로 구간을 정한다.
+type SeasonReward struct {
+	ID       uint   `json:"id" gorm:"primaryKey"`
+	SeasonID uint   `json:"season_id" gorm:"not null;index"`
+	Name     string `json:"name" gorm:"size:50;not null"`
+
+	// 순위가 MaxRank 이하면 대상
+	MaxRank int64 `json:"max_rank" gorm:"not null;default:0"`
+
+	// 순위가 상위 TopPercent% 이내면 대상 (최소 1명)
+	TopPercent float64 `json:"top_percent" gorm:"not null;default:0"`
+
+	Gold       int `json:"gold" gorm:"not null;default:0"`
+	Diamond    int `json:"diamond" gorm:"not null;default:0"`
+	Experience int `json:"experience" gorm:"not null;default:0"`
+
+	// 지급 아이템 (ItemID가 비어 있으면 없음)
+	ItemID   string `json:"item_id" gorm:"size:50"`
+	ItemName string `json:"item_name" gorm:"size:100"`
+	ItemType string `json:"item_type" gorm:"size:20"`
+	Rarity   string `json:"rarity" gorm:"size:20"`
+	Quantity int    `json:"quantity" gorm:"not null;default:0"`
+}
+
+// 시즌 최종 순위 (시즌 마감 시 보관)
+type SeasonStanding struct {
+	ID         uint           `json:"id" gorm:"primaryKey"`
+	SeasonID   uint           `json:"season_id" gorm:"not null;uniqueIndex:idx_season_standings_entry"`
+	Difficulty GameDifficulty `json:"difficulty" gorm:"size:20;not null;uniqueIndex:idx_season_standings_entry"`
+	UserID     uint           `json:"user_id" gorm:"not null;uniqueIndex:idx_season_standings_entry;index"`
+
+	Rank       int64     `json:"rank" gorm:"not null"`
+	Score      int       `json:"score" gorm:"not null"`
+	AchievedAt time.Time `json:"achieved_at"`
+
+	// 지급된 보상 (구간에 들지 못했으면 비어 있음)
+	RewardName       string `json:"reward_name,omitempty" gorm:"size:50"`
+	RewardGold       int    `json:"reward_gold" gorm:"not null;default:0"`
+	RewardDiamond    int    `json:"reward_diamond" gorm:"not null;default:0"`
+	RewardExperience int    `json:"reward_experience" gorm:"not null;default:0"`
+	RewardItemID     string `json:"reward_item_id,omitempty" gorm:"size:50"`
+	RewardQuantity   int    `json:"reward_quantity" gorm:"not null;default:0"`
+
+	CreatedAt time.Time `json:"created_at"`
+
+	Season *Season `json:"season,omitempty" gorm:"foreignKey:SeasonID"`
+}
+
+// Season 모델의 테이블 이름 반환
+func (Season) TableName() string {
+	return "seasons"
+}
+
+// SeasonReward 모델의 테이블 이름 반환
+func (SeasonReward) TableName() string {
+	return "season_rewards"
+}
+
+// SeasonStanding 모델의 테이블 이름 반환
+func (SeasonStanding) TableName() string {
+	return "season_standings"
+}
+
+// 기본 시즌 보상 구간 (1위, 상위 10위, 상위 1%)
+func DefaultSeasonRewards() []SeasonReward {
+	return []SeasonReward{
+		{Name: "1위", MaxRank: 1, Gold: 10000, Diamond: 500, Experience: 5000},
+		{Name: "상위 10위", MaxRank: 10, Gold: 5000, Diamond: 200, Experience: 2000},
+		{Name: "상위 1%", TopPercent: 1, Gold: 2000, Diamond: 50, Experience: 1000},
+	}
+}
+
+// 시즌 데이터 유효성 검사
+func (s *Season) Validate() error {
+	if s.GameID == 0 || s.Name == "" {
+		return ErrInvalidSeason
+	}
+	if !s.EndAt.After(s.StartAt) {
+		return ErrInvalidSeason
+	}
+	for i := range s.Rewards {
+		if err := s.Rewards[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 진행 중인 시즌인지 확인
+func (s *Season) IsActive(now time.Time) bool {
+	return s.ClosedAt == nil && !now.Before(s.StartAt) && now.Before(s.EndAt)
+}
+
+// 시즌 기간이 끝났는지 확인
+func (s *Season) IsEnded(now time.Time) bool {
+	return !now.Before(s.EndAt)
+}
+
+// 순위에 해당하는 보상 구간을 반환 (없으면 nil)
+func (s *Season) RewardFor(rank, total int64) *SeasonReward {
+	for i := range s.Rewards {
+		if s.Rewards[i].Covers(rank, total) {
+			return &s.Rewards[i]
+		}
+	}
+	return nil
+}
+
+// 보상 구간 유효성 검사
+func (r *SeasonReward) Validate() error {
+	if r.Name == "" {
+		return ErrInvalidSeason
+	}
+	// 구간 조건은 하나만 사용
+	if (r.MaxRank > 0) == (r.TopPercent > 0) || r.MaxRank < 0 || r.TopPercent < 0 || r.TopPercent > 100 {
+		return ErrInvalidSeason
+	}
+	if r.Gold < 0 || r.Diamond < 0 || r.Experience < 0 {
+		return ErrInvalidSeason
+	}
+	if r.ItemID != "" && (r.ItemName == "" || r.ItemType == "" || RarityRank(r.Rarity) < 0 || r.Quantity <= 0) {
+		return ErrInvalidSeason
+	}
+	return nil
+}
+
+// 순위가 보상 구간에 포함되는지 확인
+func (r *SeasonReward) Covers(rank, total int64) bool {
+	if rank <= 0 {
+		return false
+	}
+	if r.MaxRank > 0 {
+		return rank <= r.MaxRank
+	}
+	cutoff := int64(math.Ceil(float64(total) * r.TopPercent / 100))
+	if cutoff < 1 {
+		cutoff = 1
+	}
+	return rank <= cutoff
+}
+
+// 지급 아이템 정보를 인벤토리 템플릿으로 변환
+func (r *SeasonReward) ToInventory(userID uint) *Inventory {
+	return &Inventory{
+		UserID:   userID,
+		ItemID:   r.ItemID,
+		ItemName: r.ItemName,
+		ItemType: r.ItemType,
+		Rarity:   r.Rarity,
+		Level:    1,
+		Quantity: r.Quantity,
+		IsBound:  true,
+	}
+}
+
+// 에러 정의
+var (
+	ErrSeasonNotFound = errors.New("시즌을 찾을 수 없습니다")
+	ErrInvalidSeason  = errors.New("시즌 정보가 유효하지 않습니다")
+	ErrSeasonOverlap  = errors.New("같은 게임에 기간이 겹치는 시즌이 있습니다")
+	ErrSeasonClosed   = errors.New("이미 마감된 시즌입니다")
+	ErrSeasonNotEnded = errors.New("아직 종료되지 않은 시즌입니다")
+	ErrNoActiveSeason = errors.New("진행 중인 시즌이 없습니다")
+)
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// 시즌 보상 구간 판정 테스트
+func TestSeason_RewardFor(t *testing.T) {
+	season := &Season{Rewards: DefaultSeasonRewards()}
+
+	tests := []struct {
+		name   string
+		rank   int64
+		total  int64
+		reward string
+	}{
+		{name: "1위", rank: 1, total: 5000, reward: "1위"},
+		{name: "10위", rank: 10, total: 5000, reward: "상위 10위"},
+		{name: "상위 1% 경계", rank: 50, total: 5000, reward: "상위 1%"},
+		{name: "상위 1% 밖", rank: 51, total: 5000, reward: ""},
+		{name: "인원이 적어도 최소 1명", rank: 1, total: 3, reward: "1위"},
+		{name: "인원이 적으면 상위 10위까지만", rank: 11, total: 50, reward: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reward := season.RewardFor(tt.rank, tt.total)
+			if tt.reward == "" {
+				assert.Nil(t, reward)
+				return
+			}
+			if assert.NotNil(t, reward) {
+				assert.Equal(t, tt.reward, reward.Name)
+			}
+		})
+	}
+
+	percentOnly := &Season{Rewards: []SeasonReward{{Name: "상위 1%", TopPercent: 1}}}
+	assert.NotNil(t, percentOnly.RewardFor(1, 10), "상위 퍼센트 구간은 최소 1명을 포함해야 합니다")
+	assert.Nil(t, percentOnly.RewardFor(2, 10))
+}
+
+// 시즌 유효성 검사와 진행 상태 테스트
+func TestSeason_Validate(t *testing.T) {
+	now := time.Now()
+	season := &Season{GameID: 1, Name: "시즌 1", StartAt: now, EndAt: now.Add(time.Hour), Rewards: DefaultSeasonRewards()}
+	assert.NoError(t, season.Validate())
+	assert.True(t, season.IsActive(now))
+	assert.False(t, season.IsActive(now.Add(time.Hour)))
+	assert.True(t, season.IsEnded(now.Add(time.Hour)))
+
+	invalid := []SeasonReward{
+		{Name: "조건 없음", Gold: 100},
+		{Name: "조건 두 개", MaxRank: 1, TopPercent: 1},
+		{Name: "음수 보상", MaxRank: 1, Gold: -1},
+		{Name: "아이템 정보 누락", MaxRank: 1, ItemID: "crown", Quantity: 1},
+	}
+	for _, reward := range invalid {
+		season.Rewards = []SeasonReward{reward}
+		assert.ErrorIs(t, season.Validate(), ErrInvalidSeason, reward.Name)
+	}
+
+	season.Rewards = nil
+	season.EndAt = season.StartAt
+	assert.ErrorIs(t, season.Validate(), ErrInvalidSeason)
+}
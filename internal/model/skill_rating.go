@@ -0,0 +1,146 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// 스킬 레이팅 기준
+const (
+	// 처음 레이팅을 받는 플레이어의 레이팅, 편차, 변동성
+	DefaultSkillRating     = 1500
+	DefaultSkillDeviation  = 350
+	DefaultSkillVolatility = 0.06
+
+	// 편차 하한 (오래 플레이해도 레이팅이 굳지 않도록)
+	MinSkillDeviation = 30
+
+	// 편차가 이 값보다 크면 배치 중으로 보고 랭킹에서 제외
+	ProvisionalSkillDeviation = 110
+)
+
+// 랭크 티어
+type RankTier string
+
+const (
+	RankTierBronze   RankTier = "bronze"
+	RankTierSilver   RankTier = "silver"
+	RankTierGold     RankTier = "gold"
+	RankTierPlatinum RankTier = "platinum"
+	RankTierDiamond  RankTier = "diamond"
+	RankTierMaster   RankTier = "master"
+)
+
+// 티어별 최소 레이팅 (높은 티어부터)
+var rankTierThresholds = []struct {
+	tier   RankTier
+	rating float64
+}{
+	{RankTierMaster, 2000},
+	{RankTierDiamond, 1800},
+	{RankTierPlatinum, 1600},
+	{RankTierGold, 1400},
+	{RankTierSilver, 1200},
+	{RankTierBronze, 0},
+}
+
+// 게임별 플레이어 스킬 레이팅
+type SkillRating struct {
+	ID     uint `json:"id" gorm:"primaryKey"`
+	UserID uint `json:"user_id" gorm:"not null;uniqueIndex:idx_skill_rating"`
+	GameID uint `json:"game_id" gorm:"not null;uniqueIndex:idx_skill_rating;index:idx_skill_rating_rank"`
+
+	Rating     float64  `json:"rating" gorm:"not null;index:idx_skill_rating_rank"`
+	Deviation  float64  `json:"deviation" gorm:"not null"`
+	Volatility float64  `json:"volatility" gorm:"not null"`
+	Tier       RankTier `json:"tier" gorm:"size:20;not null;index"`
+
+	Matches int `json:"matches" gorm:"not null;default:0"`
+
+	// 레이팅 기간을 반영한 시각 (이후 경기가 없던 기간만큼 편차가 늘어남)
+	RatedAt      time.Time  `json:"rated_at" gorm:"not null;index"`
+	LastPlayedAt *time.Time `json:"last_played_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Nickname    string `json:"nickname,omitempty" gorm:"-"`
+	Provisional bool   `json:"provisional" gorm:"-"`
+}
+
+// 스킬 레이팅 변동 기록 (매치마다 한 건)
+type SkillRatingHistory struct {
+	ID      uint  `json:"id" gorm:"primaryKey"`
+	UserID  uint  `json:"user_id" gorm:"not null;index:idx_skill_rating_history"`
+	GameID  uint  `json:"game_id" gorm:"not null;index:idx_skill_rating_history"`
+	MatchID *uint `json:"match_id,omitempty" gorm:"index"`
+
+	Rating      float64  `json:"rating" gorm:"not null"`
+	Deviation   float64  `json:"deviation" gorm:"not null"`
+	RatingDelta float64  `json:"rating_delta" gorm:"not null"`
+	Tier        RankTier `json:"tier" gorm:"size:20;not null"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"index:idx_skill_rating_history"`
+}
+
+// SkillRating 모델의 테이블 이름 반환
+func (SkillRating) TableName() string {
+	return "skill_ratings"
+}
+
+// SkillRatingHistory 모델의 테이블 이름 반환
+func (SkillRatingHistory) TableName() string {
+	return "skill_rating_histories"
+}
+
+// 기본값으로 새 스킬 레이팅을 생성
+func NewSkillRating(userID, gameID uint, now time.Time) *SkillRating {
+	rating := &SkillRating{UserID: userID, GameID: gameID, RatedAt: now}
+	rating.Apply(RatingState{Rating: DefaultSkillRating, Deviation: DefaultSkillDeviation, Volatility: DefaultSkillVolatility})
+	return rating
+}
+
+// 레이팅 티어
+func RankTierFor(rating float64) RankTier {
+	for _, threshold := range rankTierThresholds {
+		if rating >= threshold.rating {
+			return threshold.tier
+		}
+	}
+	return RankTierBronze
+}
+
+// 유효한 티어인지 확인
+func IsRankTier(tier RankTier) bool {
+	for _, threshold := range rankTierThresholds {
+		if threshold.tier == tier {
+			return true
+		}
+	}
+	return false
+}
+
+// 계산에 쓰는 레이팅 값
+func (r *SkillRating) State() RatingState {
+	return RatingState{Rating: r.Rating, Deviation: r.Deviation, Volatility: r.Volatility}
+}
+
+// 계산한 레이팅 값을 반영하고 티어를 갱신
+func (r *SkillRating) Apply(state RatingState) {
+	r.Rating = state.Rating
+	r.Deviation = state.Deviation
+	r.Volatility = state.Volatility
+	r.Tier = RankTierFor(state.Rating)
+	r.Provisional = r.IsProvisional()
+}
+
+// 배치 중인지 확인 (편차가 커서 레이팅을 아직 믿기 어려움)
+func (r *SkillRating) IsProvisional() bool {
+	return r.Deviation > ProvisionalSkillDeviation
+}
+
+// 에러 정의
+var (
+	ErrUnknownRatingAlgorithm = errors.New("알 수 없는 레이팅 알고리즘입니다")
+	ErrInvalidRankTier        = errors.New("유효하지 않은 랭크 티어입니다")
+)
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// 레이팅별 랭크 티어 테스트
+func TestRankTierFor(t *testing.T) {
+	assert.Equal(t, RankTierBronze, RankTierFor(0))
+	assert.Equal(t, RankTierBronze, RankTierFor(1199.99))
+	assert.Equal(t, RankTierSilver, RankTierFor(1200))
+	assert.Equal(t, RankTierGold, RankTierFor(DefaultSkillRating))
+	assert.Equal(t, RankTierPlatinum, RankTierFor(1650))
+	assert.Equal(t, RankTierDiamond, RankTierFor(1999))
+	assert.Equal(t, RankTierMaster, RankTierFor(2400))
+
+	assert.True(t, IsRankTier(RankTierMaster))
+	assert.False(t, IsRankTier("grandmaster"))
+}
+
+// 기본 스킬 레이팅과 배치 여부 테스트
+func TestSkillRating_Apply(t *testing.T) {
+	now := time.Date(2025, 3, 12, 12, 0, 0, 0, time.UTC)
+	rating := NewSkillRating(1, 2, now)
+	assert.Equal(t, float64(DefaultSkillRating), rating.Rating)
+	assert.Equal(t, float64(DefaultSkillDeviation), rating.Deviation)
+	assert.Equal(t, RankTierGold, rating.Tier)
+	assert.True(t, rating.Provisional)
+	assert.Equal(t, now, rating.RatedAt)
+
+	rating.Apply(RatingState{Rating: 1820, Deviation: 80, Volatility: 0.06})
+	assert.Equal(t, RankTierDiamond, rating.Tier)
+	assert.False(t, rating.IsProvisional())
+	assert.False(t, rating.Provisional)
+}
@@ -0,0 +1,608 @@
+package model
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 토너먼트 진행 방식
+type TournamentFormat string
+
+const (
+	TournamentSingleElimination TournamentFormat = "single_elimination" // 한 번 지면 탈락
+	TournamentDoubleElimination TournamentFormat = "double_elimination" // 패자조를 거쳐 두 번 지면 탈락
+	TournamentSwiss             TournamentFormat = "swiss"              // 정해진 라운드 동안 비슷한 성적끼리 대결
+)
+
+// 토너먼트 상태
+type TournamentStatus string
+
+const (
+	TournamentRegistration TournamentStatus = "registration" // 참가 신청 기간 (시작 전)
+	TournamentInProgress   TournamentStatus = "in_progress"  // 대진 생성 후 진행 중
+	TournamentCompleted    TournamentStatus = "completed"    // 최종 순위 확정과 상금 지급 완료
+	TournamentCancelled    TournamentStatus = "cancelled"    // 취소 (참가비 환불)
+)
+
+// 참가비 재화
+const (
+	TournamentCurrencyGold    = "gold"
+	TournamentCurrencyDiamond = "diamond"
+)
+
+// 대진표 구역
+type TournamentBracket string
+
+const (
+	BracketWinners    TournamentBracket = "winners"     // 승자조 (싱글 엘리미네이션은 승자조만 사용)
+	BracketLosers     TournamentBracket = "losers"      // 패자조
+	BracketGrandFinal TournamentBracket = "grand_final" // 승자조 우승자와 패자조 우승자의 결승
+	BracketSwiss      TournamentBracket = "swiss"       // 스위스 라운드
+)
+
+// 토너먼트 매치 상태
+type TournamentMatchStatus string
+
+const (
+	TournamentMatchPending   TournamentMatchStatus = "pending"   // 앞 매치 결과를 기다리는 중
+	TournamentMatchReady     TournamentMatchStatus = "ready"     // 두 참가자가 정해져 경기 가능
+	TournamentMatchReported  TournamentMatchStatus = "reported"  // 한 참가자가 결과를 보고함 (상대 확인 대기)
+	TournamentMatchDisputed  TournamentMatchStatus = "disputed"  // 결과 이의 제기 (관리자 판정 대기)
+	TournamentMatchCompleted TournamentMatchStatus = "completed" // 결과 확정
+)
+
+// 참가자 상태
+type TournamentParticipantStatus string
+
+const (
+	ParticipantActive     TournamentParticipantStatus = "active"     // 진행 중
+	ParticipantEliminated TournamentParticipantStatus = "eliminated" // 탈락
+	ParticipantChampion   TournamentParticipantStatus = "champion"   // 우승
+	ParticipantFinished   TournamentParticipantStatus = "finished"   // 스위스 라운드 종료
+)
+
+// 이의 제기 상태
+type TournamentDisputeStatus string
+
+const (
+	DisputeOpen     TournamentDisputeStatus = "open"     // 판정 대기
+	DisputeResolved TournamentDisputeStatus = "resolved" // 관리자가 결과를 확정
+)
+
+// 토너먼트
+type Tournament struct {
+	BaseModel
+
+	GameID      uint             `json:"game_id" gorm:"not null;index"`
+	Name        string           `json:"name" gorm:"size:100;not null"`
+	Description string           `json:"description" gorm:"size:1000"`
+	Format      TournamentFormat `json:"format" gorm:"size:30;not null"`
+	Status      TournamentStatus `json:"status" gorm:"size:20;not null;index"`
+
+	// 참가 인원 제한 (시작 시 MinParticipants보다 적으면 취소)
+	MinParticipants int `json:"min_participants" gorm:"not null;default:2"`
+	MaxParticipants int `json:"max_participants" gorm:"not null"`
+
+	// 참가 신청 기간 [RegistrationStartAt, RegistrationEndAt) (마감 후 자동 시작)
+	RegistrationStartAt time.Time `json:"registration_start_at" gorm:"not null"`
+	RegistrationEndAt   time.Time `json:"registration_end_at" gorm:"not null;index"`
+
+	// 참가비 (EntryFee가 0이면 무료)
+	EntryCurrency string `json:"entry_currency" gorm:"size:20;not null;default:'gold'"`
+	EntryFee      int    `json:"entry_fee" gorm:"not null;default:0"`
+
+	// 상금 (운영 지원 상금 + 참가비 합계, 참가비와 같은 재화로 지급)
+	BasePrize int `json:"base_prize" gorm:"not null;default:0"`
+	PrizePool int `json:"prize_pool" gorm:"not null;default:0"`
+
+	// 순위별 상금 비율(%) (예: "50,30,20")
+	PrizeSplit string `json:"prize_split" gorm:"size:200;not null"`
+
+	// 스위스 라운드 수 (0이면 참가 인원으로 계산)
+	SwissRounds  int `json:"swiss_rounds" gorm:"not null;default:0"`
+	CurrentRound int `json:"current_round" gorm:"not null;default:0"`
+
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedBy   uint       `json:"created_by" gorm:"not null"`
+
+	ParticipantCount int `json:"participant_count" gorm:"-"`
+}
+
+// 토너먼트 참가자
+type TournamentParticipant struct {
+	ID           uint `json:"id" gorm:"primaryKey"`
+	TournamentID uint `json:"tournament_id" gorm:"not null;uniqueIndex:idx_tournament_participant"`
+	UserID       uint `json:"user_id" gorm:"not null;uniqueIndex:idx_tournament_participant;index"`
+
+	// 시작 시 스킬 레이팅 순으로 정한 시드 (1이 가장 높음)
+	Seed   int     `json:"seed" gorm:"not null;default:0"`
+	Rating float64 `json:"rating" gorm:"not null;default:0"`
+
+	Status TournamentParticipantStatus `json:"status" gorm:"size:20;not null"`
+	Wins   int                         `json:"wins" gorm:"not null;default:0"`
+	Losses int                         `json:"losses" gorm:"not null;default:0"`
+
+	// 탈락한 단계 (늦게 탈락할수록 큼, 최종 순위 계산용)
+	EliminatedStage int `json:"-" gorm:"not null;default:0"`
+
+	FinalRank *int `json:"final_rank,omitempty"`
+	Prize     int  `json:"prize" gorm:"not null;default:0"`
+	EntryPaid int  `json:"entry_paid" gorm:"not null;default:0"`
+
+	CreatedAt time.Time `json:"created_at"`
+
+	Nickname string `json:"nickname,omitempty" gorm:"-"`
+}
+
+// 토너먼트 매치
+type TournamentMatch struct {
+	ID           uint              `json:"id" gorm:"primaryKey"`
+	TournamentID uint              `json:"tournament_id" gorm:"not null;index:idx_tournament_match_order"`
+	Bracket      TournamentBracket `json:"bracket" gorm:"size:20;not null;index:idx_tournament_match_order"`
+	Round        int               `json:"round" gorm:"not null;index:idx_tournament_match_order"`
+	Position     int               `json:"position" gorm:"not null;index:idx_tournament_match_order"`
+
+	Player1ID *uint `json:"player1_id,omitempty"`
+	Player2ID *uint `json:"player2_id,omitempty"`
+	Score1    *int  `json:"score1,omitempty"`
+	Score2    *int  `json:"score2,omitempty"`
+	WinnerID  *uint `json:"winner_id,omitempty"`
+	LoserID   *uint `json:"loser_id,omitempty"`
+
+	Status TournamentMatchStatus `json:"status" gorm:"size:20;not null;index"`
+
+	// 아직 앞 매치 결과를 기다리는 자리 수 (0이 되면 경기 가능 또는 부전승 처리)
+	PendingSlots int `json:"-" gorm:"not null;default:0"`
+
+	// 승자/패자가 진출하는 매치와 자리 (1 또는 2)
+	NextMatchID      *uint `json:"next_match_id,omitempty"`
+	NextSlot         int   `json:"next_slot,omitempty" gorm:"not null;default:0"`
+	LoserNextMatchID *uint `json:"loser_next_match_id,omitempty"`
+	LoserNextSlot    int   `json:"loser_next_slot,omitempty" gorm:"not null;default:0"`
+
+	// 결과를 먼저 보고한 참가자
+	ReportedBy *uint `json:"reported_by,omitempty"`
+
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+
+	// 대진 생성 시 진출 매치 위치 (저장 전 ID 연결용)
+	next      *bracketLink
+	loserNext *bracketLink
+}
+
+// 대진 생성 시 진출 매치 위치
+type bracketLink struct {
+	index int
+	slot  int
+}
+
+// 토너먼트 매치 결과 이의 제기
+type TournamentDispute struct {
+	ID           uint `json:"id" gorm:"primaryKey"`
+	TournamentID uint `json:"tournament_id" gorm:"not null;index"`
+	MatchID      uint `json:"match_id" gorm:"not null;index"`
+	UserID       uint `json:"user_id" gorm:"not null"`
+
+	Reason string                  `json:"reason" gorm:"size:500;not null"`
+	Status TournamentDisputeStatus `json:"status" gorm:"size:20;not null;index"`
+
+	// 관리자 판정
+	ResolvedBy *uint      `json:"resolved_by,omitempty"`
+	Resolution string     `json:"resolution,omitempty" gorm:"size:500"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Tournament 모델의 테이블 이름 반환
+func (Tournament) TableName() string {
+	return "tournaments"
+}
+
+// TournamentParticipant 모델의 테이블 이름 반환
+func (TournamentParticipant) TableName() string {
+	return "tournament_participants"
+}
+
+// TournamentMatch 모델의 테이블 이름 반환
+func (TournamentMatch) TableName() string {
+	return "tournament_matches"
+}
+
+// TournamentDispute 모델의 테이블 이름 반환
+func (TournamentDispute) TableName() string {
+	return "tournament_disputes"
+}
+
+// 토너먼트 데이터 유효성 검사
+func (t *Tournament) Validate() error {
+	if t.GameID == 0 || t.Name == "" {
+		return ErrInvalidTournament
+	}
+	switch t.Format {
+	case TournamentSingleElimination, TournamentDoubleElimination, TournamentSwiss:
+	default:
+		return ErrInvalidTournament
+	}
+	if t.MinParticipants < 2 || t.MaxParticipants < t.MinParticipants {
+		return ErrInvalidTournament
+	}
+	if !t.RegistrationEndAt.After(t.RegistrationStartAt) {
+		return ErrInvalidTournament
+	}
+	if t.EntryCurrency != TournamentCurrencyGold && t.EntryCurrency != TournamentCurrencyDiamond {
+		return ErrInvalidTournament
+	}
+	if t.EntryFee < 0 || t.BasePrize < 0 || t.SwissRounds < 0 {
+		return ErrInvalidTournament
+	}
+	if _, err := t.GetPrizeSplit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// 순위별 상금 비율을 슬라이스로 반환 (합계는 100 이하)
+func (t *Tournament) GetPrizeSplit() ([]int, error) {
+	if strings.TrimSpace(t.PrizeSplit) == "" {
+		return []int{}, nil
+	}
+
+	total := 0
+	parts := strings.Split(t.PrizeSplit, ",")
+	split := make([]int, len(parts))
+	for i, part := range parts {
+		percent, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || percent < 0 {
+			return nil, ErrInvalidTournament
+		}
+		split[i] = percent
+		total += percent
+	}
+	if total > 100 {
+		return nil, ErrInvalidTournament
+	}
+	return split, nil
+}
+
+// 참가 신청 기간인지 확인
+func (t *Tournament) IsRegistrationOpen(now time.Time) bool {
+	return t.Status == TournamentRegistration && !now.Before(t.RegistrationStartAt) && now.Before(t.RegistrationEndAt)
+}
+
+// 스위스 라운드 수 (지정하지 않으면 참가 인원으로 우승자가 가려지는 라운드 수)
+func (t *Tournament) SwissRoundCount(participants int) int {
+	if t.SwissRounds > 0 {
+		return t.SwissRounds
+	}
+	if participants < 2 {
+		return 1
+	}
+	return int(math.Ceil(math.Log2(float64(participants))))
+}
+
+// 순위별 상금을 계산 (ranks는 참가자별 최종 순위, 공동 순위는 해당 자리 상금을 나눔)
+// 나누고 남은 금액은 1위에게 지급한다.
+func DistributePrizes(pool int, split []int, ranks []int) []int {
+	prizes := make([]int, len(ranks))
+	if pool <= 0 || len(ranks) == 0 {
+		return prizes
+	}
+
+	byRank := make(map[int][]int)
+	for i, rank := range ranks {
+		byRank[rank] = append(byRank[rank], i)
+	}
+
+	paid := 0
+	for rank, members := range byRank {
+		// 공동 순위는 rank부터 rank+인원-1 자리까지의 비율을 합쳐 나눔
+		percent := 0
+		for place := rank; place < rank+len(members); place++ {
+			if place-1 < len(split) {
+				percent += split[place-1]
+			}
+		}
+		share := pool * percent / 100 / len(members)
+		for _, i := range members {
+			prizes[i] = share
+			paid += share
+		}
+	}
+
+	remainder := pool*sumInts(split)/100 - paid
+	if members, ok := byRank[1]; ok && remainder > 0 {
+		prizes[members[0]] += remainder
+	}
+	return prizes
+}
+
+func sumInts(values []int) int {
+	total := 0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+// 참가자 자리인지 확인하고 자리 번호를 반환 (참가자가 아니면 0)
+func (m *TournamentMatch) SlotOf(userID uint) int {
+	switch {
+	case m.Player1ID != nil && *m.Player1ID == userID:
+		return 1
+	case m.Player2ID != nil && *m.Player2ID == userID:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// 자리에 참가자를 배정
+func (m *TournamentMatch) SetPlayer(slot int, userID uint) {
+	id := userID
+	if slot == 1 {
+		m.Player1ID = &id
+	} else {
+		m.Player2ID = &id
+	}
+}
+
+// 결과 보고를 받을 수 있는 상태인지 확인
+func (m *TournamentMatch) AcceptsReport() bool {
+	return m.Status == TournamentMatchReady || m.Status == TournamentMatchReported
+}
+
+// 자리 순서로 만든 시드 배치 (1번 시드와 2번 시드가 결승에서 만나도록)
+// 예: 8강은 1,8,4,5,2,7,3,6
+func BracketSeedOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		next := make([]int, 0, len(order)*2)
+		total := len(order)*2 + 1
+		for _, seed := range order {
+			next = append(next, seed, total-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// 승자조 대진을 생성 (playerIDs는 시드 순, 빈자리는 부전승)
+// 반환하는 매치는 라운드, 자리 순이며 각 라운드의 승자는 다음 라운드로 진출한다.
+func buildWinnersBracket(playerIDs []uint) ([]TournamentMatch, [][]int) {
+	size := 1
+	for size < len(playerIDs) {
+		size *= 2
+	}
+	if size < 2 {
+		size = 2
+	}
+
+	var matches []TournamentMatch
+	var rounds [][]int
+	order := BracketSeedOrder(size)
+	first := make([]int, 0, size/2)
+	for i := 0; i < size/2; i++ {
+		match := TournamentMatch{Bracket: BracketWinners, Round: 1, Position: i + 1}
+		for slot, seed := range order[i*2 : i*2+2] {
+			if seed <= len(playerIDs) {
+				match.SetPlayer(slot+1, playerIDs[seed-1])
+			}
+		}
+		first = append(first, len(matches))
+		matches = append(matches, match)
+	}
+	rounds = append(rounds, first)
+
+	for round := 2; len(rounds[len(rounds)-1]) > 1; round++ {
+		previous := rounds[len(rounds)-1]
+		current := make([]int, 0, len(previous)/2)
+		for i := 0; i < len(previous)/2; i++ {
+			index := len(matches)
+			matches = append(matches, TournamentMatch{Bracket: BracketWinners, Round: round, Position: i + 1, PendingSlots: 2})
+			matches[previous[i*2]].next = &bracketLink{index: index, slot: 1}
+			matches[previous[i*2+1]].next = &bracketLink{index: index, slot: 2}
+			current = append(current, index)
+		}
+		rounds = append(rounds, current)
+	}
+	return matches, rounds
+}
+
+// 싱글 엘리미네이션 대진을 생성 (playerIDs는 시드 순)
+func BuildSingleElimination(playerIDs []uint) []TournamentMatch {
+	matches, _ := buildWinnersBracket(playerIDs)
+	return matches
+}
+
+// 더블 엘리미네이션 대진을 생성 (playerIDs는 시드 순)
+// 승자조에서 진 참가자는 패자조로 내려가고, 승자조 우승자와 패자조 우승자가 결승에서 대결한다.
+// 패자조 우승자가 결승 첫 경기를 이기면 두 참가자 모두 한 번씩 졌으므로 결승 2라운드(리셋 매치)를 한 번 더 치른다.
+func BuildDoubleElimination(playerIDs []uint) []TournamentMatch {
+	matches, winners := buildWinnersBracket(playerIDs)
+
+	// 패자조: 홀수 라운드는 패자조 승자끼리, 짝수 라운드는 패자조 승자와 승자조에서 내려온 참가자가 대결
+	// 같은 상대를 바로 다시 만나지 않도록 내려오는 순서를 뒤집는다.
+	var losers [][]int
+	if len(winners) > 1 {
+		first := make([]int, 0, len(winners[0])/2)
+		for i := 0; i < len(winners[0])/2; i++ {
+			index := len(matches)
+			matches = append(matches, TournamentMatch{Bracket: BracketLosers, Round: 1, Position: i + 1, PendingSlots: 2})
+			matches[winners[0][i*2]].loserNext = &bracketLink{index: index, slot: 1}
+			matches[winners[0][i*2+1]].loserNext = &bracketLink{index: index, slot: 2}
+			first = append(first, index)
+		}
+		losers = append(losers, first)
+
+		for wbRound := 1; wbRound < len(winners); wbRound++ {
+			// 패자조 승자와 승자조 wbRound+1 라운드 패자
+			previous := losers[len(losers)-1]
+			dropping := winners[wbRound]
+			major := make([]int, 0, len(previous))
+			for i := range previous {
+				index := len(matches)
+				matches = append(matches, TournamentMatch{Bracket: BracketLosers, Round: len(losers) + 1, Position: i + 1, PendingSlots: 2})
+				matches[previous[i]].next = &bracketLink{index: index, slot: 1}
+				matches[dropping[len(dropping)-1-i]].loserNext = &bracketLink{index: index, slot: 2}
+				major = append(major, index)
+			}
+			losers = append(losers, major)
+
+			if len(major) == 1 {
+				break
+			}
+			minor := make([]int, 0, len(major)/2)
+			for i := 0; i < len(major)/2; i++ {
+				index := len(matches)
+				matches = append(matches, TournamentMatch{Bracket: BracketLosers, Round: len(losers) + 1, Position: i + 1, PendingSlots: 2})
+				matches[major[i*2]].next = &bracketLink{index: index, slot: 1}
+				matches[major[i*2+1]].next = &bracketLink{index: index, slot: 2}
+				minor = append(minor, index)
+			}
+			losers = append(losers, minor)
+		}
+	}
+
+	final := len(matches)
+	matches = append(matches, TournamentMatch{Bracket: BracketGrandFinal, Round: 1, Position: 1, PendingSlots: 2})
+	winnersFinal := winners[len(winners)-1][0]
+	matches[winnersFinal].next = &bracketLink{index: final, slot: 1}
+	if len(losers) > 0 {
+		matches[losers[len(losers)-1][0]].next = &bracketLink{index: final, slot: 2}
+	} else {
+		// 두 명뿐이면 승자조 결승 패자가 바로 결승으로
+		matches[winnersFinal].loserNext = &bracketLink{index: final, slot: 2}
+	}
+
+	// 리셋 매치: 1번 자리는 승자조 우승자, 2번 자리는 패자조 우승자 (결승 첫 경기 승패와 관계없이 같은 자리)
+	reset := len(matches)
+	matches = append(matches, TournamentMatch{Bracket: BracketGrandFinal, Round: 2, Position: 1, PendingSlots: 2})
+	matches[final].next = &bracketLink{index: reset, slot: 2}
+	matches[final].loserNext = &bracketLink{index: reset, slot: 1}
+	return matches
+}
+
+// 결승 첫 경기에서 리셋 매치 없이 우승자가 정해지는지 확인
+// 승자조 우승자(1번 자리)가 이기거나 상대 없이 부전승이면 리셋 매치를 치르지 않는다.
+func (m *TournamentMatch) EndsGrandFinal(winner, loser *uint) bool {
+	if m.Bracket != BracketGrandFinal || m.Round != 1 {
+		return false
+	}
+	return loser == nil || (m.Player1ID != nil && *winner == *m.Player1ID)
+}
+
+// 대진 생성 시 정한 진출 매치 위치 (없으면 -1)
+func (m *TournamentMatch) BracketLinks() (next, nextSlot, loserNext, loserSlot int) {
+	next, loserNext = -1, -1
+	if m.next != nil {
+		next, nextSlot = m.next.index, m.next.slot
+	}
+	if m.loserNext != nil {
+		loserNext, loserSlot = m.loserNext.index, m.loserNext.slot
+	}
+	return
+}
+
+// 스위스 순위 계산용 참가자 성적
+type SwissStanding struct {
+	UserID    uint
+	Seed      int
+	Wins      int
+	Buchholz  int
+	Opponents []uint
+	HadBye    bool
+}
+
+// 스위스 순위로 정렬 (승수, 상대 승수 합, 시드 순)
+func SortSwissStandings(standings []SwissStanding) {
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := &standings[i], &standings[j]
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		if a.Buchholz != b.Buchholz {
+			return a.Buchholz > b.Buchholz
+		}
+		return a.Seed < b.Seed
+	})
+}
+
+// 스위스 라운드 대진을 짬 (standings는 순위 순)
+// 인원이 홀수면 부전승을 받지 않은 가장 낮은 순위 참가자가 부전승을 받고,
+// 나머지는 위에서부터 아직 만나지 않은 가장 가까운 순위와 대결한다.
+func PairSwissRound(standings []SwissStanding) (pairs [][2]uint, bye *uint) {
+	remaining := make([]SwissStanding, len(standings))
+	copy(remaining, standings)
+
+	if len(remaining)%2 == 1 {
+		pick := len(remaining) - 1
+		for i := len(remaining) - 1; i >= 0; i-- {
+			if !remaining[i].HadBye {
+				pick = i
+				break
+			}
+		}
+		id := remaining[pick].UserID
+		bye = &id
+		remaining = append(remaining[:pick], remaining[pick+1:]...)
+	}
+
+	paired := make([]bool, len(remaining))
+	for i := range remaining {
+		if paired[i] {
+			continue
+		}
+		opponent := -1
+		for j := i + 1; j < len(remaining); j++ {
+			if paired[j] {
+				continue
+			}
+			if opponent == -1 {
+				opponent = j
+			}
+			if !containsID(remaining[i].Opponents, remaining[j].UserID) {
+				opponent = j
+				break
+			}
+		}
+		if opponent == -1 {
+			continue
+		}
+		paired[i], paired[opponent] = true, true
+		pairs = append(pairs, [2]uint{remaining[i].UserID, remaining[opponent].UserID})
+	}
+	return pairs, bye
+}
+
+func containsID(ids []uint, id uint) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// 에러 정의
+var (
+	ErrInvalidTournament       = errors.New("유효하지 않은 토너먼트 정보입니다")
+	ErrTournamentNotFound      = errors.New("토너먼트를 찾을 수 없습니다")
+	ErrRegistrationClosed      = errors.New("참가 신청 기간이 아닙니다")
+	ErrTournamentFull          = errors.New("토너먼트 참가 인원이 가득 찼습니다")
+	ErrAlreadyRegistered       = errors.New("이미 참가 신청한 토너먼트입니다")
+	ErrNotRegistered           = errors.New("참가 신청하지 않은 토너먼트입니다")
+	ErrTournamentNotStartable  = errors.New("시작할 수 없는 토너먼트입니다")
+	ErrTournamentMatchNotFound = errors.New("토너먼트 매치를 찾을 수 없습니다")
+	ErrNotMatchParticipant     = errors.New("매치 참가자가 아닙니다")
+	ErrMatchNotReportable      = errors.New("결과를 보고할 수 없는 매치입니다")
+	ErrInvalidMatchResult      = errors.New("유효하지 않은 매치 결과입니다 (무승부 불가)")
+	ErrDisputeNotFound         = errors.New("이의 제기를 찾을 수 없습니다")
+)
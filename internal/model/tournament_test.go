@@ -0,0 +1,219 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func newTestTournament() *Tournament {
+	start := time.Date(2025, 3, 12, 12, 0, 0, 0, time.UTC)
+	return &Tournament{
+		GameID:              1,
+		Name:                "봄 시즌 컵",
+		Format:              TournamentSingleElimination,
+		MinParticipants:     2,
+		MaxParticipants:     8,
+		RegistrationStartAt: start,
+		RegistrationEndAt:   start.Add(24 * time.Hour),
+		EntryCurrency:       TournamentCurrencyGold,
+		EntryFee:            100,
+		PrizeSplit:          "50,30,20",
+	}
+}
+
+// 토너먼트 유효성 검사 테스트
+func TestTournament_Validate(t *testing.T) {
+	assert.NoError(t, newTestTournament().Validate())
+
+	cases := map[string]func(*Tournament){
+		"알 수 없는 방식":      func(t *Tournament) { t.Format = "round_robin" },
+		"최소 인원 부족":       func(t *Tournament) { t.MinParticipants = 1 },
+		"최대 인원이 최소보다 작음": func(t *Tournament) { t.MaxParticipants = 1 },
+		"신청 기간 역전":       func(t *Tournament) { t.RegistrationEndAt = t.RegistrationStartAt },
+		"알 수 없는 재화":      func(t *Tournament) { t.EntryCurrency = "ruby" },
+		"음수 참가비":         func(t *Tournament) { t.EntryFee = -1 },
+		"상금 비율 합계 초과":    func(t *Tournament) { t.PrizeSplit = "60,50" },
+		"상금 비율 형식 오류":    func(t *Tournament) { t.PrizeSplit = "50,abc" },
+	}
+	for name, mutate := range cases {
+		tournament := newTestTournament()
+		mutate(tournament)
+		assert.ErrorIs(t, tournament.Validate(), ErrInvalidTournament, name)
+	}
+}
+
+// 상금 비율과 신청 기간, 스위스 라운드 수 테스트
+func TestTournament_Helpers(t *testing.T) {
+	tournament := newTestTournament()
+	split, err := tournament.GetPrizeSplit()
+	require.NoError(t, err)
+	assert.Equal(t, []int{50, 30, 20}, split)
+
+	tournament.Status = TournamentRegistration
+	assert.False(t, tournament.IsRegistrationOpen(tournament.RegistrationStartAt.Add(-time.Second)))
+	assert.True(t, tournament.IsRegistrationOpen(tournament.RegistrationStartAt))
+	assert.False(t, tournament.IsRegistrationOpen(tournament.RegistrationEndAt))
+
+	assert.Equal(t, 3, tournament.SwissRoundCount(8))
+	assert.Equal(t, 3, tournament.SwissRoundCount(5))
+	tournament.SwissRounds = 5
+	assert.Equal(t, 5, tournament.SwissRoundCount(8))
+}
+
+// 시드 배치 순서 테스트 (상위 시드끼리 늦게 만나도록)
+func TestBracketSeedOrder(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, BracketSeedOrder(2))
+	assert.Equal(t, []int{1, 4, 2, 3}, BracketSeedOrder(4))
+	assert.Equal(t, []int{1, 8, 4, 5, 2, 7, 3, 6}, BracketSeedOrder(8))
+}
+
+// 싱글 엘리미네이션 대진 생성 테스트 (5명이면 8강, 상위 3시드 부전승)
+func TestBuildSingleElimination(t *testing.T) {
+	matches := BuildSingleElimination([]uint{11, 12, 13, 14, 15})
+	require.Len(t, matches, 7)
+
+	// 1라운드: 1-bye, 4-5, 2-bye, 3-bye
+	assert.Equal(t, uint(11), *matches[0].Player1ID)
+	assert.Nil(t, matches[0].Player2ID)
+	assert.Equal(t, uint(14), *matches[1].Player1ID)
+	assert.Equal(t, uint(15), *matches[1].Player2ID)
+	assert.Nil(t, matches[2].Player2ID)
+	assert.Nil(t, matches[3].Player2ID)
+
+	// 1라운드 승자는 2라운드로, 2라운드 승자는 결승으로
+	next, slot, loserNext, _ := matches[1].BracketLinks()
+	assert.Equal(t, 4, next)
+	assert.Equal(t, 2, slot)
+	assert.Equal(t, -1, loserNext)
+	next, slot, _, _ = matches[5].BracketLinks()
+	assert.Equal(t, 6, next)
+	assert.Equal(t, 2, slot)
+
+	final := matches[6]
+	assert.Equal(t, 3, final.Round)
+	assert.Equal(t, 2, final.PendingSlots)
+	next, _, _, _ = final.BracketLinks()
+	assert.Equal(t, -1, next)
+}
+
+// 더블 엘리미네이션 대진 생성 테스트
+func TestBuildDoubleElimination(t *testing.T) {
+	// 4명: 승자조 3, 패자조 2, 결승 2 (리셋 매치 포함)
+	matches := BuildDoubleElimination([]uint{1, 2, 3, 4})
+	require.Len(t, matches, 7)
+	counts := map[TournamentBracket]int{}
+	for _, match := range matches {
+		counts[match.Bracket]++
+	}
+	assert.Equal(t, map[TournamentBracket]int{BracketWinners: 3, BracketLosers: 2, BracketGrandFinal: 2}, counts)
+
+	// 승자조 1라운드 패자는 패자조 1라운드로
+	_, _, loserNext, loserSlot := matches[0].BracketLinks()
+	assert.Equal(t, BracketLosers, matches[loserNext].Bracket)
+	assert.Equal(t, 1, matches[loserNext].Round)
+	assert.Equal(t, 1, loserSlot)
+
+	// 승자조 결승 패자는 패자조 결승으로, 승자는 최종 결승으로
+	next, nextSlot, loserNext, loserSlot := matches[2].BracketLinks()
+	assert.Equal(t, BracketGrandFinal, matches[next].Bracket)
+	assert.Equal(t, 1, nextSlot)
+	assert.Equal(t, BracketLosers, matches[loserNext].Bracket)
+	assert.Equal(t, 2, matches[loserNext].Round)
+	assert.Equal(t, 2, loserSlot)
+
+	// 결승 첫 경기의 승자와 패자는 모두 리셋 매치로 (승자조 우승자는 항상 1번 자리)
+	final := matches[5]
+	next, nextSlot, loserNext, loserSlot = final.BracketLinks()
+	assert.Equal(t, 6, next)
+	assert.Equal(t, 2, nextSlot)
+	assert.Equal(t, 6, loserNext)
+	assert.Equal(t, 1, loserSlot)
+	assert.Equal(t, BracketGrandFinal, matches[6].Bracket)
+	assert.Equal(t, 2, matches[6].Round)
+	next, _, loserNext, _ = matches[6].BracketLinks()
+	assert.Equal(t, -1, next)
+	assert.Equal(t, -1, loserNext)
+
+	// 승자조 우승자가 이기거나 부전승이면 리셋 없이 끝남
+	winnersChampion, losersChampion := uint(1), uint(4)
+	final.SetPlayer(1, winnersChampion)
+	final.SetPlayer(2, losersChampion)
+	assert.True(t, final.EndsGrandFinal(&winnersChampion, &losersChampion))
+	assert.False(t, final.EndsGrandFinal(&losersChampion, &winnersChampion))
+	assert.True(t, final.EndsGrandFinal(&winnersChampion, nil))
+	assert.False(t, matches[6].EndsGrandFinal(&winnersChampion, &losersChampion), "리셋 매치는 결과와 관계없이 끝나야 합니다")
+
+	// 8명: 승자조 7, 패자조 6 (4라운드), 결승 2
+	matches = BuildDoubleElimination([]uint{1, 2, 3, 4, 5, 6, 7, 8})
+	require.Len(t, matches, 15)
+	lastLosersRound := 0
+	for _, match := range matches {
+		if match.Bracket == BracketLosers && match.Round > lastLosersRound {
+			lastLosersRound = match.Round
+		}
+	}
+	assert.Equal(t, 4, lastLosersRound)
+
+	// 2명: 승자조 결승 패자가 바로 최종 결승으로
+	matches = BuildDoubleElimination([]uint{1, 2})
+	require.Len(t, matches, 3)
+	next, _, loserNext, loserSlot = matches[0].BracketLinks()
+	assert.Equal(t, 1, next)
+	assert.Equal(t, 1, loserNext)
+	assert.Equal(t, 2, loserSlot)
+}
+
+// 스위스 대진 테스트 (재대결 회피와 부전승)
+func TestPairSwissRound(t *testing.T) {
+	standings := []SwissStanding{
+		{UserID: 1, Seed: 1, Wins: 2, Opponents: []uint{2, 3}},
+		{UserID: 2, Seed: 2, Wins: 1, Opponents: []uint{1, 4}},
+		{UserID: 3, Seed: 3, Wins: 1, Opponents: []uint{4, 1}},
+		{UserID: 4, Seed: 4, Wins: 1, Opponents: []uint{3, 2}, HadBye: false},
+		{UserID: 5, Seed: 5, Wins: 1, HadBye: true},
+	}
+	SortSwissStandings(standings)
+
+	pairs, bye := PairSwissRound(standings)
+	require.NotNil(t, bye)
+	assert.Equal(t, uint(4), *bye, "부전승을 받은 적 없는 가장 낮은 순위가 부전승")
+	assert.Equal(t, [][2]uint{{1, 5}, {2, 3}}, pairs)
+
+	// 인원이 짝수면 부전승 없음
+	pairs, bye = PairSwissRound(standings[:4])
+	assert.Nil(t, bye)
+	assert.Len(t, pairs, 2)
+}
+
+// 스위스 순위 정렬 테스트 (승수, 상대 승수 합, 시드 순)
+func TestSortSwissStandings(t *testing.T) {
+	standings := []SwissStanding{
+		{UserID: 1, Seed: 1, Wins: 1, Buchholz: 1},
+		{UserID: 2, Seed: 2, Wins: 2, Buchholz: 1},
+		{UserID: 3, Seed: 3, Wins: 1, Buchholz: 2},
+		{UserID: 4, Seed: 4, Wins: 1, Buchholz: 1},
+	}
+	SortSwissStandings(standings)
+	ids := make([]uint, len(standings))
+	for i, standing := range standings {
+		ids[i] = standing.UserID
+	}
+	assert.Equal(t, []uint{2, 3, 1, 4}, ids)
+}
+
+// 순위별 상금 분배 테스트
+func TestDistributePrizes(t *testing.T) {
+	// 1, 2, 3위
+	assert.Equal(t, []int{500, 300, 200, 0}, DistributePrizes(1000, []int{50, 30, 20}, []int{1, 2, 3, 4}))
+
+	// 공동 3위는 3, 4위 비율을 나눔
+	assert.Equal(t, []int{500, 300, 100, 100}, DistributePrizes(1000, []int{50, 30, 20}, []int{1, 2, 3, 3}))
+
+	// 나누고 남은 금액은 1위에게
+	assert.Equal(t, []int{51, 33, 17}, DistributePrizes(101, []int{50, 33, 17}, []int{1, 2, 3}))
+
+	// 상금이 없으면 지급하지 않음
+	assert.Equal(t, []int{0, 0}, DistributePrizes(0, []int{100}, []int{1, 2}))
+}
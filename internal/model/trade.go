@@ -0,0 +1,224 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// 플레이어 간 거래 모델
+// 제안자가 대상자에게 아이템과 골드 교환을 제안하면, 양측이 내놓은 자산은
+// 에스크로에 보관되었다가 양측이 모두 확인하면 한 번에 정산된다.
+type Trade struct {
+	BaseModel
+
+	// 거래 제안자 ID
+	ProposerID uint `json:"proposer_id" gorm:"not null;index"`
+
+	// 거래 대상자 ID
+	TargetID uint `json:"target_id" gorm:"not null;index"`
+
+	// 제안자가 내놓는 골드
+	ProposerGold int `json:"proposer_gold" gorm:"not null;default:0"`
+
+	// 대상자에게 요청하는 골드
+	TargetGold int `json:"target_gold" gorm:"not null;default:0"`
+
+	// 거래 상태 (pending, completed, cancelled, expired)
+	Status TradeStatus `json:"status" gorm:"size:20;not null;default:'pending';index"`
+
+	// 제안자 확인 여부
+	ProposerConfirmed bool `json:"proposer_confirmed" gorm:"not null;default:false"`
+
+	// 대상자 확인 여부
+	TargetConfirmed bool `json:"target_confirmed" gorm:"not null;default:false"`
+
+	// 대상자 자산이 에스크로에 보관되었는지 여부
+	TargetEscrowed bool `json:"target_escrowed" gorm:"not null;default:false"`
+
+	// 거래 메시지
+	Message string `json:"message" gorm:"size:200"`
+
+	// 거래 만료 시간 (이 시간까지 확인되지 않으면 자동 취소)
+	ExpiresAt time.Time `json:"expires_at" gorm:"not null;index"`
+
+	// 정산 완료 시간
+	SettledAt *time.Time `json:"settled_at"`
+
+	// 거래를 취소한 사용자 ID
+	CancelledBy *uint `json:"cancelled_by"`
+
+	// 제안자 측 추정 가치 (골드 환산)
+	ProposerValue int `json:"proposer_value" gorm:"not null;default:0"`
+
+	// 대상자 측 추정 가치 (골드 환산)
+	TargetValue int `json:"target_value" gorm:"not null;default:0"`
+
+	// 의심 거래 여부 (가치 차이가 큰 거래)
+	IsFlagged bool `json:"is_flagged" gorm:"not null;default:false;index"`
+
+	// 의심 거래로 분류된 사유
+	FlagReason string `json:"flag_reason" gorm:"size:200"`
+
+	// 검토 완료 시간
+	ReviewedAt *time.Time `json:"reviewed_at"`
+
+	// 검토한 관리자 ID
+	ReviewedBy *uint `json:"reviewed_by"`
+
+	// 거래 아이템 목록
+	Items []TradeItem `json:"items" gorm:"foreignKey:TradeID"`
+}
+
+// 거래에 포함된 아이템
+// 아이템 정보는 제안 시점의 스냅샷으로 저장되어 에스크로 반환과 정산에 사용된다.
+type TradeItem struct {
+	ID      uint `json:"id" gorm:"primaryKey"`
+	TradeID uint `json:"trade_id" gorm:"not null;index"`
+
+	// 아이템을 내놓는 사용자 ID
+	OwnerID uint `json:"owner_id" gorm:"not null"`
+
+	// 아이템을 꺼내는 인벤토리 ID (대상자 아이템은 확인 시 이 행에서 에스크로)
+	InventoryID uint `json:"inventory_id"`
+
+	ItemID   string `json:"item_id" gorm:"not null;size:50"`
+	ItemName string `json:"item_name" gorm:"not null;size:100"`
+	ItemType string `json:"item_type" gorm:"not null;size:20"`
+	Rarity   string `json:"rarity" gorm:"not null;size:20"`
+	Level    int    `json:"level" gorm:"not null;default:1"`
+	Quantity int    `json:"quantity" gorm:"not null"`
+
+	// 에스크로 보관 여부
+	Escrowed bool `json:"escrowed" gorm:"not null;default:false"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// 거래 상태
+type TradeStatus string
+
+const (
+	TradeStatusPending   TradeStatus = "pending"   // 진행 중
+	TradeStatusCompleted TradeStatus = "completed" // 정산 완료
+	TradeStatusCancelled TradeStatus = "cancelled" // 취소됨
+	TradeStatusExpired   TradeStatus = "expired"   // 만료됨
+)
+
+// Trade 모델의 테이블 이름 반환
+func (Trade) TableName() string {
+	return "trades"
+}
+
+// TradeItem 모델의 테이블 이름 반환
+func (TradeItem) TableName() string {
+	return "trade_items"
+}
+
+// 거래 데이터 유효성 검사
+func (t *Trade) Validate() error {
+	if t.ProposerID == 0 || t.TargetID == 0 {
+		return ErrInvalidUserID
+	}
+	if t.ProposerID == t.TargetID {
+		return ErrTradeSelf
+	}
+	if t.ProposerGold < 0 || t.TargetGold < 0 {
+		return ErrInvalidTradeGold
+	}
+	if t.ProposerGold == 0 && t.TargetGold == 0 && len(t.Items) == 0 {
+		return ErrEmptyTrade
+	}
+	for _, item := range t.Items {
+		if item.Quantity <= 0 {
+			return ErrInvalidQuantity
+		}
+		if item.OwnerID != t.ProposerID && item.OwnerID != t.TargetID {
+			return ErrInvalidUserID
+		}
+	}
+	return nil
+}
+
+// 사용자가 거래 당사자인지 확인
+func (t *Trade) IsParticipant(userID uint) bool {
+	return t.ProposerID == userID || t.TargetID == userID
+}
+
+// 거래가 진행 중인지 확인
+func (t *Trade) IsPending() bool {
+	return t.Status == TradeStatusPending
+}
+
+// 거래가 만료 시간을 지났는지 확인
+func (t *Trade) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// 양측이 모두 확인했는지 확인
+func (t *Trade) IsFullyConfirmed() bool {
+	return t.ProposerConfirmed && t.TargetConfirmed
+}
+
+// 특정 사용자가 내놓은 아이템 목록 반환
+func (t *Trade) ItemsOf(ownerID uint) []TradeItem {
+	items := make([]TradeItem, 0)
+	for _, item := range t.Items {
+		if item.OwnerID == ownerID {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// 특정 사용자 측의 추정 가치를 계산 (골드 + 아이템 가치)
+func (t *Trade) ValueOf(ownerID uint) int {
+	value := 0
+	if ownerID == t.ProposerID {
+		value += t.ProposerGold
+	} else if ownerID == t.TargetID {
+		value += t.TargetGold
+	}
+	for _, item := range t.ItemsOf(ownerID) {
+		value += item.EstimatedValue()
+	}
+	return value
+}
+
+// 거래 상대방 ID 반환
+func (t *Trade) CounterpartOf(userID uint) uint {
+	if userID == t.ProposerID {
+		return t.TargetID
+	}
+	return t.ProposerID
+}
+
+// 거래 아이템의 추정 가치 (골드 환산)
+func (i *TradeItem) EstimatedValue() int {
+	return EstimateItemValue(i.Rarity, i.Level, i.Quantity)
+}
+
+// 거래 아이템을 인벤토리 형태로 변환 (정산 및 반환 시 사용)
+func (i *TradeItem) ToInventory(userID uint) *Inventory {
+	return &Inventory{
+		UserID:   userID,
+		ItemID:   i.ItemID,
+		ItemName: i.ItemName,
+		ItemType: i.ItemType,
+		Rarity:   i.Rarity,
+		Level:    i.Level,
+		Quantity: i.Quantity,
+	}
+}
+
+// 에러 정의
+var (
+	ErrTradeNotFound       = errors.New("거래를 찾을 수 없습니다")
+	ErrTradeSelf           = errors.New("자기 자신과는 거래할 수 없습니다")
+	ErrInvalidTradeGold    = errors.New("거래 골드가 유효하지 않습니다")
+	ErrEmptyTrade          = errors.New("거래할 자산이 없습니다")
+	ErrTradeNotPending     = errors.New("진행 중인 거래가 아닙니다")
+	ErrTradeExpired        = errors.New("만료된 거래입니다")
+	ErrNotTradeParticipant = errors.New("거래 당사자가 아닙니다")
+	ErrItemNotTradeable    = errors.New("거래할 수 없는 아이템입니다")
+	ErrAlreadyConfirmed    = errors.New("이미 확인한 거래입니다")
+)
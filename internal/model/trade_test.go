@@ -0,0 +1,125 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// 거래 유효성 검사 테스트
+func TestTrade_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		trade       *Trade
+		expectedErr error
+	}{
+		{
+			name: "정상적인 거래",
+			trade: &Trade{
+				ProposerID:   1,
+				TargetID:     2,
+				ProposerGold: 100,
+				Items: []TradeItem{
+					{OwnerID: 2, ItemID: "sword_001", Quantity: 1},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "자기 자신과 거래",
+			trade:       &Trade{ProposerID: 1, TargetID: 1, ProposerGold: 100},
+			expectedErr: ErrTradeSelf,
+		},
+		{
+			name:        "음수 골드",
+			trade:       &Trade{ProposerID: 1, TargetID: 2, ProposerGold: -1},
+			expectedErr: ErrInvalidTradeGold,
+		},
+		{
+			name:        "빈 거래",
+			trade:       &Trade{ProposerID: 1, TargetID: 2},
+			expectedErr: ErrEmptyTrade,
+		},
+		{
+			name: "당사자가 아닌 아이템 소유자",
+			trade: &Trade{
+				ProposerID: 1,
+				TargetID:   2,
+				Items: []TradeItem{
+					{OwnerID: 3, ItemID: "sword_001", Quantity: 1},
+				},
+			},
+			expectedErr: ErrInvalidUserID,
+		},
+		{
+			name: "수량이 0인 아이템",
+			trade: &Trade{
+				ProposerID: 1,
+				TargetID:   2,
+				Items: []TradeItem{
+					{OwnerID: 1, ItemID: "sword_001", Quantity: 0},
+				},
+			},
+			expectedErr: ErrInvalidQuantity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.trade.Validate()
+			assert.Equal(t, tt.expectedErr, err, "유효성 검사 결과가 일치해야 합니다")
+		})
+	}
+}
+
+// 거래 당사자 및 상태 확인 테스트
+func TestTrade_StateHelpers(t *testing.T) {
+	now := time.Now()
+	trade := &Trade{
+		ProposerID: 1,
+		TargetID:   2,
+		Status:     TradeStatusPending,
+		ExpiresAt:  now.Add(time.Minute),
+	}
+
+	assert.True(t, trade.IsParticipant(1), "제안자는 당사자여야 합니다")
+	assert.True(t, trade.IsParticipant(2), "대상자는 당사자여야 합니다")
+	assert.False(t, trade.IsParticipant(3), "제3자는 당사자가 아니어야 합니다")
+	assert.Equal(t, uint(2), trade.CounterpartOf(1), "제안자의 상대방은 대상자여야 합니다")
+	assert.Equal(t, uint(1), trade.CounterpartOf(2), "대상자의 상대방은 제안자여야 합니다")
+
+	assert.True(t, trade.IsPending(), "진행 중 상태여야 합니다")
+	assert.False(t, trade.IsExpired(now), "만료 시간 이전에는 만료되지 않아야 합니다")
+	assert.True(t, trade.IsExpired(now.Add(2*time.Minute)), "만료 시간 이후에는 만료되어야 합니다")
+
+	assert.False(t, trade.IsFullyConfirmed(), "확인 전에는 완료되지 않아야 합니다")
+	trade.ProposerConfirmed = true
+	trade.TargetConfirmed = true
+	assert.True(t, trade.IsFullyConfirmed(), "양측 확인 후에는 완료되어야 합니다")
+}
+
+// 거래 측별 가치 계산 테스트
+func TestTrade_ValueOf(t *testing.T) {
+	trade := &Trade{
+		ProposerID:   1,
+		TargetID:     2,
+		ProposerGold: 500,
+		TargetGold:   0,
+		Items: []TradeItem{
+			{OwnerID: 1, ItemID: "potion", Rarity: "common", Level: 1, Quantity: 5},
+			{OwnerID: 2, ItemID: "sword", Rarity: "legendary", Level: 2, Quantity: 1},
+		},
+	}
+
+	assert.Equal(t, 550, trade.ValueOf(1), "제안자 측 가치는 골드 + 아이템 가치여야 합니다")
+	assert.Equal(t, 20000, trade.ValueOf(2), "대상자 측 가치는 아이템 가치여야 합니다")
+	assert.Len(t, trade.ItemsOf(1), 1, "제안자 아이템은 1개여야 합니다")
+}
+
+// 아이템 추정 가치 계산 테스트
+func TestEstimateItemValue(t *testing.T) {
+	assert.Equal(t, 10, EstimateItemValue("common", 1, 1))
+	assert.Equal(t, 300, EstimateItemValue("rare", 3, 1))
+	assert.Equal(t, 2000, EstimateItemValue("epic", 1, 2))
+	assert.Equal(t, 10, EstimateItemValue("unknown", 0, 1), "알 수 없는 등급과 레벨은 기본값을 사용해야 합니다")
+}
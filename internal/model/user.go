@@ -94,6 +94,9 @@ type User struct {
 
 	// 비밀번호 재설정 토큰 만료 시간
 	PasswordResetExpiresAt *time.Time `json:"-"`
+
+	// 섀도우 밴 여부 (제출한 점수가 본인 모르게 검토 대기로 격리됨)
+	ShadowBanned bool `json:"-" gorm:"default:false;not null"`
 }
 
 // 사용자 계정 상태
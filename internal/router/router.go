@@ -4,6 +4,7 @@ import (
 	"g_dev/internal/auth"
 	"g_dev/internal/handler"
 	"g_dev/internal/middleware"
+	"github.com/gin-gonic/gin"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"net/http"
 )
@@ -14,6 +15,33 @@ type Router struct {
 	APIHandler  *handler.APIHandler
 	AuthHandler *handler.AuthHandler
 
+	// 게임 도메인 핸들러들 (gin 기반, 설정된 핸들러만 라우트 등록)
+	TradeHandler            *handler.TradeHandler
+	MarketHandler           *handler.MarketHandler
+	CraftingHandler         *handler.CraftingHandler
+	EnhancementHandler      *handler.EnhancementHandler
+	GachaHandler            *handler.GachaHandler
+	InventoryHistoryHandler *handler.InventoryHistoryHandler
+	ItemEffectHandler       *handler.ItemEffectHandler
+	GameHandler             *handler.GameHandler
+	GameSessionHandler      *handler.GameSessionHandler
+	ScoreReviewHandler      *handler.ScoreReviewHandler
+	LeaderboardHandler      *handler.LeaderboardHandler
+	FriendHandler           *handler.FriendHandler
+	SeasonHandler           *handler.SeasonHandler
+	AchievementHandler      *handler.AchievementHandler
+	MissionHandler          *handler.MissionHandler
+	BattlePassHandler       *handler.BattlePassHandler
+	GameReviewHandler       *handler.GameReviewHandler
+	RecommendationHandler   *handler.RecommendationHandler
+	MatchmakingHandler      *handler.MatchmakingHandler
+	SkillRatingHandler      *handler.SkillRatingHandler
+	TournamentHandler       *handler.TournamentHandler
+	RoomHandler             *handler.RoomHandler
+
+	// 게임 도메인 API를 처리하는 gin 엔진
+	engine *gin.Engine
+
 	// 인증 시스템
 	JWTAuth *auth.JWTAuth
 
@@ -44,6 +72,9 @@ func (r *Router) SetupRoutes() {
 
 	// 보호된 API 라우트
 	r.setupProtectedRoutes()
+
+	// 게임 도메인 API 라우트
+	r.setupGameRoutes()
 }
 
 // Swagger 문서 라우트 설정
@@ -106,6 +137,376 @@ func (r *Router) setupProtectedRoutes() {
 	}
 }
 
+// 게임 도메인 API 라우트 설정
+// gin 엔진에 라우트를 등록하고, 경로 접두사별로 인증 미들웨어를 적용하여 마운트
+func (r *Router) setupGameRoutes() {
+	gin.SetMode(gin.ReleaseMode)
+	r.engine = gin.New()
+	r.engine.Use(gin.Recovery())
+
+	api := r.engine.Group("/api")
+	admin := api.Group("/admin")
+
+	// 거래 API
+	if r.TradeHandler != nil {
+		trades := api.Group("/trades")
+		{
+			trades.POST("", r.TradeHandler.ProposeTrade)
+			trades.GET("", r.TradeHandler.GetTradeHistory)
+			trades.GET("/:id", r.TradeHandler.GetTrade)
+			trades.POST("/:id/confirm", r.TradeHandler.ConfirmTrade)
+			trades.POST("/:id/cancel", r.TradeHandler.CancelTrade)
+		}
+		adminTrades := admin.Group("/trades")
+		{
+			adminTrades.GET("/flagged", r.TradeHandler.GetFlaggedTrades)
+			adminTrades.POST("/:id/review", r.TradeHandler.ReviewTrade)
+		}
+		r.mountProtected("/api/trades")
+		r.mountAdmin("/api/admin/trades")
+	}
+
+	// 거래소 API
+	if r.MarketHandler != nil {
+		market := api.Group("/market")
+		{
+			market.GET("/listings", r.MarketHandler.SearchListings)
+			market.POST("/listings", r.MarketHandler.CreateListing)
+			market.GET("/listings/:id", r.MarketHandler.GetListing)
+			market.POST("/listings/:id/buy", r.MarketHandler.BuyListing)
+			market.POST("/listings/:id/bid", r.MarketHandler.PlaceBid)
+			market.POST("/listings/:id/cancel", r.MarketHandler.CancelListing)
+			market.GET("/me/listings", r.MarketHandler.GetMyListings)
+			market.GET("/prices/:item_id", r.MarketHandler.GetPriceHistory)
+		}
+		r.mountProtected("/api/market")
+	}
+
+	// 제작 API
+	if r.CraftingHandler != nil {
+		crafting := api.Group("/crafting")
+		{
+			crafting.GET("/recipes", r.CraftingHandler.GetRecipes)
+			crafting.POST("/recipes/discover", r.CraftingHandler.DiscoverRecipes)
+			crafting.POST("/recipes/:id/craft", r.CraftingHandler.Craft)
+		}
+		adminRecipes := admin.Group("/recipes")
+		{
+			adminRecipes.GET("", r.CraftingHandler.AdminListRecipes)
+			adminRecipes.POST("", r.CraftingHandler.AdminCreateRecipe)
+			adminRecipes.PUT("/:id", r.CraftingHandler.AdminUpdateRecipe)
+			adminRecipes.DELETE("/:id", r.CraftingHandler.AdminDeleteRecipe)
+			adminRecipes.POST("/:id/unlock", r.CraftingHandler.AdminUnlockRecipe)
+		}
+		r.mountProtected("/api/crafting")
+		r.mountAdmin("/api/admin/recipes")
+	}
+
+	// 강화 API
+	if r.EnhancementHandler != nil {
+		enhancement := api.Group("/enhancement")
+		{
+			enhancement.GET("/items/:id", r.EnhancementHandler.GetPreview)
+			enhancement.POST("/items/:id/enhance", r.EnhancementHandler.Enhance)
+			enhancement.GET("/logs", r.EnhancementHandler.GetMyLogs)
+		}
+		admin.GET("/enhancement/logs", r.EnhancementHandler.AdminGetLogs)
+		r.mountProtected("/api/enhancement")
+		r.mountAdmin("/api/admin/enhancement")
+	}
+
+	// 가챠 API (배너 목록과 드롭 확률은 공개)
+	if r.GachaHandler != nil {
+		gacha := api.Group("/gacha")
+		{
+			gacha.GET("/banners", r.GachaHandler.GetBanners)
+			gacha.GET("/banners/:id/rates", r.GachaHandler.GetRates)
+			gacha.POST("/banners/:id/pull", r.GachaHandler.Pull)
+			gacha.GET("/banners/:id/pity", r.GachaHandler.GetPity)
+			gacha.GET("/history", r.GachaHandler.GetMyHistory)
+		}
+		adminGacha := admin.Group("/gacha")
+		{
+			adminGacha.POST("/banners", r.GachaHandler.AdminCreateBanner)
+			adminGacha.POST("/banners/:id/active", r.GachaHandler.AdminSetBannerActive)
+			adminGacha.GET("/pulls", r.GachaHandler.AdminGetPulls)
+		}
+		r.mountPublic("/api/gacha")
+		r.mountAdmin("/api/admin/gacha")
+	}
+
+	// 인벤토리 변경 기록 API
+	if r.InventoryHistoryHandler != nil {
+		api.GET("/inventory/history", r.InventoryHistoryHandler.GetMyHistory)
+		adminInventory := admin.Group("/inventory")
+		{
+			adminInventory.GET("/history", r.InventoryHistoryHandler.AdminGetHistory)
+			adminInventory.POST("/history/:id/revert", r.InventoryHistoryHandler.AdminRevertChange)
+			adminInventory.POST("/items/:id/restore", r.InventoryHistoryHandler.AdminRestoreItem)
+		}
+		r.mountProtected("/api/inventory")
+		r.mountAdmin("/api/admin/inventory")
+	}
+
+	// 소비 아이템 API
+	if r.ItemEffectHandler != nil {
+		items := api.Group("/items")
+		{
+			items.GET("/buffs", r.ItemEffectHandler.GetMyBuffs)
+			items.GET("/:item_id/effects", r.ItemEffectHandler.GetItemEffects)
+			items.POST("/:item_id/use", r.ItemEffectHandler.UseItem)
+		}
+		admin.PUT("/items/:item_id/effects", r.ItemEffectHandler.AdminSetItemEffects)
+		r.mountProtected("/api/items")
+		r.mountAdmin("/api/admin/items")
+	}
+
+	// 게임 카탈로그 API (목록과 상세 정보는 공개)
+	if r.GameHandler != nil {
+		games := api.Group("/games")
+		{
+			games.GET("", r.GameHandler.SearchGames)
+			games.GET("/tags", r.GameHandler.GetTagCloud)
+			games.GET("/trending", r.GameHandler.GetTrending)
+			games.GET("/:id", r.GameHandler.GetGame)
+		}
+		adminGames := admin.Group("/games")
+		{
+			adminGames.POST("", r.GameHandler.AdminCreateGame)
+			adminGames.PUT("/:id", r.GameHandler.AdminUpdateGame)
+			adminGames.POST("/:id/status", r.GameHandler.AdminSetGameStatus)
+		}
+		r.mountPublic("/api/games")
+		r.mountAdmin("/api/admin/games")
+	}
+
+	// 개인화 게임 추천 API (카탈로그 경로 아래에 있지만 인증 필요)
+	if r.RecommendationHandler != nil {
+		api.GET("/games/recommended", r.RecommendationHandler.GetRecommendations)
+		r.mountProtected("/api/games/recommended")
+	}
+
+	// 게임 세션 API (점수는 세션 종료 시에만 제출)
+	if r.GameSessionHandler != nil {
+		sessions := api.Group("/sessions")
+		{
+			sessions.POST("", r.GameSessionHandler.StartSession)
+			sessions.POST("/events", r.GameSessionHandler.RecordEvent)
+			sessions.POST("/end", r.GameSessionHandler.EndSession)
+		}
+		r.mountProtected("/api/sessions")
+	}
+
+	// 매치메이킹 API (매치가 만들어지면 참가자 전원의 게임 세션이 생성됨)
+	if r.MatchmakingHandler != nil {
+		matchmaking := api.Group("/matchmaking")
+		{
+			matchmaking.POST("/queue", r.MatchmakingHandler.JoinQueue)
+			matchmaking.DELETE("/queue", r.MatchmakingHandler.LeaveQueue)
+			matchmaking.GET("/status", r.MatchmakingHandler.GetStatus)
+		}
+		r.mountProtected("/api/matchmaking")
+	}
+
+	// 스킬 레이팅 API (순위는 공개, 내 레이팅과 기록은 핸들러에서 인증 확인)
+	if r.SkillRatingHandler != nil {
+		ratings := api.Group("/ratings")
+		{
+			ratings.GET("/:game_id", r.SkillRatingHandler.GetRankings)
+			ratings.GET("/:game_id/me", r.SkillRatingHandler.GetMyRating)
+			ratings.GET("/:game_id/history", r.SkillRatingHandler.GetMyHistory)
+		}
+		r.mountPublic("/api/ratings")
+	}
+
+	// 토너먼트 API (조회는 공개, 참가 신청과 결과 보고는 핸들러에서 인증 확인)
+	if r.TournamentHandler != nil {
+		tournaments := api.Group("/tournaments")
+		{
+			tournaments.GET("", r.TournamentHandler.GetTournaments)
+			tournaments.GET("/:id", r.TournamentHandler.GetTournament)
+			tournaments.GET("/:id/bracket", r.TournamentHandler.GetBracket)
+			tournaments.POST("/:id/register", r.TournamentHandler.Register)
+			tournaments.DELETE("/:id/register", r.TournamentHandler.Withdraw)
+			tournaments.POST("/:id/matches/:match_id/report", r.TournamentHandler.ReportResult)
+			tournaments.POST("/:id/matches/:match_id/dispute", r.TournamentHandler.DisputeResult)
+		}
+		adminTournaments := admin.Group("/tournaments")
+		{
+			adminTournaments.POST("", r.TournamentHandler.AdminCreateTournament)
+			adminTournaments.POST("/:id/start", r.TournamentHandler.AdminStartTournament)
+			adminTournaments.POST("/:id/cancel", r.TournamentHandler.AdminCancelTournament)
+			adminTournaments.POST("/:id/matches/:match_id/result", r.TournamentHandler.AdminSetMatchResult)
+			adminTournaments.GET("/disputes", r.TournamentHandler.AdminGetDisputes)
+			adminTournaments.POST("/disputes/:id/resolve", r.TournamentHandler.AdminResolveDispute)
+		}
+		r.mountPublic("/api/tournaments")
+		r.mountAdmin("/api/admin/tournaments")
+	}
+
+	// 실시간 룸 웹소켓 (브라우저는 헤더를 설정할 수 없어 핸들러가 쿼리 파라미터 토큰도 확인)
+	if r.RoomHandler != nil {
+		r.engine.GET("/ws/rooms", r.RoomHandler.Connect)
+		r.mount("/ws/rooms", middleware.SimpleLoggingMiddleware(r.engine))
+	}
+
+	// 점수 검토 API (부정행위 의심 점수 검토와 섀도우 밴)
+	if r.ScoreReviewHandler != nil {
+		adminScores := admin.Group("/scores")
+		{
+			adminScores.GET("/review", r.ScoreReviewHandler.AdminGetReviewQueue)
+			adminScores.POST("/:id/approve", r.ScoreReviewHandler.AdminApproveScore)
+			adminScores.POST("/:id/reject", r.ScoreReviewHandler.AdminRejectScore)
+		}
+		admin.PUT("/users/:id/shadow-ban", r.ScoreReviewHandler.AdminSetShadowBan)
+		r.mountAdmin("/api/admin/scores")
+		r.mountAdmin("/api/admin/users")
+	}
+
+	// 리더보드 API (순위 조회는 공개, 내 순위와 친구 순위는 핸들러에서 인증 확인)
+	if r.LeaderboardHandler != nil {
+		leaderboards := api.Group("/leaderboards")
+		{
+			leaderboards.GET("/:game_id", r.LeaderboardHandler.GetTop)
+			leaderboards.GET("/:game_id/me", r.LeaderboardHandler.GetMyRank)
+			leaderboards.GET("/:game_id/friends", r.LeaderboardHandler.GetFriends)
+		}
+		admin.POST("/leaderboards/rebuild", r.LeaderboardHandler.AdminRebuild)
+		r.mountPublic("/api/leaderboards")
+		r.mountAdmin("/api/admin/leaderboards")
+	}
+
+	// 친구 API
+	if r.FriendHandler != nil {
+		friends := api.Group("/friends")
+		{
+			friends.GET("", r.FriendHandler.GetFriends)
+			friends.GET("/requests", r.FriendHandler.GetPendingRequests)
+			friends.POST("/:user_id", r.FriendHandler.SendRequest)
+			friends.DELETE("/:user_id", r.FriendHandler.RemoveFriend)
+		}
+		r.mountProtected("/api/friends")
+	}
+
+	// 시즌 API (조회는 공개, 내 기록은 핸들러에서 인증 확인)
+	if r.SeasonHandler != nil {
+		seasons := api.Group("/seasons")
+		{
+			seasons.GET("", r.SeasonHandler.GetSeasons)
+			seasons.GET("/history", r.SeasonHandler.GetHistory)
+			seasons.GET("/:id", r.SeasonHandler.GetSeason)
+			seasons.GET("/:id/standings", r.SeasonHandler.GetStandings)
+		}
+		adminSeasons := admin.Group("/seasons")
+		{
+			adminSeasons.POST("", r.SeasonHandler.AdminCreateSeason)
+			adminSeasons.POST("/:id/close", r.SeasonHandler.AdminCloseSeason)
+		}
+		r.mountPublic("/api/seasons")
+		r.mountAdmin("/api/admin/seasons")
+	}
+
+	// 업적 API (목록은 공개, 내 진행 상황은 핸들러에서 인증 확인)
+	if r.AchievementHandler != nil {
+		achievements := api.Group("/achievements")
+		{
+			achievements.GET("", r.AchievementHandler.GetAchievements)
+			achievements.GET("/progress", r.AchievementHandler.GetProgress)
+		}
+		adminAchievements := admin.Group("/achievements")
+		{
+			adminAchievements.GET("", r.AchievementHandler.AdminListAchievements)
+			adminAchievements.POST("", r.AchievementHandler.AdminCreateAchievement)
+			adminAchievements.PUT("/:id", r.AchievementHandler.AdminUpdateAchievement)
+			adminAchievements.DELETE("/:id", r.AchievementHandler.AdminDeleteAchievement)
+		}
+		r.mountPublic("/api/achievements")
+		r.mountAdmin("/api/admin/achievements")
+	}
+
+	// 미션 API
+	if r.MissionHandler != nil {
+		missions := api.Group("/missions")
+		{
+			missions.GET("", r.MissionHandler.GetMissions)
+			missions.POST("/:id/claim", r.MissionHandler.ClaimReward)
+		}
+		adminMissions := admin.Group("/missions")
+		{
+			adminMissions.GET("", r.MissionHandler.AdminListMissions)
+			adminMissions.POST("", r.MissionHandler.AdminCreateMission)
+			adminMissions.PUT("/:id", r.MissionHandler.AdminUpdateMission)
+			adminMissions.DELETE("/:id", r.MissionHandler.AdminDeleteMission)
+		}
+		r.mountProtected("/api/missions")
+		r.mountAdmin("/api/admin/missions")
+	}
+
+	// 배틀패스 API
+	if r.BattlePassHandler != nil {
+		battlePass := api.Group("/battle-pass")
+		{
+			battlePass.GET("", r.BattlePassHandler.GetCurrent)
+			battlePass.GET("/:id", r.BattlePassHandler.GetProgress)
+			battlePass.POST("/:id/premium", r.BattlePassHandler.UnlockPremium)
+			battlePass.POST("/:id/skip", r.BattlePassHandler.SkipTiers)
+			battlePass.POST("/:id/claim", r.BattlePassHandler.ClaimReward)
+			battlePass.POST("/:id/claim-all", r.BattlePassHandler.ClaimAll)
+		}
+		adminBattlePasses := admin.Group("/battle-passes")
+		{
+			adminBattlePasses.GET("", r.BattlePassHandler.AdminListPasses)
+			adminBattlePasses.POST("", r.BattlePassHandler.AdminCreatePass)
+		}
+		r.mountProtected("/api/battle-pass")
+		r.mountAdmin("/api/admin/battle-passes")
+	}
+
+	// 게임 리뷰 API (목록은 공개, 작성/투표/신고는 핸들러에서 인증 확인)
+	if r.GameReviewHandler != nil {
+		reviews := api.Group("/reviews")
+		{
+			reviews.GET("", r.GameReviewHandler.GetReviews)
+			reviews.GET("/me", r.GameReviewHandler.GetMyReview)
+			reviews.PUT("", r.GameReviewHandler.SaveReview)
+			reviews.DELETE("/:id", r.GameReviewHandler.DeleteReview)
+			reviews.POST("/:id/helpful", r.GameReviewHandler.MarkHelpful)
+			reviews.POST("/:id/report", r.GameReviewHandler.ReportReview)
+		}
+		adminReviews := admin.Group("/reviews")
+		{
+			adminReviews.GET("", r.GameReviewHandler.AdminListReviews)
+			adminReviews.POST("/:id/hide", r.GameReviewHandler.AdminHideReview)
+			adminReviews.POST("/:id/restore", r.GameReviewHandler.AdminRestoreReview)
+		}
+		r.mountPublic("/api/reviews")
+		r.mountAdmin("/api/admin/reviews")
+	}
+}
+
+// 인증 없이 접근 가능한 게임 API 경로를 마운트
+// 토큰이 있으면 사용자 정보를 컨텍스트에 저장하므로, 핸들러에서 인증 여부를 직접 확인할 수 있다.
+func (r *Router) mountPublic(prefix string) {
+	r.mount(prefix, middleware.SimpleLoggingMiddleware(middleware.OptionalAuth(r.JWTAuth)(r.engine)))
+}
+
+// JWT 인증이 필요한 게임 API 경로를 마운트
+func (r *Router) mountProtected(prefix string) {
+	r.mount(prefix, middleware.SimpleLoggingMiddleware(middleware.RequireAuth(r.JWTAuth)(r.engine)))
+}
+
+// 관리자/중재자 권한이 필요한 게임 API 경로를 마운트
+func (r *Router) mountAdmin(prefix string) {
+	staffOnly := middleware.RequireAnyRole(r.JWTAuth, "admin", "moderator")(r.engine)
+	r.mount(prefix, middleware.SimpleLoggingMiddleware(middleware.RequireAuth(r.JWTAuth)(staffOnly)))
+}
+
+// 접두사 경로와 하위 경로를 모두 같은 핸들러로 등록
+func (r *Router) mount(prefix string, h http.Handler) {
+	http.Handle(prefix, h)
+	http.Handle(prefix+"/", h)
+}
+
 func (r *Router) homeHandler(w http.ResponseWriter, req *http.Request) {
 	html := `<!DOCTYPE html>
 <html>
@@ -201,6 +602,358 @@ func (r *Router) homeHandler(w http.ResponseWriter, req *http.Request) {
             </div>
         </div>
 
+        <div class="section">
+            <h2>거래 API <span class="auth-required">(인증 필요)</span></h2>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/trades</span>
+                <div class="description">거래 제안 (제안자 자산 에스크로)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/trades</span>
+                <div class="description">거래 내역 조회</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/trades/{id}/confirm</span>
+                <div class="description">거래 확인 (양측 확인 시 정산)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/trades/{id}/cancel</span>
+                <div class="description">거래 취소 (에스크로 반환)</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>거래소 API <span class="auth-required">(인증 필요)</span></h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/market/listings</span>
+                <div class="description">판매 물품 검색 (타입, 희귀도, 레벨)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/market/listings</span>
+                <div class="description">판매 등록 (고정가/경매, 등록 수수료 차감)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/market/listings/{id}/buy</span>
+                <div class="description">즉시 구매</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/market/listings/{id}/bid</span>
+                <div class="description">경매 입찰 (골드 에스크로)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/market/prices/{item_id}</span>
+                <div class="description">아이템 가격 이력 조회</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>제작 API <span class="auth-required">(인증 필요)</span></h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/crafting/recipes</span>
+                <div class="description">사용 가능한 레시피 목록 조회</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/crafting/recipes/discover</span>
+                <div class="description">숨김 레시피 발견</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/crafting/recipes/{id}/craft</span>
+                <div class="description">아이템 제작 (재료/골드 소모)</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>강화 API <span class="auth-required">(인증 필요)</span></h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/enhancement/items/{id}</span>
+                <div class="description">강화 비용 및 성공 확률 조회</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/enhancement/items/{id}/enhance</span>
+                <div class="description">아이템 강화 (보호 주문서 선택 사용)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/enhancement/logs</span>
+                <div class="description">강화 기록 조회</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>가챠 API</h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/gacha/banners</span>
+                <div class="description">진행 중인 배너 목록 (공개)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/gacha/banners/{id}/rates</span>
+                <div class="description">드롭 확률 공시 (공개)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/gacha/banners/{id}/pull</span>
+                <div class="description">1회/10연차 뽑기 <span class="auth-required">(인증 필요)</span></div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/gacha/history</span>
+                <div class="description">뽑기 기록 조회 <span class="auth-required">(인증 필요)</span></div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>인벤토리 기록 API <span class="auth-required">(인증 필요)</span></h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/inventory/history</span>
+                <div class="description">아이템 획득/소모 기록 조회</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>소비 아이템 API <span class="auth-required">(인증 필요)</span></h2>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/items/{item_id}/use</span>
+                <div class="description">아이템 사용 (경험치/골드 지급, 버프, 상자 열기)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/items/{item_id}/effects</span>
+                <div class="description">아이템 사용 효과 조회</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/items/buffs</span>
+                <div class="description">적용 중인 버프 조회</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>게임 카탈로그 API <span class="public">(공개)</span></h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/games</span>
+                <div class="description">게임 목록 검색 (카테고리/난이도/태그/언어/플랫폼/무료/할인, 인기/트렌딩/평점/출시일 정렬)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/games/tags</span>
+                <div class="description">태그 클라우드 (태그별 게임 수)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/games/trending</span>
+                <div class="description">지금 뜨는 게임 (최근 활동을 시간 감쇠로 합산한 트렌딩 점수 순)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/games/recommended</span>
+                <div class="description">플레이 기록 기반 추천 게임 (기록이 없으면 인기 게임, 인증 필요)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/games/{id}</span>
+                <div class="description">게임 상세 정보</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>게임 세션 API <span class="auth-required">(인증 필요)</span></h2>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/sessions</span>
+                <div class="description">게임 세션 시작 (서명된 세션 토큰 발급)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/sessions/events</span>
+                <div class="description">하트비트/일시정지/재개/저장/불러오기/재시작 이벤트 기록</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/sessions/end</span>
+                <div class="description">세션 종료 및 점수 제출 (보상 지급)</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>매치메이킹 API <span class="auth-required">(인증 필요)</span></h2>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/matchmaking/queue</span>
+                <div class="description">게임/모드별 대기열 참가 (친구 파티 가능, 대기 시간에 따라 허용 레이팅 차이 확대)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">DELETE</span> <span class="url">/api/matchmaking/queue</span>
+                <div class="description">매치메이킹 취소 (파티 전체)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/matchmaking/status</span>
+                <div class="description">대기 상태 조회 (매칭되면 매치 정보와 게임 세션 토큰)</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>스킬 레이팅 API</h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/ratings/{game_id}</span>
+                <div class="description">스킬 레이팅 순위 (tier 필터, 배치 중인 플레이어 제외)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/ratings/{game_id}/me</span> <span class="auth-required">(인증 필요)</span>
+                <div class="description">내 레이팅, 편차, 랭크 티어 (bronze~master)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/ratings/{game_id}/history</span> <span class="auth-required">(인증 필요)</span>
+                <div class="description">매치별 레이팅 변동 기록</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>토너먼트 API</h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/tournaments</span>
+                <div class="description">토너먼트 목록 (game_id, status 필터)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/tournaments/{id}</span>
+                <div class="description">토너먼트 상세 (방식, 참가 신청 기간, 참가비, 상금)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/tournaments/{id}/bracket</span>
+                <div class="description">참가자 시드와 대진표</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/tournaments/{id}/register</span> <span class="auth-required">(인증 필요)</span>
+                <div class="description">참가 신청 (참가비 차감)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">DELETE</span> <span class="url">/api/tournaments/{id}/register</span> <span class="auth-required">(인증 필요)</span>
+                <div class="description">참가 취소 (시작 전, 참가비 환불)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/tournaments/{id}/matches/{match_id}/report</span> <span class="auth-required">(인증 필요)</span>
+                <div class="description">매치 결과 보고 (양쪽 보고가 일치하면 확정)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/tournaments/{id}/matches/{match_id}/dispute</span> <span class="auth-required">(인증 필요)</span>
+                <div class="description">상대가 보고한 결과에 이의 제기</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>실시간 룸 API</h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/ws/rooms?token={access_token}</span> <span class="auth-required">(인증 필요)</span>
+                <div class="description">웹소켓 룸 게이트웨이 (create, join, leave, ready, relay, input, ping 메시지, 재접속 유예 중 자리 유지)</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>리더보드 API</h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/leaderboards/{game_id}</span>
+                <div class="description">상위 순위 (difficulty, window=all/daily/weekly/monthly/season, limit)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/leaderboards/{game_id}/me</span> <span class="auth-required">(인증 필요)</span>
+                <div class="description">내 순위와 앞뒤 k명</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/leaderboards/{game_id}/friends</span> <span class="auth-required">(인증 필요)</span>
+                <div class="description">친구 리더보드</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>친구 API <span class="auth-required">(인증 필요)</span></h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/friends</span>
+                <div class="description">친구 목록</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/friends/requests</span>
+                <div class="description">받은 친구 요청</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/friends/{user_id}</span>
+                <div class="description">친구 요청 보내기/받은 요청 수락</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">DELETE</span> <span class="url">/api/friends/{user_id}</span>
+                <div class="description">친구 삭제 (요청 취소/거절 포함)</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>시즌 API</h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/seasons</span>
+                <div class="description">시즌 목록 (game_id)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/seasons/{id}</span>
+                <div class="description">시즌 기간과 보상 구간</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/seasons/{id}/standings</span>
+                <div class="description">시즌 최종 순위 (difficulty, limit, offset)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/seasons/history</span>
+                <div class="description">플레이어별 지난 시즌 기록 (user_id가 없으면 내 기록, 인증 필요)</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>업적 API</h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/achievements</span>
+                <div class="description">업적 목록과 달성률</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/achievements/progress</span>
+                <div class="description">달성한 업적과 진행 중인 업적 (user_id가 없으면 내 업적, 인증 필요)</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>미션 API (인증 필요)</h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/missions</span>
+                <div class="description">오늘/이번 주 미션과 이벤트 퀘스트 진행도 (현지 자정 기준 초기화)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/missions/{id}/claim</span>
+                <div class="description">완료한 미션 보상 수령</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>배틀패스 API (인증 필요)</h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/battle-pass</span>
+                <div class="description">진행 중인 패스의 티어와 무료/프리미엄 트랙 수령 상태</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/battle-pass/{id}/premium</span>
+                <div class="description">프리미엄 패스 구매 (도달한 티어 보상 소급 지급)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/battle-pass/{id}/skip</span>
+                <div class="description">다이아몬드로 티어 건너뛰기</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/battle-pass/{id}/claim</span>
+                <div class="description">티어 보상 수령 (claim-all로 모두 수령)</div>
+            </div>
+        </div>
+
+        <div class="section">
+            <h2>게임 리뷰 API</h2>
+            <div class="endpoint">
+                <span class="method">GET</span> <span class="url">/api/reviews?game_id={id}&amp;sort=helpful</span>
+                <div class="description">게임 리뷰 목록 (최근 순 또는 도움 순)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">PUT</span> <span class="url">/api/reviews</span>
+                <div class="description">평점(1~5)과 리뷰 작성/수정 - 게임 완료 플레이어만 (인증 필요)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/reviews/{id}/helpful</span>
+                <div class="description">도움이 됐어요 표시 (인증 필요)</div>
+            </div>
+            <div class="endpoint">
+                <span class="method">POST</span> <span class="url">/api/reviews/{id}/report</span>
+                <div class="description">부적절한 리뷰 신고 (인증 필요)</div>
+            </div>
+        </div>
+
         <div class="section">
             <h2>서버 정보</h2>
             <p><strong>포트:</strong> ` + r.Port + `</p>
@@ -8,6 +8,7 @@ import (
 	"g_dev/internal/database"
 	"g_dev/internal/handler"
 	"g_dev/internal/migration"
+	"g_dev/internal/model"
 	"g_dev/internal/router"
 	"g_dev/internal/service"
 	"github.com/redis/go-redis/v9"
@@ -15,23 +16,73 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 )
 
 // 메인 구조체
 type Server struct {
-	Config           *config.Config
-	DB               *database.Database
-	MigrationManager *migration.MigrationManager
-	RedisClient      *redis.Client
-	JWTAuth          *auth.JWTAuth
-	UserService      *service.UserService
-	APIHandler       *handler.APIHandler
-	AuthHandler      *handler.AuthHandler
-	Router           *router.Router
-	HTTPServer       *http.Server
-	Port             string
+	Config                  *config.Config
+	DB                      *database.Database
+	MigrationManager        *migration.MigrationManager
+	RedisClient             *redis.Client
+	JWTAuth                 *auth.JWTAuth
+	UserService             *service.UserService
+	InventoryService        *service.InventoryService
+	InventoryHistoryService *service.InventoryHistoryService
+	TradeService            *service.TradeService
+	MarketService           *service.MarketService
+	CraftingService         *service.CraftingService
+	EnhancementService      *service.EnhancementService
+	GachaService            *service.GachaService
+	ItemEffectService       *service.ItemEffectService
+	GameService             *service.GameService
+	GameSessionService      *service.GameSessionService
+	ScoreValidationService  *service.ScoreValidationService
+	LeaderboardService      *service.LeaderboardService
+	FriendService           *service.FriendService
+	SeasonService           *service.SeasonService
+	AchievementService      *service.AchievementService
+	MissionService          *service.MissionService
+	BattlePassService       *service.BattlePassService
+	GameReviewService       *service.GameReviewService
+	TrendingService         *service.TrendingService
+	RecommendationService   *service.RecommendationService
+	MatchmakingService      *service.MatchmakingService
+	SkillRatingService      *service.SkillRatingService
+	TournamentService       *service.TournamentService
+	RoomService             *service.RoomService
+	APIHandler              *handler.APIHandler
+	AuthHandler             *handler.AuthHandler
+	TradeHandler            *handler.TradeHandler
+	MarketHandler           *handler.MarketHandler
+	CraftingHandler         *handler.CraftingHandler
+	EnhancementHandler      *handler.EnhancementHandler
+	GachaHandler            *handler.GachaHandler
+	InventoryHistoryHandler *handler.InventoryHistoryHandler
+	ItemEffectHandler       *handler.ItemEffectHandler
+	GameHandler             *handler.GameHandler
+	GameSessionHandler      *handler.GameSessionHandler
+	ScoreReviewHandler      *handler.ScoreReviewHandler
+	LeaderboardHandler      *handler.LeaderboardHandler
+	FriendHandler           *handler.FriendHandler
+	SeasonHandler           *handler.SeasonHandler
+	AchievementHandler      *handler.AchievementHandler
+	MissionHandler          *handler.MissionHandler
+	BattlePassHandler       *handler.BattlePassHandler
+	GameReviewHandler       *handler.GameReviewHandler
+	RecommendationHandler   *handler.RecommendationHandler
+	MatchmakingHandler      *handler.MatchmakingHandler
+	SkillRatingHandler      *handler.SkillRatingHandler
+	TournamentHandler       *handler.TournamentHandler
+	RoomHandler             *handler.RoomHandler
+	Router                  *router.Router
+	HTTPServer              *http.Server
+	Port                    string
+
+	// 백그라운드 작업 종료 함수
+	stopJobs context.CancelFunc
 }
 
 // 새로운 Server 인스턴스 생성
@@ -70,7 +121,9 @@ func (s *Server) Initialize() error {
 	}
 
 	// 4. 서비스 레이어 초기화
-	s.initializeServices()
+	if err := s.initializeServices(); err != nil {
+		return fmt.Errorf("서비스 레이어 초기화 실패: %v", err)
+	}
 
 	// 5. 핸들러 초기화
 	s.initializeHandlers()
@@ -146,12 +199,126 @@ func (s *Server) initializeJWT() error {
 }
 
 // 서비스 레이어 초기화
-func (s *Server) initializeServices() {
+func (s *Server) initializeServices() error {
 	log.Println("서비스 레이어 초기화 중...")
 
 	s.UserService = service.NewUserService(s.DB.GetDB())
+	s.InventoryService = service.NewInventoryService(s.DB.GetDB())
+	s.InventoryService.SetExpiryNotifier(func(item model.Inventory) error {
+		log.Printf("아이템 만료 예정: user_id=%d, item_id=%s, expires_at=%s", item.UserID, item.ItemID, item.ExpiresAt.Format(time.RFC3339))
+		return nil
+	})
+	s.TradeService = service.NewTradeService(s.DB.GetDB())
+	s.MarketService = service.NewMarketService(s.DB.GetDB())
+	s.CraftingService = service.NewCraftingService(s.DB.GetDB())
+	s.EnhancementService = service.NewEnhancementService(s.DB.GetDB())
+	s.GachaService = service.NewGachaService(s.DB.GetDB())
+	s.InventoryHistoryService = service.NewInventoryHistoryService(s.DB.GetDB())
+	s.GameService = service.NewGameService(s.DB.GetDB())
+
+	// 세션 토큰은 JWT 비밀 키로 서명
+	s.GameSessionService = service.NewGameSessionService(s.DB.GetDB(), []byte(s.JWTAuth.Config.SecretKey))
+	s.ScoreValidationService = service.NewScoreValidationService(s.DB.GetDB())
+	s.GameSessionService.SetScoreValidator(s.ScoreValidationService)
+
+	// 리더보드는 Redis에 두고, 정상 점수가 확정될 때마다 반영
+	s.FriendService = service.NewFriendService(s.DB.GetDB())
+	s.LeaderboardService = service.NewLeaderboardService(s.DB.GetDB(), service.NewRedisLeaderboardStore(s.RedisClient))
+	s.GameSessionService.SetLeaderboard(s.LeaderboardService)
+	s.ScoreValidationService.SetLeaderboard(s.LeaderboardService)
+	s.SeasonService = service.NewSeasonService(s.DB.GetDB())
+	s.SeasonService.SetLeaderboard(s.LeaderboardService)
+
+	// 업적은 정상 점수 확정과 제작 성공 시 같은 트랜잭션에서 평가
+	s.AchievementService = service.NewAchievementService(s.DB.GetDB())
+	s.GameSessionService.SetAchievements(s.AchievementService)
+	s.ScoreValidationService.SetAchievements(s.AchievementService)
+	s.CraftingService.SetAchievements(s.AchievementService)
+
+	// 등록되지 않은 효과가 있는 카탈로그로는 서버를 시작하지 않음
+	s.ItemEffectService = service.NewItemEffectService(s.DB.GetDB())
+	if err := s.ItemEffectService.LoadCatalog(); err != nil {
+		return err
+	}
+	s.InventoryService.SetEffectService(s.ItemEffectService)
+
+	// 미션 진행도는 점수 확정과 아이템 사용 시 같은 트랜잭션에서 갱신
+	s.MissionService = service.NewMissionService(s.DB.GetDB())
+	s.GameSessionService.SetMissions(s.MissionService)
+	s.ScoreValidationService.SetMissions(s.MissionService)
+	s.ItemEffectService.SetMissions(s.MissionService)
+
+	// 배틀패스 경험치는 점수 확정과 미션 보상 수령 시 같은 트랜잭션에서 지급
+	s.BattlePassService = service.NewBattlePassService(s.DB.GetDB())
+	s.GameSessionService.SetBattlePass(s.BattlePassService)
+	s.ScoreValidationService.SetBattlePass(s.BattlePassService)
+	s.MissionService.SetBattlePass(s.BattlePassService)
+
+	// 인벤토리 통계는 Redis에 캐시하고, 인벤토리를 바꾸는 서비스는 커밋 후 바뀐 사용자의 캐시를 무효화
+	statsCache := service.NewRedisCache(s.RedisClient)
+	s.InventoryService.SetCache(statsCache)
+	for _, writer := range []interface{ SetStatsCache(service.Cache) }{
+		s.TradeService,
+		s.MarketService,
+		s.CraftingService,
+		s.EnhancementService,
+		s.GachaService,
+		s.ItemEffectService,
+		s.MissionService,
+		s.BattlePassService,
+		s.SeasonService,
+		s.GameSessionService,
+		s.ScoreValidationService,
+	} {
+		writer.SetStatsCache(statsCache)
+	}
+
+	s.GameReviewService = service.NewGameReviewService(s.DB.GetDB())
+
+	// 트렌딩/인기도 점수는 설정한 가중치로 최근 활동을 집계해 주기적으로 갱신
+	trending := s.Config.Trending
+	s.TrendingService = service.NewTrendingService(s.DB.GetDB(), model.TrendingWeights{
+		Play:       trending.PlayWeight,
+		PlayMinute: trending.PlayMinuteWeight,
+		Rating:     trending.RatingWeight,
+		HalfLife:   trending.HalfLife,
+		Lifetime:   trending.LifetimeWeight,
+	}, trending.Window)
+
+	// 게임 간 유사도는 오프라인 배치(cmd/recommendation_build)로 만들고 추천 시 조회만 함
+	s.RecommendationService = service.NewRecommendationService(s.DB.GetDB())
+
+	// 매치메이킹 대기열은 Redis에 두고, 매치 결과 레이팅은 점수 제출과 같은 트랜잭션에서 반영
+	s.MatchmakingService = service.NewMatchmakingService(s.DB.GetDB(), service.NewRedisMatchmakingQueue(s.RedisClient), s.GameSessionService)
+	s.GameSessionService.SetMatchmaking(s.MatchmakingService)
+	s.ScoreValidationService.SetMatchmaking(s.MatchmakingService)
+
+	// 스킬 레이팅은 매치 참가자 전원의 점수가 제출되면 같은 트랜잭션에서 갱신
+	algorithm, err := model.NewRatingAlgorithm(s.Config.Rating.Algorithm)
+	if err != nil {
+		return err
+	}
+	s.SkillRatingService = service.NewSkillRatingService(s.DB.GetDB(), algorithm, s.Config.Rating.Period)
+	s.MatchmakingService.SetSkillRatings(s.SkillRatingService)
+
+	// 토너먼트 시드는 스킬 레이팅 순
+	s.TournamentService = service.NewTournamentService(s.DB.GetDB())
+
+	// 실시간 룸은 서버 메모리에 두고, 설정하면 Redis pub/sub으로 다른 서버와 메시지를 주고받음
+	s.RoomService = service.NewRoomService(s.DB.GetDB(), s.Config.Room.ReconnectGrace)
+	if s.Config.Room.PubSubEnabled {
+		if err := s.RoomService.SetBroker(service.NewRedisRoomBroker(s.RedisClient)); err != nil {
+			return fmt.Errorf("룸 브로커 설정 실패: %v", err)
+		}
+	}
+
+	// 매칭 결과는 룸 웹소켓으로 바로 알리고, 연결이 없는 사용자는 상태 조회로 확인
+	s.MatchmakingService.SetNotifier(func(userID uint, found *service.MatchFound) error {
+		return s.RoomService.Notify(userID, model.RoomMessageMatchFound, found)
+	})
 
 	log.Println("서비스 레이어 초기화 완료")
+	return nil
 }
 
 // 핸들러 초기화
@@ -160,6 +327,28 @@ func (s *Server) initializeHandlers() {
 
 	s.APIHandler = handler.NewAPIHandler()
 	s.AuthHandler = handler.NewAuthHandler(s.UserService, s.JWTAuth)
+	s.TradeHandler = handler.NewTradeHandler(s.TradeService)
+	s.MarketHandler = handler.NewMarketHandler(s.MarketService)
+	s.CraftingHandler = handler.NewCraftingHandler(s.CraftingService)
+	s.EnhancementHandler = handler.NewEnhancementHandler(s.EnhancementService)
+	s.GachaHandler = handler.NewGachaHandler(s.GachaService)
+	s.InventoryHistoryHandler = handler.NewInventoryHistoryHandler(s.InventoryHistoryService)
+	s.ItemEffectHandler = handler.NewItemEffectHandler(s.ItemEffectService)
+	s.GameHandler = handler.NewGameHandler(s.GameService)
+	s.GameSessionHandler = handler.NewGameSessionHandler(s.GameSessionService)
+	s.ScoreReviewHandler = handler.NewScoreReviewHandler(s.ScoreValidationService)
+	s.LeaderboardHandler = handler.NewLeaderboardHandler(s.LeaderboardService)
+	s.FriendHandler = handler.NewFriendHandler(s.FriendService)
+	s.SeasonHandler = handler.NewSeasonHandler(s.SeasonService)
+	s.AchievementHandler = handler.NewAchievementHandler(s.AchievementService)
+	s.MissionHandler = handler.NewMissionHandler(s.MissionService)
+	s.BattlePassHandler = handler.NewBattlePassHandler(s.BattlePassService)
+	s.GameReviewHandler = handler.NewGameReviewHandler(s.GameReviewService)
+	s.RecommendationHandler = handler.NewRecommendationHandler(s.RecommendationService)
+	s.MatchmakingHandler = handler.NewMatchmakingHandler(s.MatchmakingService)
+	s.SkillRatingHandler = handler.NewSkillRatingHandler(s.SkillRatingService)
+	s.TournamentHandler = handler.NewTournamentHandler(s.TournamentService)
+	s.RoomHandler = handler.NewRoomHandler(s.RoomService, s.JWTAuth, strings.Split(s.Config.Security.CORSAllowedOrigins, ","))
 
 	log.Println("핸들러 초기화 완료")
 }
@@ -169,6 +358,28 @@ func (s *Server) initializeRouter() {
 	log.Println("라우터 초기화 중...")
 
 	s.Router = router.NewRouter(s.APIHandler, s.AuthHandler, s.JWTAuth, s.Port)
+	s.Router.TradeHandler = s.TradeHandler
+	s.Router.MarketHandler = s.MarketHandler
+	s.Router.CraftingHandler = s.CraftingHandler
+	s.Router.EnhancementHandler = s.EnhancementHandler
+	s.Router.GachaHandler = s.GachaHandler
+	s.Router.InventoryHistoryHandler = s.InventoryHistoryHandler
+	s.Router.ItemEffectHandler = s.ItemEffectHandler
+	s.Router.GameHandler = s.GameHandler
+	s.Router.GameSessionHandler = s.GameSessionHandler
+	s.Router.ScoreReviewHandler = s.ScoreReviewHandler
+	s.Router.LeaderboardHandler = s.LeaderboardHandler
+	s.Router.FriendHandler = s.FriendHandler
+	s.Router.SeasonHandler = s.SeasonHandler
+	s.Router.AchievementHandler = s.AchievementHandler
+	s.Router.MissionHandler = s.MissionHandler
+	s.Router.BattlePassHandler = s.BattlePassHandler
+	s.Router.GameReviewHandler = s.GameReviewHandler
+	s.Router.RecommendationHandler = s.RecommendationHandler
+	s.Router.MatchmakingHandler = s.MatchmakingHandler
+	s.Router.SkillRatingHandler = s.SkillRatingHandler
+	s.Router.TournamentHandler = s.TournamentHandler
+	s.Router.RoomHandler = s.RoomHandler
 	s.Router.SetupRoutes()
 
 	log.Println("라우터 초기화 완료")
@@ -202,12 +413,145 @@ func (s *Server) Start() error {
 		}
 	}()
 
+	// 백그라운드 작업 시작
+	s.startBackgroundJobs()
+
 	// 종료 신호 대기
 	s.waitForShutdown()
 
 	return nil
 }
 
+// 주기적으로 실행되는 백그라운드 작업 시작
+func (s *Server) startBackgroundJobs() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopJobs = cancel
+
+	// 만료된 거래 정리 (에스크로 자산 반환)
+	go runPeriodicJob(ctx, "거래 만료 처리", time.Minute, func() error {
+		count, err := s.TradeService.ExpireTrades()
+		if count > 0 {
+			log.Printf("만료된 거래 %d건을 처리했습니다", count)
+		}
+		return err
+	})
+
+	// 판매 기간이 끝난 거래소 물품 정산 (낙찰 처리 또는 판매자 반환)
+	go runPeriodicJob(ctx, "거래소 만료 처리", time.Minute, func() error {
+		count, err := s.MarketService.ProcessEndedListings()
+		if count > 0 {
+			log.Printf("종료된 거래소 물품 %d건을 처리했습니다", count)
+		}
+		return err
+	})
+
+	// 만료된 기간제 아이템 정리 (보상 규칙에 따라 골드/대체 아이템 지급)
+	go runPeriodicJob(ctx, "기간제 아이템 정리", time.Minute, func() error {
+		count, err := s.InventoryService.SweepExpiredItems(time.Now())
+		if count > 0 {
+			log.Printf("만료된 아이템 %d개를 정리했습니다", count)
+		}
+		return err
+	})
+
+	// 곧 만료되는 아이템 알림
+	go runPeriodicJob(ctx, "아이템 만료 알림", 10*time.Minute, func() error {
+		_, err := s.InventoryService.NotifyExpiringItems(time.Now(), service.ItemExpiryNoticeWindow)
+		return err
+	})
+
+	// 만료된 버프 정리
+	go runPeriodicJob(ctx, "만료 버프 정리", time.Hour, func() error {
+		_, err := s.ItemEffectService.PurgeExpiredBuffs(time.Now())
+		return err
+	})
+
+	// 하트비트가 끊긴 게임 세션 만료 처리
+	go runPeriodicJob(ctx, "게임 세션 만료 처리", time.Minute, func() error {
+		count, err := s.GameSessionService.ExpireStaleSessions(time.Now())
+		if count > 0 {
+			log.Printf("응답이 없는 게임 세션 %d건을 만료 처리했습니다", count)
+		}
+		return err
+	})
+
+	// 기간이 끝난 시즌 마감 (최종 순위 보관과 보상 지급)
+	go runPeriodicJob(ctx, "시즌 마감 처리", 5*time.Minute, func() error {
+		count, err := s.SeasonService.CloseEndedSeasons(time.Now())
+		if count > 0 {
+			log.Printf("종료된 시즌 %d개를 마감했습니다", count)
+		}
+		return err
+	})
+
+	// 최근 활동 집계와 트렌딩/인기도 점수 갱신
+	go runPeriodicJob(ctx, "트렌딩 점수 갱신", s.Config.Trending.RefreshInterval, func() error {
+		count, err := s.TrendingService.Refresh(time.Now())
+		if count > 0 {
+			log.Printf("게임 %d개의 트렌딩 점수를 갱신했습니다", count)
+		}
+		return err
+	})
+
+	// 대기 시간이 늘어 허용 레이팅 차이가 넓어진 대기열 매칭
+	go runPeriodicJob(ctx, "매치메이킹 처리", 5*time.Second, func() error {
+		count, err := s.MatchmakingService.ProcessQueues(time.Now())
+		if count > 0 {
+			log.Printf("매치 %d개를 만들었습니다", count)
+		}
+		return err
+	})
+
+	// 경기가 없던 플레이어의 스킬 레이팅 편차 증가
+	go runPeriodicJob(ctx, "스킬 레이팅 편차 갱신", time.Hour, func() error {
+		count, err := s.SkillRatingService.DecayInactive(time.Now())
+		if count > 0 {
+			log.Printf("스킬 레이팅 %d건의 편차를 갱신했습니다", count)
+		}
+		return err
+	})
+
+	// 참가 신청이 마감된 토너먼트 시작 (최소 인원 미달 시 취소와 환불)
+	go runPeriodicJob(ctx, "토너먼트 시작 처리", time.Minute, func() error {
+		count, err := s.TournamentService.StartDueTournaments(time.Now())
+		if count > 0 {
+			log.Printf("토너먼트 %d개를 시작했습니다", count)
+		}
+		return err
+	})
+
+	// 실시간 룸 틱과 다른 서버의 룸 이벤트 처리
+	go s.RoomService.RunTicks(ctx, s.Config.Room.TickInterval)
+	go func() {
+		if err := s.RoomService.Listen(ctx); err != nil {
+			log.Printf("룸 이벤트 구독 실패: %v", err)
+		}
+	}()
+
+	// 재접속 유예가 지난 룸 참가자 정리와 룸 소유 정보 갱신
+	go runPeriodicJob(ctx, "룸 정리", 5*time.Second, func() error {
+		return s.RoomService.Sweep(time.Now())
+	})
+}
+
+// 지정한 간격으로 작업을 반복 실행
+// 컨텍스트가 취소되면 종료
+func runPeriodicJob(ctx context.Context, name string, interval time.Duration, job func() error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job(); err != nil {
+				log.Printf("백그라운드 작업 실패 [%s]: %v", name, err)
+			}
+		}
+	}
+}
+
 // 서버 종료 신호 대기
 func (s *Server) waitForShutdown() {
 	// 종료 신호 채널 생성
@@ -237,6 +581,10 @@ func (s *Server) waitForShutdown() {
 func (s *Server) cleanup() {
 	log.Println("서버 리소스 정리 중...")
 
+	if s.stopJobs != nil {
+		s.stopJobs()
+	}
+
 	if s.RedisClient != nil {
 		s.RedisClient.Close()
 	}
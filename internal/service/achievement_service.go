@@ -0,0 +1,391 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"math"
+	"sort"
+	"time"
+)
+
+// 업적 정보와 달성률
+type AchievementInfo struct {
+	model.Achievement
+
+	// 달성한 플레이어 수와 비율 (%)
+	UnlockedCount int64   `json:"unlocked_count"`
+	Rarity        float64 `json:"rarity"`
+}
+
+// 플레이어에게 보여줄 업적 진행 상황
+type PlayerAchievement struct {
+	AchievementInfo
+	Progress   float64    `json:"progress"`
+	Percent    float64    `json:"percent"`
+	UnlockedAt *time.Time `json:"unlocked_at"`
+}
+
+// 사용자의 달성/진행 중 업적 목록
+type UserAchievementList struct {
+	UserID     uint                `json:"user_id"`
+	Earned     []PlayerAchievement `json:"earned"`
+	InProgress []PlayerAchievement `json:"in_progress"`
+}
+
+// 업적 정의와 사용자별 진행도를 관리하는 서비스
+// 점수 확정이나 제작 같은 이벤트가 발생하면 호출자의 트랜잭션 안에서 진행도를 갱신하고, 달성한 업적의 보상을 지급한다.
+type AchievementService struct {
+	db *gorm.DB
+
+	now func() time.Time
+}
+
+// 새로운 AchievementService 인스턴스를 생성
+func NewAchievementService(db *gorm.DB) *AchievementService {
+	return &AchievementService{db: db, now: time.Now}
+}
+
+// 이벤트로 업적 진행도를 갱신하고 이번에 달성한 업적을 반환
+// 호출자의 트랜잭션 안에서 실행되며, 보상 지급에 실패하면 이벤트를 일으킨 작업도 함께 실패한다.
+func (s *AchievementService) Record(tx *gorm.DB, event model.AchievementEvent) ([]model.Achievement, error) {
+	if event.At.IsZero() {
+		event.At = s.now()
+	}
+
+	query := tx.Where("is_active = ?", true)
+	if event.GameID != 0 {
+		query = query.Where("game_id IS NULL OR game_id = ?", event.GameID)
+	} else {
+		query = query.Where("game_id IS NULL")
+	}
+	var candidates []model.Achievement
+	if err := query.Order("id ASC").Find(&candidates).Error; err != nil {
+		return nil, fmt.Errorf("업적 조회 중 오류 발생: %w", err)
+	}
+
+	achievements := make([]model.Achievement, 0, len(candidates))
+	ids := make([]uint, 0, len(candidates))
+	for _, achievement := range candidates {
+		if achievement.Matches(&event) {
+			achievements = append(achievements, achievement)
+			ids = append(ids, achievement.ID)
+		}
+	}
+	if len(achievements) == 0 {
+		return nil, nil
+	}
+
+	// 같은 사용자의 진행도 생성이 동시에 일어나지 않도록 사용자 행을 잠근다
+	user, err := lockUser(tx, event.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc := model.UserLocation(user)
+	var rows []model.UserAchievement
+	if err := tx.Where("user_id = ? AND achievement_id IN ?", event.UserID, ids).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("업적 진행도 조회 중 오류 발생: %w", err)
+	}
+	progresses := make(map[uint]*model.UserAchievement, len(rows))
+	for i := range rows {
+		progresses[rows[i].AchievementID] = &rows[i]
+	}
+
+	var unlocked []model.Achievement
+	for i := range achievements {
+		achievement := &achievements[i]
+		progress := progresses[achievement.ID]
+		if progress == nil {
+			progress = &model.UserAchievement{UserID: event.UserID, AchievementID: achievement.ID}
+		}
+		if progress.IsUnlocked() {
+			continue
+		}
+
+		done := achievement.Apply(progress, &event, loc)
+		if err := tx.Omit("Achievement").Save(progress).Error; err != nil {
+			return nil, fmt.Errorf("업적 진행도 저장 중 오류 발생: %w", err)
+		}
+		if !done {
+			continue
+		}
+		if err := grantAchievementReward(tx, achievement, event.UserID); err != nil {
+			return nil, err
+		}
+		unlocked = append(unlocked, *achievement)
+	}
+	return unlocked, nil
+}
+
+// 공개 업적 목록을 달성률과 함께 조회 (숨김/비활성 제외)
+func (s *AchievementService) GetAchievements() ([]AchievementInfo, error) {
+	var achievements []model.Achievement
+	if err := s.db.Where("is_active = ? AND is_hidden = ?", true, false).Order("id ASC").Find(&achievements).Error; err != nil {
+		return nil, fmt.Errorf("업적 목록 조회 중 오류 발생: %w", err)
+	}
+
+	rarity, err := s.loadRarity()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]AchievementInfo, len(achievements))
+	for i := range achievements {
+		infos[i] = rarity.info(&achievements[i])
+	}
+	return infos, nil
+}
+
+// 사용자의 달성한 업적과 진행 중인 업적을 조회
+// 진행 중 목록에는 아직 진행도가 없는 공개 업적도 포함하며, 숨김 업적은 달성한 경우에만 보인다.
+func (s *AchievementService) GetUserAchievements(userID uint) (*UserAchievementList, error) {
+	var rows []model.UserAchievement
+	if err := s.db.Preload("Achievement").Where("user_id = ?", userID).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("업적 진행도 조회 중 오류 발생: %w", err)
+	}
+	progresses := make(map[uint]*model.UserAchievement, len(rows))
+	for i := range rows {
+		progresses[rows[i].AchievementID] = &rows[i]
+	}
+
+	var visible []model.Achievement
+	if err := s.db.Where("is_active = ? AND is_hidden = ?", true, false).Order("id ASC").Find(&visible).Error; err != nil {
+		return nil, fmt.Errorf("업적 목록 조회 중 오류 발생: %w", err)
+	}
+
+	rarity, err := s.loadRarity()
+	if err != nil {
+		return nil, err
+	}
+
+	list := &UserAchievementList{
+		UserID:     userID,
+		Earned:     []PlayerAchievement{},
+		InProgress: []PlayerAchievement{},
+	}
+	for i := range rows {
+		if rows[i].IsUnlocked() && rows[i].Achievement != nil {
+			list.Earned = append(list.Earned, rarity.player(rows[i].Achievement, &rows[i]))
+		}
+	}
+	for i := range visible {
+		progress := progresses[visible[i].ID]
+		if progress != nil && progress.IsUnlocked() {
+			continue
+		}
+		list.InProgress = append(list.InProgress, rarity.player(&visible[i], progress))
+	}
+
+	// 최근 달성 순
+	sortPlayerAchievements(list.Earned)
+	return list, nil
+}
+
+// 새로운 업적을 생성
+func (s *AchievementService) CreateAchievement(achievement *model.Achievement) error {
+	if err := achievement.Validate(); err != nil {
+		return err
+	}
+
+	var count int64
+	if err := s.db.Model(&model.Achievement{}).Where("code = ?", achievement.Code).Count(&count).Error; err != nil {
+		return fmt.Errorf("업적 코드 확인 중 오류 발생: %w", err)
+	}
+	if count > 0 {
+		return model.ErrDuplicateAchievementCode
+	}
+
+	if err := s.db.Create(achievement).Error; err != nil {
+		return fmt.Errorf("업적 생성 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 업적을 수정
+// 이미 달성한 사용자의 기록과 지급된 보상은 바뀌지 않는다.
+func (s *AchievementService) UpdateAchievement(id uint, updated *model.Achievement) (*model.Achievement, error) {
+	if err := updated.Validate(); err != nil {
+		return nil, err
+	}
+
+	var achievement model.Achievement
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&achievement, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.ErrAchievementNotFound
+			}
+			return fmt.Errorf("업적 조회 중 오류 발생: %w", err)
+		}
+
+		if updated.Code != achievement.Code {
+			var count int64
+			if err := tx.Model(&model.Achievement{}).Where("code = ? AND id <> ?", updated.Code, id).Count(&count).Error; err != nil {
+				return fmt.Errorf("업적 코드 확인 중 오류 발생: %w", err)
+			}
+			if count > 0 {
+				return model.ErrDuplicateAchievementCode
+			}
+		}
+
+		err := tx.Model(&achievement).Select(
+			"code", "name", "description", "game_id", "condition_type", "difficulty", "event_type", "target",
+			"is_hidden", "is_active", "gold", "diamond", "experience",
+			"item_id", "item_name", "item_type", "rarity", "quantity",
+		).Updates(&model.Achievement{
+			Code:        updated.Code,
+			Name:        updated.Name,
+			Description: updated.Description,
+			GameID:      updated.GameID,
+			Condition:   updated.Condition,
+			Difficulty:  updated.Difficulty,
+			EventType:   updated.EventType,
+			Target:      updated.Target,
+			IsHidden:    updated.IsHidden,
+			IsActive:    updated.IsActive,
+			Gold:        updated.Gold,
+			Diamond:     updated.Diamond,
+			Experience:  updated.Experience,
+			ItemID:      updated.ItemID,
+			ItemName:    updated.ItemName,
+			ItemType:    updated.ItemType,
+			Rarity:      updated.Rarity,
+			Quantity:    updated.Quantity,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("업적 수정 중 오류 발생: %w", err)
+		}
+		return tx.First(&achievement, id).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &achievement, nil
+}
+
+// 업적을 삭제 (소프트 삭제)
+func (s *AchievementService) DeleteAchievement(id uint) error {
+	result := s.db.Delete(&model.Achievement{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("업적 삭제 중 오류 발생: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return model.ErrAchievementNotFound
+	}
+	return nil
+}
+
+// 전체 업적 목록을 조회 (관리자용, 숨김/비활성 포함)
+func (s *AchievementService) GetAllAchievements(limit, offset int) ([]model.Achievement, error) {
+	var achievements []model.Achievement
+	if err := s.db.Order("id ASC").Limit(limit).Offset(offset).Find(&achievements).Error; err != nil {
+		return nil, fmt.Errorf("업적 목록 조회 중 오류 발생: %w", err)
+	}
+	return achievements, nil
+}
+
+// 업적별 달성자 수와 달성률 계산 기준
+// 게임 전용 업적은 해당 게임의 정상 점수가 있는 플레이어, 공통 업적은 전체 사용자를 기준으로 한다.
+type achievementRarity struct {
+	unlocked    map[uint]int64
+	gamePlayers map[uint]int64
+	allPlayers  int64
+}
+
+// 달성률 계산에 필요한 집계를 조회
+func (s *AchievementService) loadRarity() (*achievementRarity, error) {
+	rarity := &achievementRarity{
+		unlocked:    make(map[uint]int64),
+		gamePlayers: make(map[uint]int64),
+	}
+
+	var unlocked []struct {
+		AchievementID uint
+		Count         int64
+	}
+	err := s.db.Model(&model.UserAchievement{}).
+		Select("achievement_id, COUNT(*) AS count").
+		Where("unlocked_at IS NOT NULL").
+		Group("achievement_id").Scan(&unlocked).Error
+	if err != nil {
+		return nil, fmt.Errorf("업적 달성자 수 조회 중 오류 발생: %w", err)
+	}
+	for _, row := range unlocked {
+		rarity.unlocked[row.AchievementID] = row.Count
+	}
+
+	var players []struct {
+		GameID  uint
+		Players int64
+	}
+	err = s.db.Model(&model.Score{}).
+		Select("game_id, COUNT(DISTINCT user_id) AS players").
+		Where("review_status = ?", model.ScoreAccepted).
+		Group("game_id").Scan(&players).Error
+	if err != nil {
+		return nil, fmt.Errorf("게임별 플레이어 수 조회 중 오류 발생: %w", err)
+	}
+	for _, row := range players {
+		rarity.gamePlayers[row.GameID] = row.Players
+	}
+
+	if err := s.db.Model(&model.User{}).Count(&rarity.allPlayers).Error; err != nil {
+		return nil, fmt.Errorf("사용자 수 조회 중 오류 발생: %w", err)
+	}
+	return rarity, nil
+}
+
+// 업적의 달성률 정보를 생성
+func (r *achievementRarity) info(achievement *model.Achievement) AchievementInfo {
+	players := r.allPlayers
+	if achievement.GameID != nil {
+		players = r.gamePlayers[*achievement.GameID]
+	}
+
+	info := AchievementInfo{Achievement: *achievement, UnlockedCount: r.unlocked[achievement.ID]}
+	if players > 0 {
+		info.Rarity = math.Min(float64(info.UnlockedCount)/float64(players)*100, 100)
+	}
+	return info
+}
+
+// 업적의 플레이어 진행 상황을 생성 (진행도가 없으면 0)
+func (r *achievementRarity) player(achievement *model.Achievement, progress *model.UserAchievement) PlayerAchievement {
+	entry := PlayerAchievement{AchievementInfo: r.info(achievement)}
+	if progress != nil {
+		entry.Progress = progress.Progress
+		entry.UnlockedAt = progress.UnlockedAt
+	}
+	entry.Percent = achievement.Percent(entry.Progress)
+	if entry.UnlockedAt != nil {
+		entry.Percent = 100
+	}
+	return entry
+}
+
+// 달성한 업적을 최근 달성 순으로 정렬
+func sortPlayerAchievements(entries []PlayerAchievement) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].UnlockedAt.After(*entries[j].UnlockedAt)
+	})
+}
+
+// 업적 보상을 지급
+func grantAchievementReward(tx *gorm.DB, achievement *model.Achievement, userID uint) error {
+	if err := adjustUserGold(tx, userID, achievement.Gold); err != nil {
+		return err
+	}
+	if err := adjustUserDiamond(tx, userID, achievement.Diamond); err != nil {
+		return err
+	}
+	if _, err := adjustUserExperience(tx, userID, achievement.Experience); err != nil {
+		return err
+	}
+	if achievement.HasItemReward() {
+		change := model.NewInventoryChange(model.InventorySourceAchievement, 0, "achievement", achievement.ID)
+		if _, err := grantInventoryItem(tx, userID, achievement.ToInventory(userID), achievement.Quantity, change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
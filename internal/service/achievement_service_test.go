@@ -0,0 +1,142 @@
+package service
+
+import (
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func setupAchievementTest(t *testing.T) (*gorm.DB, *AchievementService, *GameSessionService, *model.User, *model.Game) {
+	db, sessions, user, game := setupGameSessionTest(t)
+	require.NoError(t, db.AutoMigrate(&model.Achievement{}, &model.UserAchievement{}))
+
+	service := NewAchievementService(db)
+	sessions.SetAchievements(service)
+	return db, service, sessions, user, game
+}
+
+// 세션을 시작하고 바로 점수를 제출
+func playSession(t *testing.T, sessions *GameSessionService, userID, gameID uint, submission *ScoreSubmission) *GameSessionResult {
+	started, err := sessions.StartSession(userID, &StartSessionRequest{GameID: gameID})
+	require.NoError(t, err)
+	result, err := sessions.EndSession(started.Token, userID, submission)
+	require.NoError(t, err)
+	return result
+}
+
+// 점수 제출 시 업적 평가, 보상 지급, 달성률 조회 테스트
+func TestAchievementService_ScoreEvents(t *testing.T) {
+	db, service, sessions, alice, game := setupAchievementTest(t)
+	bob := seedUser(t, db, "bob", 0)
+
+	first := &model.Achievement{
+		Code: "first_clear", Name: "첫 완료", GameID: &game.ID, Condition: model.AchievementFirstCompletion, Target: 1, IsActive: true,
+		Gold: 50, ItemID: "badge", ItemName: "배지", ItemType: "cosmetic", Rarity: "rare", Quantity: 1,
+	}
+	hard := &model.Achievement{Code: "hard_1000", Name: "고수", Condition: model.AchievementScore, Difficulty: model.GameDifficultyHard, Target: 1000, IsActive: true}
+	plays := &model.Achievement{Code: "plays_2", Name: "단골", Condition: model.AchievementPlays, Target: 2, IsActive: true, Diamond: 5}
+	secret := &model.Achievement{Code: "secret", Name: "비밀", Condition: model.AchievementPlays, Target: 100, IsActive: true, IsHidden: true}
+	for _, achievement := range []*model.Achievement{first, hard, plays, secret} {
+		require.NoError(t, service.CreateAchievement(achievement))
+	}
+	assert.ErrorIs(t, service.CreateAchievement(&model.Achievement{Code: "plays_2", Name: "중복", Condition: model.AchievementPlays, Target: 3}), model.ErrDuplicateAchievementCode)
+
+	result := playSession(t, sessions, alice.ID, game.ID, &ScoreSubmission{Score: 1200, Completed: true, Difficulty: model.GameDifficultyHard})
+	require.Len(t, result.Achievements, 2)
+	assert.Equal(t, "first_clear", result.Achievements[0].Code)
+	assert.Equal(t, "hard_1000", result.Achievements[1].Code)
+	assert.Equal(t, 100+result.Gold+50, userGold(t, db, alice.ID), "업적 골드 보상이 지급되어야 합니다")
+
+	var badge model.Inventory
+	require.NoError(t, db.Where("user_id = ? AND item_id = ?", alice.ID, "badge").First(&badge).Error)
+	var record model.InventoryLog
+	require.NoError(t, db.Where("inventory_id = ?", badge.ID).First(&record).Error)
+	assert.Equal(t, model.InventorySourceAchievement, record.Source)
+
+	// 이미 달성한 업적은 다시 지급하지 않음
+	result = playSession(t, sessions, alice.ID, game.ID, &ScoreSubmission{Score: 1500, Completed: true, Difficulty: model.GameDifficultyHard})
+	require.Len(t, result.Achievements, 1)
+	assert.Equal(t, "plays_2", result.Achievements[0].Code)
+	var badges int64
+	require.NoError(t, db.Model(&model.InventoryLog{}).Where("user_id = ? AND item_id = ?", alice.ID, "badge").Count(&badges).Error)
+	assert.Equal(t, int64(1), badges)
+
+	list, err := service.GetUserAchievements(alice.ID)
+	require.NoError(t, err)
+	require.Len(t, list.Earned, 3)
+	require.Len(t, list.InProgress, 0, "숨김 업적은 달성 전까지 보이지 않아야 합니다")
+	for _, earned := range list.Earned {
+		assert.Equal(t, 100.0, earned.Percent)
+		require.NotNil(t, earned.UnlockedAt)
+	}
+
+	// 게임 전용 업적은 게임 플레이어 기준, 공통 업적은 전체 사용자 기준 달성률
+	infos, err := service.GetAchievements()
+	require.NoError(t, err)
+	require.Len(t, infos, 3)
+	assert.Equal(t, 100.0, infos[0].Rarity)
+	assert.Equal(t, 50.0, infos[2].Rarity)
+	assert.Equal(t, int64(1), infos[2].UnlockedCount)
+
+	list, err = service.GetUserAchievements(bob.ID)
+	require.NoError(t, err)
+	assert.Empty(t, list.Earned)
+	require.Len(t, list.InProgress, 3)
+	assert.Zero(t, list.InProgress[0].Progress)
+}
+
+// 연속 플레이와 이벤트 누적 업적, 비활성 업적 테스트
+func TestAchievementService_Record(t *testing.T) {
+	db, service, _, alice, _ := setupAchievementTest(t)
+	day := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	streak := &model.Achievement{Code: "streak_3", Name: "3일 연속", Condition: model.AchievementStreak, Target: 3, IsActive: true}
+	crafter := &model.Achievement{Code: "crafter", Name: "장인", Condition: model.AchievementEventCount, EventType: model.AchievementEventCraft, Target: 5, IsActive: true}
+	inactive := &model.Achievement{Code: "off", Name: "비활성", Condition: model.AchievementEventCount, EventType: model.AchievementEventCraft, Target: 1}
+	for _, achievement := range []*model.Achievement{streak, crafter, inactive} {
+		require.NoError(t, service.CreateAchievement(achievement))
+	}
+
+	record := func(event model.AchievementEvent) []model.Achievement {
+		var unlocked []model.Achievement
+		require.NoError(t, db.Transaction(func(tx *gorm.DB) error {
+			var err error
+			unlocked, err = service.Record(tx, event)
+			return err
+		}))
+		return unlocked
+	}
+
+	for i := 0; i < 3; i++ {
+		ended := day.AddDate(0, 0, i)
+		score := &model.Score{UserID: alice.ID, GameID: 1, EndedAt: &ended}
+		unlocked := record(model.NewScoreAchievementEvent(score))
+		if i < 2 {
+			assert.Empty(t, unlocked)
+		} else {
+			require.Len(t, unlocked, 1)
+			assert.Equal(t, "streak_3", unlocked[0].Code)
+		}
+	}
+
+	assert.Empty(t, record(model.AchievementEvent{UserID: alice.ID, Type: model.AchievementEventCraft, Count: 3}))
+	unlocked := record(model.AchievementEvent{UserID: alice.ID, Type: model.AchievementEventCraft, Count: 2})
+	require.Len(t, unlocked, 1)
+	assert.Equal(t, "crafter", unlocked[0].Code)
+
+	var rows int64
+	require.NoError(t, db.Model(&model.UserAchievement{}).Where("achievement_id = ?", inactive.ID).Count(&rows).Error)
+	assert.Zero(t, rows, "비활성 업적은 평가하지 않아야 합니다")
+
+	updated, err := service.UpdateAchievement(inactive.ID, &model.Achievement{Code: "crafter", Name: "중복", Condition: model.AchievementPlays, Target: 1})
+	assert.ErrorIs(t, err, model.ErrDuplicateAchievementCode)
+	assert.Nil(t, updated)
+	updated, err = service.UpdateAchievement(inactive.ID, &model.Achievement{Code: "on", Name: "활성", Condition: model.AchievementPlays, Target: 1, IsActive: true})
+	require.NoError(t, err)
+	assert.True(t, updated.IsActive)
+	assert.NoError(t, service.DeleteAchievement(inactive.ID))
+	assert.ErrorIs(t, service.DeleteAchievement(inactive.ID), model.ErrAchievementNotFound)
+}
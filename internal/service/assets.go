@@ -0,0 +1,427 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"log"
+	"sync"
+	"time"
+)
+
+// 트랜잭션 안에서 사용자 재화와 인벤토리를 변경하는 공용 헬퍼
+// 거래, 경매, 제작 등 여러 서비스가 동일한 방식으로 자산을 이동시키도록 한다.
+// 모든 함수는 호출자가 연 트랜잭션(tx) 안에서 호출되어야 한다.
+
+// 행 잠금(SELECT ... FOR UPDATE)이 적용된 쿼리를 반환
+func lockForUpdate(tx *gorm.DB) *gorm.DB {
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"})
+}
+
+// 만료되지 않은 인벤토리 아이템만 조회하도록 조건을 추가
+func notExpired(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Where("(expires_at IS NULL OR expires_at > ?)", now)
+}
+
+// 사용자를 잠금 상태로 조회
+func lockUser(tx *gorm.DB, userID uint) (*model.User, error) {
+	var user model.User
+	if err := lockForUpdate(tx).First(&user, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrInvalidUserID
+		}
+		return nil, fmt.Errorf("사용자 조회 중 오류 발생: %w", err)
+	}
+	return &user, nil
+}
+
+// 사용자의 골드를 증감
+// 음수 금액은 차감을 의미하며 잔액이 부족하면 에러를 반환
+func adjustUserGold(tx *gorm.DB, userID uint, amount int) error {
+	if amount == 0 {
+		return nil
+	}
+
+	user, err := lockUser(tx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := user.AddGold(amount); err != nil {
+		return ErrInsufficientGold
+	}
+
+	if err := tx.Model(user).Update("gold", user.Gold).Error; err != nil {
+		return fmt.Errorf("골드 업데이트 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 사용자의 다이아몬드를 증감
+// 음수 금액은 차감을 의미하며 잔액이 부족하면 에러를 반환
+func adjustUserDiamond(tx *gorm.DB, userID uint, amount int) error {
+	if amount == 0 {
+		return nil
+	}
+
+	user, err := lockUser(tx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := user.AddDiamond(amount); err != nil {
+		return ErrInsufficientDiamond
+	}
+
+	if err := tx.Model(user).Update("diamond", user.Diamond).Error; err != nil {
+		return fmt.Errorf("다이아몬드 업데이트 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 사용자에게 경험치를 지급 (레벨업 포함)
+func adjustUserExperience(tx *gorm.DB, userID uint, amount int) (*model.User, error) {
+	user, err := lockUser(tx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if amount <= 0 {
+		return user, nil
+	}
+
+	user.AddExperience(amount)
+	if err := tx.Model(user).Updates(map[string]interface{}{"level": user.Level, "experience": user.Experience}).Error; err != nil {
+		return nil, fmt.Errorf("경험치 업데이트 중 오류 발생: %w", err)
+	}
+	return user, nil
+}
+
+// 사용자의 특정 아이템을 잠금 상태로 조회 (만료된 아이템은 제외)
+// 같은 아이템이 여러 행이면 강화되지 않은 낮은 레벨부터 사용해, 강화된 아이템이 재료나 거래 대상으로 빠져나가지 않게 한다.
+func lockInventoryItem(tx *gorm.DB, userID uint, itemID string) (*model.Inventory, error) {
+	var inventory model.Inventory
+	if err := notExpired(lockForUpdate(tx), time.Now()).
+		Where("user_id = ? AND item_id = ?", userID, itemID).
+		Order("level ASC, id ASC").
+		First(&inventory).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("아이템을 찾을 수 없습니다: user_id=%d, item_id=%s: %w", userID, itemID, ErrItemNotOwned)
+		}
+		return nil, fmt.Errorf("아이템 조회 중 오류 발생: %w", err)
+	}
+	return &inventory, nil
+}
+
+// 사용자가 지정한 인벤토리 행을 잠금 상태로 조회 (만료된 아이템은 제외)
+// 같은 아이템도 레벨이나 만료 시간이 다른 여러 행으로 나뉘므로, 판매나 거래 대상은 아이템 ID가 아니라 행 ID로 지정받는다.
+func lockOwnedInventory(tx *gorm.DB, userID, inventoryID uint) (*model.Inventory, error) {
+	var inventory model.Inventory
+	if err := notExpired(lockForUpdate(tx), time.Now()).
+		Where("id = ? AND user_id = ?", inventoryID, userID).
+		First(&inventory).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("아이템을 찾을 수 없습니다: user_id=%d, inventory_id=%d: %w", userID, inventoryID, ErrItemNotOwned)
+		}
+		return nil, fmt.Errorf("아이템 조회 중 오류 발생: %w", err)
+	}
+	return &inventory, nil
+}
+
+// 인벤토리 변경 기록을 남김
+// inventory에는 변경 후 상태가 담겨 있어야 한다.
+func recordInventoryChange(tx *gorm.DB, inventory *model.Inventory, beforeQuantity, beforeLevel int, change model.InventoryChange) error {
+	entry := newInventoryLog(inventory, beforeQuantity, beforeLevel, change)
+	if err := tx.Create(entry).Error; err != nil {
+		return fmt.Errorf("인벤토리 변경 기록 생성 중 오류 발생: %w", err)
+	}
+	touchInventory(tx, inventory.UserID)
+	return nil
+}
+
+// 트랜잭션 context에 담는 인벤토리 변경 사용자 목록의 키
+type inventoryTouchesKey struct{}
+
+// 트랜잭션 안에서 인벤토리가 바뀐 사용자 (커밋 후 통계 캐시 무효화용)
+type inventoryTouches struct {
+	mu      sync.Mutex
+	userIDs []uint
+}
+
+// 인벤토리가 바뀐 사용자를 트랜잭션 context에 기록 (inventoryStats.transaction으로 연 트랜잭션이 아니면 무시)
+func touchInventory(tx *gorm.DB, userID uint) {
+	if tx.Statement == nil || tx.Statement.Context == nil {
+		return
+	}
+	touches, ok := tx.Statement.Context.Value(inventoryTouchesKey{}).(*inventoryTouches)
+	if !ok {
+		return
+	}
+	touches.mu.Lock()
+	defer touches.mu.Unlock()
+	if !containsUserID(touches.userIDs, userID) {
+		touches.userIDs = append(touches.userIDs, userID)
+	}
+}
+
+// 인벤토리 통계 캐시 무효화
+// 인벤토리를 바꾸는 서비스에 포함해, 트랜잭션이 커밋된 뒤 인벤토리가 바뀐 사용자의 통계 캐시를 지운다.
+type inventoryStats struct {
+	// 인벤토리 통계 캐시 (nil이면 무효화하지 않음)
+	statsCache Cache
+}
+
+// 인벤토리 통계 캐시를 설정
+func (s *inventoryStats) SetStatsCache(cache Cache) {
+	s.statsCache = cache
+}
+
+// 트랜잭션을 실행하고, 커밋되면 그 안에서 인벤토리가 바뀐 사용자의 통계 캐시를 무효화
+// 다른 서비스에 tx를 넘겨 지급한 보상(업적, 미션 등)도 같은 트랜잭션의 변경으로 모인다.
+func (s *inventoryStats) transaction(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	touches := &inventoryTouches{}
+	ctx := context.WithValue(db.Statement.Context, inventoryTouchesKey{}, touches)
+	if err := db.WithContext(ctx).Transaction(fn); err != nil {
+		return err
+	}
+	s.invalidateStats(touches.userIDs...)
+	return nil
+}
+
+// 사용자들의 인벤토리 통계 캐시를 무효화
+func (s *inventoryStats) invalidateStats(userIDs ...uint) {
+	if s.statsCache == nil {
+		return
+	}
+	for _, userID := range userIDs {
+		if err := s.statsCache.Delete(context.Background(), inventoryStatsCacheKey(userID)); err != nil {
+			log.Printf("인벤토리 통계 캐시 무효화 실패 (user_id=%d): %v", userID, err)
+		}
+	}
+}
+
+// 변경 후 아이템 상태와 변경 전 값으로 변경 기록을 생성
+func newInventoryLog(inventory *model.Inventory, beforeQuantity, beforeLevel int, change model.InventoryChange) *model.InventoryLog {
+	return &model.InventoryLog{
+		UserID:         inventory.UserID,
+		InventoryID:    inventory.ID,
+		ItemID:         inventory.ItemID,
+		ItemName:       inventory.ItemName,
+		ItemType:       inventory.ItemType,
+		Rarity:         inventory.Rarity,
+		BeforeQuantity: beforeQuantity,
+		AfterQuantity:  inventory.Quantity,
+		BeforeLevel:    beforeLevel,
+		AfterLevel:     inventory.Level,
+		Source:         change.Source,
+		ActorID:        change.ActorID,
+		Reference:      change.Reference,
+	}
+}
+
+// 인벤토리 아이템의 수량을 차감
+// 수량이 0이 되면 아이템을 삭제
+func removeInventoryQuantity(tx *gorm.DB, inventory *model.Inventory, quantity int, change model.InventoryChange) error {
+	if quantity <= 0 {
+		return model.ErrInvalidQuantity
+	}
+	if inventory.Quantity < quantity {
+		return model.ErrInsufficientQuantity
+	}
+
+	before := inventory.Quantity
+	inventory.Quantity -= quantity
+	if inventory.Quantity == 0 {
+		if err := tx.Delete(inventory).Error; err != nil {
+			return fmt.Errorf("빈 아이템 삭제 중 오류 발생: %w", err)
+		}
+	} else if err := tx.Model(inventory).Update("quantity", inventory.Quantity).Error; err != nil {
+		return fmt.Errorf("아이템 수량 업데이트 중 오류 발생: %w", err)
+	}
+
+	return recordInventoryChange(tx, inventory, before, inventory.Level, change)
+}
+
+// 같은 아이템의 여러 행에서 합계 수량만큼 차감 (만료된 아이템은 제외)
+// 레벨이나 만료 시간이 달라 나뉜 행도 재료로는 같은 아이템이므로, lockInventoryItem과 같은 순서로
+// 낮은 레벨부터 차감해 강화된 아이템이 재료로 먼저 빠져나가지 않게 한다.
+func removeItemQuantity(tx *gorm.DB, userID uint, itemID string, quantity int, change model.InventoryChange) error {
+	if quantity <= 0 {
+		return model.ErrInvalidQuantity
+	}
+
+	var rows []model.Inventory
+	if err := notExpired(lockForUpdate(tx), time.Now()).
+		Where("user_id = ? AND item_id = ?", userID, itemID).
+		Order("level ASC, id ASC").
+		Find(&rows).Error; err != nil {
+		return fmt.Errorf("아이템 조회 중 오류 발생: %w", err)
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("아이템을 찾을 수 없습니다: user_id=%d, item_id=%s: %w", userID, itemID, ErrItemNotOwned)
+	}
+
+	total := 0
+	for i := range rows {
+		total += rows[i].Quantity
+	}
+	if total < quantity {
+		return model.ErrInsufficientQuantity
+	}
+
+	for i := range rows {
+		if quantity == 0 {
+			break
+		}
+		take := rows[i].Quantity
+		if take > quantity {
+			take = quantity
+		}
+		if err := removeInventoryQuantity(tx, &rows[i], take, change); err != nil {
+			return err
+		}
+		quantity -= take
+	}
+	return nil
+}
+
+// 인벤토리 아이템을 1회 사용
+// 사용 횟수 제한이 있는 아이템은 횟수를 차감하고 모두 소진하면 삭제하며,
+// 그 외 아이템은 수량을 1 차감한다.
+func consumeInventoryItem(tx *gorm.DB, inventory *model.Inventory, change model.InventoryChange) error {
+	if inventory.UsageLimit == 0 {
+		return removeInventoryQuantity(tx, inventory, 1, change)
+	}
+
+	exhausted, err := inventory.ConsumeUse()
+	if err != nil {
+		return fmt.Errorf("아이템 사용 실패: %w", err)
+	}
+	if exhausted {
+		return deleteInventoryItem(tx, inventory, change)
+	}
+	if err := tx.Model(inventory).Update("usage_count", inventory.UsageCount).Error; err != nil {
+		return fmt.Errorf("아이템 사용 횟수 업데이트 중 오류 발생: %w", err)
+	}
+	return recordInventoryChange(tx, inventory, inventory.Quantity, inventory.Level, change)
+}
+
+// 인벤토리 아이템을 수량과 관계없이 삭제
+func deleteInventoryItem(tx *gorm.DB, inventory *model.Inventory, change model.InventoryChange) error {
+	before := inventory.Quantity
+	if err := tx.Delete(inventory).Error; err != nil {
+		return fmt.Errorf("아이템 삭제 중 오류 발생: %w", err)
+	}
+
+	inventory.Quantity = 0
+	return recordInventoryChange(tx, inventory, before, inventory.Level, change)
+}
+
+// 여러 개가 겹쳐진 아이템에서 1개를 떼어 개별 행으로 만들고 떼어낸 행을 반환
+// 강화처럼 한 개에만 적용되는 변경 전에 사용하며, 기간과 사용 횟수 등 나머지 속성은 그대로 복사한다.
+func splitInventoryUnit(tx *gorm.DB, inventory *model.Inventory, change model.InventoryChange) (*model.Inventory, error) {
+	if err := removeInventoryQuantity(tx, inventory, 1, change); err != nil {
+		return nil, err
+	}
+
+	unit := &model.Inventory{
+		UserID:     inventory.UserID,
+		ItemID:     inventory.ItemID,
+		ItemName:   inventory.ItemName,
+		ItemType:   inventory.ItemType,
+		Rarity:     inventory.Rarity,
+		Level:      inventory.Level,
+		Quantity:   1,
+		IsBound:    inventory.IsBound,
+		ExpiresAt:  inventory.ExpiresAt,
+		UsageLimit: inventory.UsageLimit,
+		UsageCount: inventory.UsageCount,
+	}
+	if err := tx.Create(unit).Error; err != nil {
+		return nil, fmt.Errorf("인벤토리 생성 중 오류 발생: %w", err)
+	}
+	if err := recordInventoryChange(tx, unit, 0, unit.Level, change); err != nil {
+		return nil, err
+	}
+	return unit, nil
+}
+
+// 사용자에게 아이템을 지급
+// 같은 영구 아이템이 이미 있으면 수량만 증가시키고, 없으면 template 정보로 새로 생성
+// 기간제 아이템과 강화된 아이템(레벨 2 이상)은 개별 행으로 보관하므로 수량을 합치지 않는다.
+func grantInventoryItem(tx *gorm.DB, userID uint, template *model.Inventory, quantity int, change model.InventoryChange) (*model.Inventory, error) {
+	if quantity <= 0 {
+		return nil, model.ErrInvalidQuantity
+	}
+
+	level := template.Level
+	if level <= 0 {
+		level = 1
+	}
+
+	if level == 1 {
+		existing, err := lockStackableItem(tx, userID, template.ItemID)
+		if err == nil {
+			if err := addInventoryQuantity(tx, existing, quantity, change); err != nil {
+				return nil, err
+			}
+			return existing, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("아이템 조회 중 오류 발생: %w", err)
+		}
+	}
+
+	inventory := &model.Inventory{
+		UserID:   userID,
+		ItemID:   template.ItemID,
+		ItemName: template.ItemName,
+		ItemType: template.ItemType,
+		Rarity:   template.Rarity,
+		Level:    level,
+		Quantity: quantity,
+		IsBound:  template.IsBound,
+	}
+	if err := inventory.Validate(); err != nil {
+		return nil, fmt.Errorf("인벤토리 유효성 검사 실패: %w", err)
+	}
+	if err := tx.Create(inventory).Error; err != nil {
+		return nil, fmt.Errorf("인벤토리 생성 중 오류 발생: %w", err)
+	}
+	if err := recordInventoryChange(tx, inventory, 0, level, change); err != nil {
+		return nil, err
+	}
+	return inventory, nil
+}
+
+// 수량을 합칠 수 있는 영구 아이템을 잠금 상태로 조회 (없으면 gorm.ErrRecordNotFound)
+// 기간제, 사용 횟수 제한, 강화된 아이템은 개별 행으로 보관하므로 대상이 아니다.
+func lockStackableItem(tx *gorm.DB, userID uint, itemID string) (*model.Inventory, error) {
+	var inventory model.Inventory
+	if err := lockForUpdate(tx).
+		Where("user_id = ? AND item_id = ? AND level = ?", userID, itemID, 1).
+		Where("expires_at IS NULL AND usage_limit = ?", 0).
+		First(&inventory).Error; err != nil {
+		return nil, err
+	}
+	return &inventory, nil
+}
+
+// 인벤토리 아이템의 수량을 증가시키고 변경 기록을 남김
+func addInventoryQuantity(tx *gorm.DB, inventory *model.Inventory, quantity int, change model.InventoryChange) error {
+	before := inventory.Quantity
+	inventory.AddItem(quantity)
+	if err := tx.Model(inventory).Update("quantity", inventory.Quantity).Error; err != nil {
+		return fmt.Errorf("아이템 수량 업데이트 중 오류 발생: %w", err)
+	}
+	return recordInventoryChange(tx, inventory, before, inventory.Level, change)
+}
+
+// 에러 정의
+var (
+	ErrInsufficientGold    = errors.New("골드가 부족합니다")
+	ErrInsufficientDiamond = errors.New("다이아몬드가 부족합니다")
+	ErrItemNotOwned        = errors.New("보유하지 않은 아이템입니다")
+)
@@ -0,0 +1,491 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"time"
+)
+
+// 배틀패스 티어별 보상과 수령 상태
+type BattlePassTierState struct {
+	Tier       int  `json:"tier"`
+	RequiredXP int  `json:"required_xp"`
+	Unlocked   bool `json:"unlocked"`
+
+	Free    *model.BattlePassReward `json:"free,omitempty"`
+	Premium *model.BattlePassReward `json:"premium,omitempty"`
+
+	FreeClaimed    bool `json:"free_claimed"`
+	PremiumClaimed bool `json:"premium_claimed"`
+}
+
+// 사용자의 배틀패스 진행 상황 (전체 트랙 포함)
+type BattlePassProgress struct {
+	Pass      *model.BattlePass `json:"pass"`
+	XP        int               `json:"xp"`
+	Tier      int               `json:"tier"`
+	IsPremium bool              `json:"is_premium"`
+	PremiumAt *time.Time        `json:"premium_at"`
+
+	// 다음 티어까지 남은 패스 경험치 (최고 티어면 0)
+	XPToNextTier int `json:"xp_to_next_tier"`
+
+	Tiers []BattlePassTierState `json:"tiers"`
+}
+
+// 배틀패스 보상 수령 결과
+type BattlePassClaimResult struct {
+	PassID  uint                     `json:"pass_id"`
+	Rewards []model.BattlePassReward `json:"rewards"`
+
+	Gold       int               `json:"gold"`
+	Diamond    int               `json:"diamond"`
+	Experience int               `json:"experience"`
+	Items      []model.Inventory `json:"items"`
+
+	// 프리미엄 해금이나 티어 건너뛰기에 사용한 다이아몬드
+	DiamondSpent int `json:"diamond_spent,omitempty"`
+}
+
+// 티어 건너뛰기 결과
+type BattlePassSkipResult struct {
+	PassID       uint `json:"pass_id"`
+	FromTier     int  `json:"from_tier"`
+	ToTier       int  `json:"to_tier"`
+	XP           int  `json:"xp"`
+	DiamondSpent int  `json:"diamond_spent"`
+}
+
+// 시즌 배틀패스를 관리하는 서비스
+// 패스 경험치는 점수 확정이나 미션 보상 수령과 같은 트랜잭션에서 진행 중인 패스에 쌓이고, 보상은 사용자가 직접 수령한다.
+type BattlePassService struct {
+	db  *gorm.DB
+	now func() time.Time
+
+	// 인벤토리 통계 캐시 (설정하지 않으면 무효화하지 않음)
+	inventoryStats
+}
+
+// 새로운 BattlePassService 인스턴스를 생성
+func NewBattlePassService(db *gorm.DB) *BattlePassService {
+	return &BattlePassService{db: db, now: time.Now}
+}
+
+// 배틀패스를 생성
+func (s *BattlePassService) CreatePass(pass *model.BattlePass) error {
+	if err := pass.Validate(); err != nil {
+		return err
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var overlapping int64
+		err := tx.Model(&model.BattlePass{}).
+			Where("start_at < ? AND end_at > ?", pass.EndAt, pass.StartAt).
+			Count(&overlapping).Error
+		if err != nil {
+			return fmt.Errorf("배틀패스 조회 중 오류 발생: %w", err)
+		}
+		if overlapping > 0 {
+			return model.ErrBattlePassOverlap
+		}
+
+		if err := tx.Create(pass).Error; err != nil {
+			return fmt.Errorf("배틀패스 생성 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+}
+
+// 배틀패스 목록을 조회 (최근 시작 순)
+func (s *BattlePassService) GetPasses(limit, offset int) ([]model.BattlePass, error) {
+	var passes []model.BattlePass
+	if err := s.db.Order("start_at DESC").Limit(limit).Offset(offset).Find(&passes).Error; err != nil {
+		return nil, fmt.Errorf("배틀패스 목록 조회 중 오류 발생: %w", err)
+	}
+	return passes, nil
+}
+
+// 사용자의 배틀패스 진행 상황과 전체 트랙을 조회 (passID가 0이면 진행 중인 패스)
+func (s *BattlePassService) GetProgress(userID, passID uint) (*BattlePassProgress, error) {
+	if passID == 0 {
+		active, err := s.activePass(s.db)
+		if err != nil {
+			return nil, err
+		}
+		if active == nil {
+			return nil, model.ErrBattlePassNotFound
+		}
+		passID = active.ID
+	}
+	pass, err := loadBattlePass(s.db, passID)
+	if err != nil {
+		return nil, err
+	}
+
+	var progress model.UserBattlePass
+	err = s.db.Where("user_id = ? AND pass_id = ?", userID, pass.ID).First(&progress).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("배틀패스 진행도 조회 중 오류 발생: %w", err)
+	}
+	claims, err := loadBattlePassClaims(s.db, userID, pass.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tier := pass.TierFor(progress.XP)
+	result := &BattlePassProgress{
+		Pass:      pass,
+		XP:        progress.XP,
+		Tier:      tier,
+		IsPremium: progress.IsPremium,
+		PremiumAt: progress.PremiumAt,
+		Tiers:     make([]BattlePassTierState, pass.MaxTier),
+	}
+	if tier < pass.MaxTier {
+		result.XPToNextTier = pass.RequiredXP(tier+1) - progress.XP
+	}
+	for i := range result.Tiers {
+		t := i + 1
+		result.Tiers[i] = BattlePassTierState{
+			Tier:           t,
+			RequiredXP:     pass.RequiredXP(t),
+			Unlocked:       t <= tier,
+			Free:           pass.RewardFor(t, model.BattlePassFree),
+			Premium:        pass.RewardFor(t, model.BattlePassPremium),
+			FreeClaimed:    claims[battlePassClaimKey(t, model.BattlePassFree)],
+			PremiumClaimed: claims[battlePassClaimKey(t, model.BattlePassPremium)],
+		}
+	}
+	return result, nil
+}
+
+// 확정된 점수로 진행 중인 패스에 패스 경험치를 지급
+// 호출자의 트랜잭션 안에서 실행된다.
+func (s *BattlePassService) RecordScore(tx *gorm.DB, score *model.Score) error {
+	return s.addXP(tx, score.UserID, func(pass *model.BattlePass) int { return pass.SessionXP })
+}
+
+// 미션 보상 수령으로 진행 중인 패스에 패스 경험치를 지급
+// 호출자의 트랜잭션 안에서 실행된다.
+func (s *BattlePassService) RecordMission(tx *gorm.DB, userID uint) error {
+	return s.addXP(tx, userID, func(pass *model.BattlePass) int { return pass.MissionXP })
+}
+
+// 다이아몬드로 프리미엄 트랙을 해금
+// 이미 도달한 티어의 프리미엄 보상은 해금과 함께 바로 지급한다.
+func (s *BattlePassService) UnlockPremium(userID, passID uint) (*BattlePassClaimResult, error) {
+	var result *BattlePassClaimResult
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		pass, err := loadBattlePass(tx, passID)
+		if err != nil {
+			return err
+		}
+		if !pass.IsActive(s.now()) {
+			return model.ErrBattlePassNotActive
+		}
+
+		progress, err := lockUserBattlePass(tx, userID, pass.ID)
+		if err != nil {
+			return err
+		}
+		if progress.IsPremium {
+			return model.ErrBattlePassPremiumOwned
+		}
+		if err := adjustUserDiamond(tx, userID, -pass.PremiumPrice); err != nil {
+			return err
+		}
+
+		now := s.now()
+		progress.IsPremium = true
+		progress.PremiumAt = &now
+		if err := tx.Model(progress).Updates(map[string]interface{}{"is_premium": true, "premium_at": now}).Error; err != nil {
+			return fmt.Errorf("프리미엄 패스 저장 중 오류 발생: %w", err)
+		}
+
+		result, err = claimBattlePassRewards(tx, pass, progress, func(reward *model.BattlePassReward) bool {
+			return reward.Track == model.BattlePassPremium
+		})
+		if err != nil {
+			return err
+		}
+		result.DiamondSpent = pass.PremiumPrice
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// 다이아몬드로 티어를 건너뜀
+// 건너뛴 티어의 보상은 수령 요청으로 받는다.
+func (s *BattlePassService) SkipTiers(userID, passID uint, tiers int) (*BattlePassSkipResult, error) {
+	if tiers <= 0 {
+		return nil, model.ErrInvalidTierSkip
+	}
+
+	var result *BattlePassSkipResult
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		pass, err := loadBattlePass(tx, passID)
+		if err != nil {
+			return err
+		}
+		if !pass.IsActive(s.now()) {
+			return model.ErrBattlePassNotActive
+		}
+
+		progress, err := lockUserBattlePass(tx, userID, pass.ID)
+		if err != nil {
+			return err
+		}
+		from := pass.TierFor(progress.XP)
+		to := from + tiers
+		if to > pass.MaxTier {
+			return model.ErrInvalidTierSkip
+		}
+
+		cost := pass.TierSkipPrice * tiers
+		if err := adjustUserDiamond(tx, userID, -cost); err != nil {
+			return err
+		}
+
+		// 현재 티어 안에서 쌓은 경험치는 유지
+		progress.XP += pass.RequiredXP(tiers)
+		if err := tx.Model(progress).Update("xp", progress.XP).Error; err != nil {
+			return fmt.Errorf("배틀패스 경험치 저장 중 오류 발생: %w", err)
+		}
+
+		result = &BattlePassSkipResult{PassID: pass.ID, FromTier: from, ToTier: to, XP: progress.XP, DiamondSpent: cost}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// 도달한 티어의 보상 하나를 수령
+// 종료된 패스라도 도달한 티어의 보상은 수령할 수 있다.
+func (s *BattlePassService) ClaimReward(userID, passID uint, tier int, track model.BattlePassTrack) (*BattlePassClaimResult, error) {
+	var result *BattlePassClaimResult
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		pass, err := loadBattlePass(tx, passID)
+		if err != nil {
+			return err
+		}
+		reward := pass.RewardFor(tier, track)
+		if reward == nil {
+			return model.ErrBattlePassRewardNotFound
+		}
+
+		progress, err := lockUserBattlePass(tx, userID, pass.ID)
+		if err != nil {
+			return err
+		}
+		if tier > pass.TierFor(progress.XP) {
+			return model.ErrBattlePassTierLocked
+		}
+		if track == model.BattlePassPremium && !progress.IsPremium {
+			return model.ErrBattlePassPremiumRequired
+		}
+
+		result, err = claimBattlePassRewards(tx, pass, progress, func(r *model.BattlePassReward) bool {
+			return r.ID == reward.ID
+		})
+		if err != nil {
+			return err
+		}
+		if len(result.Rewards) == 0 {
+			return model.ErrBattlePassRewardClaimed
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// 수령 가능한 모든 보상을 수령
+func (s *BattlePassService) ClaimAll(userID, passID uint) (*BattlePassClaimResult, error) {
+	var result *BattlePassClaimResult
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		pass, err := loadBattlePass(tx, passID)
+		if err != nil {
+			return err
+		}
+		progress, err := lockUserBattlePass(tx, userID, pass.ID)
+		if err != nil {
+			return err
+		}
+
+		result, err = claimBattlePassRewards(tx, pass, progress, func(*model.BattlePassReward) bool { return true })
+		if err != nil {
+			return err
+		}
+		if len(result.Rewards) == 0 {
+			return model.ErrBattlePassNothingToClaim
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// 진행 중인 패스에 패스 경험치를 지급 (진행 중인 패스가 없으면 무시)
+func (s *BattlePassService) addXP(tx *gorm.DB, userID uint, amountFor func(*model.BattlePass) int) error {
+	pass, err := s.activePass(tx)
+	if err != nil || pass == nil {
+		return err
+	}
+	amount := amountFor(pass)
+	if amount <= 0 {
+		return nil
+	}
+
+	progress, err := lockUserBattlePass(tx, userID, pass.ID)
+	if err != nil {
+		return err
+	}
+	progress.XP += amount
+	if err := tx.Model(progress).Update("xp", progress.XP).Error; err != nil {
+		return fmt.Errorf("배틀패스 경험치 저장 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 현재 진행 중인 패스를 조회 (없으면 nil)
+func (s *BattlePassService) activePass(db *gorm.DB) (*model.BattlePass, error) {
+	now := s.now()
+	var pass model.BattlePass
+	err := db.Where("start_at <= ? AND end_at > ?", now, now).First(&pass).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("배틀패스 조회 중 오류 발생: %w", err)
+	}
+	return &pass, nil
+}
+
+// 보상을 포함한 배틀패스를 조회
+func loadBattlePass(db *gorm.DB, id uint) (*model.BattlePass, error) {
+	var pass model.BattlePass
+	err := db.Preload("Rewards", func(db *gorm.DB) *gorm.DB {
+		return db.Order("tier ASC, track ASC")
+	}).First(&pass, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrBattlePassNotFound
+		}
+		return nil, fmt.Errorf("배틀패스 조회 중 오류 발생: %w", err)
+	}
+	return &pass, nil
+}
+
+// 사용자의 패스 진행도를 잠금 상태로 조회 (없으면 생성)
+func lockUserBattlePass(tx *gorm.DB, userID, passID uint) (*model.UserBattlePass, error) {
+	if _, err := lockUser(tx, userID); err != nil {
+		return nil, err
+	}
+
+	var progress model.UserBattlePass
+	err := lockForUpdate(tx).Where("user_id = ? AND pass_id = ?", userID, passID).First(&progress).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		progress = model.UserBattlePass{UserID: userID, PassID: passID}
+		if err := tx.Create(&progress).Error; err != nil {
+			return nil, fmt.Errorf("배틀패스 진행도 생성 중 오류 발생: %w", err)
+		}
+		return &progress, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("배틀패스 진행도 조회 중 오류 발생: %w", err)
+	}
+	return &progress, nil
+}
+
+// 사용자가 수령한 보상 목록을 조회
+func loadBattlePassClaims(db *gorm.DB, userID, passID uint) (map[string]bool, error) {
+	var claims []model.BattlePassClaim
+	if err := db.Where("user_id = ? AND pass_id = ?", userID, passID).Find(&claims).Error; err != nil {
+		return nil, fmt.Errorf("배틀패스 보상 수령 기록 조회 중 오류 발생: %w", err)
+	}
+
+	claimed := make(map[string]bool, len(claims))
+	for _, claim := range claims {
+		claimed[battlePassClaimKey(claim.Tier, claim.Track)] = true
+	}
+	return claimed, nil
+}
+
+// 보상 수령 기록 조회 키
+func battlePassClaimKey(tier int, track model.BattlePassTrack) string {
+	return fmt.Sprintf("%d:%s", tier, track)
+}
+
+// 도달한 티어의 수령 가능한 보상 중 filter에 맞는 보상을 모두 지급하고 수령 기록을 남김
+func claimBattlePassRewards(tx *gorm.DB, pass *model.BattlePass, progress *model.UserBattlePass, filter func(*model.BattlePassReward) bool) (*BattlePassClaimResult, error) {
+	claimed, err := loadBattlePassClaims(tx, progress.UserID, pass.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BattlePassClaimResult{
+		PassID:  pass.ID,
+		Rewards: []model.BattlePassReward{},
+		Items:   []model.Inventory{},
+	}
+	tier := pass.TierFor(progress.XP)
+	for i := range pass.Rewards {
+		reward := &pass.Rewards[i]
+		if reward.Tier > tier || claimed[battlePassClaimKey(reward.Tier, reward.Track)] {
+			continue
+		}
+		if reward.Track == model.BattlePassPremium && !progress.IsPremium {
+			continue
+		}
+		if !filter(reward) {
+			continue
+		}
+
+		if err := grantBattlePassReward(tx, pass, reward, progress.UserID, result); err != nil {
+			return nil, err
+		}
+		claim := &model.BattlePassClaim{UserID: progress.UserID, PassID: pass.ID, Tier: reward.Tier, Track: reward.Track}
+		if err := tx.Create(claim).Error; err != nil {
+			return nil, fmt.Errorf("배틀패스 보상 수령 기록 중 오류 발생: %w", err)
+		}
+		result.Rewards = append(result.Rewards, *reward)
+	}
+	return result, nil
+}
+
+// 배틀패스 보상을 지급
+func grantBattlePassReward(tx *gorm.DB, pass *model.BattlePass, reward *model.BattlePassReward, userID uint, result *BattlePassClaimResult) error {
+	if err := adjustUserGold(tx, userID, reward.Gold); err != nil {
+		return err
+	}
+	if err := adjustUserDiamond(tx, userID, reward.Diamond); err != nil {
+		return err
+	}
+	if _, err := adjustUserExperience(tx, userID, reward.Experience); err != nil {
+		return err
+	}
+	result.Gold += reward.Gold
+	result.Diamond += reward.Diamond
+	result.Experience += reward.Experience
+
+	if reward.ItemID != "" {
+		change := model.NewInventoryChange(model.InventorySourceBattlePass, userID, "battle_pass", pass.ID)
+		item, err := grantInventoryItem(tx, userID, reward.ToInventory(userID), reward.Quantity, change)
+		if err != nil {
+			return err
+		}
+		result.Items = append(result.Items, *item)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func setupBattlePassTest(t *testing.T) (*gorm.DB, *BattlePassService, *GameSessionService, *model.User, *model.Game, *model.BattlePass) {
+	db, sessions, user, game := setupGameSessionTest(t)
+	require.NoError(t, db.AutoMigrate(&model.BattlePass{}, &model.BattlePassReward{}, &model.UserBattlePass{}, &model.BattlePassClaim{}))
+
+	service := NewBattlePassService(db)
+	sessions.SetBattlePass(service)
+
+	now := time.Now()
+	pass := &model.BattlePass{
+		Name: "시즌 1", StartAt: now.Add(-time.Hour), EndAt: now.AddDate(0, 0, 30),
+		MaxTier: 5, XPPerTier: 100, SessionXP: 60, MissionXP: 50, PremiumPrice: 300, TierSkipPrice: 40,
+		Rewards: []model.BattlePassReward{
+			{Tier: 1, Track: model.BattlePassFree, Gold: 10},
+			{Tier: 1, Track: model.BattlePassPremium, Diamond: 5},
+			{Tier: 2, Track: model.BattlePassPremium, ItemID: "skin", ItemName: "스킨", ItemType: "cosmetic", Rarity: "epic", Quantity: 1},
+			{Tier: 3, Track: model.BattlePassFree, Gold: 30},
+		},
+	}
+	require.NoError(t, service.CreatePass(pass))
+	return db, service, sessions, user, game, pass
+}
+
+// 사용자 다이아몬드 잔액 조회
+func userDiamond(t *testing.T, db *gorm.DB, userID uint) int {
+	var user model.User
+	require.NoError(t, db.First(&user, userID).Error)
+	return user.Diamond
+}
+
+// 패스 경험치 적립, 티어 건너뛰기, 프리미엄 소급 보상, 보상 수령 테스트
+func TestBattlePassService_Progress(t *testing.T) {
+	db, service, sessions, alice, game, pass := setupBattlePassTest(t)
+
+	overlapping := &model.BattlePass{Name: "겹침", StartAt: pass.EndAt.Add(-time.Hour), EndAt: pass.EndAt.AddDate(0, 1, 0), MaxTier: 1, XPPerTier: 10}
+	assert.ErrorIs(t, service.CreatePass(overlapping), model.ErrBattlePassOverlap)
+
+	playSession(t, sessions, alice.ID, game.ID, &ScoreSubmission{Score: 100, Completed: true})
+	playSession(t, sessions, alice.ID, game.ID, &ScoreSubmission{Score: 100, Completed: true})
+
+	_, err := service.ClaimReward(alice.ID, pass.ID, 1, model.BattlePassPremium)
+	assert.ErrorIs(t, err, model.ErrBattlePassPremiumRequired)
+	_, err = service.ClaimReward(alice.ID, pass.ID, 2, model.BattlePassFree)
+	assert.ErrorIs(t, err, model.ErrBattlePassRewardNotFound)
+	_, err = service.ClaimReward(alice.ID, pass.ID, 3, model.BattlePassFree)
+	assert.ErrorIs(t, err, model.ErrBattlePassTierLocked)
+
+	gold := userGold(t, db, alice.ID)
+	claimed, err := service.ClaimAll(alice.ID, pass.ID)
+	require.NoError(t, err)
+	require.Len(t, claimed.Rewards, 1)
+	assert.Equal(t, gold+10, userGold(t, db, alice.ID))
+	_, err = service.ClaimAll(alice.ID, pass.ID)
+	assert.ErrorIs(t, err, model.ErrBattlePassNothingToClaim)
+
+	// 다이아몬드가 부족하면 건너뛸 수 없음
+	_, err = service.SkipTiers(alice.ID, pass.ID, 1)
+	assert.ErrorIs(t, err, ErrInsufficientDiamond)
+	require.NoError(t, db.Model(alice).Update("diamond", 1000).Error)
+
+	skipped, err := service.SkipTiers(alice.ID, pass.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, skipped.FromTier)
+	assert.Equal(t, 2, skipped.ToTier)
+	assert.Equal(t, 220, skipped.XP, "티어 안에서 쌓은 경험치는 유지되어야 합니다")
+	_, err = service.SkipTiers(alice.ID, pass.ID, 4)
+	assert.ErrorIs(t, err, model.ErrInvalidTierSkip)
+
+	// 프리미엄 해금 시 이미 도달한 티어의 프리미엄 보상을 소급 지급
+	unlocked, err := service.UnlockPremium(alice.ID, pass.ID)
+	require.NoError(t, err)
+	require.Len(t, unlocked.Rewards, 2)
+	assert.Equal(t, 300, unlocked.DiamondSpent)
+	require.Len(t, unlocked.Items, 1)
+	assert.Equal(t, "skin", unlocked.Items[0].ItemID)
+	assert.Equal(t, 1000-40-300+5, userDiamond(t, db, alice.ID))
+	_, err = service.UnlockPremium(alice.ID, pass.ID)
+	assert.ErrorIs(t, err, model.ErrBattlePassPremiumOwned)
+
+	var record model.InventoryLog
+	require.NoError(t, db.Where("user_id = ? AND item_id = ?", alice.ID, "skin").First(&record).Error)
+	assert.Equal(t, model.InventorySourceBattlePass, record.Source)
+
+	require.NoError(t, db.Transaction(func(tx *gorm.DB) error {
+		return service.RecordMission(tx, alice.ID)
+	}))
+
+	progress, err := service.GetProgress(alice.ID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 270, progress.XP)
+	assert.Equal(t, 2, progress.Tier)
+	assert.Equal(t, 30, progress.XPToNextTier)
+	assert.True(t, progress.IsPremium)
+	require.Len(t, progress.Tiers, 5)
+	assert.True(t, progress.Tiers[0].FreeClaimed)
+	assert.True(t, progress.Tiers[0].PremiumClaimed)
+	assert.True(t, progress.Tiers[1].PremiumClaimed)
+	assert.False(t, progress.Tiers[2].Unlocked)
+	require.NotNil(t, progress.Tiers[2].Free)
+	assert.Nil(t, progress.Tiers[2].Premium)
+}
+
+// 진행 중이 아닌 패스 처리 테스트
+func TestBattlePassService_Ended(t *testing.T) {
+	db, service, sessions, alice, game, pass := setupBattlePassTest(t)
+	playSession(t, sessions, alice.ID, game.ID, &ScoreSubmission{Score: 100, Completed: true})
+	playSession(t, sessions, alice.ID, game.ID, &ScoreSubmission{Score: 100, Completed: true})
+	require.NoError(t, db.Model(alice).Update("diamond", 1000).Error)
+
+	service.now = func() time.Time { return pass.EndAt }
+
+	_, err := service.GetProgress(alice.ID, 0)
+	assert.ErrorIs(t, err, model.ErrBattlePassNotFound, "진행 중인 패스가 없어야 합니다")
+	_, err = service.UnlockPremium(alice.ID, pass.ID)
+	assert.ErrorIs(t, err, model.ErrBattlePassNotActive)
+	_, err = service.SkipTiers(alice.ID, pass.ID, 1)
+	assert.ErrorIs(t, err, model.ErrBattlePassNotActive)
+
+	// 종료 후에도 도달한 티어 보상은 수령 가능하고, 새 점수는 경험치가 쌓이지 않음
+	_, err = service.ClaimReward(alice.ID, pass.ID, 1, model.BattlePassFree)
+	require.NoError(t, err)
+	require.NoError(t, db.Transaction(func(tx *gorm.DB) error {
+		return service.RecordMission(tx, alice.ID)
+	}))
+	progress, err := service.GetProgress(alice.ID, pass.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 120, progress.XP)
+	assert.True(t, progress.Tiers[0].FreeClaimed)
+}
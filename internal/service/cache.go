@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// 서비스에서 사용하는 키-값 캐시
+// 운영 환경에서는 Redis를 사용하며, 캐시 장애 시 서비스는 DB 조회로 대체한다.
+type Cache interface {
+	// 값을 조회 (없으면 ErrCacheMiss)
+	Get(ctx context.Context, key string) (string, error)
+
+	// 만료 시간과 함께 값을 저장
+	Set(ctx context.Context, key, value string, ttl time.Duration) error
+
+	// 키를 삭제
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// Redis 기반 캐시
+type redisCache struct {
+	client *redis.Client
+}
+
+// Redis 클라이언트로 캐시를 생성
+func NewRedisCache(client *redis.Client) Cache {
+	return &redisCache{client: client}
+}
+
+func (c *redisCache) Get(ctx context.Context, key string) (string, error) {
+	value, err := c.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ErrCacheMiss
+	}
+	return value, err
+}
+
+func (c *redisCache) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	return c.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (c *redisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return c.client.Del(ctx, keys...).Err()
+}
+
+// 에러 정의
+var (
+	ErrCacheMiss = errors.New("캐시에 값이 없습니다")
+)
@@ -0,0 +1,470 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"math/rand"
+	"time"
+)
+
+// 한 번에 제작할 수 있는 최대 횟수
+const maxCraftTimes = 10
+
+// 플레이어에게 보여줄 레시피 정보
+type PlayerRecipe struct {
+	model.Recipe
+	Unlocked     bool `json:"unlocked"`
+	CanCraft     bool `json:"can_craft"`
+	CraftCount   int  `json:"craft_count"`
+	SuccessCount int  `json:"success_count"`
+}
+
+// 제작 결과
+type CraftResult struct {
+	RecipeID       uint             `json:"recipe_id"`
+	Attempts       int              `json:"attempts"`
+	Successes      int              `json:"successes"`
+	Failures       int              `json:"failures"`
+	GoldSpent      int              `json:"gold_spent"`
+	OutputQuantity int              `json:"output_quantity"`
+	Output         *model.Inventory `json:"output,omitempty"`
+
+	// 이번 제작으로 달성한 업적
+	Achievements []model.Achievement `json:"achievements,omitempty"`
+}
+
+// 제작 및 레시피 관련 비즈니스 로직을 처리하는 서비스
+type CraftingService struct {
+	db *gorm.DB
+
+	// 0 이상 1 미만의 난수를 반환하는 함수 (테스트에서 교체 가능)
+	roll func() float64
+
+	// 제작 성공 시 평가할 업적 (설정하지 않으면 평가하지 않음)
+	achievements *AchievementService
+
+	// 인벤토리 통계 캐시 (설정하지 않으면 무효화하지 않음)
+	inventoryStats
+}
+
+// 새로운 CraftingService 인스턴스를 생성
+func NewCraftingService(db *gorm.DB) *CraftingService {
+	return &CraftingService{
+		db:   db,
+		roll: rand.Float64,
+	}
+}
+
+// 업적 서비스를 설정
+func (s *CraftingService) SetAchievements(achievements *AchievementService) {
+	s.achievements = achievements
+}
+
+// 레시피로 아이템을 제작
+// 재료와 골드는 시도 횟수만큼 소모되며, 성공한 횟수만큼 결과 아이템이 지급된다.
+// 모든 처리는 하나의 트랜잭션에서 수행된다.
+func (s *CraftingService) Craft(userID, recipeID uint, times int) (*CraftResult, error) {
+	if times <= 0 {
+		times = 1
+	}
+	if times > maxCraftTimes {
+		return nil, fmt.Errorf("%w: 최대 %d회까지 제작할 수 있습니다", model.ErrInvalidQuantity, maxCraftTimes)
+	}
+
+	result := &CraftResult{
+		RecipeID: recipeID,
+		Attempts: times,
+	}
+
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		recipe, err := s.loadRecipe(tx, recipeID)
+		if err != nil {
+			return err
+		}
+		if !recipe.IsActive {
+			return model.ErrRecipeInactive
+		}
+
+		user, err := lockUser(tx, userID)
+		if err != nil {
+			return err
+		}
+		if !recipe.MeetsLevel(user.Level) {
+			return fmt.Errorf("%w: 레벨 %d 이상 필요", model.ErrLevelTooLow, recipe.RequiredLevel)
+		}
+
+		unlock, err := s.findUserRecipe(tx, userID, recipeID)
+		if err != nil {
+			return err
+		}
+		if recipe.IsHidden && unlock == nil {
+			return model.ErrRecipeLocked
+		}
+
+		// 재료 및 골드 소모
+		change := model.NewInventoryChange(model.InventorySourceCraft, userID, "recipe", recipeID)
+		for _, material := range recipe.Materials {
+			if err := removeItemQuantity(tx, userID, material.ItemID, material.Quantity*times, change); err != nil {
+				return fmt.Errorf("재료 부족 (%s): %w", material.ItemID, err)
+			}
+		}
+
+		result.GoldSpent = recipe.GoldCost * times
+		if err := adjustUserGold(tx, userID, -result.GoldSpent); err != nil {
+			return err
+		}
+
+		// 성공 판정
+		for i := 0; i < times; i++ {
+			if s.roll() < recipe.SuccessRate {
+				result.Successes++
+			}
+		}
+		result.Failures = times - result.Successes
+
+		if result.Successes > 0 {
+			result.OutputQuantity = recipe.OutputQuantity * result.Successes
+			output, err := grantInventoryItem(tx, userID, recipe.OutputInventory(userID), result.OutputQuantity, change)
+			if err != nil {
+				return err
+			}
+			result.Output = output
+		}
+
+		// 제작 기록 갱신 (공개 레시피는 첫 제작 시 기록 생성)
+		if unlock == nil {
+			unlock = &model.UserRecipe{
+				UserID:     userID,
+				RecipeID:   recipeID,
+				Source:     model.RecipeSourceCrafted,
+				UnlockedAt: time.Now(),
+			}
+		}
+		unlock.CraftCount += times
+		unlock.SuccessCount += result.Successes
+		if err := tx.Save(unlock).Error; err != nil {
+			return fmt.Errorf("제작 기록 저장 중 오류 발생: %w", err)
+		}
+
+		if s.achievements != nil && result.Successes > 0 {
+			event := model.AchievementEvent{UserID: userID, Type: model.AchievementEventCraft, Count: result.Successes}
+			if result.Achievements, err = s.achievements.Record(tx, event); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// 사용자가 볼 수 있는 레시피 목록을 조회
+// 공개 레시피와 해금한 숨김 레시피를 반환하며, 현재 제작 가능 여부를 함께 표시
+func (s *CraftingService) GetPlayerRecipes(userID uint) ([]PlayerRecipe, error) {
+	var user model.User
+	if err := s.db.First(&user, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrInvalidUserID
+		}
+		return nil, fmt.Errorf("사용자 조회 중 오류 발생: %w", err)
+	}
+
+	var recipes []model.Recipe
+	if err := s.db.Preload("Materials").Where("is_active = ?", true).Order("id ASC").Find(&recipes).Error; err != nil {
+		return nil, fmt.Errorf("레시피 목록 조회 중 오류 발생: %w", err)
+	}
+
+	unlocks, err := s.userRecipeMap(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	holdings, err := s.userHoldings(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]PlayerRecipe, 0, len(recipes))
+	for _, recipe := range recipes {
+		unlock, unlocked := unlocks[recipe.ID]
+		if recipe.IsHidden && !unlocked {
+			continue
+		}
+
+		view := PlayerRecipe{
+			Recipe:   recipe,
+			Unlocked: true,
+			CanCraft: recipe.MeetsLevel(user.Level) && user.Gold >= recipe.GoldCost && hasMaterials(&recipe, holdings),
+		}
+		if unlocked {
+			view.CraftCount = unlock.CraftCount
+			view.SuccessCount = unlock.SuccessCount
+		}
+		result = append(result, view)
+	}
+
+	return result, nil
+}
+
+// 숨김 레시피 발견 처리
+// 사용자가 숨김 레시피의 재료를 모두 보유하고 있으면 해당 레시피를 해금하고, 새로 발견한 레시피를 반환
+func (s *CraftingService) DiscoverRecipes(userID uint) ([]model.Recipe, error) {
+	var recipes []model.Recipe
+	if err := s.db.Preload("Materials").Where("is_active = ? AND is_hidden = ?", true, true).Find(&recipes).Error; err != nil {
+		return nil, fmt.Errorf("숨김 레시피 조회 중 오류 발생: %w", err)
+	}
+
+	unlocks, err := s.userRecipeMap(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	holdings, err := s.userHoldings(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	discovered := make([]model.Recipe, 0)
+	for _, recipe := range recipes {
+		if _, unlocked := unlocks[recipe.ID]; unlocked {
+			continue
+		}
+		if !hasMaterials(&recipe, holdings) {
+			continue
+		}
+
+		if err := s.db.Create(&model.UserRecipe{
+			UserID:     userID,
+			RecipeID:   recipe.ID,
+			Source:     model.RecipeSourceDiscovered,
+			UnlockedAt: time.Now(),
+		}).Error; err != nil {
+			return nil, fmt.Errorf("레시피 해금 중 오류 발생: %w", err)
+		}
+		discovered = append(discovered, recipe)
+	}
+
+	return discovered, nil
+}
+
+// 관리자가 사용자에게 레시피를 해금
+// 이미 해금된 경우에는 아무 작업도 하지 않음
+func (s *CraftingService) UnlockRecipe(userID, recipeID uint) error {
+	if _, err := s.GetRecipeByID(recipeID); err != nil {
+		return err
+	}
+
+	var user model.User
+	if err := s.db.First(&user, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.ErrInvalidUserID
+		}
+		return fmt.Errorf("사용자 조회 중 오류 발생: %w", err)
+	}
+
+	existing, err := s.findUserRecipe(s.db, userID, recipeID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return nil
+	}
+
+	if err := s.db.Create(&model.UserRecipe{
+		UserID:     userID,
+		RecipeID:   recipeID,
+		Source:     model.RecipeSourceGranted,
+		UnlockedAt: time.Now(),
+	}).Error; err != nil {
+		return fmt.Errorf("레시피 해금 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 새로운 레시피를 생성
+func (s *CraftingService) CreateRecipe(recipe *model.Recipe) error {
+	if err := recipe.Validate(); err != nil {
+		return err
+	}
+
+	var count int64
+	if err := s.db.Model(&model.Recipe{}).Where("code = ?", recipe.Code).Count(&count).Error; err != nil {
+		return fmt.Errorf("레시피 코드 확인 중 오류 발생: %w", err)
+	}
+	if count > 0 {
+		return model.ErrDuplicateRecipeCode
+	}
+
+	if err := s.db.Create(recipe).Error; err != nil {
+		return fmt.Errorf("레시피 생성 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 레시피를 수정
+// 재료 목록은 전달된 목록으로 교체된다.
+func (s *CraftingService) UpdateRecipe(id uint, updated *model.Recipe) (*model.Recipe, error) {
+	if err := updated.Validate(); err != nil {
+		return nil, err
+	}
+
+	var recipe *model.Recipe
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		recipe, err = s.loadRecipe(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if updated.Code != recipe.Code {
+			var count int64
+			if err := tx.Model(&model.Recipe{}).Where("code = ? AND id <> ?", updated.Code, id).Count(&count).Error; err != nil {
+				return fmt.Errorf("레시피 코드 확인 중 오류 발생: %w", err)
+			}
+			if count > 0 {
+				return model.ErrDuplicateRecipeCode
+			}
+		}
+
+		if err := tx.Model(recipe).Select(
+			"code", "name", "description",
+			"output_item_id", "output_item_name", "output_item_type", "output_rarity", "output_level", "output_quantity",
+			"gold_cost", "required_level", "success_rate", "is_hidden", "is_active",
+		).Updates(&model.Recipe{
+			Code:           updated.Code,
+			Name:           updated.Name,
+			Description:    updated.Description,
+			OutputItemID:   updated.OutputItemID,
+			OutputItemName: updated.OutputItemName,
+			OutputItemType: updated.OutputItemType,
+			OutputRarity:   updated.OutputRarity,
+			OutputLevel:    updated.OutputLevel,
+			OutputQuantity: updated.OutputQuantity,
+			GoldCost:       updated.GoldCost,
+			RequiredLevel:  updated.RequiredLevel,
+			SuccessRate:    updated.SuccessRate,
+			IsHidden:       updated.IsHidden,
+			IsActive:       updated.IsActive,
+		}).Error; err != nil {
+			return fmt.Errorf("레시피 수정 중 오류 발생: %w", err)
+		}
+
+		if err := tx.Where("recipe_id = ?", id).Delete(&model.RecipeMaterial{}).Error; err != nil {
+			return fmt.Errorf("레시피 재료 삭제 중 오류 발생: %w", err)
+		}
+		materials := make([]model.RecipeMaterial, len(updated.Materials))
+		for i, material := range updated.Materials {
+			materials[i] = model.RecipeMaterial{
+				RecipeID: id,
+				ItemID:   material.ItemID,
+				Quantity: material.Quantity,
+			}
+		}
+		if err := tx.Create(&materials).Error; err != nil {
+			return fmt.Errorf("레시피 재료 생성 중 오류 발생: %w", err)
+		}
+
+		recipe, err = s.loadRecipe(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return recipe, nil
+}
+
+// 레시피를 삭제 (소프트 삭제)
+func (s *CraftingService) DeleteRecipe(id uint) error {
+	result := s.db.Delete(&model.Recipe{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("레시피 삭제 중 오류 발생: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return model.ErrRecipeNotFound
+	}
+	return nil
+}
+
+// ID로 레시피를 조회
+func (s *CraftingService) GetRecipeByID(id uint) (*model.Recipe, error) {
+	return s.loadRecipe(s.db, id)
+}
+
+// 전체 레시피 목록을 조회 (관리자용, 숨김/비활성 포함)
+func (s *CraftingService) GetAllRecipes(limit, offset int) ([]model.Recipe, error) {
+	var recipes []model.Recipe
+	if err := s.db.Preload("Materials").Order("id ASC").Limit(limit).Offset(offset).Find(&recipes).Error; err != nil {
+		return nil, fmt.Errorf("레시피 목록 조회 중 오류 발생: %w", err)
+	}
+	return recipes, nil
+}
+
+// 재료를 포함한 레시피를 조회
+func (s *CraftingService) loadRecipe(db *gorm.DB, id uint) (*model.Recipe, error) {
+	var recipe model.Recipe
+	if err := db.Preload("Materials").First(&recipe, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrRecipeNotFound
+		}
+		return nil, fmt.Errorf("레시피 조회 중 오류 발생: %w", err)
+	}
+	return &recipe, nil
+}
+
+// 사용자의 레시피 기록을 조회 (없으면 nil)
+func (s *CraftingService) findUserRecipe(db *gorm.DB, userID, recipeID uint) (*model.UserRecipe, error) {
+	var unlock model.UserRecipe
+	err := db.Where("user_id = ? AND recipe_id = ?", userID, recipeID).First(&unlock).Error
+	if err == nil {
+		return &unlock, nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("레시피 기록 조회 중 오류 발생: %w", err)
+}
+
+// 사용자의 레시피 기록을 레시피 ID 기준 맵으로 조회
+func (s *CraftingService) userRecipeMap(userID uint) (map[uint]model.UserRecipe, error) {
+	var unlocks []model.UserRecipe
+	if err := s.db.Where("user_id = ?", userID).Find(&unlocks).Error; err != nil {
+		return nil, fmt.Errorf("레시피 기록 조회 중 오류 발생: %w", err)
+	}
+
+	result := make(map[uint]model.UserRecipe, len(unlocks))
+	for _, unlock := range unlocks {
+		result[unlock.RecipeID] = unlock
+	}
+	return result, nil
+}
+
+// 사용자의 아이템 보유 수량을 아이템 ID 기준 맵으로 조회
+func (s *CraftingService) userHoldings(userID uint) (map[string]int, error) {
+	var inventories []model.Inventory
+	if err := s.db.Where("user_id = ?", userID).Find(&inventories).Error; err != nil {
+		return nil, fmt.Errorf("인벤토리 조회 중 오류 발생: %w", err)
+	}
+
+	holdings := make(map[string]int, len(inventories))
+	for _, inventory := range inventories {
+		holdings[inventory.ItemID] += inventory.Quantity
+	}
+	return holdings, nil
+}
+
+// 보유 아이템으로 레시피 재료를 모두 충족하는지 확인
+func hasMaterials(recipe *model.Recipe, holdings map[string]int) bool {
+	for _, material := range recipe.Materials {
+		if holdings[material.ItemID] < material.Quantity {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"testing"
+
+	"g_dev/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// 제작 테스트용 서비스와 기본 레시피를 설정
+func setupCraftingTest(t *testing.T) (*gorm.DB, *CraftingService, *model.Recipe) {
+	db := setupGameTestDB(t, &model.Recipe{}, &model.RecipeMaterial{}, &model.UserRecipe{})
+	service := NewCraftingService(db)
+
+	recipe := &model.Recipe{
+		Code:           "iron_sword",
+		Name:           "철검 제작",
+		OutputItemID:   "iron_sword",
+		OutputItemName: "철검",
+		OutputItemType: "weapon",
+		OutputRarity:   "rare",
+		OutputLevel:    1,
+		OutputQuantity: 1,
+		GoldCost:       100,
+		SuccessRate:    0.5,
+		IsActive:       true,
+		Materials: []model.RecipeMaterial{
+			{ItemID: "iron_ore", Quantity: 3},
+		},
+	}
+	require.NoError(t, service.CreateRecipe(recipe))
+
+	return db, service, recipe
+}
+
+// 고정된 난수 순서를 반환하는 함수 생성
+func fixedRolls(values ...float64) func() float64 {
+	i := 0
+	return func() float64 {
+		v := values[i%len(values)]
+		i++
+		return v
+	}
+}
+
+// 제작 시 재료와 골드 소모, 결과 지급 테스트
+func TestCraftingService_Craft(t *testing.T) {
+	db, service, recipe := setupCraftingTest(t)
+	service.roll = fixedRolls(0.1, 0.9)
+
+	user := seedUser(t, db, "crafter", 500)
+	material := seedItem(t, db, user.ID, "iron_ore", "common", 7)
+	require.NoError(t, db.Model(material).Update("item_type", "material").Error)
+
+	result, err := service.Craft(user.ID, recipe.ID, 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.Successes)
+	assert.Equal(t, 1, result.Failures)
+	assert.Equal(t, 200, result.GoldSpent)
+	assert.Equal(t, 300, userGold(t, db, user.ID))
+	assert.Equal(t, 1, itemQuantity(t, db, user.ID, "iron_ore"), "실패한 시도에도 재료가 소모되어야 합니다")
+	assert.Equal(t, 1, itemQuantity(t, db, user.ID, "iron_sword"), "성공한 횟수만큼 결과가 지급되어야 합니다")
+
+	var unlock model.UserRecipe
+	require.NoError(t, db.Where("user_id = ? AND recipe_id = ?", user.ID, recipe.ID).First(&unlock).Error)
+	assert.Equal(t, 2, unlock.CraftCount)
+	assert.Equal(t, 1, unlock.SuccessCount)
+
+	t.Run("재료 부족 시 전체 롤백", func(t *testing.T) {
+		_, err := service.Craft(user.ID, recipe.ID, 1)
+		assert.ErrorIs(t, err, model.ErrInsufficientQuantity)
+		assert.Equal(t, 300, userGold(t, db, user.ID), "실패 시 골드가 차감되지 않아야 합니다")
+		assert.Equal(t, 1, itemQuantity(t, db, user.ID, "iron_ore"))
+	})
+
+	t.Run("골드 부족", func(t *testing.T) {
+		poor := seedUser(t, db, "poor", 50)
+		seedItem(t, db, poor.ID, "iron_ore", "common", 3)
+
+		_, err := service.Craft(poor.ID, recipe.ID, 1)
+		assert.ErrorIs(t, err, ErrInsufficientGold)
+		assert.Equal(t, 3, itemQuantity(t, db, poor.ID, "iron_ore"), "골드 부족 시 재료가 반환되어야 합니다")
+	})
+
+	t.Run("여러 행에 나뉜 재료 합산", func(t *testing.T) {
+		split := seedUser(t, db, "split", 500)
+		seedItem(t, db, split.ID, "iron_ore", "common", 2)
+		upper := seedItem(t, db, split.ID, "iron_ore", "common", 2)
+		require.NoError(t, db.Model(upper).Update("level", 3).Error)
+
+		_, err := service.Craft(split.ID, recipe.ID, 1)
+		require.NoError(t, err)
+
+		var rows []model.Inventory
+		require.NoError(t, db.Where("user_id = ? AND item_id = ?", split.ID, "iron_ore").Find(&rows).Error)
+		require.Len(t, rows, 1, "낮은 레벨 행부터 모두 소모되어야 합니다")
+		assert.Equal(t, upper.ID, rows[0].ID)
+		assert.Equal(t, 1, rows[0].Quantity)
+
+		_, err = service.Craft(split.ID, recipe.ID, 1)
+		assert.ErrorIs(t, err, model.ErrInsufficientQuantity)
+		assert.Equal(t, 1, itemQuantity(t, db, split.ID, "iron_ore"), "합계가 부족하면 어느 행도 차감되지 않아야 합니다")
+	})
+
+	t.Run("요구 레벨 부족", func(t *testing.T) {
+		require.NoError(t, db.Model(&model.Recipe{}).Where("id = ?", recipe.ID).Update("required_level", 10).Error)
+		_, err := service.Craft(user.ID, recipe.ID, 1)
+		assert.ErrorIs(t, err, model.ErrLevelTooLow)
+	})
+}
+
+// 숨김 레시피 발견 및 해금 테스트
+func TestCraftingService_HiddenRecipes(t *testing.T) {
+	db, service, _ := setupCraftingTest(t)
+	service.roll = fixedRolls(0)
+
+	hidden := &model.Recipe{
+		Code:           "dragon_blade",
+		Name:           "용검 제작",
+		OutputItemID:   "dragon_blade",
+		OutputItemName: "용검",
+		OutputItemType: "weapon",
+		OutputRarity:   "legendary",
+		OutputLevel:    1,
+		OutputQuantity: 1,
+		SuccessRate:    1,
+		IsHidden:       true,
+		IsActive:       true,
+		Materials: []model.RecipeMaterial{
+			{ItemID: "dragon_scale", Quantity: 1},
+		},
+	}
+	require.NoError(t, service.CreateRecipe(hidden))
+
+	user := seedUser(t, db, "explorer", 0)
+
+	recipes, err := service.GetPlayerRecipes(user.ID)
+	require.NoError(t, err)
+	assert.Len(t, recipes, 1, "해금 전 숨김 레시피는 보이지 않아야 합니다")
+	assert.False(t, recipes[0].CanCraft)
+
+	_, err = service.Craft(user.ID, hidden.ID, 1)
+	assert.ErrorIs(t, err, model.ErrRecipeLocked)
+
+	discovered, err := service.DiscoverRecipes(user.ID)
+	require.NoError(t, err)
+	assert.Empty(t, discovered, "재료가 없으면 발견되지 않아야 합니다")
+
+	seedItem(t, db, user.ID, "dragon_scale", "epic", 1)
+	discovered, err = service.DiscoverRecipes(user.ID)
+	require.NoError(t, err)
+	require.Len(t, discovered, 1)
+	assert.Equal(t, "dragon_blade", discovered[0].Code)
+
+	recipes, err = service.GetPlayerRecipes(user.ID)
+	require.NoError(t, err)
+	assert.Len(t, recipes, 2)
+
+	result, err := service.Craft(user.ID, hidden.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Successes)
+
+	t.Run("관리자 해금", func(t *testing.T) {
+		other := seedUser(t, db, "granted", 0)
+		require.NoError(t, service.UnlockRecipe(other.ID, hidden.ID))
+		require.NoError(t, service.UnlockRecipe(other.ID, hidden.ID), "중복 해금은 무시되어야 합니다")
+
+		recipes, err := service.GetPlayerRecipes(other.ID)
+		require.NoError(t, err)
+		assert.Len(t, recipes, 2)
+	})
+}
+
+// 레시피 관리 기능 테스트
+func TestCraftingService_ManageRecipes(t *testing.T) {
+	_, service, recipe := setupCraftingTest(t)
+
+	duplicate := *recipe
+	duplicate.ID = 0
+	duplicate.Materials = []model.RecipeMaterial{{ItemID: "iron_ore", Quantity: 1}}
+	assert.ErrorIs(t, service.CreateRecipe(&duplicate), model.ErrDuplicateRecipeCode)
+
+	changes := *recipe
+	changes.GoldCost = 250
+	changes.Materials = []model.RecipeMaterial{
+		{ItemID: "iron_ore", Quantity: 2},
+		{ItemID: "coal", Quantity: 1},
+	}
+	updated, err := service.UpdateRecipe(recipe.ID, &changes)
+	require.NoError(t, err)
+	assert.Equal(t, 250, updated.GoldCost)
+	assert.Len(t, updated.Materials, 2, "재료 목록이 교체되어야 합니다")
+
+	require.NoError(t, service.DeleteRecipe(recipe.ID))
+	_, err = service.GetRecipeByID(recipe.ID)
+	assert.ErrorIs(t, err, model.ErrRecipeNotFound)
+	assert.ErrorIs(t, service.DeleteRecipe(recipe.ID), model.ErrRecipeNotFound)
+}
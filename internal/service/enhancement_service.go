@@ -0,0 +1,255 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"math/rand"
+)
+
+// 강화 시도 전 확인용 정보
+type EnhancePreview struct {
+	InventoryID    uint                 `json:"inventory_id"`
+	ItemID         string               `json:"item_id"`
+	Rarity         string               `json:"rarity"`
+	CurrentLevel   int                  `json:"current_level"`
+	MaxLevel       int                  `json:"max_level"`
+	GoldCost       int                  `json:"gold_cost"`
+	MaterialItemID string               `json:"material_item_id"`
+	MaterialCost   int                  `json:"material_cost"`
+	SuccessRate    float64              `json:"success_rate"`
+	FailStreak     int                  `json:"fail_streak"`
+	FailureOutcome model.EnhanceOutcome `json:"failure_outcome"`
+}
+
+// 강화 결과
+type EnhanceResult struct {
+	Log  *model.EnhancementLog `json:"log"`
+	Item *model.Inventory      `json:"item,omitempty"` // 파괴된 경우 nil
+}
+
+// 아이템 강화 관련 비즈니스 로직을 처리하는 서비스
+type EnhancementService struct {
+	db *gorm.DB
+
+	// 0 이상 1 미만의 난수를 반환하는 함수 (테스트에서 교체 가능)
+	roll func() float64
+
+	// 인벤토리 통계 캐시 (설정하지 않으면 무효화하지 않음)
+	inventoryStats
+}
+
+// 새로운 EnhancementService 인스턴스를 생성
+func NewEnhancementService(db *gorm.DB) *EnhancementService {
+	return &EnhancementService{
+		db:   db,
+		roll: rand.Float64,
+	}
+}
+
+// 아이템 강화를 시도
+// 골드와 강화석을 소모하고 성공 확률에 따라 레벨을 올린다. 실패 시 희귀도별 규칙에 따라
+// 변화 없음, 레벨 하락, 파괴 중 하나가 적용되며, 보호 주문서를 사용하면 하락/파괴를 막을 수 있다.
+func (s *EnhancementService) Enhance(userID, inventoryID uint, useProtection bool) (*EnhanceResult, error) {
+	result := &EnhanceResult{}
+
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		item, err := s.lockEnhanceableItem(tx, userID, inventoryID)
+		if err != nil {
+			return err
+		}
+
+		rule := model.EnhancementRuleFor(item.Rarity)
+		if item.Level >= rule.MaxLevel {
+			return model.ErrMaxEnhanceLevel
+		}
+
+		streak, err := s.failStreak(tx, userID, inventoryID)
+		if err != nil {
+			return err
+		}
+
+		log := &model.EnhancementLog{
+			UserID:       userID,
+			InventoryID:  inventoryID,
+			ItemID:       item.ItemID,
+			Rarity:       item.Rarity,
+			FromLevel:    item.Level,
+			SuccessRate:  rule.SuccessRate(item.Level, streak),
+			FailStreak:   streak,
+			GoldCost:     rule.GoldCost(item.Level),
+			MaterialCost: rule.MaterialCost(item.Level),
+		}
+
+		// 비용 소모
+		change := model.NewInventoryChange(model.InventorySourceEnhance, userID, "inventory", inventoryID)
+		if err := adjustUserGold(tx, userID, -log.GoldCost); err != nil {
+			return err
+		}
+		stone, err := lockInventoryItem(tx, userID, model.EnhanceStoneItemID)
+		if err != nil {
+			return err
+		}
+		if err := removeInventoryQuantity(tx, stone, log.MaterialCost, change); err != nil {
+			return fmt.Errorf("강화석 부족: %w", err)
+		}
+
+		// 보호 주문서는 실패 시 하락/파괴가 발생하는 레벨에서만 소모
+		failure := rule.FailureOutcome(item.Level)
+		if useProtection && failure != model.EnhanceOutcomeNoChange {
+			scroll, err := lockInventoryItem(tx, userID, model.ProtectionScrollItemID)
+			if err != nil {
+				return err
+			}
+			if err := removeInventoryQuantity(tx, scroll, 1, change); err != nil {
+				return err
+			}
+			log.Protected = true
+		}
+
+		// 성공 판정
+		log.Roll = s.roll()
+		log.ToLevel = item.Level
+		switch {
+		case log.Roll < log.SuccessRate:
+			log.Outcome = model.EnhanceOutcomeSuccess
+			log.ToLevel++
+		case log.Protected:
+			log.Outcome = model.EnhanceOutcomeProtected
+		case failure == model.EnhanceOutcomeDowngrade:
+			log.Outcome = model.EnhanceOutcomeDowngrade
+			log.ToLevel--
+		case failure == model.EnhanceOutcomeDestroyed:
+			log.Outcome = model.EnhanceOutcomeDestroyed
+			log.ToLevel = 0
+		default:
+			log.Outcome = model.EnhanceOutcomeNoChange
+		}
+
+		// 여러 개가 겹쳐진 아이템은 1개만 떼어 강화 결과를 적용 (나머지는 그대로 남김)
+		// 강화 기록은 요청한 행 기준으로 남겨 겹쳐진 아이템의 연속 실패 횟수가 이어지도록 한다.
+		switch {
+		case log.Outcome == model.EnhanceOutcomeDestroyed && item.Quantity > 1:
+			if err := removeInventoryQuantity(tx, item, 1, change); err != nil {
+				return fmt.Errorf("아이템 파괴 처리 중 오류 발생: %w", err)
+			}
+		case log.Outcome == model.EnhanceOutcomeDestroyed:
+			if err := deleteInventoryItem(tx, item, change); err != nil {
+				return fmt.Errorf("아이템 파괴 처리 중 오류 발생: %w", err)
+			}
+		case log.ToLevel != log.FromLevel:
+			if item.Quantity > 1 {
+				if item, err = splitInventoryUnit(tx, item, change); err != nil {
+					return err
+				}
+			}
+			item.Level = log.ToLevel
+			if err := tx.Model(item).Update("level", item.Level).Error; err != nil {
+				return fmt.Errorf("아이템 레벨 업데이트 중 오류 발생: %w", err)
+			}
+			if err := recordInventoryChange(tx, item, item.Quantity, log.FromLevel, change); err != nil {
+				return err
+			}
+			result.Item = item
+		default:
+			result.Item = item
+		}
+
+		if err := tx.Create(log).Error; err != nil {
+			return fmt.Errorf("강화 기록 생성 중 오류 발생: %w", err)
+		}
+		result.Log = log
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// 강화 시도 전 비용과 확률을 조회
+func (s *EnhancementService) GetPreview(userID, inventoryID uint) (*EnhancePreview, error) {
+	var item model.Inventory
+	if err := s.db.Where("id = ? AND user_id = ?", inventoryID, userID).First(&item).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrItemNotOwned
+		}
+		return nil, fmt.Errorf("아이템 조회 중 오류 발생: %w", err)
+	}
+	if !model.IsEnhanceable(item.ItemType) {
+		return nil, model.ErrItemNotEnhanceable
+	}
+
+	streak, err := s.failStreak(s.db, userID, inventoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := model.EnhancementRuleFor(item.Rarity)
+	return &EnhancePreview{
+		InventoryID:    item.ID,
+		ItemID:         item.ItemID,
+		Rarity:         item.Rarity,
+		CurrentLevel:   item.Level,
+		MaxLevel:       rule.MaxLevel,
+		GoldCost:       rule.GoldCost(item.Level),
+		MaterialItemID: model.EnhanceStoneItemID,
+		MaterialCost:   rule.MaterialCost(item.Level),
+		SuccessRate:    rule.SuccessRate(item.Level, streak),
+		FailStreak:     streak,
+		FailureOutcome: rule.FailureOutcome(item.Level),
+	}, nil
+}
+
+// 강화 기록을 최신순으로 조회
+// userID가 0이면 전체 사용자의 기록을 조회 (관리자 감사용)
+func (s *EnhancementService) GetLogs(userID uint, limit, offset int) ([]model.EnhancementLog, error) {
+	query := s.db.Model(&model.EnhancementLog{})
+	if userID != 0 {
+		query = query.Where("user_id = ?", userID)
+	}
+
+	var logs []model.EnhancementLog
+	if err := query.Order("id DESC").Limit(limit).Offset(offset).Find(&logs).Error; err != nil {
+		return nil, fmt.Errorf("강화 기록 조회 중 오류 발생: %w", err)
+	}
+	return logs, nil
+}
+
+// 강화할 아이템을 잠금 상태로 조회하고 강화 가능 여부를 확인
+func (s *EnhancementService) lockEnhanceableItem(tx *gorm.DB, userID, inventoryID uint) (*model.Inventory, error) {
+	var item model.Inventory
+	if err := lockForUpdate(tx).Where("id = ? AND user_id = ?", inventoryID, userID).First(&item).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrItemNotOwned
+		}
+		return nil, fmt.Errorf("아이템 조회 중 오류 발생: %w", err)
+	}
+	if !model.IsEnhanceable(item.ItemType) {
+		return nil, model.ErrItemNotEnhanceable
+	}
+	return &item, nil
+}
+
+// 아이템의 마지막 강화 성공 이후 연속 실패 횟수를 계산
+func (s *EnhancementService) failStreak(db *gorm.DB, userID, inventoryID uint) (int, error) {
+	var outcomes []model.EnhanceOutcome
+	if err := db.Model(&model.EnhancementLog{}).
+		Where("user_id = ? AND inventory_id = ?", userID, inventoryID).
+		Order("id DESC").
+		Limit(model.PityGuaranteeStreak).
+		Pluck("outcome", &outcomes).Error; err != nil {
+		return 0, fmt.Errorf("강화 기록 조회 중 오류 발생: %w", err)
+	}
+
+	streak := 0
+	for _, outcome := range outcomes {
+		if outcome == model.EnhanceOutcomeSuccess {
+			break
+		}
+		streak++
+	}
+	return streak, nil
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"testing"
+
+	"g_dev/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// 강화 테스트용 사용자와 아이템을 설정
+func setupEnhancementTest(t *testing.T, rarity string, level int) (*gorm.DB, *EnhancementService, *model.User, *model.Inventory) {
+	db := setupGameTestDB(t, &model.EnhancementLog{})
+	service := NewEnhancementService(db)
+
+	user := seedUser(t, db, "smith", 10000)
+	item := seedItem(t, db, user.ID, "sword", rarity, 1)
+	require.NoError(t, db.Model(item).Update("level", level).Error)
+	stone := seedItem(t, db, user.ID, model.EnhanceStoneItemID, "common", 20)
+	require.NoError(t, db.Model(stone).Update("item_type", "material").Error)
+
+	return db, service, user, item
+}
+
+// 현재 아이템 레벨 조회
+func itemLevel(t *testing.T, db *gorm.DB, inventoryID uint) int {
+	var inventory model.Inventory
+	require.NoError(t, db.First(&inventory, inventoryID).Error)
+	return inventory.Level
+}
+
+// 강화 성공 시 레벨 상승과 비용 소모 테스트
+func TestEnhancementService_Success(t *testing.T) {
+	db, service, user, item := setupEnhancementTest(t, "rare", 1)
+	service.roll = fixedRolls(0.1)
+
+	result, err := service.Enhance(user.ID, item.ID, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, model.EnhanceOutcomeSuccess, result.Log.Outcome)
+	assert.Equal(t, 2, result.Item.Level)
+	assert.Equal(t, 2, itemLevel(t, db, item.ID))
+	assert.Equal(t, 9900, userGold(t, db, user.ID))
+	assert.Equal(t, 19, itemQuantity(t, db, user.ID, model.EnhanceStoneItemID))
+	assert.Equal(t, 0.1, result.Log.Roll, "난수 값이 기록되어야 합니다")
+}
+
+// 실패 결과별 처리 테스트
+func TestEnhancementService_Failures(t *testing.T) {
+	t.Run("변화 없음", func(t *testing.T) {
+		db, service, user, item := setupEnhancementTest(t, "rare", 2)
+		service.roll = fixedRolls(0.99)
+
+		result, err := service.Enhance(user.ID, item.ID, false)
+		require.NoError(t, err)
+		assert.Equal(t, model.EnhanceOutcomeNoChange, result.Log.Outcome)
+		assert.Equal(t, 2, itemLevel(t, db, item.ID))
+	})
+
+	t.Run("레벨 하락", func(t *testing.T) {
+		db, service, user, item := setupEnhancementTest(t, "rare", 6)
+		service.roll = fixedRolls(0.99)
+
+		result, err := service.Enhance(user.ID, item.ID, false)
+		require.NoError(t, err)
+		assert.Equal(t, model.EnhanceOutcomeDowngrade, result.Log.Outcome)
+		assert.Equal(t, 5, itemLevel(t, db, item.ID))
+	})
+
+	t.Run("아이템 파괴", func(t *testing.T) {
+		db, service, user, item := setupEnhancementTest(t, "rare", 10)
+		service.roll = fixedRolls(0.99)
+
+		result, err := service.Enhance(user.ID, item.ID, false)
+		require.NoError(t, err)
+		assert.Equal(t, model.EnhanceOutcomeDestroyed, result.Log.Outcome)
+		assert.Nil(t, result.Item)
+		assert.Equal(t, 0, itemQuantity(t, db, user.ID, "sword"), "파괴된 아이템은 삭제되어야 합니다")
+	})
+
+	t.Run("보호 주문서로 파괴 방지", func(t *testing.T) {
+		db, service, user, item := setupEnhancementTest(t, "rare", 10)
+		seedItem(t, db, user.ID, model.ProtectionScrollItemID, "epic", 1)
+		service.roll = fixedRolls(0.99)
+
+		result, err := service.Enhance(user.ID, item.ID, true)
+		require.NoError(t, err)
+		assert.Equal(t, model.EnhanceOutcomeProtected, result.Log.Outcome)
+		assert.True(t, result.Log.Protected)
+		assert.Equal(t, 10, itemLevel(t, db, item.ID))
+		assert.Equal(t, 0, itemQuantity(t, db, user.ID, model.ProtectionScrollItemID), "주문서가 소모되어야 합니다")
+
+		_, err = service.Enhance(user.ID, item.ID, true)
+		assert.ErrorIs(t, err, ErrItemNotOwned, "주문서가 없으면 보호 강화를 할 수 없어야 합니다")
+	})
+
+	t.Run("하락이 없는 레벨에서는 주문서를 소모하지 않음", func(t *testing.T) {
+		db, service, user, item := setupEnhancementTest(t, "rare", 1)
+		seedItem(t, db, user.ID, model.ProtectionScrollItemID, "epic", 1)
+		service.roll = fixedRolls(0.99)
+
+		result, err := service.Enhance(user.ID, item.ID, true)
+		require.NoError(t, err)
+		assert.False(t, result.Log.Protected)
+		assert.Equal(t, 1, itemQuantity(t, db, user.ID, model.ProtectionScrollItemID))
+	})
+}
+
+// 연속 실패에 따른 천장 보정 테스트
+func TestEnhancementService_Pity(t *testing.T) {
+	_, service, user, item := setupEnhancementTest(t, "common", 5)
+	service.roll = fixedRolls(0.99)
+
+	for i := 0; i < 3; i++ {
+		_, err := service.Enhance(user.ID, item.ID, false)
+		require.NoError(t, err)
+	}
+
+	preview, err := service.GetPreview(user.ID, item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, preview.FailStreak)
+	assert.InDelta(t, 0.9, preview.SuccessRate, 0.0001, "천장 보정으로 확률이 증가해야 합니다")
+
+	service.roll = fixedRolls(0.85)
+	result, err := service.Enhance(user.ID, item.ID, false)
+	require.NoError(t, err)
+	assert.Equal(t, model.EnhanceOutcomeSuccess, result.Log.Outcome)
+	assert.Equal(t, 3, result.Log.FailStreak)
+
+	preview, err = service.GetPreview(user.ID, item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, preview.FailStreak, "성공 후 연속 실패가 초기화되어야 합니다")
+
+	logs, err := service.GetLogs(user.ID, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, logs, 4, "모든 시도가 기록되어야 합니다")
+}
+
+// 여러 개가 겹쳐진 아이템은 1개만 떼어 강화하는지 테스트
+func TestEnhancementService_StackedItem(t *testing.T) {
+	db, service, user, _ := setupEnhancementTest(t, "rare", 1)
+	stacked := seedItem(t, db, user.ID, "shield", "rare", 3)
+
+	// 실패해 변화가 없으면 겹쳐진 그대로 유지
+	service.roll = fixedRolls(0.99)
+	result, err := service.Enhance(user.ID, stacked.ID, false)
+	require.NoError(t, err)
+	assert.Equal(t, model.EnhanceOutcomeNoChange, result.Log.Outcome)
+	assert.Equal(t, stacked.ID, result.Item.ID)
+	assert.Equal(t, 3, itemQuantity(t, db, user.ID, "shield"))
+
+	// 성공하면 1개만 떼어 레벨을 올림
+	service.roll = fixedRolls(0.1)
+	result, err = service.Enhance(user.ID, stacked.ID, false)
+	require.NoError(t, err)
+	assert.Equal(t, model.EnhanceOutcomeSuccess, result.Log.Outcome)
+	assert.Equal(t, stacked.ID, result.Log.InventoryID, "강화 기록은 요청한 행 기준이어야 합니다")
+	assert.NotEqual(t, stacked.ID, result.Item.ID)
+	assert.Equal(t, 1, result.Item.Quantity)
+	assert.Equal(t, 2, itemLevel(t, db, result.Item.ID))
+
+	var rows []model.Inventory
+	require.NoError(t, db.Where("user_id = ? AND item_id = ?", user.ID, "shield").Order("id ASC").Find(&rows).Error)
+	require.Len(t, rows, 2)
+	assert.Equal(t, 1, rows[0].Level)
+	assert.Equal(t, 2, rows[0].Quantity)
+	assert.Equal(t, 2, rows[1].Level)
+	assert.Equal(t, 1, rows[1].Quantity)
+
+	// 떼어낸 아이템은 다시 강화할 수 있음
+	_, err = service.Enhance(user.ID, result.Item.ID, false)
+	require.NoError(t, err)
+	assert.Equal(t, 3, itemLevel(t, db, result.Item.ID))
+
+	var logs []model.InventoryLog
+	require.NoError(t, db.Where("user_id = ? AND item_id = ?", user.ID, "shield").Order("id ASC").Find(&logs).Error)
+	require.Len(t, logs, 4, "분리, 새 행 생성, 레벨 변경 2회가 기록되어야 합니다")
+	assert.Equal(t, 3, logs[0].BeforeQuantity)
+	assert.Equal(t, 2, logs[0].AfterQuantity)
+	assert.Equal(t, 0, logs[1].BeforeQuantity)
+	assert.Equal(t, 1, logs[1].AfterQuantity)
+}
+
+// 강화 불가 조건 테스트
+func TestEnhancementService_Validation(t *testing.T) {
+	db, service, user, item := setupEnhancementTest(t, "common", 10)
+
+	_, err := service.Enhance(user.ID, item.ID, false)
+	assert.ErrorIs(t, err, model.ErrMaxEnhanceLevel)
+
+	other := seedUser(t, db, "other", 0)
+	_, err = service.Enhance(other.ID, item.ID, false)
+	assert.ErrorIs(t, err, ErrItemNotOwned)
+
+	var stone model.Inventory
+	require.NoError(t, db.Where("user_id = ? AND item_id = ?", user.ID, model.EnhanceStoneItemID).First(&stone).Error)
+	_, err = service.Enhance(user.ID, stone.ID, false)
+	assert.ErrorIs(t, err, model.ErrItemNotEnhanceable)
+}
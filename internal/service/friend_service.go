@@ -0,0 +1,113 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+)
+
+// 친구 요청과 친구 목록을 관리하는 서비스
+// 요청은 요청한 사용자 기준의 pending 행으로 저장하고, 상대가 요청을 보내면 수락으로 처리해 양방향 모두 accepted가 된다.
+type FriendService struct {
+	db *gorm.DB
+}
+
+// 새로운 FriendService 인스턴스를 생성
+func NewFriendService(db *gorm.DB) *FriendService {
+	return &FriendService{db: db}
+}
+
+// 친구 요청을 보내거나 받은 요청을 수락
+func (s *FriendService) SendRequest(userID, friendID uint) (*model.Friendship, error) {
+	if userID == friendID {
+		return nil, model.ErrCannotFriendSelf
+	}
+
+	var friendship model.Friendship
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockUser(tx, friendID); err != nil {
+			return err
+		}
+
+		var existing model.Friendship
+		err := lockForUpdate(tx).Where("user_id = ? AND friend_id = ?", userID, friendID).First(&existing).Error
+		if err == nil {
+			if existing.Status == model.FriendshipPending {
+				return model.ErrFriendRequestExists
+			}
+			friendship = existing
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("친구 관계 조회 중 오류 발생: %w", err)
+		}
+
+		// 상대가 먼저 보낸 요청이 있으면 수락
+		friendship = model.Friendship{UserID: userID, FriendID: friendID, Status: model.FriendshipPending}
+		var reverse model.Friendship
+		err = lockForUpdate(tx).Where("user_id = ? AND friend_id = ?", friendID, userID).First(&reverse).Error
+		if err == nil {
+			friendship.Status = model.FriendshipAccepted
+			if err := tx.Model(&reverse).Update("status", model.FriendshipAccepted).Error; err != nil {
+				return fmt.Errorf("친구 요청 수락 중 오류 발생: %w", err)
+			}
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("친구 관계 조회 중 오류 발생: %w", err)
+		}
+
+		if err := tx.Create(&friendship).Error; err != nil {
+			return fmt.Errorf("친구 요청 생성 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &friendship, nil
+}
+
+// 친구를 삭제하거나 요청을 취소/거절 (양방향 모두 삭제)
+func (s *FriendService) RemoveFriend(userID, friendID uint) error {
+	result := s.db.Where("(user_id = ? AND friend_id = ?) OR (user_id = ? AND friend_id = ?)", userID, friendID, friendID, userID).
+		Delete(&model.Friendship{})
+	if result.Error != nil {
+		return fmt.Errorf("친구 삭제 중 오류 발생: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return model.ErrFriendshipNotFound
+	}
+	return nil
+}
+
+// 친구 목록을 조회
+func (s *FriendService) GetFriends(userID uint) ([]model.Friendship, error) {
+	var friends []model.Friendship
+	err := s.db.Where("user_id = ? AND status = ?", userID, model.FriendshipAccepted).Order("id ASC").Find(&friends).Error
+	if err != nil {
+		return nil, fmt.Errorf("친구 목록 조회 중 오류 발생: %w", err)
+	}
+	return friends, nil
+}
+
+// 받은 친구 요청을 조회
+func (s *FriendService) GetPendingRequests(userID uint) ([]model.Friendship, error) {
+	var requests []model.Friendship
+	err := s.db.Where("friend_id = ? AND status = ?", userID, model.FriendshipPending).Order("id ASC").Find(&requests).Error
+	if err != nil {
+		return nil, fmt.Errorf("친구 요청 조회 중 오류 발생: %w", err)
+	}
+	return requests, nil
+}
+
+// 친구 사용자 ID 목록을 조회
+func (s *FriendService) GetFriendIDs(userID uint) ([]uint, error) {
+	var ids []uint
+	err := s.db.Model(&model.Friendship{}).
+		Where("user_id = ? AND status = ?", userID, model.FriendshipAccepted).
+		Pluck("friend_id", &ids).Error
+	if err != nil {
+		return nil, fmt.Errorf("친구 목록 조회 중 오류 발생: %w", err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// 친구 요청, 수락, 삭제 테스트
+func TestFriendService_Requests(t *testing.T) {
+	db := setupGameTestDB(t, &model.Friendship{})
+	service := NewFriendService(db)
+	alice := seedUser(t, db, "alice", 0)
+	bob := seedUser(t, db, "bob", 0)
+
+	_, err := service.SendRequest(alice.ID, alice.ID)
+	assert.ErrorIs(t, err, model.ErrCannotFriendSelf)
+	_, err = service.SendRequest(alice.ID, 999)
+	assert.ErrorIs(t, err, model.ErrInvalidUserID)
+
+	request, err := service.SendRequest(alice.ID, bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.FriendshipPending, request.Status)
+	_, err = service.SendRequest(alice.ID, bob.ID)
+	assert.ErrorIs(t, err, model.ErrFriendRequestExists)
+
+	pending, err := service.GetPendingRequests(bob.ID)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, alice.ID, pending[0].UserID)
+
+	// 받은 요청에 요청을 보내면 수락
+	accepted, err := service.SendRequest(bob.ID, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.FriendshipAccepted, accepted.Status)
+
+	ids, err := service.GetFriendIDs(alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uint{bob.ID}, ids)
+	friends, err := service.GetFriends(bob.ID)
+	require.NoError(t, err)
+	require.Len(t, friends, 1)
+	assert.Equal(t, alice.ID, friends[0].FriendID)
+
+	pending, err = service.GetPendingRequests(bob.ID)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	// 이미 친구면 기존 관계를 반환
+	again, err := service.SendRequest(alice.ID, bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.FriendshipAccepted, again.Status)
+
+	require.NoError(t, service.RemoveFriend(bob.ID, alice.ID))
+	ids, err = service.GetFriendIDs(alice.ID)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+	assert.ErrorIs(t, service.RemoveFriend(alice.ID, bob.ID), model.ErrFriendshipNotFound)
+}
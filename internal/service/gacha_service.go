@@ -0,0 +1,275 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// 뽑기 결과
+type PullResult struct {
+	BannerID    uint              `json:"banner_id"`
+	BatchID     string            `json:"batch_id"`
+	CostDiamond int               `json:"cost_diamond"`
+	Pulls       []model.GachaPull `json:"pulls"`
+	Pity        *model.GachaPity  `json:"pity"`
+}
+
+// 배너 공개 정보 (드롭 확률 포함)
+type BannerRates struct {
+	Banner *model.GachaBanner `json:"banner"`
+	Rates  []model.DropRate   `json:"rates"`
+}
+
+// 가챠(뽑기) 관련 비즈니스 로직을 처리하는 서비스
+// 서버 측 난수 생성기를 사용하며, 시드를 지정하면 결과를 재현할 수 있다.
+type GachaService struct {
+	db *gorm.DB
+
+	mu  sync.Mutex
+	rng *rand.Rand
+
+	// 인벤토리 통계 캐시 (설정하지 않으면 무효화하지 않음)
+	inventoryStats
+}
+
+// 새로운 GachaService 인스턴스를 생성
+func NewGachaService(db *gorm.DB) *GachaService {
+	return NewGachaServiceWithSeed(db, time.Now().UnixNano())
+}
+
+// 지정한 시드로 GachaService 인스턴스를 생성 (결과 재현용)
+func NewGachaServiceWithSeed(db *gorm.DB, seed int64) *GachaService {
+	return &GachaService{
+		db:  db,
+		rng: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// 배너에서 뽑기를 진행
+// 다이아몬드를 차감하고, 천장과 10연차 보장 규칙을 적용하여 아이템을 지급한다.
+// 모든 뽑기 결과는 감사용으로 기록된다.
+func (s *GachaService) Pull(userID, bannerID uint, count int) (*PullResult, error) {
+	if count != 1 && count != model.GachaBundleSize {
+		return nil, model.ErrInvalidPullCount
+	}
+
+	// 난수 생성기는 동시 사용이 안전하지 않으므로 뽑기 전체를 직렬화
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := &PullResult{
+		BannerID: bannerID,
+		BatchID:  fmt.Sprintf("%d-%d-%d", userID, bannerID, time.Now().UnixNano()),
+		Pulls:    make([]model.GachaPull, 0, count),
+	}
+
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		banner, err := s.loadBanner(tx, bannerID)
+		if err != nil {
+			return err
+		}
+		if !banner.IsOpen(time.Now()) {
+			return model.ErrBannerClosed
+		}
+
+		result.CostDiamond = banner.Cost(count)
+		if err := adjustUserDiamond(tx, userID, -result.CostDiamond); err != nil {
+			return err
+		}
+
+		pity, err := s.lockPity(tx, userID, bannerID)
+		if err != nil {
+			return err
+		}
+
+		change := model.NewInventoryChange(model.InventorySourceGacha, userID, "banner", bannerID)
+		guaranteeMet := banner.BundleGuarantee == ""
+		for i := 0; i < count; i++ {
+			pull := model.GachaPull{
+				UserID:    userID,
+				BannerID:  bannerID,
+				BatchID:   result.BatchID,
+				PityCount: pity.PullsSinceLegendary,
+			}
+
+			minRarity := ""
+			switch {
+			case banner.PityThreshold > 0 && pity.PullsSinceLegendary+1 >= banner.PityThreshold:
+				minRarity = "legendary"
+				pull.IsPity = true
+			case count == model.GachaBundleSize && i == count-1 && !guaranteeMet:
+				minRarity = banner.BundleGuarantee
+				pull.IsGuaranteed = true
+			}
+
+			rarity := banner.DrawRarity(s.rng, minRarity)
+			entry := banner.DrawEntry(s.rng, rarity)
+			if entry == nil {
+				return fmt.Errorf("%w: %s", model.ErrEmptyDropTable, rarity)
+			}
+
+			pull.Rarity = entry.Rarity
+			pull.ItemID = entry.ItemID
+			pull.Quantity = entry.Quantity
+
+			if !guaranteeMet && model.RarityRank(entry.Rarity) >= model.RarityRank(banner.BundleGuarantee) {
+				guaranteeMet = true
+			}
+			if entry.Rarity == "legendary" {
+				pity.PullsSinceLegendary = 0
+			} else {
+				pity.PullsSinceLegendary++
+			}
+			pity.TotalPulls++
+
+			if _, err := grantInventoryItem(tx, userID, entry.ToInventory(userID), entry.Quantity, change); err != nil {
+				return err
+			}
+			if err := tx.Create(&pull).Error; err != nil {
+				return fmt.Errorf("뽑기 기록 생성 중 오류 발생: %w", err)
+			}
+			result.Pulls = append(result.Pulls, pull)
+		}
+
+		if err := tx.Save(pity).Error; err != nil {
+			return fmt.Errorf("천장 카운터 저장 중 오류 발생: %w", err)
+		}
+		result.Pity = pity
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// 현재 뽑기 가능한 배너 목록을 조회
+func (s *GachaService) GetActiveBanners() ([]model.GachaBanner, error) {
+	var banners []model.GachaBanner
+	if err := s.db.Preload("Entries").Where("is_active = ?", true).Order("id ASC").Find(&banners).Error; err != nil {
+		return nil, fmt.Errorf("배너 목록 조회 중 오류 발생: %w", err)
+	}
+
+	now := time.Now()
+	open := make([]model.GachaBanner, 0, len(banners))
+	for _, banner := range banners {
+		if banner.IsOpen(now) {
+			open = append(open, banner)
+		}
+	}
+	return open, nil
+}
+
+// 배너의 공개 드롭 확률을 조회
+func (s *GachaService) GetBannerRates(bannerID uint) (*BannerRates, error) {
+	banner, err := s.loadBanner(s.db, bannerID)
+	if err != nil {
+		return nil, err
+	}
+	return &BannerRates{
+		Banner: banner,
+		Rates:  banner.DropRates(),
+	}, nil
+}
+
+// 사용자의 배너 천장 카운터를 조회 (뽑기 기록이 없으면 0으로 시작)
+func (s *GachaService) GetPity(userID, bannerID uint) (*model.GachaPity, error) {
+	if _, err := s.loadBanner(s.db, bannerID); err != nil {
+		return nil, err
+	}
+
+	var pity model.GachaPity
+	err := s.db.Where("user_id = ? AND banner_id = ?", userID, bannerID).First(&pity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &model.GachaPity{UserID: userID, BannerID: bannerID}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("천장 카운터 조회 중 오류 발생: %w", err)
+	}
+	return &pity, nil
+}
+
+// 뽑기 기록을 최신순으로 조회
+// userID가 0이면 전체 사용자의 기록을 조회 (관리자 감사용)
+func (s *GachaService) GetPullHistory(userID uint, limit, offset int) ([]model.GachaPull, error) {
+	query := s.db.Model(&model.GachaPull{})
+	if userID != 0 {
+		query = query.Where("user_id = ?", userID)
+	}
+
+	var pulls []model.GachaPull
+	if err := query.Order("id DESC").Limit(limit).Offset(offset).Find(&pulls).Error; err != nil {
+		return nil, fmt.Errorf("뽑기 기록 조회 중 오류 발생: %w", err)
+	}
+	return pulls, nil
+}
+
+// 새로운 배너를 생성
+func (s *GachaService) CreateBanner(banner *model.GachaBanner) error {
+	if err := banner.Validate(); err != nil {
+		return err
+	}
+
+	var count int64
+	if err := s.db.Model(&model.GachaBanner{}).Where("code = ?", banner.Code).Count(&count).Error; err != nil {
+		return fmt.Errorf("배너 코드 확인 중 오류 발생: %w", err)
+	}
+	if count > 0 {
+		return model.ErrDuplicateBannerCode
+	}
+
+	if err := s.db.Create(banner).Error; err != nil {
+		return fmt.Errorf("배너 생성 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 배너 활성화 여부를 변경
+func (s *GachaService) SetBannerActive(bannerID uint, active bool) error {
+	result := s.db.Model(&model.GachaBanner{}).Where("id = ?", bannerID).Update("is_active", active)
+	if result.Error != nil {
+		return fmt.Errorf("배너 상태 변경 중 오류 발생: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return model.ErrBannerNotFound
+	}
+	return nil
+}
+
+// 드롭 아이템을 포함한 배너를 조회
+func (s *GachaService) loadBanner(db *gorm.DB, bannerID uint) (*model.GachaBanner, error) {
+	var banner model.GachaBanner
+	if err := db.Preload("Entries", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).First(&banner, bannerID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrBannerNotFound
+		}
+		return nil, fmt.Errorf("배너 조회 중 오류 발생: %w", err)
+	}
+	return &banner, nil
+}
+
+// 사용자의 천장 카운터를 잠금 상태로 조회 (없으면 새로 생성)
+func (s *GachaService) lockPity(tx *gorm.DB, userID, bannerID uint) (*model.GachaPity, error) {
+	var pity model.GachaPity
+	err := lockForUpdate(tx).Where("user_id = ? AND banner_id = ?", userID, bannerID).First(&pity).Error
+	if err == nil {
+		return &pity, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("천장 카운터 조회 중 오류 발생: %w", err)
+	}
+
+	pity = model.GachaPity{UserID: userID, BannerID: bannerID}
+	if err := tx.Create(&pity).Error; err != nil {
+		return nil, fmt.Errorf("천장 카운터 생성 중 오류 발생: %w", err)
+	}
+	return &pity, nil
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"testing"
+
+	"g_dev/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// 가챠 테스트용 데이터베이스와 배너를 설정
+func setupGachaTest(t *testing.T, seed int64, modify func(b *model.GachaBanner)) (*gorm.DB, *GachaService, *model.GachaBanner) {
+	db := setupGameTestDB(t, &model.GachaBanner{}, &model.GachaEntry{}, &model.GachaPity{}, &model.GachaPull{})
+	service := NewGachaServiceWithSeed(db, seed)
+
+	banner := &model.GachaBanner{
+		Code:              "starter",
+		Name:              "스타터 배너",
+		CostDiamond:       100,
+		BundleCostDiamond: 900,
+		CommonWeight:      90,
+		RareWeight:        9,
+		LegendaryWeight:   1,
+		PityThreshold:     20,
+		BundleGuarantee:   "rare",
+		IsActive:          true,
+		Entries: []model.GachaEntry{
+			{Rarity: "common", ItemID: "potion", ItemName: "포션", ItemType: "consumable", Quantity: 1, Weight: 1},
+			{Rarity: "rare", ItemID: "sword", ItemName: "검", ItemType: "weapon", Quantity: 1, Weight: 1},
+			{Rarity: "legendary", ItemID: "blade", ItemName: "전설검", ItemType: "weapon", Quantity: 1, Weight: 1},
+		},
+	}
+	if modify != nil {
+		modify(banner)
+	}
+	require.NoError(t, service.CreateBanner(banner))
+
+	return db, service, banner
+}
+
+// 테스트 사용자에게 다이아몬드를 지급
+func seedDiamondUser(t *testing.T, db *gorm.DB, username string, diamond int) *model.User {
+	user := seedUser(t, db, username, 0)
+	require.NoError(t, db.Model(user).Update("diamond", diamond).Error)
+	return user
+}
+
+// 같은 시드에서 같은 결과가 나오는지 테스트
+func TestGachaService_Reproducible(t *testing.T) {
+	results := make([][]string, 2)
+	for run := range results {
+		db, service, banner := setupGachaTest(t, 1234, nil)
+		user := seedDiamondUser(t, db, "player", 10000)
+
+		result, err := service.Pull(user.ID, banner.ID, model.GachaBundleSize)
+		require.NoError(t, err)
+		for _, pull := range result.Pulls {
+			results[run] = append(results[run], pull.ItemID)
+		}
+	}
+
+	assert.Len(t, results[0], model.GachaBundleSize)
+	assert.Equal(t, results[0], results[1], "같은 시드는 같은 결과를 내야 합니다")
+}
+
+// 10연차 비용과 등급 보장 테스트
+func TestGachaService_BundlePull(t *testing.T) {
+	db, service, banner := setupGachaTest(t, 1, func(b *model.GachaBanner) {
+		// rare 이상이 거의 나오지 않도록 설정하여 보장 규칙을 확인
+		b.CommonWeight, b.RareWeight, b.LegendaryWeight = 100000, 1, 1
+		b.PityThreshold = 0
+	})
+	user := seedDiamondUser(t, db, "player", 1000)
+
+	result, err := service.Pull(user.ID, banner.ID, model.GachaBundleSize)
+	require.NoError(t, err)
+
+	assert.Equal(t, 900, result.CostDiamond, "10연차 할인 가격이 적용되어야 합니다")
+	require.Len(t, result.Pulls, model.GachaBundleSize)
+	last := result.Pulls[model.GachaBundleSize-1]
+	assert.True(t, last.IsGuaranteed, "마지막 뽑기는 보장 뽑기여야 합니다")
+	assert.GreaterOrEqual(t, model.RarityRank(last.Rarity), model.RarityRank("rare"))
+
+	var user2 model.User
+	require.NoError(t, db.First(&user2, user.ID).Error)
+	assert.Equal(t, 100, user2.Diamond)
+	assert.Equal(t, 9, itemQuantity(t, db, user.ID, "potion"))
+
+	history, err := service.GetPullHistory(user.ID, 20, 0)
+	require.NoError(t, err)
+	assert.Len(t, history, model.GachaBundleSize, "모든 뽑기가 기록되어야 합니다")
+
+	_, err = service.Pull(user.ID, banner.ID, model.GachaBundleSize)
+	assert.ErrorIs(t, err, ErrInsufficientDiamond)
+
+	_, err = service.Pull(user.ID, banner.ID, 3)
+	assert.ErrorIs(t, err, model.ErrInvalidPullCount)
+}
+
+// 천장 규칙 테스트
+func TestGachaService_Pity(t *testing.T) {
+	db, service, banner := setupGachaTest(t, 1, func(b *model.GachaBanner) {
+		b.CommonWeight, b.RareWeight, b.LegendaryWeight = 100000, 0, 1
+		b.PityThreshold = 5
+		b.BundleGuarantee = ""
+	})
+	user := seedDiamondUser(t, db, "player", 10000)
+
+	for i := 0; i < 4; i++ {
+		result, err := service.Pull(user.ID, banner.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "common", result.Pulls[0].Rarity)
+	}
+
+	pity, err := service.GetPity(user.ID, banner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 4, pity.PullsSinceLegendary)
+
+	result, err := service.Pull(user.ID, banner.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "legendary", result.Pulls[0].Rarity, "천장에 도달하면 legendary가 확정되어야 합니다")
+	assert.True(t, result.Pulls[0].IsPity)
+	assert.Equal(t, 0, result.Pity.PullsSinceLegendary, "legendary 획득 후 카운터가 초기화되어야 합니다")
+	assert.Equal(t, 5, result.Pity.TotalPulls)
+}
+
+// 비활성 배너 테스트
+func TestGachaService_ClosedBanner(t *testing.T) {
+	db, service, banner := setupGachaTest(t, 1, nil)
+	user := seedDiamondUser(t, db, "player", 1000)
+
+	require.NoError(t, service.SetBannerActive(banner.ID, false))
+	_, err := service.Pull(user.ID, banner.ID, 1)
+	assert.ErrorIs(t, err, model.ErrBannerClosed)
+
+	banners, err := service.GetActiveBanners()
+	require.NoError(t, err)
+	assert.Empty(t, banners)
+
+	rates, err := service.GetBannerRates(banner.ID)
+	require.NoError(t, err)
+	assert.NotEmpty(t, rates.Rates, "비활성 배너도 확률은 공개되어야 합니다")
+
+	assert.ErrorIs(t, service.SetBannerActive(999, true), model.ErrBannerNotFound)
+}
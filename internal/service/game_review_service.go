@@ -0,0 +1,367 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"time"
+)
+
+// 리뷰 목록 정렬 기준
+const (
+	ReviewSortRecent  = "recent"  // 최근 작성 순
+	ReviewSortHelpful = "helpful" // 도움이 됐어요 많은 순
+)
+
+// 관리자 리뷰 목록 필터
+const (
+	ReviewFilterReported = "reported" // 신고가 있고 아직 숨기지 않은 리뷰 (신고 많은 순)
+	ReviewFilterHidden   = "hidden"   // 숨김 처리된 리뷰
+)
+
+// 게임 리뷰와 평점, 도움 투표, 신고, 중재를 관리하는 서비스
+// 리뷰가 바뀔 때마다 같은 트랜잭션에서 게임의 평균 평점과 총 평가 수를 리뷰 기록으로 다시 계산한다.
+type GameReviewService struct {
+	db  *gorm.DB
+	now func() time.Time
+}
+
+// 새로운 GameReviewService 인스턴스를 생성
+func NewGameReviewService(db *gorm.DB) *GameReviewService {
+	return &GameReviewService{db: db, now: time.Now}
+}
+
+// 리뷰를 작성하거나 수정 (사용자당 게임별 하나)
+// 게임을 완료한 정상 점수 기록이 있는 사용자만 작성할 수 있다.
+func (s *GameReviewService) SaveReview(userID, gameID uint, rating int, content string) (*model.GameReview, error) {
+	review := &model.GameReview{UserID: userID, GameID: gameID, Rating: rating, Content: content}
+	if err := review.Validate(); err != nil {
+		return nil, err
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockGame(tx, gameID); err != nil {
+			return err
+		}
+
+		var completed int64
+		err := tx.Model(&model.Score{}).
+			Where("user_id = ? AND game_id = ? AND completed = ? AND review_status = ?", userID, gameID, true, model.ScoreAccepted).
+			Count(&completed).Error
+		if err != nil {
+			return fmt.Errorf("점수 기록 조회 중 오류 발생: %w", err)
+		}
+		if completed == 0 {
+			return model.ErrReviewNotEligible
+		}
+
+		var existing model.GameReview
+		err = lockForUpdate(tx).Where("user_id = ? AND game_id = ?", userID, gameID).First(&existing).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			review.RatedAt = s.now()
+			if err := tx.Create(review).Error; err != nil {
+				return fmt.Errorf("리뷰 생성 중 오류 발생: %w", err)
+			}
+		case err != nil:
+			return fmt.Errorf("리뷰 조회 중 오류 발생: %w", err)
+		default:
+			// 숨김 처리와 투표/신고 수는 수정해도 유지하고, 평점 시각은 평점이 바뀔 때만 갱신
+			updates := map[string]interface{}{"rating": rating, "content": content}
+			if existing.Rating != rating {
+				existing.RatedAt = s.now()
+				updates["rated_at"] = existing.RatedAt
+			}
+			existing.Rating = rating
+			existing.Content = content
+			if err := tx.Model(&existing).Updates(updates).Error; err != nil {
+				return fmt.Errorf("리뷰 수정 중 오류 발생: %w", err)
+			}
+			*review = existing
+		}
+
+		return refreshGameRating(tx, gameID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// 내 리뷰를 삭제 (도움 투표와 신고 기록도 함께 삭제)
+// 숨김 처리된 리뷰는 삭제 후 다시 작성해 숨김을 풀 수 없도록 삭제를 거부한다.
+func (s *GameReviewService) DeleteReview(userID, reviewID uint) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		review, err := lockReview(tx, reviewID)
+		if err != nil {
+			return err
+		}
+		if review.UserID != userID {
+			return model.ErrReviewNotFound
+		}
+		if review.IsHidden {
+			return model.ErrReviewHidden
+		}
+
+		if err := tx.Where("review_id = ?", review.ID).Delete(&model.ReviewVote{}).Error; err != nil {
+			return fmt.Errorf("리뷰 투표 삭제 중 오류 발생: %w", err)
+		}
+		if err := tx.Where("review_id = ?", review.ID).Delete(&model.ReviewReport{}).Error; err != nil {
+			return fmt.Errorf("리뷰 신고 삭제 중 오류 발생: %w", err)
+		}
+		if err := tx.Delete(review).Error; err != nil {
+			return fmt.Errorf("리뷰 삭제 중 오류 발생: %w", err)
+		}
+		return refreshGameRating(tx, review.GameID)
+	})
+}
+
+// 게임의 공개 리뷰 목록을 조회 (숨긴 리뷰 제외)
+func (s *GameReviewService) GetGameReviews(gameID uint, sort string, limit, offset int) ([]model.GameReview, int64, error) {
+	query := s.db.Model(&model.GameReview{}).Where("game_id = ? AND is_hidden = ?", gameID, false)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("리뷰 수 조회 중 오류 발생: %w", err)
+	}
+
+	order := "created_at DESC, id DESC"
+	if sort == ReviewSortHelpful {
+		order = "helpful_count DESC, created_at DESC, id DESC"
+	}
+
+	var reviews []model.GameReview
+	if err := query.Order(order).Limit(limit).Offset(offset).Find(&reviews).Error; err != nil {
+		return nil, 0, fmt.Errorf("리뷰 목록 조회 중 오류 발생: %w", err)
+	}
+	if err := s.fillNicknames(reviews); err != nil {
+		return nil, 0, err
+	}
+	return reviews, total, nil
+}
+
+// 게임에 작성한 내 리뷰를 조회 (숨김 처리된 리뷰 포함)
+func (s *GameReviewService) GetUserReview(userID, gameID uint) (*model.GameReview, error) {
+	var review model.GameReview
+	if err := s.db.Where("user_id = ? AND game_id = ?", userID, gameID).First(&review).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrReviewNotFound
+		}
+		return nil, fmt.Errorf("리뷰 조회 중 오류 발생: %w", err)
+	}
+	return &review, nil
+}
+
+// 리뷰에 도움이 됐어요를 표시
+func (s *GameReviewService) MarkHelpful(userID, reviewID uint) (*model.GameReview, error) {
+	var review *model.GameReview
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		review, err = lockVisibleReview(tx, reviewID, userID)
+		if err != nil {
+			return err
+		}
+
+		var voted int64
+		if err := tx.Model(&model.ReviewVote{}).Where("review_id = ? AND user_id = ?", reviewID, userID).Count(&voted).Error; err != nil {
+			return fmt.Errorf("리뷰 투표 조회 중 오류 발생: %w", err)
+		}
+		if voted > 0 {
+			return model.ErrReviewAlreadyVoted
+		}
+		if err := tx.Create(&model.ReviewVote{ReviewID: reviewID, UserID: userID}).Error; err != nil {
+			return fmt.Errorf("리뷰 투표 저장 중 오류 발생: %w", err)
+		}
+
+		review.HelpfulCount++
+		if err := tx.Model(review).Update("helpful_count", review.HelpfulCount).Error; err != nil {
+			return fmt.Errorf("리뷰 투표 수 갱신 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// 부적절한 리뷰를 신고
+func (s *GameReviewService) ReportReview(userID, reviewID uint, reason string) error {
+	if reason == "" {
+		return model.ErrInvalidReview
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		review, err := lockVisibleReview(tx, reviewID, userID)
+		if err != nil {
+			return err
+		}
+
+		var reported int64
+		if err := tx.Model(&model.ReviewReport{}).Where("review_id = ? AND user_id = ?", reviewID, userID).Count(&reported).Error; err != nil {
+			return fmt.Errorf("리뷰 신고 조회 중 오류 발생: %w", err)
+		}
+		if reported > 0 {
+			return model.ErrReviewAlreadyReported
+		}
+		if err := tx.Create(&model.ReviewReport{ReviewID: reviewID, UserID: userID, Reason: reason}).Error; err != nil {
+			return fmt.Errorf("리뷰 신고 저장 중 오류 발생: %w", err)
+		}
+
+		if err := tx.Model(review).Update("report_count", review.ReportCount+1).Error; err != nil {
+			return fmt.Errorf("리뷰 신고 수 갱신 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+}
+
+// 중재 대상 리뷰 목록을 조회 (관리자용)
+func (s *GameReviewService) GetModerationQueue(filter string, limit, offset int) ([]model.GameReview, error) {
+	query := s.db.Model(&model.GameReview{})
+	switch filter {
+	case ReviewFilterHidden:
+		query = query.Where("is_hidden = ?", true).Order("hidden_at DESC")
+	default:
+		query = query.Where("is_hidden = ? AND report_count > 0", false).Order("report_count DESC, id ASC")
+	}
+
+	var reviews []model.GameReview
+	if err := query.Limit(limit).Offset(offset).Find(&reviews).Error; err != nil {
+		return nil, fmt.Errorf("리뷰 목록 조회 중 오류 발생: %w", err)
+	}
+	if err := s.fillNicknames(reviews); err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// 리뷰를 숨김 처리 (관리자용, 평점 계산에서도 제외)
+func (s *GameReviewService) HideReview(moderatorID, reviewID uint, reason string) (*model.GameReview, error) {
+	return s.setHidden(reviewID, func(review *model.GameReview) map[string]interface{} {
+		now := s.now()
+		review.IsHidden = true
+		review.HiddenBy = &moderatorID
+		review.HiddenAt = &now
+		review.HiddenReason = reason
+		return map[string]interface{}{"is_hidden": true, "hidden_by": moderatorID, "hidden_at": now, "hidden_reason": reason}
+	})
+}
+
+// 숨긴 리뷰를 복구 (관리자용, 처리된 신고는 초기화)
+func (s *GameReviewService) RestoreReview(reviewID uint) (*model.GameReview, error) {
+	return s.setHidden(reviewID, func(review *model.GameReview) map[string]interface{} {
+		review.IsHidden = false
+		review.HiddenBy = nil
+		review.HiddenAt = nil
+		review.HiddenReason = ""
+		review.ReportCount = 0
+		return map[string]interface{}{"is_hidden": false, "hidden_by": nil, "hidden_at": nil, "hidden_reason": "", "report_count": 0}
+	})
+}
+
+// 리뷰 숨김 상태를 변경하고 게임 평점을 다시 계산
+func (s *GameReviewService) setHidden(reviewID uint, apply func(*model.GameReview) map[string]interface{}) (*model.GameReview, error) {
+	var review *model.GameReview
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		review, err = lockReview(tx, reviewID)
+		if err != nil {
+			return err
+		}
+
+		wasHidden := review.IsHidden
+		if err := tx.Model(review).Updates(apply(review)).Error; err != nil {
+			return fmt.Errorf("리뷰 숨김 상태 변경 중 오류 발생: %w", err)
+		}
+		if review.IsHidden == wasHidden {
+			return nil
+		}
+		// 복구 시 처리된 신고 기록도 정리해 다시 신고할 수 있게 함
+		if !review.IsHidden {
+			if err := tx.Where("review_id = ?", review.ID).Delete(&model.ReviewReport{}).Error; err != nil {
+				return fmt.Errorf("리뷰 신고 삭제 중 오류 발생: %w", err)
+			}
+		}
+		return refreshGameRating(tx, review.GameID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// 리뷰 작성자 닉네임을 채움
+func (s *GameReviewService) fillNicknames(reviews []model.GameReview) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+
+	userIDs := make([]uint, len(reviews))
+	for i := range reviews {
+		userIDs[i] = reviews[i].UserID
+	}
+	var users []model.User
+	if err := s.db.Select("id", "nickname").Where("id IN ?", userIDs).Find(&users).Error; err != nil {
+		return fmt.Errorf("사용자 조회 중 오류 발생: %w", err)
+	}
+	nicknames := make(map[uint]string, len(users))
+	for _, user := range users {
+		nicknames[user.ID] = user.Nickname
+	}
+	for i := range reviews {
+		reviews[i].Nickname = nicknames[reviews[i].UserID]
+	}
+	return nil
+}
+
+// 리뷰를 잠금 상태로 조회
+func lockReview(tx *gorm.DB, id uint) (*model.GameReview, error) {
+	var review model.GameReview
+	if err := lockForUpdate(tx).First(&review, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrReviewNotFound
+		}
+		return nil, fmt.Errorf("리뷰 조회 중 오류 발생: %w", err)
+	}
+	return &review, nil
+}
+
+// 다른 사용자의 공개 리뷰를 잠금 상태로 조회 (투표/신고 대상)
+func lockVisibleReview(tx *gorm.DB, id, userID uint) (*model.GameReview, error) {
+	review, err := lockReview(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if review.IsHidden {
+		return nil, model.ErrReviewNotFound
+	}
+	if review.UserID == userID {
+		return nil, model.ErrOwnReview
+	}
+	return review, nil
+}
+
+// 숨기지 않은 리뷰로 게임의 평균 평점과 총 평가 수를 다시 계산
+func refreshGameRating(tx *gorm.DB, gameID uint) error {
+	var stats struct {
+		Total int
+		Count int
+	}
+	err := tx.Model(&model.GameReview{}).
+		Select("COALESCE(SUM(rating), 0) AS total, COUNT(*) AS count").
+		Where("game_id = ? AND is_hidden = ?", gameID, false).
+		Scan(&stats).Error
+	if err != nil {
+		return fmt.Errorf("평점 집계 중 오류 발생: %w", err)
+	}
+
+	var game model.Game
+	game.SetRatingStats(stats.Total, stats.Count)
+	err = tx.Model(&model.Game{}).Where("id = ?", gameID).
+		Updates(map[string]interface{}{"average_rating": game.AverageRating, "total_ratings": game.TotalRatings}).Error
+	if err != nil {
+		return fmt.Errorf("게임 평점 갱신 중 오류 발생: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func setupGameReviewTest(t *testing.T) (*gorm.DB, *GameReviewService, *model.User, *model.User, *model.Game) {
+	db, _, alice, game := setupGameSessionTest(t)
+	require.NoError(t, db.AutoMigrate(&model.GameReview{}, &model.ReviewVote{}, &model.ReviewReport{}))
+	bob := seedUser(t, db, "bob", 100)
+	return db, NewGameReviewService(db), alice, bob, game
+}
+
+// 게임을 완료한 정상 점수 기록 저장
+func seedCompletedScore(t *testing.T, db *gorm.DB, userID, gameID uint) {
+	score := seedScore(t, db, userID, gameID, 100, 60, "")
+	require.NoError(t, db.Model(score).Update("completed", true).Error)
+}
+
+// 게임 평점 통계 조회
+func gameRating(t *testing.T, db *gorm.DB, gameID uint) (float64, int) {
+	var game model.Game
+	require.NoError(t, db.First(&game, gameID).Error)
+	return game.AverageRating, game.TotalRatings
+}
+
+// 완료 플레이어만 리뷰 작성, 사용자당 하나, 평점 재계산 테스트
+func TestGameReviewService_SaveReview(t *testing.T) {
+	db, service, alice, bob, game := setupGameReviewTest(t)
+
+	_, err := service.SaveReview(alice.ID, game.ID, 5, "재밌어요")
+	assert.ErrorIs(t, err, model.ErrReviewNotEligible)
+
+	// 완료하지 못한 점수나 검토 대기 중인 점수는 자격이 없음
+	seedScore(t, db, alice.ID, game.ID, 50, 30, "")
+	pending := seedScore(t, db, alice.ID, game.ID, 100, 60, "")
+	require.NoError(t, db.Model(pending).Updates(map[string]interface{}{"completed": true, "review_status": model.ScoreQuarantined}).Error)
+	_, err = service.SaveReview(alice.ID, game.ID, 5, "재밌어요")
+	assert.ErrorIs(t, err, model.ErrReviewNotEligible)
+
+	seedCompletedScore(t, db, alice.ID, game.ID)
+	seedCompletedScore(t, db, bob.ID, game.ID)
+
+	_, err = service.SaveReview(alice.ID, game.ID, 6, "")
+	assert.ErrorIs(t, err, model.ErrInvalidReview)
+	_, err = service.SaveReview(alice.ID, 999, 5, "")
+	assert.ErrorIs(t, err, model.ErrGameNotFound)
+
+	first, err := service.SaveReview(alice.ID, game.ID, 5, "재밌어요")
+	require.NoError(t, err)
+	_, err = service.SaveReview(bob.ID, game.ID, 2, "")
+	require.NoError(t, err)
+	average, total := gameRating(t, db, game.ID)
+	assert.Equal(t, 3.5, average)
+	assert.Equal(t, 2, total)
+
+	// 다시 작성하면 기존 리뷰를 수정
+	updated, err := service.SaveReview(alice.ID, game.ID, 4, "어려워요")
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, updated.ID)
+	average, total = gameRating(t, db, game.ID)
+	assert.Equal(t, 3.0, average)
+	assert.Equal(t, 2, total)
+
+	mine, err := service.GetUserReview(alice.ID, game.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "어려워요", mine.Content)
+
+	assert.ErrorIs(t, service.DeleteReview(bob.ID, first.ID), model.ErrReviewNotFound)
+	require.NoError(t, service.DeleteReview(alice.ID, first.ID))
+	average, total = gameRating(t, db, game.ID)
+	assert.Equal(t, 2.0, average)
+	assert.Equal(t, 1, total)
+	_, err = service.GetUserReview(alice.ID, game.ID)
+	assert.ErrorIs(t, err, model.ErrReviewNotFound)
+}
+
+// 평점 시각은 평점이 바뀔 때만 갱신되는지 테스트
+func TestGameReviewService_RatedAt(t *testing.T) {
+	db, service, alice, bob, game := setupGameReviewTest(t)
+	seedCompletedScore(t, db, alice.ID, game.ID)
+	rated := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := rated
+	service.now = func() time.Time { return clock }
+
+	review, err := service.SaveReview(alice.ID, game.ID, 4, "좋아요")
+	require.NoError(t, err)
+
+	// 투표, 신고, 숨김/복구, 같은 평점으로 다시 저장해도 평점 시각은 유지
+	clock = rated.Add(time.Hour)
+	_, err = service.MarkHelpful(bob.ID, review.ID)
+	require.NoError(t, err)
+	require.NoError(t, service.ReportReview(bob.ID, review.ID, "스팸"))
+	_, err = service.HideReview(bob.ID, review.ID, "확인")
+	require.NoError(t, err)
+	_, err = service.RestoreReview(review.ID)
+	require.NoError(t, err)
+	_, err = service.SaveReview(alice.ID, game.ID, 4, "여전히 좋아요")
+	require.NoError(t, err)
+
+	mine, err := service.GetUserReview(alice.ID, game.ID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, rated, mine.RatedAt, time.Second, "평점이 바뀌지 않으면 평점 시각을 유지해야 합니다")
+
+	updated, err := service.SaveReview(alice.ID, game.ID, 2, "")
+	require.NoError(t, err)
+	assert.Equal(t, clock, updated.RatedAt)
+	mine, err = service.GetUserReview(alice.ID, game.ID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, clock, mine.RatedAt, time.Second)
+}
+
+// 도움 투표, 신고, 중재자 숨김/복구 테스트
+func TestGameReviewService_Moderation(t *testing.T) {
+	db, service, alice, bob, game := setupGameReviewTest(t)
+	carol := seedUser(t, db, "carol", 100)
+	seedCompletedScore(t, db, alice.ID, game.ID)
+	seedCompletedScore(t, db, bob.ID, game.ID)
+
+	aliceReview, err := service.SaveReview(alice.ID, game.ID, 5, "최고")
+	require.NoError(t, err)
+	bobReview, err := service.SaveReview(bob.ID, game.ID, 1, "스팸 링크")
+	require.NoError(t, err)
+
+	_, err = service.MarkHelpful(alice.ID, aliceReview.ID)
+	assert.ErrorIs(t, err, model.ErrOwnReview)
+	voted, err := service.MarkHelpful(bob.ID, aliceReview.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, voted.HelpfulCount)
+	_, err = service.MarkHelpful(bob.ID, aliceReview.ID)
+	assert.ErrorIs(t, err, model.ErrReviewAlreadyVoted)
+
+	reviews, total, err := service.GetGameReviews(game.ID, ReviewSortHelpful, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, reviews, 2)
+	assert.Equal(t, aliceReview.ID, reviews[0].ID)
+	assert.Equal(t, alice.Nickname, reviews[0].Nickname)
+
+	assert.ErrorIs(t, service.ReportReview(alice.ID, bobReview.ID, ""), model.ErrInvalidReview)
+	require.NoError(t, service.ReportReview(alice.ID, bobReview.ID, "스팸"))
+	require.NoError(t, service.ReportReview(carol.ID, bobReview.ID, "광고"))
+	assert.ErrorIs(t, service.ReportReview(alice.ID, bobReview.ID, "스팸"), model.ErrReviewAlreadyReported)
+
+	queue, err := service.GetModerationQueue(ReviewFilterReported, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, queue, 1)
+	assert.Equal(t, 2, queue[0].ReportCount)
+
+	// 숨긴 리뷰는 목록과 평점에서 제외
+	hidden, err := service.HideReview(carol.ID, bobReview.ID, "스팸")
+	require.NoError(t, err)
+	assert.True(t, hidden.IsHidden)
+	average, count := gameRating(t, db, game.ID)
+	assert.Equal(t, 5.0, average)
+	assert.Equal(t, 1, count)
+	_, total, err = service.GetGameReviews(game.ID, ReviewSortRecent, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	_, err = service.MarkHelpful(alice.ID, bobReview.ID)
+	assert.ErrorIs(t, err, model.ErrReviewNotFound)
+
+	// 숨긴 리뷰는 작성자가 삭제 후 다시 작성해 숨김을 풀 수 없음
+	assert.ErrorIs(t, service.DeleteReview(bob.ID, bobReview.ID), model.ErrReviewHidden)
+	rewritten, err := service.SaveReview(bob.ID, game.ID, bobReview.Rating, "다시 작성")
+	require.NoError(t, err)
+	assert.Equal(t, bobReview.ID, rewritten.ID)
+	assert.True(t, rewritten.IsHidden, "다시 작성해도 숨김 상태가 유지되어야 합니다")
+
+	queue, err = service.GetModerationQueue(ReviewFilterHidden, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, queue, 1)
+
+	restored, err := service.RestoreReview(bobReview.ID)
+	require.NoError(t, err)
+	assert.False(t, restored.IsHidden)
+	assert.Equal(t, 0, restored.ReportCount)
+	average, count = gameRating(t, db, game.ID)
+	assert.Equal(t, 3.0, average)
+	assert.Equal(t, 2, count)
+
+	// 복구 후에는 다시 신고할 수 있음
+	require.NoError(t, service.ReportReview(alice.ID, bobReview.ID, "스팸"))
+}
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+// 게임 목록 정렬 기준
+const (
+	GameSortPopularity = "popularity"
+	GameSortTrending   = "trending"
+	GameSortRating     = "rating"
+	GameSortRelease    = "release"
+)
+
+// 게임 목록 검색 조건
+type GameSearchFilter struct {
+	Category   model.GameCategory
+	Difficulty model.GameDifficulty
+
+	// 태그 조건 (MatchAllTags가 true이면 모든 태그, false이면 하나 이상의 태그를 가진 게임)
+	Tags         []string
+	MatchAllTags bool
+
+	Language   string
+	Platform   string
+	Free       bool
+	Discounted bool
+	SortBy     string // popularity, trending, rating, release
+	Limit      int
+	Offset     int
+}
+
+// 태그별 게임 수
+type TagCount struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+// 게임 카탈로그 관련 비즈니스 로직을 처리하는 서비스
+type GameService struct {
+	db *gorm.DB
+}
+
+// 새로운 GameService 인스턴스를 생성
+func NewGameService(db *gorm.DB) *GameService {
+	return &GameService{
+		db: db,
+	}
+}
+
+// 공개된 게임 목록을 검색 (비활성 게임 제외)
+func (s *GameService) SearchGames(filter *GameSearchFilter) ([]model.Game, int64, error) {
+	query := s.db.Model(&model.Game{}).Where("status <> ?", model.GameStatusInactive)
+
+	if filter.Category != "" {
+		query = query.Where("category = ?", filter.Category)
+	}
+	if filter.Difficulty != "" {
+		query = query.Where("difficulty = ?", filter.Difficulty)
+	}
+	if tags := model.NormalizeGameAttributes(filter.Tags); len(tags) > 0 {
+		tagged := s.db.Table("game_tag_links").
+			Select("game_tag_links.game_id").
+			Joins("JOIN game_tags ON game_tags.id = game_tag_links.tag_id").
+			Where("game_tags.name IN ?", tags)
+		if filter.MatchAllTags {
+			tagged = tagged.Group("game_tag_links.game_id").Having("COUNT(DISTINCT game_tags.id) = ?", len(tags))
+		}
+		query = query.Where("id IN (?)", tagged)
+	}
+	if filter.Language != "" {
+		query = query.Where("id IN (?)", s.db.Model(&model.GameLanguage{}).Select("game_id").
+			Where("language = ?", strings.ToLower(strings.TrimSpace(filter.Language))))
+	}
+	if filter.Platform != "" {
+		query = query.Where("id IN (?)", s.db.Model(&model.GamePlatform{}).Select("game_id").
+			Where("platform = ?", strings.ToLower(strings.TrimSpace(filter.Platform))))
+	}
+	if filter.Free {
+		query = query.Where("price = ?", 0)
+	}
+	if filter.Discounted {
+		query = query.Where("discount_rate > ? AND (discount_end_date IS NULL OR discount_end_date > ?)", 0, time.Now())
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("게임 수 조회 중 오류 발생: %w", err)
+	}
+
+	switch filter.SortBy {
+	case GameSortTrending:
+		query = query.Order("trending_score DESC")
+	case GameSortRating:
+		query = query.Order("average_rating DESC").Order("total_ratings DESC")
+	case GameSortRelease:
+		query = query.Order("release_date IS NULL").Order("release_date DESC")
+	default:
+		query = query.Order("popularity_score DESC")
+	}
+
+	var games []model.Game
+	if err := query.Order("id ASC").Limit(filter.Limit).Offset(filter.Offset).Find(&games).Error; err != nil {
+		return nil, 0, fmt.Errorf("게임 목록 조회 중 오류 발생: %w", err)
+	}
+
+	return games, total, nil
+}
+
+// ID로 게임을 조회
+func (s *GameService) GetGameByID(id uint) (*model.Game, error) {
+	var game model.Game
+	if err := s.db.First(&game, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrGameNotFound
+		}
+		return nil, fmt.Errorf("게임 조회 중 오류 발생: %w", err)
+	}
+	return &game, nil
+}
+
+// 새로운 게임을 등록
+func (s *GameService) CreateGame(game *model.Game) error {
+	if game.Status == "" {
+		game.Status = model.GameStatusActive
+	}
+	if err := validateGame(game); err != nil {
+		return err
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.checkDuplicateName(tx, game.Name, 0); err != nil {
+			return err
+		}
+		if err := tx.Create(game).Error; err != nil {
+			return fmt.Errorf("게임 생성 중 오류 발생: %w", err)
+		}
+		return model.SyncGameAttributes(tx, game)
+	})
+}
+
+// 게임 정보를 수정
+// 상태와 플레이/평점 통계, 점수는 별도 경로로만 변경되므로 유지한다.
+func (s *GameService) UpdateGame(id uint, updated *model.Game) (*model.Game, error) {
+	var game *model.Game
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		game, err = lockGame(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if updated.Name != game.Name {
+			if err := s.checkDuplicateName(tx, updated.Name, id); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		game.Name = updated.Name
+		game.Description = updated.Description
+		game.Category = updated.Category
+		game.Difficulty = updated.Difficulty
+		game.Version = updated.Version
+		game.MinLevel = updated.MinLevel
+		game.MaxPlayers = updated.MaxPlayers
+		game.EstimatedPlayTime = updated.EstimatedPlayTime
+		game.ImageURL = updated.ImageURL
+		game.IconURL = updated.IconURL
+		game.Tags = updated.Tags
+		game.ReleaseDate = updated.ReleaseDate
+		game.Developer = updated.Developer
+		game.SupportedLanguages = updated.SupportedLanguages
+		game.SupportedPlatforms = updated.SupportedPlatforms
+		game.FileSize = updated.FileSize
+		game.DownloadURL = updated.DownloadURL
+		game.PlayURL = updated.PlayURL
+		game.TutorialURL = updated.TutorialURL
+		game.FAQURL = updated.FAQURL
+		game.CommunityURL = updated.CommunityURL
+		game.OfficialURL = updated.OfficialURL
+		game.License = updated.License
+		game.Price = updated.Price
+		game.Currency = updated.Currency
+		game.DiscountRate = updated.DiscountRate
+		game.DiscountEndDate = updated.DiscountEndDate
+		game.LastUpdatedAt = &now
+
+		if err := validateGame(game); err != nil {
+			return err
+		}
+		if err := tx.Save(game).Error; err != nil {
+			return fmt.Errorf("게임 수정 중 오류 발생: %w", err)
+		}
+		return model.SyncGameAttributes(tx, game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return game, nil
+}
+
+// 게임 상태를 변경
+func (s *GameService) SetGameStatus(id uint, status model.GameStatus) (*model.Game, error) {
+	var game *model.Game
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		game, err = lockGame(tx, id)
+		if err != nil {
+			return err
+		}
+
+		game.Status = status
+		if err := validateGame(game); err != nil {
+			return err
+		}
+		if err := tx.Model(game).Update("status", game.Status).Error; err != nil {
+			return fmt.Errorf("게임 상태 변경 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return game, nil
+}
+
+// 공개된 게임의 태그별 게임 수를 많은 순으로 조회 (태그 클라우드)
+func (s *GameService) GetTagCounts(limit int) ([]TagCount, error) {
+	var counts []TagCount
+	err := s.db.Table("game_tag_links").
+		Select("game_tags.name AS name, COUNT(*) AS count").
+		Joins("JOIN game_tags ON game_tags.id = game_tag_links.tag_id").
+		Joins("JOIN games ON games.id = game_tag_links.game_id").
+		Where("games.status <> ? AND games.deleted_at IS NULL", model.GameStatusInactive).
+		Group("game_tags.name").
+		Order("count DESC").Order("name ASC").
+		Limit(limit).
+		Scan(&counts).Error
+	if err != nil {
+		return nil, fmt.Errorf("태그 집계 중 오류 발생: %w", err)
+	}
+	return counts, nil
+}
+
+// 최근 활동이 많은 게임을 트렌딩 점수 순으로 조회 (지금 뜨는 게임)
+// 트렌딩 점수는 TrendingService가 주기적으로 갱신하며, 최근 활동이 없는 게임은 제외한다.
+func (s *GameService) GetTrendingGames(limit int) ([]model.Game, error) {
+	var games []model.Game
+	err := s.db.Where("status <> ? AND trending_score > ?", model.GameStatusInactive, 0).
+		Order("trending_score DESC").Order("id ASC").
+		Limit(limit).
+		Find(&games).Error
+	if err != nil {
+		return nil, fmt.Errorf("트렌딩 게임 조회 중 오류 발생: %w", err)
+	}
+	return games, nil
+}
+
+// 기존 게임의 태그/언어/플랫폼 문자열 컬럼으로 정규화 테이블을 채움
+// 이미 채워진 게임도 다시 동기화하므로 여러 번 실행해도 결과가 같다.
+func (s *GameService) BackfillAttributes() (int, error) {
+	return model.BackfillGameAttributes(s.db)
+}
+
+// 게임을 잠금 상태로 조회
+func lockGame(tx *gorm.DB, id uint) (*model.Game, error) {
+	var game model.Game
+	if err := lockForUpdate(tx).First(&game, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrGameNotFound
+		}
+		return nil, fmt.Errorf("게임 조회 중 오류 발생: %w", err)
+	}
+	return &game, nil
+}
+
+// 같은 이름의 다른 게임이 있는지 확인
+func (s *GameService) checkDuplicateName(tx *gorm.DB, name string, excludeID uint) error {
+	var count int64
+	if err := tx.Model(&model.Game{}).Where("name = ? AND id <> ?", name, excludeID).Count(&count).Error; err != nil {
+		return fmt.Errorf("게임 이름 확인 중 오류 발생: %w", err)
+	}
+	if count > 0 {
+		return model.ErrDuplicateGameName
+	}
+	return nil
+}
+
+// Game.Validate 결과를 ErrInvalidGame으로 감싸서 반환
+func validateGame(game *model.Game) error {
+	if err := game.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", model.ErrInvalidGame, err)
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func setupGameCatalogTest(t *testing.T) (*gorm.DB, *GameService) {
+	db := setupGameTestDB(t, &model.Game{}, &model.GameTag{}, &model.GameTagLink{}, &model.GameLanguage{}, &model.GamePlatform{})
+	return db, NewGameService(db)
+}
+
+// 테스트용 게임 생성
+func newTestGame(name string, category model.GameCategory) *model.Game {
+	return &model.Game{
+		Name:               name,
+		Category:           category,
+		Difficulty:         model.GameDifficultyNormal,
+		MinLevel:           1,
+		MaxPlayers:         1,
+		SupportedLanguages: "ko,en",
+		SupportedPlatforms: "web",
+	}
+}
+
+// 게임 목록 필터와 정렬 테스트
+func TestGameService_SearchGames(t *testing.T) {
+	_, service := setupGameCatalogTest(t)
+
+	past := time.Now().Add(-time.Hour)
+	older := time.Now().AddDate(-1, 0, 0)
+	newer := time.Now().AddDate(0, -1, 0)
+
+	puzzle := newTestGame("Block Puzzle", model.GameCategoryPuzzle)
+	puzzle.PopularityScore = 10
+	puzzle.AverageRating = 4.5
+	puzzle.SetTags([]string{"casual", "brain"})
+	puzzle.ReleaseDate = &older
+
+	shooter := newTestGame("Space Shooter", model.GameCategoryAction)
+	shooter.PopularityScore = 30
+	shooter.TrendingScore = 1
+	shooter.Price = 5000
+	shooter.DiscountRate = 20
+	shooter.SupportedPlatforms = "web,mobile"
+	shooter.ReleaseDate = &newer
+
+	expired := newTestGame("Old Racer", model.GameCategoryRacing)
+	expired.PopularityScore = 20
+	expired.TrendingScore = 5
+	expired.Price = 3000
+	expired.DiscountRate = 50
+	expired.DiscountEndDate = &past
+
+	hidden := newTestGame("Hidden Game", model.GameCategoryPuzzle)
+	hidden.PopularityScore = 100
+
+	for _, game := range []*model.Game{puzzle, shooter, expired, hidden} {
+		require.NoError(t, service.CreateGame(game))
+	}
+	_, err := service.SetGameStatus(hidden.ID, model.GameStatusInactive)
+	require.NoError(t, err)
+
+	games, total, err := service.SearchGames(&GameSearchFilter{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total, "비활성 게임은 목록에서 제외되어야 합니다")
+	assert.Equal(t, "Space Shooter", games[0].Name, "기본 정렬은 인기도순이어야 합니다")
+
+	games, _, err = service.SearchGames(&GameSearchFilter{SortBy: GameSortTrending, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "Old Racer", games[0].Name)
+
+	games, _, err = service.SearchGames(&GameSearchFilter{SortBy: GameSortRating, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "Block Puzzle", games[0].Name)
+
+	games, _, err = service.SearchGames(&GameSearchFilter{SortBy: GameSortRelease, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "Space Shooter", games[0].Name)
+	assert.Equal(t, "Old Racer", games[2].Name, "출시일이 없는 게임은 마지막이어야 합니다")
+
+	games, _, err = service.SearchGames(&GameSearchFilter{Category: model.GameCategoryPuzzle, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, games, 1)
+	assert.Equal(t, "Block Puzzle", games[0].Name)
+
+	games, _, err = service.SearchGames(&GameSearchFilter{Tags: []string{"brain"}, Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, games, 1)
+
+	games, _, err = service.SearchGames(&GameSearchFilter{Platform: "mobile", Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, games, 1)
+
+	games, _, err = service.SearchGames(&GameSearchFilter{Free: true, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, games, 1)
+	assert.Equal(t, "Block Puzzle", games[0].Name)
+
+	games, _, err = service.SearchGames(&GameSearchFilter{Discounted: true, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, games, 1, "할인이 끝난 게임은 제외되어야 합니다")
+	assert.Equal(t, "Space Shooter", games[0].Name)
+
+	games, total, err = service.SearchGames(&GameSearchFilter{Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, games, 1)
+	assert.Equal(t, "Old Racer", games[0].Name)
+}
+
+// 게임 등록/수정 시 유효성 검사 테스트
+func TestGameService_CreateAndUpdate(t *testing.T) {
+	_, service := setupGameCatalogTest(t)
+
+	game := newTestGame("Chess Master", model.GameCategoryBoard)
+	require.NoError(t, service.CreateGame(game))
+	assert.Equal(t, model.GameStatusActive, game.Status, "상태가 없으면 활성으로 등록되어야 합니다")
+
+	err := service.CreateGame(newTestGame("Chess Master", model.GameCategoryBoard))
+	assert.ErrorIs(t, err, model.ErrDuplicateGameName)
+
+	err = service.CreateGame(newTestGame("Bad Category", "shooter"))
+	assert.ErrorIs(t, err, model.ErrInvalidGame)
+
+	// 통계 값은 수정 요청으로 변경되지 않아야 함
+	require.NoError(t, service.db.Model(game).Update("total_plays", 42).Error)
+
+	updated := newTestGame("Chess Grandmaster", model.GameCategoryBoard)
+	updated.Price = 1000
+	result, err := service.UpdateGame(game.ID, updated)
+	require.NoError(t, err)
+	assert.Equal(t, "Chess Grandmaster", result.Name)
+	assert.Equal(t, 42, result.TotalPlays)
+	assert.NotNil(t, result.LastUpdatedAt)
+
+	invalid := newTestGame("Chess Grandmaster", model.GameCategoryBoard)
+	invalid.DiscountRate = 150
+	_, err = service.UpdateGame(game.ID, invalid)
+	assert.ErrorIs(t, err, model.ErrInvalidGame)
+
+	_, err = service.UpdateGame(999, updated)
+	assert.ErrorIs(t, err, model.ErrGameNotFound)
+
+	_, err = service.SetGameStatus(game.ID, "deleted")
+	assert.ErrorIs(t, err, model.ErrInvalidGame)
+
+	result, err = service.SetGameStatus(game.ID, model.GameStatusMaintenance)
+	require.NoError(t, err)
+	assert.Equal(t, model.GameStatusMaintenance, result.Status)
+
+	found, err := service.GetGameByID(game.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.GameStatusMaintenance, found.Status)
+	assert.True(t, found.IsListed())
+}
+
+// 태그 AND/OR 검색과 언어 필터 테스트
+func TestGameService_TagSearch(t *testing.T) {
+	_, service := setupGameCatalogTest(t)
+
+	chess := newTestGame("Chess", model.GameCategoryBoard)
+	chess.SetTags([]string{"Classic", "Strategy", "multiplayer"})
+	chess.SupportedLanguages = "ko, EN, ja"
+	go_ := newTestGame("Go", model.GameCategoryBoard)
+	go_.SetTags([]string{"classic", "strategy"})
+	cards := newTestGame("Poker", model.GameCategoryCard)
+	cards.SetTags([]string{"multiplayer", "casino"})
+	for _, game := range []*model.Game{chess, go_, cards} {
+		require.NoError(t, service.CreateGame(game))
+	}
+
+	games, total, err := service.SearchGames(&GameSearchFilter{Tags: []string{"classic", "multiplayer"}, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total, "OR 검색은 태그 중 하나만 있어도 포함해야 합니다")
+	assert.Len(t, games, 3)
+
+	games, total, err = service.SearchGames(&GameSearchFilter{Tags: []string{"classic", " MULTIPLAYER "}, MatchAllTags: true, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total, "AND 검색은 모든 태그를 가진 게임만 포함해야 합니다")
+	require.Len(t, games, 1)
+	assert.Equal(t, "Chess", games[0].Name)
+
+	games, _, err = service.SearchGames(&GameSearchFilter{Language: "ja", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, games, 1)
+	assert.Equal(t, "Chess", games[0].Name)
+
+	// 태그를 수정하면 연결도 갱신되어야 함
+	updated := newTestGame("Poker", model.GameCategoryCard)
+	updated.SetTags([]string{"casino"})
+	_, err = service.UpdateGame(cards.ID, updated)
+	require.NoError(t, err)
+
+	counts, err := service.GetTagCounts(10)
+	require.NoError(t, err)
+	assert.Equal(t, []TagCount{
+		{Name: "classic", Count: 2},
+		{Name: "strategy", Count: 2},
+		{Name: "casino", Count: 1},
+		{Name: "multiplayer", Count: 1},
+	}, counts)
+
+	// 비활성 게임의 태그는 집계에서 제외
+	_, err = service.SetGameStatus(go_.ID, model.GameStatusInactive)
+	require.NoError(t, err)
+	counts, err = service.GetTagCounts(1)
+	require.NoError(t, err)
+	assert.Equal(t, []TagCount{{Name: "casino", Count: 1}}, counts)
+}
+
+// 기존 문자열 컬럼으로 정규화 테이블을 채우는 백필 테스트
+func TestGameService_BackfillAttributes(t *testing.T) {
+	db, service := setupGameCatalogTest(t)
+
+	// 서비스를 거치지 않고 저장된 기존 게임
+	legacy := newTestGame("Legacy Racer", model.GameCategoryRacing)
+	legacy.Status = model.GameStatusActive
+	legacy.Tags = "speed, cars, Speed"
+	legacy.SupportedPlatforms = "web, mobile"
+	require.NoError(t, db.Create(legacy).Error)
+
+	games, _, err := service.SearchGames(&GameSearchFilter{Tags: []string{"speed"}, Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, games)
+
+	for i := 0; i < 2; i++ {
+		count, err := service.BackfillAttributes()
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	}
+
+	games, _, err = service.SearchGames(&GameSearchFilter{Tags: []string{"speed", "cars"}, MatchAllTags: true, Platform: "mobile", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, games, 1)
+	assert.Equal(t, []string{"speed", "cars", "Speed"}, games[0].GetTags(), "기존 접근자는 그대로 동작해야 합니다")
+
+	var links int64
+	require.NoError(t, db.Model(&model.GameTagLink{}).Where("game_id = ?", legacy.ID).Count(&links).Error)
+	assert.Equal(t, int64(2), links, "중복 태그는 한 번만 연결되어야 합니다")
+}
@@ -0,0 +1,630 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 클라이언트가 보고한 플레이 시간이 서버 기록보다 길어도 허용하는 오차 (초)
+const sessionPlayTimeTolerance = 5
+
+// 게임 세션 시작 요청
+type StartSessionRequest struct {
+	GameID      uint
+	GameMode    string
+	PlayerCount int
+	Platform    string
+	GameVersion string
+	IPAddress   string
+	UserAgent   string
+}
+
+// 세션 시작 결과 (토큰은 이후 이벤트/종료 요청에 사용)
+type GameSessionStart struct {
+	Session *model.GameSession `json:"session"`
+	Token   string             `json:"token"`
+}
+
+// 세션 종료 시 제출하는 점수
+// 카운터와 플레이 시간은 클라이언트가 보고한 값이며, 서버 기록과 일치하지 않으면 거부한다.
+type ScoreSubmission struct {
+	GameID      uint
+	Score       int
+	Completed   bool
+	Difficulty  model.GameDifficulty
+	GameData    string
+	SuccessRate float64
+	Accuracy    float64
+
+	PlayTime     int
+	PauseCount   int
+	SaveCount    int
+	LoadCount    int
+	RestartCount int
+}
+
+// 세션 종료 결과
+type GameSessionResult struct {
+	Score       *model.Score `json:"score"`
+	IsHighScore bool         `json:"is_high_score"`
+	Gold        int          `json:"gold"`
+	Experience  int          `json:"experience"`
+	Level       int          `json:"level"`
+
+	// 부정행위 의심으로 검토 대기 중 (보상은 승인 후 지급)
+	Quarantined bool `json:"quarantined,omitempty"`
+
+	// 이번 점수로 달성한 업적
+	Achievements []model.Achievement `json:"achievements,omitempty"`
+
+	// 이번 점수로 완료한 미션 (보상은 수령 요청 시 지급)
+	CompletedMissions []model.Mission `json:"completed_missions,omitempty"`
+}
+
+// 서버가 관리하는 게임 세션과 점수 제출을 처리하는 서비스
+// 세션 토큰은 세션 ID에 HMAC 서명을 붙인 값이며, 점수는 세션을 종료하면서만 기록된다.
+type GameSessionService struct {
+	db         *gorm.DB
+	signingKey []byte
+
+	// 점수 부정행위 검사 (설정하지 않으면 검사 없이 반영)
+	validator *ScoreValidationService
+
+	// 리더보드 (설정하지 않으면 반영하지 않음)
+	leaderboard *LeaderboardService
+
+	// 업적 (설정하지 않으면 평가하지 않음)
+	achievements *AchievementService
+
+	// 미션 (설정하지 않으면 진행도를 갱신하지 않음)
+	missions *MissionService
+
+	// 배틀패스 (설정하지 않으면 패스 경험치를 지급하지 않음)
+	battlePass *BattlePassService
+
+	// 매치메이킹 (설정하지 않으면 매치 결과를 반영하지 않음)
+	matchmaking *MatchmakingService
+
+	now func() time.Time
+
+	// 인벤토리 통계 캐시 (설정하지 않으면 무효화하지 않음)
+	inventoryStats
+}
+
+// 새로운 GameSessionService 인스턴스를 생성
+func NewGameSessionService(db *gorm.DB, signingKey []byte) *GameSessionService {
+	return &GameSessionService{
+		db:         db,
+		signingKey: signingKey,
+		now:        time.Now,
+	}
+}
+
+// 점수 부정행위 검사 서비스를 설정
+func (s *GameSessionService) SetScoreValidator(validator *ScoreValidationService) {
+	s.validator = validator
+}
+
+// 리더보드 서비스를 설정
+func (s *GameSessionService) SetLeaderboard(leaderboard *LeaderboardService) {
+	s.leaderboard = leaderboard
+}
+
+// 업적 서비스를 설정
+func (s *GameSessionService) SetAchievements(achievements *AchievementService) {
+	s.achievements = achievements
+}
+
+// 미션 서비스를 설정
+func (s *GameSessionService) SetMissions(missions *MissionService) {
+	s.missions = missions
+}
+
+// 배틀패스 서비스를 설정
+func (s *GameSessionService) SetBattlePass(battlePass *BattlePassService) {
+	s.battlePass = battlePass
+}
+
+// 매치메이킹 서비스를 설정
+func (s *GameSessionService) SetMatchmaking(matchmaking *MatchmakingService) {
+	s.matchmaking = matchmaking
+}
+
+// 게임 세션을 시작
+// 같은 게임에 진행 중인 세션이 있으면 거부하고, 응답이 끊긴 세션은 만료 처리한다.
+func (s *GameSessionService) StartSession(userID uint, req *StartSessionRequest) (*GameSessionStart, error) {
+	var start *GameSessionStart
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		start, err = s.OpenSession(tx, userID, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return start, nil
+}
+
+// 호출자의 트랜잭션에서 게임 세션을 시작
+// 매치메이킹이 매치 참가자 전원의 세션을 한 트랜잭션으로 만들 때 사용한다.
+func (s *GameSessionService) OpenSession(tx *gorm.DB, userID uint, req *StartSessionRequest) (*GameSessionStart, error) {
+	if req.PlayerCount <= 0 {
+		req.PlayerCount = 1
+	}
+	if req.GameMode == "" {
+		req.GameMode = "single"
+	}
+
+	sessionID, err := newSessionID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	session := &model.GameSession{
+		SessionID:       sessionID,
+		UserID:          userID,
+		GameID:          req.GameID,
+		Status:          model.GameSessionActive,
+		GameMode:        req.GameMode,
+		PlayerCount:     req.PlayerCount,
+		Platform:        req.Platform,
+		GameVersion:     req.GameVersion,
+		StartedAt:       now,
+		LastHeartbeatAt: now,
+		IPAddress:       req.IPAddress,
+		UserAgent:       req.UserAgent,
+	}
+
+	var game model.Game
+	if err := tx.First(&game, req.GameID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrGameNotFound
+		}
+		return nil, fmt.Errorf("게임 조회 중 오류 발생: %w", err)
+	}
+	if !game.IsPlayable() {
+		return nil, model.ErrGameNotPlayable
+	}
+	if req.PlayerCount > game.MaxPlayers {
+		return nil, model.ErrTooManyPlayers
+	}
+
+	user, err := lockUser(tx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Level < game.MinLevel {
+		return nil, model.ErrLevelTooLow
+	}
+
+	var open []model.GameSession
+	err = tx.Where("user_id = ? AND game_id = ? AND status IN ?", userID, req.GameID,
+		[]model.GameSessionStatus{model.GameSessionActive, model.GameSessionPaused}).Find(&open).Error
+	if err != nil {
+		return nil, fmt.Errorf("진행 중인 세션 조회 중 오류 발생: %w", err)
+	}
+	for i := range open {
+		if !open[i].IsStale(now) {
+			return nil, model.ErrSessionInProgress
+		}
+		if err := expireSession(tx, &open[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Create(session).Error; err != nil {
+		return nil, fmt.Errorf("게임 세션 생성 중 오류 발생: %w", err)
+	}
+
+	return &GameSessionStart{Session: session, Token: s.sign(session)}, nil
+}
+
+// 세션 이벤트(하트비트, 일시정지, 저장 등)를 기록
+func (s *GameSessionService) RecordEvent(token string, userID uint, event model.GameSessionEvent) (*model.GameSession, error) {
+	var session *model.GameSession
+	expired := false
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		session, err = s.lockSession(tx, token, userID)
+		if err != nil {
+			return err
+		}
+
+		now := s.now()
+		if session.IsOpen() && session.IsStale(now) {
+			expired = true
+			return expireSession(tx, session)
+		}
+		if err := session.Apply(event, now); err != nil {
+			return err
+		}
+		if err := tx.Save(session).Error; err != nil {
+			return fmt.Errorf("게임 세션 업데이트 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if expired {
+		return nil, model.ErrSessionExpired
+	}
+	return session, nil
+}
+
+// 세션을 종료하고 점수를 기록
+// 제출 내용을 세션 기록과 대조한 뒤 점수 저장, 보상 지급, 게임 통계 갱신을 한 트랜잭션으로 처리한다.
+func (s *GameSessionService) EndSession(token string, userID uint, submission *ScoreSubmission) (*GameSessionResult, error) {
+	var result *GameSessionResult
+	expired := false
+
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		session, err := s.lockSession(tx, token, userID)
+		if err != nil {
+			return err
+		}
+		if !session.IsOpen() {
+			return model.ErrSessionClosed
+		}
+
+		now := s.now()
+		if session.IsStale(now) {
+			expired = true
+			return expireSession(tx, session)
+		}
+
+		session.End(now)
+		if err := verifySubmission(session, submission, now); err != nil {
+			return err
+		}
+
+		game, err := lockGame(tx, session.GameID)
+		if err != nil {
+			return err
+		}
+		settings, err := game.GetRewardSettings()
+		if err != nil {
+			return err
+		}
+
+		score := newSessionScore(session, submission)
+		if err := score.Validate(); err != nil {
+			return fmt.Errorf("%w: %v", model.ErrInvalidScore, err)
+		}
+
+		if err := calculateRewards(tx, score, settings, now); err != nil {
+			return err
+		}
+		score.UpdateEfficiency()
+
+		score.ReviewStatus = model.ScoreAccepted
+		if err := tx.Omit(clause.Associations).Create(score).Error; err != nil {
+			return fmt.Errorf("점수 저장 중 오류 발생: %w", err)
+		}
+		result, err = s.screenAndCredit(tx, score, game)
+		if err != nil {
+			return err
+		}
+
+		session.ScoreID = &score.ID
+		if err := tx.Save(session).Error; err != nil {
+			return fmt.Errorf("게임 세션 업데이트 중 오류 발생: %w", err)
+		}
+
+		game.AddPlay(score.PlayTime / 60)
+		err = tx.Model(game).Updates(map[string]interface{}{
+			"total_plays":     game.TotalPlays,
+			"total_play_time": game.TotalPlayTime,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("게임 통계 업데이트 중 오류 발생: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if expired {
+		return nil, model.ErrSessionExpired
+	}
+
+	// 리더보드는 DB 기록으로 재구축할 수 있으므로 반영 실패가 점수 제출을 실패시키지 않는다
+	if s.leaderboard != nil {
+		if err := s.leaderboard.RecordScore(result.Score); err != nil {
+			log.Printf("리더보드 반영 실패 (score_id=%d): %v", result.Score.ID, err)
+		}
+	}
+	return result, nil
+}
+
+// 점수 검사 후 통과하면 보상을 지급
+// 격리된 점수는 보상과 최고 점수 반영이 보류되며, 섀도우 밴 사용자에게는 정상 처리된 것처럼 응답한다.
+func (s *GameSessionService) screenAndCredit(tx *gorm.DB, score *model.Score, game *model.Game) (*GameSessionResult, error) {
+	result := &GameSessionResult{Score: score}
+
+	var flags []model.ScoreFlag
+	if s.validator != nil {
+		var err error
+		if flags, err = s.validator.Screen(tx, score, game); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(flags) == 0 {
+		user, isHighScore, err := creditScore(tx, score)
+		if err != nil {
+			return nil, err
+		}
+		result.Gold = score.EarnedGold
+		result.Experience = score.EarnedExperience
+		result.Level = user.Level
+		result.IsHighScore = isHighScore
+
+		if s.achievements != nil {
+			if result.Achievements, err = s.achievements.Record(tx, model.NewScoreAchievementEvent(score)); err != nil {
+				return nil, err
+			}
+		}
+		if s.missions != nil {
+			if result.CompletedMissions, err = s.missions.RecordScore(tx, score); err != nil {
+				return nil, err
+			}
+		}
+		if s.battlePass != nil {
+			if err := s.battlePass.RecordScore(tx, score); err != nil {
+				return nil, err
+			}
+		}
+		// 의심 점수는 검토 결과가 나온 뒤 매치에 반영
+		if s.matchmaking != nil {
+			if err := s.matchmaking.RecordScore(tx, score); err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+
+	for _, flag := range flags {
+		if flag.Rule == model.ScoreRuleShadowBan {
+			var user model.User
+			if err := tx.First(&user, score.UserID).Error; err != nil {
+				return nil, fmt.Errorf("사용자 조회 중 오류 발생: %w", err)
+			}
+			result.Gold = score.EarnedGold
+			result.Experience = score.EarnedExperience
+			result.Level = user.Level
+			return result, nil
+		}
+	}
+
+	result.Quarantined = true
+	return result, nil
+}
+
+// 하트비트가 끊긴 세션을 만료 처리
+// 매치 세션이 만료되면 그 참가자를 기권(0점)으로 기록해, 나간 참가자 때문에 매치가 끝나지 않는 일이 없게 한다.
+func (s *GameSessionService) ExpireStaleSessions(now time.Time) (int64, error) {
+	cutoff := now.Add(-model.GameSessionHeartbeatTimeout)
+	var expired int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var sessions []model.GameSession
+		err := lockForUpdate(tx).
+			Where("status IN ? AND last_heartbeat_at <= ?",
+				[]model.GameSessionStatus{model.GameSessionActive, model.GameSessionPaused}, cutoff).
+			Find(&sessions).Error
+		if err != nil {
+			return fmt.Errorf("게임 세션 조회 중 오류 발생: %w", err)
+		}
+		if len(sessions) == 0 {
+			return nil
+		}
+
+		ids := make([]uint, len(sessions))
+		for i := range sessions {
+			ids[i] = sessions[i].ID
+		}
+		result := tx.Model(&model.GameSession{}).Where("id IN ?", ids).Update("status", model.GameSessionExpired)
+		if result.Error != nil {
+			return fmt.Errorf("게임 세션 만료 처리 중 오류 발생: %w", result.Error)
+		}
+		expired = result.RowsAffected
+
+		if s.matchmaking != nil {
+			for i := range sessions {
+				if err := s.matchmaking.ForfeitSession(tx, sessions[i].SessionID); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return expired, nil
+}
+
+// 점수 버프와 보상을 계산 (지급은 검사를 통과한 뒤 creditScore에서 처리)
+func calculateRewards(tx *gorm.DB, score *model.Score, settings *model.GameRewardSettings, now time.Time) error {
+	scoreMultiplier, err := rewardMultiplier(tx, score.UserID, model.BuffStatScore, now)
+	if err != nil {
+		return err
+	}
+	score.BonusScore = int(float64(score.Score) * (scoreMultiplier - 1))
+
+	gold, experience := settings.Calculate(score.GetTotalScore(), score.Completed)
+
+	goldMultiplier, err := rewardMultiplier(tx, score.UserID, model.BuffStatGold, now)
+	if err != nil {
+		return err
+	}
+	xpMultiplier, err := rewardMultiplier(tx, score.UserID, model.BuffStatXP, now)
+	if err != nil {
+		return err
+	}
+	score.EarnedGold = int(float64(gold) * goldMultiplier)
+	score.EarnedExperience = int(float64(experience) * xpMultiplier)
+	return nil
+}
+
+// 점수의 보상을 지급하고 최고 점수를 갱신
+// 검사를 통과했거나 중재자가 승인한 점수에만 호출한다.
+func creditScore(tx *gorm.DB, score *model.Score) (*model.User, bool, error) {
+	if err := adjustUserGold(tx, score.UserID, score.EarnedGold); err != nil {
+		return nil, false, err
+	}
+	user, err := adjustUserExperience(tx, score.UserID, score.EarnedExperience)
+	if err != nil {
+		return nil, false, err
+	}
+	isHighScore, err := updateHighScore(tx, score)
+	if err != nil {
+		return nil, false, err
+	}
+	return user, isHighScore, nil
+}
+
+// 토큰을 검증하고 세션을 잠금 상태로 조회
+// 다른 사용자의 세션은 존재 여부를 드러내지 않도록 토큰 오류로 처리한다.
+func (s *GameSessionService) lockSession(tx *gorm.DB, token string, userID uint) (*model.GameSession, error) {
+	sessionID, _, ok := strings.Cut(token, ".")
+	if !ok || sessionID == "" {
+		return nil, model.ErrInvalidSessionToken
+	}
+
+	var session model.GameSession
+	if err := lockForUpdate(tx).Where("session_id = ?", sessionID).First(&session).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("게임 세션 조회 중 오류 발생: %w", err)
+	}
+
+	if !hmac.Equal([]byte(token), []byte(s.sign(&session))) || session.UserID != userID {
+		return nil, model.ErrInvalidSessionToken
+	}
+	return &session, nil
+}
+
+// 세션 토큰 생성 (세션 ID.서명)
+func (s *GameSessionService) sign(session *model.GameSession) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(session.SessionID + "|" +
+		strconv.FormatUint(uint64(session.UserID), 10) + "|" +
+		strconv.FormatUint(uint64(session.GameID), 10)))
+	return session.SessionID + "." + hex.EncodeToString(mac.Sum(nil))
+}
+
+// 랜덤 세션 ID 생성
+func newSessionID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("세션 ID 생성 중 오류 발생: %w", err)
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// 세션을 만료 상태로 변경
+func expireSession(tx *gorm.DB, session *model.GameSession) error {
+	session.Status = model.GameSessionExpired
+	if err := tx.Model(session).Update("status", session.Status).Error; err != nil {
+		return fmt.Errorf("게임 세션 만료 처리 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 제출 내용이 세션 기록과 일치하는지 확인
+func verifySubmission(session *model.GameSession, submission *ScoreSubmission, now time.Time) error {
+	if submission.GameID != 0 && submission.GameID != session.GameID {
+		return model.ErrSessionMismatch
+	}
+	if submission.PauseCount != session.PauseCount ||
+		submission.SaveCount != session.SaveCount ||
+		submission.LoadCount != session.LoadCount ||
+		submission.RestartCount != session.RestartCount {
+		return model.ErrSessionMismatch
+	}
+	if submission.PlayTime > session.ActivePlaySeconds(now)+sessionPlayTimeTolerance {
+		return model.ErrSessionMismatch
+	}
+	return nil
+}
+
+// 세션 기록과 제출 내용으로 점수 기록을 생성 (플레이 시간과 카운터는 서버 값 사용)
+func newSessionScore(session *model.GameSession, submission *ScoreSubmission) *model.Score {
+	difficulty := submission.Difficulty
+	if difficulty == "" {
+		difficulty = model.GameDifficultyNormal
+	}
+	gameData := submission.GameData
+	if gameData == "" {
+		gameData = "{}"
+	}
+
+	score := &model.Score{
+		UserID:       session.UserID,
+		GameID:       session.GameID,
+		Score:        submission.Score,
+		PlayTime:     session.ActivePlaySeconds(*session.EndedAt),
+		Completed:    submission.Completed,
+		Difficulty:   difficulty,
+		GameMode:     session.GameMode,
+		GameSettings: "{}",
+		GameData:     gameData,
+		Metadata:     "{}",
+		StartedAt:    &session.StartedAt,
+		EndedAt:      session.EndedAt,
+		Platform:     session.Platform,
+		GameVersion:  session.GameVersion,
+		IPAddress:    session.IPAddress,
+		UserAgent:    session.UserAgent,
+		SessionID:    session.SessionID,
+		RestartCount: session.RestartCount,
+		PauseCount:   session.PauseCount,
+		SaveCount:    session.SaveCount,
+		LoadCount:    session.LoadCount,
+		SuccessRate:  submission.SuccessRate,
+		Accuracy:     submission.Accuracy,
+	}
+	return score
+}
+
+// 최고 점수 여부를 갱신
+// 기존 최고 기록보다 총점이 높으면 이전 기록의 표시를 해제하고 새 기록을 최고 점수로 표시한다.
+func updateHighScore(tx *gorm.DB, score *model.Score) (bool, error) {
+	var best model.Score
+	err := tx.Omit(clause.Associations).
+		Where("user_id = ? AND game_id = ? AND is_high_score = ? AND id <> ?", score.UserID, score.GameID, true, score.ID).
+		First(&best).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, fmt.Errorf("최고 점수 조회 중 오류 발생: %w", err)
+	}
+	if err == nil {
+		if score.GetTotalScore() <= best.GetTotalScore() {
+			return false, nil
+		}
+		if err := tx.Model(&best).Update("is_high_score", false).Error; err != nil {
+			return false, fmt.Errorf("최고 점수 갱신 중 오류 발생: %w", err)
+		}
+	}
+
+	score.IsHighScore = true
+	if err := tx.Model(score).Update("is_high_score", true).Error; err != nil {
+		return false, fmt.Errorf("최고 점수 갱신 중 오류 발생: %w", err)
+	}
+	return true, nil
+}
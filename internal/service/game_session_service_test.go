@@ -0,0 +1,270 @@
+package service
+
+import (
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"strings"
+	"testing"
+	"time"
+)
+
+func setupGameSessionTest(t *testing.T) (*gorm.DB, *GameSessionService, *model.User, *model.Game) {
+	db := setupGameTestDB(t, &model.Game{}, &model.Score{}, &model.GameSession{}, &model.ActiveBuff{})
+	service := NewGameSessionService(db, []byte("test-signing-key"))
+	user := seedUser(t, db, "alice", 100)
+
+	game := newTestGame("Tetris", model.GameCategoryPuzzle)
+	game.Status = model.GameStatusActive
+	game.PlayURL = "https://example.com/tetris"
+	game.MaxPlayers = 2
+	game.RewardSettings = `{"gold_per_point":0.1,"experience_per_point":1,"completion_gold":10,"max_experience":5000}`
+	require.NoError(t, db.Create(game).Error)
+	return db, service, user, game
+}
+
+// 세션 시작 조건(플레이 가능 여부, 최소 레벨, 최대 인원, 중복 세션) 테스트
+func TestGameSessionService_StartSession(t *testing.T) {
+	db, service, user, game := setupGameSessionTest(t)
+	now := time.Now()
+	service.now = func() time.Time { return now }
+
+	_, err := service.StartSession(user.ID, &StartSessionRequest{GameID: game.ID, PlayerCount: 3})
+	assert.ErrorIs(t, err, model.ErrTooManyPlayers)
+
+	_, err = service.StartSession(user.ID, &StartSessionRequest{GameID: 999})
+	assert.ErrorIs(t, err, model.ErrGameNotFound)
+
+	require.NoError(t, db.Model(game).Update("min_level", 5).Error)
+	_, err = service.StartSession(user.ID, &StartSessionRequest{GameID: game.ID})
+	assert.ErrorIs(t, err, model.ErrLevelTooLow)
+	require.NoError(t, db.Model(game).Update("min_level", 1).Error)
+
+	require.NoError(t, db.Model(game).Update("status", model.GameStatusMaintenance).Error)
+	_, err = service.StartSession(user.ID, &StartSessionRequest{GameID: game.ID})
+	assert.ErrorIs(t, err, model.ErrGameNotPlayable)
+	require.NoError(t, db.Model(game).Update("status", model.GameStatusActive).Error)
+
+	started, err := service.StartSession(user.ID, &StartSessionRequest{GameID: game.ID})
+	require.NoError(t, err)
+	assert.Equal(t, model.GameSessionActive, started.Session.Status)
+	assert.Equal(t, "single", started.Session.GameMode)
+	assert.Contains(t, started.Token, started.Session.SessionID+".")
+
+	_, err = service.StartSession(user.ID, &StartSessionRequest{GameID: game.ID})
+	assert.ErrorIs(t, err, model.ErrSessionInProgress)
+
+	// 응답이 끊긴 세션은 새 세션을 시작할 때 만료 처리
+	now = now.Add(model.GameSessionHeartbeatTimeout)
+	_, err = service.StartSession(user.ID, &StartSessionRequest{GameID: game.ID})
+	require.NoError(t, err)
+
+	var previous model.GameSession
+	require.NoError(t, db.First(&previous, started.Session.ID).Error)
+	assert.Equal(t, model.GameSessionExpired, previous.Status)
+}
+
+// 이벤트 기록과 토큰 검증 테스트
+func TestGameSessionService_RecordEvent(t *testing.T) {
+	db, service, user, game := setupGameSessionTest(t)
+	other := seedUser(t, db, "bob", 0)
+	now := time.Now()
+	service.now = func() time.Time { return now }
+
+	started, err := service.StartSession(user.ID, &StartSessionRequest{GameID: game.ID})
+	require.NoError(t, err)
+
+	now = now.Add(30 * time.Second)
+	session, err := service.RecordEvent(started.Token, user.ID, model.GameSessionEventPause)
+	require.NoError(t, err)
+	assert.Equal(t, model.GameSessionPaused, session.Status)
+
+	_, err = service.RecordEvent(started.Token, user.ID, model.GameSessionEventPause)
+	assert.ErrorIs(t, err, model.ErrInvalidSessionEvent)
+
+	now = now.Add(20 * time.Second)
+	session, err = service.RecordEvent(started.Token, user.ID, model.GameSessionEventResume)
+	require.NoError(t, err)
+	assert.Equal(t, 20, session.PausedSeconds)
+
+	_, err = service.RecordEvent(started.Token, user.ID, model.GameSessionEventSave)
+	require.NoError(t, err)
+
+	_, err = service.RecordEvent(started.Token, other.ID, model.GameSessionEventHeartbeat)
+	assert.ErrorIs(t, err, model.ErrInvalidSessionToken, "다른 사용자는 세션을 사용할 수 없어야 합니다")
+
+	// 서명의 마지막 문자를 항상 다른 값으로 바꿈
+	tampered := started.Token[:len(started.Token)-1] + "0"
+	if strings.HasSuffix(started.Token, "0") {
+		tampered = started.Token[:len(started.Token)-1] + "1"
+	}
+	_, err = service.RecordEvent(tampered, user.ID, model.GameSessionEventHeartbeat)
+	assert.ErrorIs(t, err, model.ErrInvalidSessionToken, "서명이 변조된 토큰은 거부해야 합니다")
+
+	_, err = service.RecordEvent("unknown.token", user.ID, model.GameSessionEventHeartbeat)
+	assert.ErrorIs(t, err, model.ErrSessionNotFound)
+
+	now = now.Add(model.GameSessionHeartbeatTimeout)
+	_, err = service.RecordEvent(started.Token, user.ID, model.GameSessionEventHeartbeat)
+	assert.ErrorIs(t, err, model.ErrSessionExpired)
+
+	var expired model.GameSession
+	require.NoError(t, db.First(&expired, started.Session.ID).Error)
+	assert.Equal(t, model.GameSessionExpired, expired.Status)
+	assert.Equal(t, 1, expired.PauseCount)
+	assert.Equal(t, 1, expired.SaveCount)
+}
+
+// 점수 제출, 보상 지급, 최고 점수와 게임 통계 갱신 테스트
+func TestGameSessionService_EndSession(t *testing.T) {
+	db, service, user, game := setupGameSessionTest(t)
+	now := time.Now()
+	service.now = func() time.Time { return now }
+
+	started, err := service.StartSession(user.ID, &StartSessionRequest{GameID: game.ID})
+	require.NoError(t, err)
+	_, err = service.RecordEvent(started.Token, user.ID, model.GameSessionEventSave)
+	require.NoError(t, err)
+
+	now = now.Add(90 * time.Second)
+	result, err := service.EndSession(started.Token, user.ID, &ScoreSubmission{
+		Score:     1000,
+		Completed: true,
+		PlayTime:  90,
+		SaveCount: 1,
+	})
+	require.NoError(t, err)
+	assert.True(t, result.IsHighScore)
+	assert.Equal(t, 110, result.Gold, "점수당 골드 + 완료 보너스")
+	assert.Equal(t, 1000, result.Experience)
+	assert.Equal(t, 2, result.Level)
+	assert.Equal(t, 90, result.Score.PlayTime, "플레이 시간은 서버 기록을 사용해야 합니다")
+	assert.Equal(t, started.Session.SessionID, result.Score.SessionID)
+	assert.Equal(t, 210, userGold(t, db, user.ID))
+
+	var session model.GameSession
+	require.NoError(t, db.First(&session, started.Session.ID).Error)
+	assert.Equal(t, model.GameSessionEnded, session.Status)
+	require.NotNil(t, session.ScoreID)
+	assert.Equal(t, result.Score.ID, *session.ScoreID)
+
+	var updatedGame model.Game
+	require.NoError(t, db.First(&updatedGame, game.ID).Error)
+	assert.Equal(t, 1, updatedGame.TotalPlays)
+	assert.Equal(t, 1, updatedGame.TotalPlayTime)
+
+	// 종료된 세션으로는 다시 제출할 수 없음
+	_, err = service.EndSession(started.Token, user.ID, &ScoreSubmission{Score: 1000})
+	assert.ErrorIs(t, err, model.ErrSessionClosed)
+
+	// 점수 버프는 보너스 점수로 반영되고, 더 높은 총점이면 최고 점수가 교체됨
+	require.NoError(t, db.Create(&model.ActiveBuff{
+		UserID: user.ID, Stat: model.BuffStatScore, Bonus: 0.5, SourceItemID: "score_potion", ExpiresAt: now.Add(time.Hour),
+	}).Error)
+	second, err := service.StartSession(user.ID, &StartSessionRequest{GameID: game.ID})
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	result, err = service.EndSession(second.Token, user.ID, &ScoreSubmission{Score: 800})
+	require.NoError(t, err)
+	assert.Equal(t, 400, result.Score.BonusScore)
+	assert.True(t, result.IsHighScore)
+
+	var highScores int64
+	require.NoError(t, db.Model(&model.Score{}).Where("user_id = ? AND game_id = ? AND is_high_score = ?", user.ID, game.ID, true).Count(&highScores).Error)
+	assert.Equal(t, int64(1), highScores)
+}
+
+// 세션 기록과 맞지 않는 제출은 점수와 보상 없이 거부되는지 테스트
+func TestGameSessionService_EndSessionMismatch(t *testing.T) {
+	db, service, user, game := setupGameSessionTest(t)
+	now := time.Now()
+	service.now = func() time.Time { return now }
+
+	started, err := service.StartSession(user.ID, &StartSessionRequest{GameID: game.ID})
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+
+	tests := []struct {
+		name       string
+		submission *ScoreSubmission
+	}{
+		{"다른 게임", &ScoreSubmission{GameID: game.ID + 1, Score: 100}},
+		{"카운터 불일치", &ScoreSubmission{Score: 100, RestartCount: 2}},
+		{"플레이 시간 초과", &ScoreSubmission{Score: 100, PlayTime: 3600}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.EndSession(started.Token, user.ID, tt.submission)
+			assert.ErrorIs(t, err, model.ErrSessionMismatch)
+		})
+	}
+
+	var scores int64
+	require.NoError(t, db.Model(&model.Score{}).Count(&scores).Error)
+	assert.Equal(t, int64(0), scores)
+	assert.Equal(t, 100, userGold(t, db, user.ID))
+
+	// 거부된 뒤에도 세션은 열려 있어 올바른 제출이 가능
+	_, err = service.EndSession(started.Token, user.ID, &ScoreSubmission{Score: 100, PlayTime: 60})
+	require.NoError(t, err)
+}
+
+// 하트비트가 끊긴 세션 일괄 만료 테스트
+func TestGameSessionService_ExpireStaleSessions(t *testing.T) {
+	_, service, user, game := setupGameSessionTest(t)
+	now := time.Now()
+	service.now = func() time.Time { return now }
+
+	started, err := service.StartSession(user.ID, &StartSessionRequest{GameID: game.ID})
+	require.NoError(t, err)
+
+	count, err := service.ExpireStaleSessions(now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	count, err = service.ExpireStaleSessions(now.Add(model.GameSessionHeartbeatTimeout))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	_, err = service.EndSession(started.Token, user.ID, &ScoreSubmission{Score: 100})
+	assert.ErrorIs(t, err, model.ErrSessionClosed)
+}
+
+// 부정행위 의심 점수는 격리되고 보상이 보류되는지 테스트
+func TestGameSessionService_EndSessionQuarantine(t *testing.T) {
+	db, service, user, game := setupGameSessionTest(t)
+	require.NoError(t, db.AutoMigrate(&model.ScoreFlag{}))
+	service.SetScoreValidator(NewScoreValidationService(db))
+	now := time.Now()
+	service.now = func() time.Time { return now }
+
+	// 예상 10분 게임을 10초 만에 완료
+	started, err := service.StartSession(user.ID, &StartSessionRequest{GameID: game.ID})
+	require.NoError(t, err)
+	now = now.Add(10 * time.Second)
+	result, err := service.EndSession(started.Token, user.ID, &ScoreSubmission{Score: 1000, Completed: true})
+	require.NoError(t, err)
+	assert.True(t, result.Quarantined)
+	assert.False(t, result.IsHighScore)
+	assert.Zero(t, result.Gold)
+	assert.Equal(t, 100, userGold(t, db, user.ID), "격리된 점수의 보상은 지급하지 않아야 합니다")
+
+	var saved model.Score
+	require.NoError(t, db.Preload("Flags").First(&saved, result.Score.ID).Error)
+	assert.Equal(t, model.ScoreQuarantined, saved.ReviewStatus)
+	assert.Equal(t, 110, saved.EarnedGold, "승인 시 지급할 보상은 기록해 두어야 합니다")
+	require.Len(t, saved.Flags, 1)
+	assert.Equal(t, model.ScoreRulePlayTime, saved.Flags[0].Rule)
+
+	// 섀도우 밴 사용자에게는 격리 사실을 알리지 않음
+	require.NoError(t, db.Model(user).Update("shadow_banned", true).Error)
+	second, err := service.StartSession(user.ID, &StartSessionRequest{GameID: game.ID})
+	require.NoError(t, err)
+	now = now.Add(90 * time.Second)
+	result, err = service.EndSession(second.Token, user.ID, &ScoreSubmission{Score: 100})
+	require.NoError(t, err)
+	assert.False(t, result.Quarantined)
+	assert.Equal(t, 10, result.Gold)
+	assert.Equal(t, 100, userGold(t, db, user.ID))
+}
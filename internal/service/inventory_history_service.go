@@ -0,0 +1,175 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+)
+
+// 인벤토리 변경 기록 조회와 고객 지원용 복구를 처리하는 서비스
+type InventoryHistoryService struct {
+	db *gorm.DB
+}
+
+// 새로운 InventoryHistoryService 인스턴스를 생성
+func NewInventoryHistoryService(db *gorm.DB) *InventoryHistoryService {
+	return &InventoryHistoryService{
+		db: db,
+	}
+}
+
+// 아이템 변경 기록을 최신순으로 조회
+// userID가 0이면 전체 사용자, itemID가 비어 있으면 모든 아이템의 기록을 조회
+func (s *InventoryHistoryService) GetHistory(userID uint, itemID string, limit, offset int) ([]model.InventoryLog, error) {
+	query := s.db.Model(&model.InventoryLog{})
+	if userID != 0 {
+		query = query.Where("user_id = ?", userID)
+	}
+	if itemID != "" {
+		query = query.Where("item_id = ?", itemID)
+	}
+
+	var logs []model.InventoryLog
+	if err := query.Order("id DESC").Limit(limit).Offset(offset).Find(&logs).Error; err != nil {
+		return nil, fmt.Errorf("인벤토리 변경 기록 조회 중 오류 발생: %w", err)
+	}
+	return logs, nil
+}
+
+// 특정 변경을 되돌림
+// 변경된 수량만큼 현재 수량을 반대로 조정하고, 레벨이 바뀐 변경이면 이전 레벨로 되돌린다.
+// 삭제된 아이템은 복구되며, 이후 아이템이 사용/이동되어 되돌릴 수 없으면 ErrRevertConflict를 반환한다.
+// 거래 상대방의 골드 등 아이템 외 자산은 되돌리지 않는다.
+func (s *InventoryHistoryService) RevertChange(logID, adminID uint) (*model.InventoryLog, error) {
+	var revert *model.InventoryLog
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var original model.InventoryLog
+		if err := lockForUpdate(tx).First(&original, logID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.ErrInventoryLogNotFound
+			}
+			return fmt.Errorf("인벤토리 변경 기록 조회 중 오류 발생: %w", err)
+		}
+		if original.IsReverted() {
+			return model.ErrAlreadyReverted
+		}
+
+		inventory, err := s.lockAnyInventory(tx, original.InventoryID)
+		if err != nil {
+			return err
+		}
+
+		current := inventory.Quantity
+		if inventory.DeletedAt.Valid {
+			current = 0
+		}
+		quantity := current - original.QuantityDelta()
+		level := inventory.Level
+		if original.BeforeLevel != original.AfterLevel {
+			if inventory.Level != original.AfterLevel {
+				return model.ErrRevertConflict
+			}
+			level = original.BeforeLevel
+		}
+		if quantity < 0 || (quantity == 0 && current == 0) {
+			return model.ErrRevertConflict
+		}
+
+		change := model.NewInventoryChange(model.InventorySourceRollback, adminID, "log", original.ID)
+		revert, err = s.applyState(tx, inventory, current, quantity, level, change)
+		if err != nil {
+			return err
+		}
+
+		original.RevertedByID = &revert.ID
+		if err := tx.Model(&original).Update("reverted_by_id", revert.ID).Error; err != nil {
+			return fmt.Errorf("인벤토리 변경 기록 업데이트 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return revert, nil
+}
+
+// 삭제된 인벤토리 아이템을 삭제 직전 수량으로 복구
+func (s *InventoryHistoryService) RestoreItem(inventoryID, adminID uint) (*model.Inventory, error) {
+	var restored *model.Inventory
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		inventory, err := s.lockAnyInventory(tx, inventoryID)
+		if err != nil {
+			return err
+		}
+		if !inventory.DeletedAt.Valid {
+			return model.ErrItemNotDeleted
+		}
+
+		// 소프트 삭제 시 수량 컬럼은 갱신되지 않으므로 삭제 직전 수량이 남아 있다
+		quantity := inventory.Quantity
+		if quantity <= 0 {
+			quantity = 1
+		}
+
+		change := model.NewInventoryChange(model.InventorySourceRollback, adminID, "inventory", inventory.ID)
+		if _, err := s.applyState(tx, inventory, 0, quantity, inventory.Level, change); err != nil {
+			return err
+		}
+		restored = inventory
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return restored, nil
+}
+
+// 삭제 여부와 관계없이 인벤토리 아이템을 잠금 상태로 조회
+func (s *InventoryHistoryService) lockAnyInventory(tx *gorm.DB, inventoryID uint) (*model.Inventory, error) {
+	var inventory model.Inventory
+	if err := lockForUpdate(tx).Unscoped().First(&inventory, inventoryID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("인벤토리 아이템을 찾을 수 없습니다: %d: %w", inventoryID, model.ErrRevertConflict)
+		}
+		return nil, fmt.Errorf("인벤토리 조회 중 오류 발생: %w", err)
+	}
+	return &inventory, nil
+}
+
+// 아이템을 지정한 수량/레벨로 변경하고 변경 기록을 남김
+// 수량이 0이면 아이템을 삭제하고, 삭제된 아이템에 수량이 생기면 복구한다.
+func (s *InventoryHistoryService) applyState(tx *gorm.DB, inventory *model.Inventory, current, quantity, level int, change model.InventoryChange) (*model.InventoryLog, error) {
+	beforeLevel := inventory.Level
+
+	// 삭제하는 경우 이후 복구할 수 있도록 수량 컬럼은 유지
+	updates := map[string]interface{}{"level": level}
+	if quantity > 0 {
+		updates["quantity"] = quantity
+		updates["deleted_at"] = nil
+	}
+	if err := tx.Unscoped().Model(inventory).Updates(updates).Error; err != nil {
+		return nil, fmt.Errorf("인벤토리 복구 중 오류 발생: %w", err)
+	}
+	if quantity == 0 {
+		if err := tx.Delete(inventory).Error; err != nil {
+			return nil, fmt.Errorf("인벤토리 삭제 중 오류 발생: %w", err)
+		}
+	}
+
+	inventory.Quantity = quantity
+	inventory.Level = level
+	if quantity > 0 {
+		inventory.DeletedAt = gorm.DeletedAt{}
+	}
+
+	entry := newInventoryLog(inventory, current, beforeLevel, change)
+	if err := tx.Create(entry).Error; err != nil {
+		return nil, fmt.Errorf("인벤토리 변경 기록 생성 중 오류 발생: %w", err)
+	}
+	return entry, nil
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// 인벤토리 변경이 출처와 함께 기록되는지 테스트
+func TestInventoryHistoryService_RecordsChanges(t *testing.T) {
+	db := setupGameTestDB(t)
+	inventoryService := NewInventoryService(db)
+	historyService := NewInventoryHistoryService(db)
+	user := seedUser(t, db, "owner", 0)
+
+	require.NoError(t, inventoryService.CreateInventory(99, &model.Inventory{
+		UserID: user.ID, ItemID: "potion", ItemName: "포션", ItemType: "consumable", Rarity: "common", Level: 1, Quantity: 2,
+	}))
+	require.NoError(t, inventoryService.UseItem(user.ID, "potion"))
+	require.NoError(t, inventoryService.UseItem(user.ID, "potion"))
+
+	logs, err := historyService.GetHistory(user.ID, "potion", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+
+	// 최신순
+	assert.Equal(t, model.InventorySourceUse, logs[0].Source)
+	assert.Equal(t, user.ID, logs[0].ActorID)
+	assert.Equal(t, 1, logs[0].BeforeQuantity)
+	assert.Equal(t, 0, logs[0].AfterQuantity)
+	assert.Equal(t, model.InventorySourceAdmin, logs[2].Source)
+	assert.Equal(t, uint(99), logs[2].ActorID, "관리자 변경은 요청한 관리자가 기록되어야 합니다")
+	assert.Equal(t, 2, logs[2].AfterQuantity)
+
+	other, err := historyService.GetHistory(user.ID, "sword", 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, other)
+}
+
+// 삭제 변경을 되돌리면 아이템이 복구되는지 테스트
+func TestInventoryHistoryService_RevertRemoval(t *testing.T) {
+	db := setupGameTestDB(t)
+	inventoryService := NewInventoryService(db)
+	historyService := NewInventoryHistoryService(db)
+	user := seedUser(t, db, "owner", 0)
+	sword := seedItem(t, db, user.ID, "legend_sword", "legendary", 1)
+
+	require.NoError(t, inventoryService.DeleteInventory(99, sword.ID))
+	assert.Equal(t, 0, itemQuantity(t, db, user.ID, "legend_sword"))
+
+	logs, err := historyService.GetHistory(user.ID, "legend_sword", 1, 0)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.True(t, logs[0].IsRemoval())
+	assert.Equal(t, uint(99), logs[0].ActorID)
+
+	revert, err := historyService.RevertChange(logs[0].ID, 99)
+	require.NoError(t, err)
+	assert.Equal(t, model.InventorySourceRollback, revert.Source)
+	assert.Equal(t, uint(99), revert.ActorID)
+	assert.Equal(t, 1, itemQuantity(t, db, user.ID, "legend_sword"), "삭제된 아이템이 복구되어야 합니다")
+
+	_, err = historyService.RevertChange(logs[0].ID, 99)
+	assert.ErrorIs(t, err, model.ErrAlreadyReverted)
+}
+
+// 이미 사용된 획득 기록은 되돌릴 수 없는지 테스트
+func TestInventoryHistoryService_RevertConflict(t *testing.T) {
+	db := setupGameTestDB(t)
+	inventoryService := NewInventoryService(db)
+	historyService := NewInventoryHistoryService(db)
+	user := seedUser(t, db, "owner", 0)
+
+	require.NoError(t, inventoryService.CreateInventory(99, &model.Inventory{
+		UserID: user.ID, ItemID: "potion", ItemName: "포션", ItemType: "consumable", Rarity: "common", Level: 1, Quantity: 3,
+	}))
+	require.NoError(t, inventoryService.AddItemQuantity(99, user.ID, "potion", 2))
+
+	logs, err := historyService.GetHistory(user.ID, "potion", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	grant, add := logs[1], logs[0]
+
+	// 3개 획득 기록을 되돌리면 현재 5개에서 2개가 남아야 함
+	_, err = historyService.RevertChange(grant.ID, 99)
+	require.NoError(t, err)
+	assert.Equal(t, 2, itemQuantity(t, db, user.ID, "potion"))
+
+	require.NoError(t, inventoryService.UseItem(user.ID, "potion"))
+
+	// 2개 추가 기록은 이미 1개가 사용되어 되돌릴 수 없음
+	_, err = historyService.RevertChange(add.ID, 99)
+	assert.ErrorIs(t, err, model.ErrRevertConflict)
+	assert.Equal(t, 1, itemQuantity(t, db, user.ID, "potion"))
+
+	_, err = historyService.RevertChange(9999, 99)
+	assert.ErrorIs(t, err, model.ErrInventoryLogNotFound)
+}
+
+// 강화 레벨 변경 되돌리기 테스트
+func TestInventoryHistoryService_RevertLevelChange(t *testing.T) {
+	db := setupGameTestDB(t, &model.EnhancementLog{})
+	historyService := NewInventoryHistoryService(db)
+	user := seedUser(t, db, "owner", 1000)
+	sword := seedItem(t, db, user.ID, "iron_sword", "common", 1)
+	seedItem(t, db, user.ID, model.EnhanceStoneItemID, "common", 5)
+	require.NoError(t, db.Model(&model.Inventory{}).Where("item_id = ?", model.EnhanceStoneItemID).Update("item_type", "material").Error)
+
+	enhancementService := NewEnhancementService(db)
+	enhancementService.roll = fixedRolls(0)
+	_, err := enhancementService.Enhance(user.ID, sword.ID, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, itemLevel(t, db, sword.ID))
+
+	logs, err := historyService.GetHistory(user.ID, "iron_sword", 1, 0)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, model.InventorySourceEnhance, logs[0].Source)
+	assert.Equal(t, 1, logs[0].BeforeLevel)
+	assert.Equal(t, 2, logs[0].AfterLevel)
+
+	_, err = historyService.RevertChange(logs[0].ID, 99)
+	require.NoError(t, err)
+	assert.Equal(t, 1, itemLevel(t, db, sword.ID), "강화 전 레벨로 되돌아가야 합니다")
+	assert.Equal(t, 1, itemQuantity(t, db, user.ID, "iron_sword"))
+}
+
+// 삭제된 아이템 복구 테스트
+func TestInventoryHistoryService_RestoreItem(t *testing.T) {
+	db := setupGameTestDB(t)
+	historyService := NewInventoryHistoryService(db)
+	user := seedUser(t, db, "owner", 0)
+	gems := seedItem(t, db, user.ID, "gem", "epic", 4)
+
+	_, err := historyService.RestoreItem(gems.ID, 99)
+	assert.ErrorIs(t, err, model.ErrItemNotDeleted)
+
+	require.NoError(t, NewInventoryService(db).DeleteInventory(99, gems.ID))
+
+	restored, err := historyService.RestoreItem(gems.ID, 99)
+	require.NoError(t, err)
+	assert.Equal(t, 4, restored.Quantity, "삭제 직전 수량으로 복구되어야 합니다")
+	assert.Equal(t, 4, itemQuantity(t, db, user.ID, "gem"))
+}
@@ -1,14 +1,41 @@
 package service
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"g_dev/internal/model"
 	"gorm.io/gorm"
+	"log"
+	"time"
 )
 
+const (
+	// 만료 임박 알림을 보내는 기준 시간
+	ItemExpiryNoticeWindow = 24 * time.Hour
+
+	// 인벤토리 통계 캐시 유지 시간
+	inventoryStatsCacheTTL = 5 * time.Minute
+
+	// 만료 아이템 정리 시 한 번에 처리하는 최대 개수
+	expirySweepBatchSize = 100
+)
+
+// 만료 임박 아이템 알림 함수
+type ItemExpiryNotifier func(item model.Inventory) error
+
 type InventoryService struct {
 	db *gorm.DB
+
+	// 만료 임박 알림 훅 (nil이면 알림을 보내지 않음)
+	expiryNotifier ItemExpiryNotifier
+
+	// 인벤토리 통계 캐시 (설정하지 않으면 캐시를 사용하지 않음)
+	inventoryStats
+
+	// 소비 아이템 효과 서비스 (nil이면 수량만 차감)
+	effects *ItemEffectService
 }
 
 func NewInventoryService(db *gorm.DB) *InventoryService {
@@ -17,8 +44,30 @@ func NewInventoryService(db *gorm.DB) *InventoryService {
 	}
 }
 
-// 새로운 인벤토리 아이템 생성
-func (s *InventoryService) CreateInventory(inventory *model.Inventory) error {
+// 만료 임박 알림 훅을 설정
+func (s *InventoryService) SetExpiryNotifier(notifier ItemExpiryNotifier) {
+	s.expiryNotifier = notifier
+}
+
+// 소비 아이템 효과 서비스를 설정
+func (s *InventoryService) SetEffectService(effects *ItemEffectService) {
+	s.effects = effects
+}
+
+// 인벤토리 통계 캐시를 설정
+// 인벤토리를 바꾸는 다른 서비스(거래, 제작 등)에도 같은 캐시를 SetStatsCache로 설정해야
+// 그 변경이 커밋된 뒤 캐시가 무효화된다.
+func (s *InventoryService) SetCache(cache Cache) {
+	s.SetStatsCache(cache)
+}
+
+// 인벤토리 통계 캐시 키
+func inventoryStatsCacheKey(userID uint) string {
+	return fmt.Sprintf("inventory:stats:%d", userID)
+}
+
+// 새로운 인벤토리 아이템 생성 (actorID는 요청한 관리자)
+func (s *InventoryService) CreateInventory(actorID uint, inventory *model.Inventory) error {
 	// 유효성 검사
 	if err := inventory.Validate(); err != nil {
 		return fmt.Errorf("인벤토리 유효성 검사 실패: %w", err)
@@ -33,25 +82,37 @@ func (s *InventoryService) CreateInventory(inventory *model.Inventory) error {
 		return model.ErrInvalidUserID
 	}
 
-	// 동일한 아이템이 이미 존재하는지 확인
-	var existingCount int64
-	if err := s.db.Model(&model.Inventory{}).
-		Where("user_id = ? AND item_id = ?", inventory.UserID, inventory.ItemID).
-		Count(&existingCount).Error; err != nil {
-		return fmt.Errorf("기존 아이템 확인 중 오류 발생: %w", err)
-	}
+	return s.transaction(s.db, func(tx *gorm.DB) error {
+		// 기간제 아이템은 항상 별도로 생성
+		if inventory.IsTimeLimited() {
+			if inventory.IsExpired(time.Now()) {
+				return model.ErrItemExpired
+			}
+			return createInventory(tx, inventory, adminInventoryChange(actorID))
+		}
 
-	if existingCount > 0 {
-		// 기존 아이템이 있으면 수량만 증가
-		return s.AddItemQuantity(inventory.UserID, inventory.ItemID, inventory.Quantity)
-	}
+		// 동일한 영구 아이템이 있으면 수량만 증가 (강화된 아이템은 별도로 생성)
+		if inventory.Level <= 1 {
+			existing, err := lockStackableItem(tx, inventory.UserID, inventory.ItemID)
+			if err == nil {
+				return addInventoryQuantity(tx, existing, inventory.Quantity, adminInventoryChange(actorID))
+			}
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("기존 아이템 확인 중 오류 발생: %w", err)
+			}
+		}
 
-	// 새 아이템 생성
-	if err := s.db.Create(inventory).Error; err != nil {
+		// 새 아이템 생성
+		return createInventory(tx, inventory, adminInventoryChange(actorID))
+	})
+}
+
+// 인벤토리 아이템을 생성하고 변경 기록을 남김
+func createInventory(tx *gorm.DB, inventory *model.Inventory, change model.InventoryChange) error {
+	if err := tx.Create(inventory).Error; err != nil {
 		return fmt.Errorf("인벤토리 생성 중 오류 발생: %w", err)
 	}
-
-	return nil
+	return recordInventoryChange(tx, inventory, 0, inventory.Level, change)
 }
 
 // ID로 인벤토리 아이템을 조회
@@ -66,35 +127,35 @@ func (s *InventoryService) GetInventoryByID(id uint) (*model.Inventory, error) {
 	return &inventory, nil
 }
 
-// 사용자의 모든 인벤토리 아이템을 조회
+// 사용자의 모든 인벤토리 아이템을 조회 (만료된 아이템 제외)
 func (s *InventoryService) GetUserInventory(userID uint) ([]model.Inventory, error) {
 	var inventories []model.Inventory
-	if err := s.db.Where("user_id = ?", userID).Find(&inventories).Error; err != nil {
+	if err := notExpired(s.db, time.Now()).Where("user_id = ?", userID).Find(&inventories).Error; err != nil {
 		return nil, fmt.Errorf("사용자 인벤토리 조회 중 오류 발생: %w", err)
 	}
 	return inventories, nil
 }
 
-// 사용자의 특정 타입 인벤토리 아이템 조회
+// 사용자의 특정 타입 인벤토리 아이템 조회 (만료된 아이템 제외)
 func (s *InventoryService) GetUserInventoryByType(userID uint, itemType string) ([]model.Inventory, error) {
 	var inventories []model.Inventory
-	if err := s.db.Where("user_id = ? AND item_type = ?", userID, itemType).Find(&inventories).Error; err != nil {
+	if err := notExpired(s.db, time.Now()).Where("user_id = ? AND item_type = ?", userID, itemType).Find(&inventories).Error; err != nil {
 		return nil, fmt.Errorf("사용자 인벤토리 타입별 조회 중 오류 발생: %w", err)
 	}
 	return inventories, nil
 }
 
-// 사용자의 특정 등급 인벤토리 아이템을 조회
+// 사용자의 특정 등급 인벤토리 아이템을 조회 (만료된 아이템 제외)
 func (s *InventoryService) GetUserInventoryByRarity(userID uint, rarity string) ([]model.Inventory, error) {
 	var inventories []model.Inventory
-	if err := s.db.Where("user_id = ? AND rarity = ?", userID, rarity).Find(&inventories).Error; err != nil {
+	if err := notExpired(s.db, time.Now()).Where("user_id = ? AND rarity = ?", userID, rarity).Find(&inventories).Error; err != nil {
 		return nil, fmt.Errorf("사용자 인벤토리 등급별 조회 중 오류 발생: %w", err)
 	}
 	return inventories, nil
 }
 
-// 인벤토리 아이템을 업데이트
-func (s *InventoryService) UpdateInventory(inventory *model.Inventory) error {
+// 인벤토리 아이템을 업데이트 (actorID는 요청한 관리자)
+func (s *InventoryService) UpdateInventory(actorID uint, inventory *model.Inventory) error {
 	// 유효성 검사
 	if err := inventory.Validate(); err != nil {
 		return fmt.Errorf("인벤토리 유효성 검사 실패: %w", err)
@@ -110,15 +171,25 @@ func (s *InventoryService) UpdateInventory(inventory *model.Inventory) error {
 	}
 
 	// 업데이트
-	if err := s.db.Save(inventory).Error; err != nil {
-		return fmt.Errorf("인벤토리 업데이트 중 오류 발생: %w", err)
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		if err := tx.Save(inventory).Error; err != nil {
+			return fmt.Errorf("인벤토리 업데이트 중 오류 발생: %w", err)
+		}
+		return recordInventoryChange(tx, inventory, existingInventory.Quantity, existingInventory.Level, adminInventoryChange(actorID))
+	})
+	if err != nil {
+		return err
 	}
 
+	// 소유자가 바뀌면 이전 소유자의 통계도 무효화
+	if inventory.UserID != existingInventory.UserID {
+		s.invalidateStats(existingInventory.UserID)
+	}
 	return nil
 }
 
-// 인벤토리 아이템을 삭제
-func (s *InventoryService) DeleteInventory(id uint) error {
+// 인벤토리 아이템을 삭제 (actorID는 요청한 관리자)
+func (s *InventoryService) DeleteInventory(actorID, id uint) error {
 	var inventory model.Inventory
 	if err := s.db.First(&inventory, id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -127,123 +198,121 @@ func (s *InventoryService) DeleteInventory(id uint) error {
 		return fmt.Errorf("인벤토리 조회 중 오류 발생: %w", err)
 	}
 
-	if err := s.db.Delete(&inventory).Error; err != nil {
-		return fmt.Errorf("인벤토리 삭제 중 오류 발생: %w", err)
-	}
-
-	return nil
+	return s.transaction(s.db, func(tx *gorm.DB) error {
+		if err := deleteInventoryItem(tx, &inventory, adminInventoryChange(actorID)); err != nil {
+			return fmt.Errorf("인벤토리 삭제 중 오류 발생: %w", err)
+		}
+		return nil
+	})
 }
 
 // 특정 아이템의 수량을 증가
-func (s *InventoryService) AddItemQuantity(userID uint, itemID string, quantity int) error {
+// 수량을 합칠 수 있는 영구 아이템만 대상이며, 조회와 증가를 한 트랜잭션에서 잠금 후 처리한다.
+// actorID는 요청한 관리자로 변경 기록에 남는다.
+func (s *InventoryService) AddItemQuantity(actorID, userID uint, itemID string, quantity int) error {
 	if quantity <= 0 {
 		return fmt.Errorf("추가할 수량은 0보다 커야 합니다: %d", quantity)
 	}
 
-	var inventory model.Inventory
-	if err := s.db.Where("user_id = ? AND item_id = ?", userID, itemID).First(&inventory).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return fmt.Errorf("아이템을 찾을 수 없습니다: user_id=%d, item_id=%s", userID, itemID)
+	return s.transaction(s.db, func(tx *gorm.DB) error {
+		inventory, err := lockStackableItem(tx, userID, itemID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("아이템을 찾을 수 없습니다: user_id=%d, item_id=%s", userID, itemID)
+			}
+			return fmt.Errorf("아이템 조회 중 오류 발생: %w", err)
 		}
-		return fmt.Errorf("아이템 조회 중 오류 발생: %w", err)
-	}
-
-	inventory.AddItem(quantity)
-	if err := s.db.Save(&inventory).Error; err != nil {
-		return fmt.Errorf("아이템 수량 업데이트 중 오류 발생: %w", err)
-	}
-
-	return nil
+		return addInventoryQuantity(tx, inventory, quantity, adminInventoryChange(actorID))
+	})
 }
 
 // 특정 아이템을 사용
+// 사용 횟수 제한이 있는 아이템은 횟수를 차감하고, 모두 소진하면 삭제
+// 효과 서비스가 설정되어 있으면 아이템 효과도 같은 트랜잭션에서 적용
 func (s *InventoryService) UseItem(userID uint, itemID string) error {
-	var inventory model.Inventory
-	if err := s.db.Where("user_id = ? AND item_id = ?", userID, itemID).First(&inventory).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return fmt.Errorf("아이템을 찾을 수 없습니다: user_id=%d, item_id=%s", userID, itemID)
-		}
-		return fmt.Errorf("아이템 조회 중 오류 발생: %w", err)
+	if s.effects != nil {
+		_, err := s.effects.UseItem(userID, itemID)
+		return err
 	}
 
-	if err := inventory.UseItem(); err != nil {
-		return fmt.Errorf("아이템 사용 실패: %w", err)
-	}
-
-	// 수량이 0이 되면 아이템 삭제
-	if inventory.Quantity == 0 {
-		if err := s.db.Delete(&inventory).Error; err != nil {
-			return fmt.Errorf("빈 아이템 삭제 중 오류 발생: %w", err)
-		}
-	} else {
-		if err := s.db.Save(&inventory).Error; err != nil {
-			return fmt.Errorf("아이템 수량 업데이트 중 오류 발생: %w", err)
+	change := model.NewInventoryChange(model.InventorySourceUse, userID, "", 0)
+	return s.transaction(s.db, func(tx *gorm.DB) error {
+		inventory, err := lockInventoryItem(tx, userID, itemID)
+		if err != nil {
+			return err
 		}
-	}
-
-	return nil
+		return consumeInventoryItem(tx, inventory, change)
+	})
 }
 
 // 사용자의 인벤토리 통계를 반환
+// 등급/타입/활성 여부로 한 번에 집계하며, 캐시가 설정되어 있으면 캐시된 결과를 사용
 func (s *InventoryService) GetUserInventoryStats(userID uint) (*InventoryStats, error) {
-	var stats InventoryStats
-
-	// 전체 아이템 수
-	if err := s.db.Model(&model.Inventory{}).Where("user_id = ?", userID).Count(&stats.TotalItems).Error; err != nil {
-		return nil, fmt.Errorf("전체 아이템 수 조회 중 오류 발생: %w", err)
+	ctx := context.Background()
+	key := inventoryStatsCacheKey(userID)
+
+	if s.statsCache != nil {
+		if cached, err := s.statsCache.Get(ctx, key); err == nil {
+			var stats InventoryStats
+			if err := json.Unmarshal([]byte(cached), &stats); err == nil {
+				return &stats, nil
+			}
+		} else if !errors.Is(err, ErrCacheMiss) {
+			log.Printf("인벤토리 통계 캐시 조회 실패 (user_id=%d): %v", userID, err)
+		}
 	}
 
-	// 등급별 아이템 수
-	rarities := []string{"common", "rare", "epic", "legendary"}
-	for _, rarity := range rarities {
-		var count int64
-		if err := s.db.Model(&model.Inventory{}).Where("user_id = ? AND rarity = ?", userID, rarity).Count(&count).Error; err != nil {
-			return nil, fmt.Errorf("%s 등급 아이템 수 조회 중 오류 발생: %w", rarity, err)
-		}
-		switch rarity {
-		case "common":
-			stats.CommonItems = count
-		case "rare":
-			stats.RareItems = count
-		case "epic":
-			stats.EpicItems = count
-		case "legendary":
-			stats.LegendaryItems = count
-		}
+	var rows []struct {
+		Rarity   string
+		ItemType string
+		IsActive bool
+		Count    int64
+	}
+	if err := notExpired(s.db.Model(&model.Inventory{}), time.Now()).
+		Select("rarity, item_type, is_active, COUNT(*) AS count").
+		Where("user_id = ?", userID).
+		Group("rarity, item_type, is_active").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("인벤토리 통계 조회 중 오류 발생: %w", err)
 	}
 
-	// 타입별 아이템 수
-	types := []string{"weapon", "armor", "consumable", "material"}
-	for _, itemType := range types {
-		var count int64
-		if err := s.db.Model(&model.Inventory{}).Where("user_id = ? AND item_type = ?", userID, itemType).Count(&count).Error; err != nil {
-			return nil, fmt.Errorf("%s 타입 아이템 수 조회 중 오류 발생: %w", itemType, err)
-		}
-		switch itemType {
-		case "weapon":
-			stats.WeaponItems = count
-		case "armor":
-			stats.ArmorItems = count
-		case "consumable":
-			stats.ConsumableItems = count
-		case "material":
-			stats.MaterialItems = count
+	stats := &InventoryStats{
+		ByRarity: make(map[string]int64),
+		ByType:   make(map[string]int64),
+	}
+	for _, row := range rows {
+		stats.TotalItems += row.Count
+		stats.ByRarity[row.Rarity] += row.Count
+		stats.ByType[row.ItemType] += row.Count
+		if row.IsActive {
+			stats.ActiveItems += row.Count
 		}
 	}
 
-	// 활성화된 아이템 수
-	if err := s.db.Model(&model.Inventory{}).Where("user_id = ? AND is_active = ?", userID, true).Count(&stats.ActiveItems).Error; err != nil {
-		return nil, fmt.Errorf("활성화된 아이템 수 조회 중 오류 발생: %w", err)
+	if s.statsCache != nil {
+		if data, err := json.Marshal(stats); err == nil {
+			if err := s.statsCache.Set(ctx, key, string(data), inventoryStatsCacheTTL); err != nil {
+				log.Printf("인벤토리 통계 캐시 저장 실패 (user_id=%d): %v", userID, err)
+			}
+		}
 	}
 
-	return &stats, nil
+	return stats, nil
 }
 
-// 아이템을 활성화
+// 아이템을 활성화 (만료된 아이템은 장착 불가)
 func (s *InventoryService) ActivateItem(userID uint, itemID string) error {
 	var inventory model.Inventory
-	if err := s.db.Where("user_id = ? AND item_id = ?", userID, itemID).First(&inventory).Error; err != nil {
+	if err := notExpired(s.db, time.Now()).Where("user_id = ? AND item_id = ?", userID, itemID).First(&inventory).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// 정리되기 전의 만료 아이템인지 확인
+			var expiredCount int64
+			if err := s.db.Model(&model.Inventory{}).Where("user_id = ? AND item_id = ?", userID, itemID).Count(&expiredCount).Error; err != nil {
+				return fmt.Errorf("아이템 조회 중 오류 발생: %w", err)
+			}
+			if expiredCount > 0 {
+				return model.ErrItemExpired
+			}
 			return fmt.Errorf("아이템을 찾을 수 없습니다: user_id=%d, item_id=%s", userID, itemID)
 		}
 		return fmt.Errorf("아이템 조회 중 오류 발생: %w", err)
@@ -262,6 +331,7 @@ func (s *InventoryService) ActivateItem(userID uint, itemID string) error {
 		return fmt.Errorf("아이템 활성화 중 오류 발생: %w", err)
 	}
 
+	s.invalidateStats(userID)
 	return nil
 }
 
@@ -280,28 +350,159 @@ func (s *InventoryService) DeactivateItem(userID uint, itemID string) error {
 		return fmt.Errorf("아이템 비활성화 중 오류 발생: %w", err)
 	}
 
+	s.invalidateStats(userID)
 	return nil
 }
 
-// 사용자의 활성화된 아이템들을 조회
+// 사용자의 활성화된 아이템들을 조회 (만료된 아이템 제외)
 func (s *InventoryService) GetActiveItems(userID uint) ([]model.Inventory, error) {
 	var inventories []model.Inventory
-	if err := s.db.Where("user_id = ? AND is_active = ?", userID, true).Find(&inventories).Error; err != nil {
+	if err := notExpired(s.db, time.Now()).Where("user_id = ? AND is_active = ?", userID, true).Find(&inventories).Error; err != nil {
 		return nil, fmt.Errorf("활성화된 아이템 조회 중 오류 발생: %w", err)
 	}
 	return inventories, nil
 }
 
+// 만료된 기간제 아이템을 정리
+// 아이템을 삭제하고, 만료 보상 규칙이 있으면 골드나 대체 아이템을 지급한다.
+// 처리한 아이템 수를 반환
+func (s *InventoryService) SweepExpiredItems(now time.Time) (int, error) {
+	var expired []model.Inventory
+	if err := s.db.Where("expires_at IS NOT NULL AND expires_at <= ?", now).
+		Order("id ASC").
+		Limit(expirySweepBatchSize).
+		Find(&expired).Error; err != nil {
+		return 0, fmt.Errorf("만료 아이템 조회 중 오류 발생: %w", err)
+	}
+	if len(expired) == 0 {
+		return 0, nil
+	}
+
+	rules, err := s.loadExpiryRules(expired)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, item := range expired {
+		rule := rules[item.ItemID]
+		err := s.transaction(s.db, func(tx *gorm.DB) error {
+			// 다른 작업에서 이미 정리했을 수 있으므로 잠금 후 다시 확인
+			var locked model.Inventory
+			if err := lockForUpdate(tx).Where("id = ? AND expires_at <= ?", item.ID, now).First(&locked).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil
+				}
+				return fmt.Errorf("만료 아이템 조회 중 오류 발생: %w", err)
+			}
+
+			quantity := locked.Quantity
+			change := model.NewInventoryChange(model.InventorySourceExpire, 0, "inventory", locked.ID)
+			if err := deleteInventoryItem(tx, &locked, change); err != nil {
+				return fmt.Errorf("만료 아이템 삭제 중 오류 발생: %w", err)
+			}
+			if rule == nil {
+				return nil
+			}
+
+			if err := adjustUserGold(tx, locked.UserID, rule.ConsolationGold*quantity); err != nil {
+				return err
+			}
+			if template := rule.ConsolationInventory(locked.UserID); template != nil {
+				if _, err := grantInventoryItem(tx, locked.UserID, template, template.Quantity*quantity, change); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return processed, fmt.Errorf("만료 아이템 정리 실패 (inventory_id=%d): %w", item.ID, err)
+		}
+		processed++
+	}
+
+	return processed, nil
+}
+
+// 곧 만료되는 아이템에 대해 알림 훅을 호출
+// 아이템마다 한 번만 알리며, 알림을 보낸 아이템 수를 반환
+func (s *InventoryService) NotifyExpiringItems(now time.Time, within time.Duration) (int, error) {
+	if s.expiryNotifier == nil {
+		return 0, nil
+	}
+
+	var items []model.Inventory
+	if err := s.db.Where("expires_at > ? AND expires_at <= ? AND expiry_notified_at IS NULL", now, now.Add(within)).
+		Order("expires_at ASC").
+		Limit(expirySweepBatchSize).
+		Find(&items).Error; err != nil {
+		return 0, fmt.Errorf("만료 임박 아이템 조회 중 오류 발생: %w", err)
+	}
+
+	notified := 0
+	for _, item := range items {
+		if err := s.expiryNotifier(item); err != nil {
+			return notified, fmt.Errorf("만료 임박 알림 실패 (inventory_id=%d): %w", item.ID, err)
+		}
+		if err := s.db.Model(&item).Update("expiry_notified_at", now).Error; err != nil {
+			return notified, fmt.Errorf("알림 시각 업데이트 중 오류 발생: %w", err)
+		}
+		notified++
+	}
+
+	return notified, nil
+}
+
+// 아이템 만료 보상 규칙을 등록하거나 갱신
+func (s *InventoryService) SetExpiryRule(rule *model.ItemExpiryRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	var existing model.ItemExpiryRule
+	err := s.db.Where("item_id = ?", rule.ItemID).First(&existing).Error
+	if err == nil {
+		rule.ID = existing.ID
+		rule.CreatedAt = existing.CreatedAt
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("만료 보상 규칙 조회 중 오류 발생: %w", err)
+	}
+
+	if err := s.db.Save(rule).Error; err != nil {
+		return fmt.Errorf("만료 보상 규칙 저장 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 만료 아이템들에 해당하는 보상 규칙을 아이템 ID별로 조회
+func (s *InventoryService) loadExpiryRules(items []model.Inventory) (map[string]*model.ItemExpiryRule, error) {
+	itemIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		itemIDs = append(itemIDs, item.ItemID)
+	}
+
+	var rules []model.ItemExpiryRule
+	if err := s.db.Where("item_id IN ?", itemIDs).Find(&rules).Error; err != nil {
+		return nil, fmt.Errorf("만료 보상 규칙 조회 중 오류 발생: %w", err)
+	}
+
+	byItem := make(map[string]*model.ItemExpiryRule, len(rules))
+	for i := range rules {
+		byItem[rules[i].ItemID] = &rules[i]
+	}
+	return byItem, nil
+}
+
+// 관리 API를 통한 직접 변경 정보 (actorID는 요청한 관리자)
+func adminInventoryChange(actorID uint) model.InventoryChange {
+	return model.NewInventoryChange(model.InventorySourceAdmin, actorID, "", 0)
+}
+
 // 인벤토리 통계 정보를 담는 구조체
+// 등급/타입별 개수는 실제 보유한 값만 키로 포함된다.
 type InventoryStats struct {
-	TotalItems      int64 `json:"total_items"`
-	CommonItems     int64 `json:"common_items"`
-	RareItems       int64 `json:"rare_items"`
-	EpicItems       int64 `json:"epic_items"`
-	LegendaryItems  int64 `json:"legendary_items"`
-	WeaponItems     int64 `json:"weapon_items"`
-	ArmorItems      int64 `json:"armor_items"`
-	ConsumableItems int64 `json:"consumable_items"`
-	MaterialItems   int64 `json:"material_items"`
-	ActiveItems     int64 `json:"active_items"`
+	TotalItems  int64            `json:"total_items"`
+	ActiveItems int64            `json:"active_items"`
+	ByRarity    map[string]int64 `json:"by_rarity"`
+	ByType      map[string]int64 `json:"by_type"`
 }
@@ -1,9 +1,14 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"g_dev/internal/model"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
 // NewInventoryService 함수 테스트
@@ -123,31 +128,157 @@ func TestInventoryService_UseItem(t *testing.T) {
 
 // GetUserInventoryStats 메서드 테스트
 func TestInventoryService_GetUserInventoryStats(t *testing.T) {
-	t.Run("통계 구조체 테스트", func(t *testing.T) {
-		stats := &InventoryStats{
-			TotalItems:      10,
-			CommonItems:     5,
-			RareItems:       3,
-			EpicItems:       1,
-			LegendaryItems:  1,
-			WeaponItems:     4,
-			ArmorItems:      3,
-			ConsumableItems: 2,
-			MaterialItems:   1,
-			ActiveItems:     2,
+	db := setupGameTestDB(t)
+	service := NewInventoryService(db)
+	user := seedUser(t, db, "collector", 0)
+
+	seedItem(t, db, user.ID, "iron_sword", "common", 1)
+	seedItem(t, db, user.ID, "flame_sword", "epic", 1)
+	seedItem(t, db, user.ID, "mythic_blade", "mythic", 1)
+	require.NoError(t, db.Create(&model.Inventory{
+		UserID: user.ID, ItemID: "pet_egg", ItemName: "펫 알", ItemType: "pet", Rarity: "rare", Level: 1, Quantity: 1,
+	}).Error)
+	seedTimedItem(t, db, user.ID, "expired_sword", time.Now().Add(-time.Minute))
+	require.NoError(t, service.ActivateItem(user.ID, "flame_sword"))
+
+	stats, err := service.GetUserInventoryStats(user.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(4), stats.TotalItems, "만료된 아이템은 집계되지 않아야 합니다")
+	assert.Equal(t, int64(1), stats.ActiveItems)
+	assert.Equal(t, int64(1), stats.ByRarity["mythic"], "새로운 등급도 집계되어야 합니다")
+	assert.Equal(t, int64(1), stats.ByRarity["common"])
+	assert.Equal(t, int64(3), stats.ByType["weapon"])
+	assert.Equal(t, int64(1), stats.ByType["pet"], "새로운 타입도 집계되어야 합니다")
+	assert.NotContains(t, stats.ByRarity, "legendary", "보유하지 않은 등급은 포함되지 않아야 합니다")
+}
+
+// memoryCache는 테스트용 메모리 캐시.
+type memoryCache struct {
+	values map[string]string
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{values: make(map[string]string)}
+}
+
+func (c *memoryCache) Get(ctx context.Context, key string) (string, error) {
+	value, ok := c.values[key]
+	if !ok {
+		return "", ErrCacheMiss
+	}
+	return value, nil
+}
+
+func (c *memoryCache) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	c.values[key] = value
+	return nil
+}
+
+func (c *memoryCache) Delete(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		delete(c.values, key)
+	}
+	return nil
+}
+
+// 통계 캐시와 무효화 테스트
+func TestInventoryService_StatsCache(t *testing.T) {
+	db := setupGameTestDB(t)
+	service := NewInventoryService(db)
+	cache := newMemoryCache()
+	service.SetCache(cache)
+	user := seedUser(t, db, "collector", 100)
+	other := seedUser(t, db, "other", 0)
+
+	seedItem(t, db, user.ID, "iron_sword", "common", 1)
+	seedItem(t, db, other.ID, "iron_sword", "common", 1)
+
+	stats, err := service.GetUserInventoryStats(user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.TotalItems)
+	assert.Contains(t, cache.values, inventoryStatsCacheKey(user.ID), "조회 결과가 캐시되어야 합니다")
+
+	// 캐시된 값이 사용되는지 확인 (DB를 직접 변경해도 캐시 값 반환)
+	require.NoError(t, db.Exec("DELETE FROM inventories WHERE user_id = ?", user.ID).Error)
+	stats, err = service.GetUserInventoryStats(user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.TotalItems)
+
+	// 실패한 변경은 캐시를 유지
+	expired := time.Now().Add(-time.Hour)
+	err = service.CreateInventory(99, &model.Inventory{
+		UserID: user.ID, ItemID: "ticket", ItemName: "입장권", ItemType: "consumable", Rarity: "common", Level: 1, Quantity: 1, ExpiresAt: &expired,
+	})
+	require.ErrorIs(t, err, model.ErrItemExpired)
+	assert.Contains(t, cache.values, inventoryStatsCacheKey(user.ID), "롤백된 변경은 캐시를 무효화하지 않아야 합니다")
+
+	// 커밋된 인벤토리 변경으로 캐시 무효화
+	_, err = service.GetUserInventoryStats(other.ID)
+	require.NoError(t, err)
+	require.NoError(t, service.CreateInventory(99, &model.Inventory{
+		UserID: user.ID, ItemID: "potion", ItemName: "포션", ItemType: "consumable", Rarity: "common", Level: 1, Quantity: 1,
+	}))
+	assert.NotContains(t, cache.values, inventoryStatsCacheKey(user.ID), "변경된 사용자의 캐시가 무효화되어야 합니다")
+	assert.Contains(t, cache.values, inventoryStatsCacheKey(other.ID), "다른 사용자의 캐시는 유지되어야 합니다")
+
+	stats, err = service.GetUserInventoryStats(user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.TotalItems)
+	assert.Equal(t, int64(1), stats.ByType["consumable"])
+}
+
+// 다른 서비스의 인벤토리 변경도 커밋 후 통계 캐시를 무효화하는지 테스트
+func TestInventoryStats_OtherServices(t *testing.T) {
+	db, enhancement, user, sword := setupEnhancementTest(t, "rare", 1)
+	inventory := NewInventoryService(db)
+	cache := newMemoryCache()
+	inventory.SetCache(cache)
+	other := seedUser(t, db, "other", 0)
+
+	cacheStats := func(userIDs ...uint) {
+		for _, userID := range userIDs {
+			_, err := inventory.GetUserInventoryStats(userID)
+			require.NoError(t, err)
+		}
+	}
+
+	// 강화로 바뀐 사용자의 캐시 무효화
+	cacheStats(user.ID, other.ID)
+	enhancement.SetStatsCache(cache)
+	enhancement.roll = fixedRolls(0.1)
+	_, err := enhancement.Enhance(user.ID, sword.ID, false)
+	require.NoError(t, err)
+	assert.NotContains(t, cache.values, inventoryStatsCacheKey(user.ID))
+	assert.Contains(t, cache.values, inventoryStatsCacheKey(other.ID))
+
+	// 공용 헬퍼로 지급한 아이템도 모아서 커밋 후 무효화하고, 롤백되면 유지
+	stats := &inventoryStats{}
+	stats.SetStatsCache(cache)
+	template := &model.Inventory{ItemID: "potion", ItemName: "포션", ItemType: "consumable", Rarity: "common"}
+	change := model.NewInventoryChange(model.InventorySourceAdmin, 0, "", 0)
+	cacheStats(user.ID)
+
+	err = stats.transaction(db, func(tx *gorm.DB) error {
+		if _, err := grantInventoryItem(tx, other.ID, template, 1, change); err != nil {
+			return err
 		}
+		return errors.New("롤백")
+	})
+	require.Error(t, err)
+	assert.Contains(t, cache.values, inventoryStatsCacheKey(other.ID), "롤백된 변경은 캐시를 무효화하지 않아야 합니다")
 
-		assert.Equal(t, int64(10), stats.TotalItems, "전체 아이템 수가 일치해야 합니다")
-		assert.Equal(t, int64(5), stats.CommonItems, "일반 아이템 수가 일치해야 합니다")
-		assert.Equal(t, int64(3), stats.RareItems, "레어 아이템 수가 일치해야 합니다")
-		assert.Equal(t, int64(1), stats.EpicItems, "에픽 아이템 수가 일치해야 합니다")
-		assert.Equal(t, int64(1), stats.LegendaryItems, "전설 아이템 수가 일치해야 합니다")
-		assert.Equal(t, int64(4), stats.WeaponItems, "무기 아이템 수가 일치해야 합니다")
-		assert.Equal(t, int64(3), stats.ArmorItems, "방어구 아이템 수가 일치해야 합니다")
-		assert.Equal(t, int64(2), stats.ConsumableItems, "소비 아이템 수가 일치해야 합니다")
-		assert.Equal(t, int64(1), stats.MaterialItems, "재료 아이템 수가 일치해야 합니다")
-		assert.Equal(t, int64(2), stats.ActiveItems, "활성 아이템 수가 일치해야 합니다")
+	err = stats.transaction(db, func(tx *gorm.DB) error {
+		for _, userID := range []uint{user.ID, other.ID, other.ID} {
+			if _, err := grantInventoryItem(tx, userID, template, 1, change); err != nil {
+				return err
+			}
+		}
+		return nil
 	})
+	require.NoError(t, err)
+	assert.NotContains(t, cache.values, inventoryStatsCacheKey(user.ID))
+	assert.NotContains(t, cache.values, inventoryStatsCacheKey(other.ID))
 }
 
 // InventoryService의 통합 테스트를 수행
@@ -423,3 +554,154 @@ func TestInventoryService_Validation(t *testing.T) {
 		})
 	}
 }
+
+// seedTimedItem은 만료 시각이 지정된 기간제 아이템을 생성.
+func seedTimedItem(t *testing.T, db *gorm.DB, userID uint, itemID string, expiresAt time.Time) *model.Inventory {
+	item := &model.Inventory{
+		UserID:    userID,
+		ItemID:    itemID,
+		ItemName:  itemID,
+		ItemType:  "weapon",
+		Rarity:    "rare",
+		Level:     1,
+		Quantity:  1,
+		ExpiresAt: &expiresAt,
+	}
+	require.NoError(t, db.Create(item).Error)
+	return item
+}
+
+// 만료된 아이템이 조회와 장착에서 제외되는지 테스트
+func TestInventoryService_ExpiredItemsHidden(t *testing.T) {
+	db := setupGameTestDB(t)
+	service := NewInventoryService(db)
+	user := seedUser(t, db, "renter", 0)
+
+	seedItem(t, db, user.ID, "iron_sword", "common", 1)
+	seedTimedItem(t, db, user.ID, "rental_sword", time.Now().Add(-time.Minute))
+	seedTimedItem(t, db, user.ID, "event_sword", time.Now().Add(time.Hour))
+
+	items, err := service.GetUserInventory(user.ID)
+	require.NoError(t, err)
+	assert.Len(t, items, 2, "만료된 아이템은 조회되지 않아야 합니다")
+	for _, item := range items {
+		assert.NotEqual(t, "rental_sword", item.ItemID)
+	}
+
+	assert.ErrorIs(t, service.ActivateItem(user.ID, "rental_sword"), model.ErrItemExpired)
+	assert.NoError(t, service.ActivateItem(user.ID, "event_sword"))
+
+	// 장착 후 만료되면 활성 아이템 목록에서 제외
+	require.NoError(t, db.Model(&model.Inventory{}).Where("item_id = ?", "event_sword").
+		Update("expires_at", time.Now().Add(-time.Second)).Error)
+	active, err := service.GetActiveItems(user.ID)
+	require.NoError(t, err)
+	assert.Empty(t, active)
+}
+
+// 사용 횟수 제한 아이템 사용 테스트
+func TestInventoryService_UseLimitedItem(t *testing.T) {
+	db := setupGameTestDB(t)
+	service := NewInventoryService(db)
+	user := seedUser(t, db, "renter", 0)
+
+	require.NoError(t, service.CreateInventory(99, &model.Inventory{
+		UserID: user.ID, ItemID: "rental_pickaxe", ItemName: "대여 곡괭이", ItemType: "weapon",
+		Rarity: "common", Level: 1, Quantity: 1, UsageLimit: 2,
+	}))
+
+	require.NoError(t, service.UseItem(user.ID, "rental_pickaxe"))
+	assert.Equal(t, 1, itemQuantity(t, db, user.ID, "rental_pickaxe"), "횟수가 남아 있으면 아이템이 유지되어야 합니다")
+
+	require.NoError(t, service.UseItem(user.ID, "rental_pickaxe"))
+	assert.Equal(t, 0, itemQuantity(t, db, user.ID, "rental_pickaxe"), "횟수를 모두 쓰면 아이템이 삭제되어야 합니다")
+}
+
+// 영구 아이템 수량은 기간제/사용 횟수 제한 아이템에 합쳐지지 않아야 함
+func TestInventoryService_AddQuantityOnlyToPermanentStack(t *testing.T) {
+	db := setupGameTestDB(t)
+	service := NewInventoryService(db)
+	user := seedUser(t, db, "collector", 0)
+
+	seedTimedItem(t, db, user.ID, "iron_sword", time.Now().Add(time.Hour))
+	require.NoError(t, service.CreateInventory(99, &model.Inventory{
+		UserID: user.ID, ItemID: "iron_sword", ItemName: "철검", ItemType: "weapon",
+		Rarity: "common", Level: 1, Quantity: 1, UsageLimit: 3,
+	}))
+
+	err := service.AddItemQuantity(99, user.ID, "iron_sword", 2)
+	assert.Error(t, err, "영구 아이템이 없으면 기간제나 사용 횟수 제한 아이템에 더하지 않아야 합니다")
+
+	// 영구 아이템은 별도로 생성된 뒤 그 행에만 수량이 더해짐
+	require.NoError(t, service.CreateInventory(99, &model.Inventory{
+		UserID: user.ID, ItemID: "iron_sword", ItemName: "철검", ItemType: "weapon",
+		Rarity: "common", Level: 1, Quantity: 1,
+	}))
+	require.NoError(t, service.AddItemQuantity(99, user.ID, "iron_sword", 2))
+
+	var items []model.Inventory
+	require.NoError(t, db.Where("user_id = ? AND item_id = ?", user.ID, "iron_sword").Order("id").Find(&items).Error)
+	require.Len(t, items, 3)
+	assert.Equal(t, 1, items[0].Quantity, "기간제 아이템 수량은 유지")
+	assert.Equal(t, 1, items[1].Quantity, "사용 횟수 제한 아이템 수량은 유지")
+	assert.Equal(t, 3, items[2].Quantity)
+}
+
+// 만료 아이템 정리와 보상 지급 테스트
+func TestInventoryService_SweepExpiredItems(t *testing.T) {
+	db := setupGameTestDB(t, &model.ItemExpiryRule{})
+	service := NewInventoryService(db)
+	user := seedUser(t, db, "renter", 0)
+
+	require.NoError(t, service.SetExpiryRule(&model.ItemExpiryRule{ItemID: "rental_sword", ConsolationGold: 50}))
+	require.NoError(t, service.SetExpiryRule(&model.ItemExpiryRule{
+		ItemID: "event_sword", ConsolationItemID: "event_token", ConsolationItemName: "이벤트 토큰",
+		ConsolationItemType: "material", ConsolationItemRarity: "common", ConsolationItemQuantity: 2,
+	}))
+
+	now := time.Now()
+	seedTimedItem(t, db, user.ID, "rental_sword", now.Add(-time.Minute))
+	seedTimedItem(t, db, user.ID, "event_sword", now.Add(-time.Minute))
+	seedTimedItem(t, db, user.ID, "plain_timed", now.Add(-time.Minute))
+	seedTimedItem(t, db, user.ID, "future_sword", now.Add(time.Hour))
+
+	count, err := service.SweepExpiredItems(now)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	assert.Equal(t, 50, userGold(t, db, user.ID), "만료 보상 골드가 지급되어야 합니다")
+	assert.Equal(t, 2, itemQuantity(t, db, user.ID, "event_token"), "대체 아이템이 지급되어야 합니다")
+	assert.Equal(t, 0, itemQuantity(t, db, user.ID, "rental_sword"))
+	assert.Equal(t, 0, itemQuantity(t, db, user.ID, "plain_timed"))
+	assert.Equal(t, 1, itemQuantity(t, db, user.ID, "future_sword"))
+
+	count, err = service.SweepExpiredItems(now)
+	require.NoError(t, err)
+	assert.Zero(t, count, "이미 정리된 아이템은 다시 처리되지 않아야 합니다")
+}
+
+// 만료 임박 알림 테스트
+func TestInventoryService_NotifyExpiringItems(t *testing.T) {
+	db := setupGameTestDB(t)
+	service := NewInventoryService(db)
+	user := seedUser(t, db, "renter", 0)
+
+	now := time.Now()
+	seedTimedItem(t, db, user.ID, "soon_sword", now.Add(time.Hour))
+	seedTimedItem(t, db, user.ID, "later_sword", now.Add(72*time.Hour))
+
+	var notified []string
+	service.SetExpiryNotifier(func(item model.Inventory) error {
+		notified = append(notified, item.ItemID)
+		return nil
+	})
+
+	count, err := service.NotifyExpiringItems(now, ItemExpiryNoticeWindow)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, []string{"soon_sword"}, notified)
+
+	count, err = service.NotifyExpiringItems(now, ItemExpiryNoticeWindow)
+	require.NoError(t, err)
+	assert.Zero(t, count, "같은 아이템은 한 번만 알려야 합니다")
+}
@@ -0,0 +1,418 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// 아이템 사용 결과
+type ItemUseResult struct {
+	ItemID string `json:"item_id"`
+
+	// 사용 후 남은 수량 (0이면 소진되어 삭제됨)
+	RemainingQuantity int `json:"remaining_quantity"`
+
+	// 지급된 경험치/골드 (버프 보너스 포함)
+	Experience int `json:"experience"`
+	Gold       int `json:"gold"`
+
+	// 경험치 지급 후 레벨 (경험치 효과가 없으면 0)
+	Level int `json:"level,omitempty"`
+
+	Buffs []model.ActiveBuff `json:"buffs"`
+	Items []model.Inventory  `json:"items"`
+
+	// 이번 사용으로 완료한 미션
+	CompletedMissions []model.Mission `json:"completed_missions,omitempty"`
+}
+
+// 아이템 효과 처리기
+// Validate는 카탈로그 로드/등록 시, Apply는 아이템 사용 트랜잭션 안에서 호출된다.
+type ItemEffectHandler struct {
+	Validate func(effect *model.ItemEffect) error
+	Apply    func(tx *gorm.DB, use *ItemUse, effect *model.ItemEffect) error
+}
+
+// 효과 적용 중인 아이템 사용 정보
+type ItemUse struct {
+	UserID    uint
+	Inventory *model.Inventory
+	Change    model.InventoryChange
+	Now       time.Time
+	Result    *ItemUseResult
+}
+
+// 소비 아이템 효과를 등록하고 적용하는 서비스
+// 아이템별 효과 정의(카탈로그)는 메모리에 올려 두고 사용하며,
+// 등록되지 않은 효과 타입은 카탈로그 로드 시점에 거부한다.
+type ItemEffectService struct {
+	db *gorm.DB
+
+	mu       sync.RWMutex
+	handlers map[model.ItemEffectType]ItemEffectHandler
+	catalog  map[string][]model.ItemEffect
+
+	// 상자 내용물 확률 판정에 사용하는 난수 함수 (테스트에서 교체 가능)
+	roll func() float64
+	now  func() time.Time
+
+	// 아이템 사용 미션 (설정하지 않으면 진행도를 갱신하지 않음)
+	missions *MissionService
+
+	// 인벤토리 통계 캐시 (설정하지 않으면 무효화하지 않음)
+	inventoryStats
+}
+
+// 새로운 ItemEffectService 인스턴스를 생성 (기본 효과 등록)
+func NewItemEffectService(db *gorm.DB) *ItemEffectService {
+	s := &ItemEffectService{
+		db:       db,
+		handlers: make(map[model.ItemEffectType]ItemEffectHandler),
+		catalog:  make(map[string][]model.ItemEffect),
+		roll:     rand.Float64,
+		now:      time.Now,
+	}
+
+	s.RegisterEffect(model.ItemEffectGrantXP, ItemEffectHandler{Validate: validateAmountEffect, Apply: s.applyGrantXP})
+	s.RegisterEffect(model.ItemEffectGrantGold, ItemEffectHandler{Validate: validateAmountEffect, Apply: s.applyGrantGold})
+	s.RegisterEffect(model.ItemEffectBuff, ItemEffectHandler{Validate: validateBuffEffect, Apply: s.applyBuff})
+	s.RegisterEffect(model.ItemEffectOpenContainer, ItemEffectHandler{Validate: validateContainerEffect, Apply: s.applyContainer})
+	return s
+}
+
+// 미션 서비스를 설정
+func (s *ItemEffectService) SetMissions(missions *MissionService) {
+	s.missions = missions
+}
+
+// 효과 타입에 처리기를 등록 (같은 타입이면 교체)
+func (s *ItemEffectService) RegisterEffect(effectType model.ItemEffectType, handler ItemEffectHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[effectType] = handler
+}
+
+// DB에 저장된 아이템 효과 카탈로그를 불러옴
+// 등록되지 않은 효과나 설정이 잘못된 효과가 있으면 에러를 반환하고 기존 카탈로그를 유지한다.
+func (s *ItemEffectService) LoadCatalog() error {
+	var effects []model.ItemEffect
+	if err := s.db.Preload("Drops").Order("id ASC").Find(&effects).Error; err != nil {
+		return fmt.Errorf("아이템 효과 조회 중 오류 발생: %w", err)
+	}
+
+	catalog := make(map[string][]model.ItemEffect)
+	for i := range effects {
+		if err := s.validate(&effects[i]); err != nil {
+			return fmt.Errorf("아이템 효과 로드 실패 (item_id=%s, type=%s): %w", effects[i].ItemID, effects[i].Type, err)
+		}
+		catalog[effects[i].ItemID] = append(catalog[effects[i].ItemID], effects[i])
+	}
+
+	s.mu.Lock()
+	s.catalog = catalog
+	s.mu.Unlock()
+	return nil
+}
+
+// 아이템의 효과 목록을 조회
+func (s *ItemEffectService) GetItemEffects(itemID string) []model.ItemEffect {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.catalog[itemID]
+}
+
+// 아이템의 효과 목록을 교체
+// 빈 목록을 전달하면 효과가 제거되어 수량만 차감되는 아이템이 된다.
+func (s *ItemEffectService) SetItemEffects(itemID string, effects []model.ItemEffect) ([]model.ItemEffect, error) {
+	if itemID == "" {
+		return nil, model.ErrInvalidItemID
+	}
+	for i := range effects {
+		effects[i].ID = 0
+		effects[i].ItemID = itemID
+		for j := range effects[i].Drops {
+			effects[i].Drops[j].ID = 0
+			effects[i].Drops[j].EffectID = 0
+		}
+		if err := s.validate(&effects[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		existing := tx.Model(&model.ItemEffect{}).Select("id").Where("item_id = ?", itemID)
+		if err := tx.Where("effect_id IN (?)", existing).Delete(&model.ContainerDrop{}).Error; err != nil {
+			return fmt.Errorf("상자 내용물 삭제 중 오류 발생: %w", err)
+		}
+		if err := tx.Where("item_id = ?", itemID).Delete(&model.ItemEffect{}).Error; err != nil {
+			return fmt.Errorf("아이템 효과 삭제 중 오류 발생: %w", err)
+		}
+		if len(effects) == 0 {
+			return nil
+		}
+		if err := tx.Create(&effects).Error; err != nil {
+			return fmt.Errorf("아이템 효과 생성 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	if len(effects) == 0 {
+		delete(s.catalog, itemID)
+	} else {
+		s.catalog[itemID] = effects
+	}
+	s.mu.Unlock()
+	return effects, nil
+}
+
+// 아이템을 1회 사용하고 효과를 적용
+// 수량(또는 사용 횟수) 차감과 효과 적용은 하나의 트랜잭션에서 처리되어, 효과 적용에 실패하면 아이템도 소모되지 않는다.
+func (s *ItemEffectService) UseItem(userID uint, itemID string) (*ItemUseResult, error) {
+	effects := s.GetItemEffects(itemID)
+	result := &ItemUseResult{
+		ItemID: itemID,
+		Buffs:  []model.ActiveBuff{},
+		Items:  []model.Inventory{},
+	}
+
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		inventory, err := lockInventoryItem(tx, userID, itemID)
+		if err != nil {
+			return err
+		}
+
+		change := model.NewInventoryChange(model.InventorySourceUse, userID, "inventory", inventory.ID)
+		if err := consumeInventoryItem(tx, inventory, change); err != nil {
+			return err
+		}
+		result.RemainingQuantity = inventory.Quantity
+
+		use := &ItemUse{
+			UserID:    userID,
+			Inventory: inventory,
+			Change:    change,
+			Now:       s.now(),
+			Result:    result,
+		}
+		for i := range effects {
+			handler, err := s.handler(effects[i].Type)
+			if err != nil {
+				return err
+			}
+			if err := handler.Apply(tx, use, &effects[i]); err != nil {
+				return fmt.Errorf("아이템 효과 적용 실패 (type=%s): %w", effects[i].Type, err)
+			}
+		}
+
+		if s.missions != nil {
+			events := []model.MissionEvent{{Objective: model.MissionUseItem, ItemID: itemID, Amount: 1}}
+			if result.Level > 0 {
+				events = append(events, model.MissionEvent{Objective: model.MissionReachLevel, Amount: result.Level})
+			}
+			if result.CompletedMissions, err = s.missions.Record(tx, userID, events...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// 사용자에게 적용 중인 버프 목록을 조회
+func (s *ItemEffectService) GetActiveBuffs(userID uint) ([]model.ActiveBuff, error) {
+	var buffs []model.ActiveBuff
+	if err := s.db.Where("user_id = ? AND expires_at > ?", userID, s.now()).Order("expires_at ASC").Find(&buffs).Error; err != nil {
+		return nil, fmt.Errorf("버프 조회 중 오류 발생: %w", err)
+	}
+	return buffs, nil
+}
+
+// 보상 종류에 적용되는 버프 배율을 반환 (버프가 없으면 1)
+// 점수/보상 계산 시 기본값에 곱해서 사용한다.
+func (s *ItemEffectService) RewardMultiplier(userID uint, stat string) (float64, error) {
+	return rewardMultiplier(s.db, userID, stat, s.now())
+}
+
+// 만료된 버프를 삭제
+func (s *ItemEffectService) PurgeExpiredBuffs(now time.Time) (int64, error) {
+	result := s.db.Where("expires_at <= ?", now).Delete(&model.ActiveBuff{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("만료된 버프 삭제 중 오류 발생: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// 등록된 처리기로 효과 설정을 검사
+func (s *ItemEffectService) validate(effect *model.ItemEffect) error {
+	if err := effect.Validate(); err != nil {
+		return err
+	}
+	handler, err := s.handler(effect.Type)
+	if err != nil {
+		return err
+	}
+	if handler.Validate == nil {
+		return nil
+	}
+	return handler.Validate(effect)
+}
+
+// 효과 타입의 처리기를 조회
+func (s *ItemEffectService) handler(effectType model.ItemEffectType) (ItemEffectHandler, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	handler, ok := s.handlers[effectType]
+	if !ok {
+		return ItemEffectHandler{}, fmt.Errorf("%s: %w", effectType, model.ErrUnknownEffect)
+	}
+	return handler, nil
+}
+
+// 경험치 지급 (경험치 버프 적용)
+func (s *ItemEffectService) applyGrantXP(tx *gorm.DB, use *ItemUse, effect *model.ItemEffect) error {
+	amount, err := buffedAmount(tx, use, model.BuffStatXP, effect.Amount)
+	if err != nil {
+		return err
+	}
+	user, err := adjustUserExperience(tx, use.UserID, amount)
+	if err != nil {
+		return err
+	}
+	use.Result.Experience += amount
+	use.Result.Level = user.Level
+	return nil
+}
+
+// 골드 지급 (골드 버프 적용)
+func (s *ItemEffectService) applyGrantGold(tx *gorm.DB, use *ItemUse, effect *model.ItemEffect) error {
+	amount, err := buffedAmount(tx, use, model.BuffStatGold, effect.Amount)
+	if err != nil {
+		return err
+	}
+	if err := adjustUserGold(tx, use.UserID, amount); err != nil {
+		return err
+	}
+	use.Result.Gold += amount
+	return nil
+}
+
+// 시간제 버프 적용
+// 같은 아이템으로 얻은 같은 종류의 버프가 남아 있으면 중첩하지 않고 지속 시간을 갱신한다.
+func (s *ItemEffectService) applyBuff(tx *gorm.DB, use *ItemUse, effect *model.ItemEffect) error {
+	expiresAt := use.Now.Add(effect.Duration())
+
+	var buff model.ActiveBuff
+	err := lockForUpdate(tx).
+		Where("user_id = ? AND stat = ? AND source_item_id = ? AND expires_at > ?", use.UserID, effect.BuffStat, effect.ItemID, use.Now).
+		First(&buff).Error
+	switch {
+	case err == nil:
+		buff.Bonus = effect.BuffBonus
+		buff.ExpiresAt = expiresAt
+		if err := tx.Model(&buff).Updates(map[string]interface{}{"bonus": buff.Bonus, "expires_at": buff.ExpiresAt}).Error; err != nil {
+			return fmt.Errorf("버프 갱신 중 오류 발생: %w", err)
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		buff = model.ActiveBuff{
+			UserID:       use.UserID,
+			Stat:         effect.BuffStat,
+			Bonus:        effect.BuffBonus,
+			SourceItemID: effect.ItemID,
+			ExpiresAt:    expiresAt,
+		}
+		if err := tx.Create(&buff).Error; err != nil {
+			return fmt.Errorf("버프 생성 중 오류 발생: %w", err)
+		}
+	default:
+		return fmt.Errorf("버프 조회 중 오류 발생: %w", err)
+	}
+
+	use.Result.Buffs = append(use.Result.Buffs, buff)
+	return nil
+}
+
+// 상자 열기 (내용물마다 확률 판정 후 지급)
+func (s *ItemEffectService) applyContainer(tx *gorm.DB, use *ItemUse, effect *model.ItemEffect) error {
+	for i := range effect.Drops {
+		drop := &effect.Drops[i]
+		if s.roll() >= drop.Chance {
+			continue
+		}
+		item, err := grantInventoryItem(tx, use.UserID, drop.ToInventory(use.UserID), drop.Quantity, use.Change)
+		if err != nil {
+			return err
+		}
+		use.Result.Items = append(use.Result.Items, *item)
+	}
+	return nil
+}
+
+// 버프 배율을 적용한 지급량을 계산
+func buffedAmount(tx *gorm.DB, use *ItemUse, stat string, amount int) (int, error) {
+	multiplier, err := rewardMultiplier(tx, use.UserID, stat, use.Now)
+	if err != nil {
+		return 0, err
+	}
+	return int(math.Floor(float64(amount) * multiplier)), nil
+}
+
+// 사용자의 활성 버프를 합산한 보상 배율을 계산
+func rewardMultiplier(db *gorm.DB, userID uint, stat string, now time.Time) (float64, error) {
+	var bonus float64
+	err := db.Model(&model.ActiveBuff{}).
+		Where("user_id = ? AND stat = ? AND expires_at > ?", userID, stat, now).
+		Select("COALESCE(SUM(bonus), 0)").
+		Scan(&bonus).Error
+	if err != nil {
+		return 0, fmt.Errorf("버프 배율 조회 중 오류 발생: %w", err)
+	}
+	return 1 + bonus, nil
+}
+
+// 경험치/골드 지급 효과 검사
+func validateAmountEffect(effect *model.ItemEffect) error {
+	if effect.Amount <= 0 {
+		return model.ErrInvalidEffect
+	}
+	return nil
+}
+
+// 버프 효과 검사
+func validateBuffEffect(effect *model.ItemEffect) error {
+	switch effect.BuffStat {
+	case model.BuffStatXP, model.BuffStatGold, model.BuffStatScore:
+	default:
+		return model.ErrInvalidEffect
+	}
+	if effect.BuffBonus <= 0 || effect.DurationSeconds <= 0 {
+		return model.ErrInvalidEffect
+	}
+	return nil
+}
+
+// 상자 효과 검사
+func validateContainerEffect(effect *model.ItemEffect) error {
+	if len(effect.Drops) == 0 {
+		return model.ErrInvalidEffect
+	}
+	for i := range effect.Drops {
+		if err := effect.Drops[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func setupItemEffectTest(t *testing.T) (*gorm.DB, *ItemEffectService, *model.User) {
+	db := setupGameTestDB(t, &model.ItemEffect{}, &model.ContainerDrop{}, &model.ActiveBuff{})
+	service := NewItemEffectService(db)
+	user := seedUser(t, db, "alice", 100)
+	return db, service, user
+}
+
+// 경험치/골드 지급 효과가 수량 차감과 함께 적용되는지 테스트
+func TestItemEffectService_GrantEffects(t *testing.T) {
+	db, service, user := setupItemEffectTest(t)
+	seedItem(t, db, user.ID, "xp_scroll", "common", 2)
+
+	_, err := service.SetItemEffects("xp_scroll", []model.ItemEffect{
+		{Type: model.ItemEffectGrantXP, Amount: 1500},
+		{Type: model.ItemEffectGrantGold, Amount: 50},
+	})
+	require.NoError(t, err)
+
+	result, err := service.UseItem(user.ID, "xp_scroll")
+	require.NoError(t, err)
+	assert.Equal(t, 1500, result.Experience)
+	assert.Equal(t, 50, result.Gold)
+	assert.Equal(t, 2, result.Level, "경험치 1000마다 레벨업해야 합니다")
+	assert.Equal(t, 1, result.RemainingQuantity)
+
+	assert.Equal(t, 150, userGold(t, db, user.ID))
+	assert.Equal(t, 1, itemQuantity(t, db, user.ID, "xp_scroll"))
+
+	var updated model.User
+	require.NoError(t, db.First(&updated, user.ID).Error)
+	assert.Equal(t, 2, updated.Level)
+	assert.Equal(t, 500, updated.Experience)
+}
+
+// 버프 적용, 갱신, 보상 배율 반영 테스트
+func TestItemEffectService_Buffs(t *testing.T) {
+	db, service, user := setupItemEffectTest(t)
+	now := time.Now()
+	service.now = func() time.Time { return now }
+
+	seedItem(t, db, user.ID, "gold_potion", "rare", 2)
+	seedItem(t, db, user.ID, "gold_pouch", "common", 1)
+	_, err := service.SetItemEffects("gold_potion", []model.ItemEffect{
+		{Type: model.ItemEffectBuff, BuffStat: model.BuffStatGold, BuffBonus: 0.5, DurationSeconds: 3600},
+	})
+	require.NoError(t, err)
+	_, err = service.SetItemEffects("gold_pouch", []model.ItemEffect{
+		{Type: model.ItemEffectGrantGold, Amount: 100},
+	})
+	require.NoError(t, err)
+
+	result, err := service.UseItem(user.ID, "gold_potion")
+	require.NoError(t, err)
+	require.Len(t, result.Buffs, 1)
+
+	multiplier, err := service.RewardMultiplier(user.ID, model.BuffStatGold)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.5, multiplier, 0.0001)
+
+	multiplier, err = service.RewardMultiplier(user.ID, model.BuffStatScore)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, multiplier, 0.0001, "다른 종류의 보상에는 영향이 없어야 합니다")
+
+	result, err = service.UseItem(user.ID, "gold_pouch")
+	require.NoError(t, err)
+	assert.Equal(t, 150, result.Gold, "골드 버프가 지급량에 반영되어야 합니다")
+
+	// 같은 버프 아이템을 다시 사용하면 중첩되지 않고 지속 시간만 갱신
+	now = now.Add(30 * time.Minute)
+	_, err = service.UseItem(user.ID, "gold_potion")
+	require.NoError(t, err)
+
+	buffs, err := service.GetActiveBuffs(user.ID)
+	require.NoError(t, err)
+	require.Len(t, buffs, 1)
+	assert.WithinDuration(t, now.Add(time.Hour), buffs[0].ExpiresAt, time.Second)
+
+	// 만료 후에는 배율에서 제외되고 정리 대상이 됨
+	now = now.Add(2 * time.Hour)
+	multiplier, err = service.RewardMultiplier(user.ID, model.BuffStatGold)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, multiplier, 0.0001)
+
+	purged, err := service.PurgeExpiredBuffs(now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+}
+
+// 상자 열기 시 확률에 따라 내용물이 지급되는지 테스트
+func TestItemEffectService_OpenContainer(t *testing.T) {
+	db, service, user := setupItemEffectTest(t)
+	service.roll = fixedRolls(0.1, 0.9)
+
+	seedItem(t, db, user.ID, "treasure_box", "epic", 1)
+	_, err := service.SetItemEffects("treasure_box", []model.ItemEffect{{
+		Type: model.ItemEffectOpenContainer,
+		Drops: []model.ContainerDrop{
+			{ItemID: "hp_potion", ItemName: "체력 물약", ItemType: "consumable", Rarity: "common", Quantity: 3, Chance: 1},
+			{ItemID: "dragon_egg", ItemName: "용의 알", ItemType: "material", Rarity: "legendary", Quantity: 1, Chance: 0.5},
+		},
+	}})
+	require.NoError(t, err)
+
+	result, err := service.UseItem(user.ID, "treasure_box")
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "hp_potion", result.Items[0].ItemID)
+	assert.Equal(t, 0, result.RemainingQuantity)
+
+	assert.Equal(t, 0, itemQuantity(t, db, user.ID, "treasure_box"))
+	assert.Equal(t, 3, itemQuantity(t, db, user.ID, "hp_potion"))
+	assert.Equal(t, 0, itemQuantity(t, db, user.ID, "dragon_egg"))
+
+	var logs []model.InventoryLog
+	require.NoError(t, db.Where("source = ?", model.InventorySourceUse).Find(&logs).Error)
+	assert.Len(t, logs, 2, "상자 소모와 내용물 지급이 모두 기록되어야 합니다")
+}
+
+// 효과 적용에 실패하면 아이템도 소모되지 않아야 함
+func TestItemEffectService_RollbackOnFailure(t *testing.T) {
+	db, service, user := setupItemEffectTest(t)
+	seedItem(t, db, user.ID, "cursed_coin", "common", 1)
+
+	service.RegisterEffect("curse", ItemEffectHandler{
+		Apply: func(tx *gorm.DB, use *ItemUse, effect *model.ItemEffect) error {
+			return adjustUserGold(tx, use.UserID, -1000)
+		},
+	})
+	_, err := service.SetItemEffects("cursed_coin", []model.ItemEffect{{Type: "curse"}})
+	require.NoError(t, err)
+
+	_, err = service.UseItem(user.ID, "cursed_coin")
+	assert.ErrorIs(t, err, ErrInsufficientGold)
+	assert.Equal(t, 1, itemQuantity(t, db, user.ID, "cursed_coin"))
+	assert.Equal(t, 100, userGold(t, db, user.ID))
+}
+
+// 카탈로그 로드 시 알 수 없는 효과를 거부하는지 테스트
+func TestItemEffectService_LoadCatalog(t *testing.T) {
+	db, service, _ := setupItemEffectTest(t)
+
+	require.NoError(t, db.Create(&model.ItemEffect{ItemID: "hp_potion", Type: model.ItemEffectGrantXP, Amount: 10}).Error)
+	require.NoError(t, service.LoadCatalog())
+	assert.Len(t, service.GetItemEffects("hp_potion"), 1)
+
+	require.NoError(t, db.Create(&model.ItemEffect{ItemID: "mystery", Type: "teleport"}).Error)
+	err := service.LoadCatalog()
+	assert.ErrorIs(t, err, model.ErrUnknownEffect)
+	assert.Len(t, service.GetItemEffects("hp_potion"), 1, "로드 실패 시 기존 카탈로그를 유지해야 합니다")
+
+	_, err = service.SetItemEffects("mystery", []model.ItemEffect{{Type: "teleport"}})
+	assert.ErrorIs(t, err, model.ErrUnknownEffect)
+
+	_, err = service.SetItemEffects("bad_buff", []model.ItemEffect{{Type: model.ItemEffectBuff, BuffStat: "luck", BuffBonus: 1, DurationSeconds: 60}})
+	assert.ErrorIs(t, err, model.ErrInvalidEffect)
+
+	_, err = service.SetItemEffects("empty_box", []model.ItemEffect{{Type: model.ItemEffectOpenContainer}})
+	assert.ErrorIs(t, err, model.ErrInvalidEffect)
+}
+
+// 인벤토리 서비스의 아이템 사용이 효과 서비스로 위임되는지 테스트
+func TestInventoryService_UseItemWithEffects(t *testing.T) {
+	db, effects, user := setupItemEffectTest(t)
+	inventoryService := NewInventoryService(db)
+	inventoryService.SetEffectService(effects)
+
+	seedItem(t, db, user.ID, "gold_pouch", "common", 1)
+	_, err := effects.SetItemEffects("gold_pouch", []model.ItemEffect{{Type: model.ItemEffectGrantGold, Amount: 30}})
+	require.NoError(t, err)
+
+	require.NoError(t, inventoryService.UseItem(user.ID, "gold_pouch"))
+	assert.Equal(t, 130, userGold(t, db, user.ID))
+	assert.Equal(t, 0, itemQuantity(t, db, user.ID, "gold_pouch"))
+}
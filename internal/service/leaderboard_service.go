@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"sort"
+	"time"
+)
+
+// 리더보드 조회 결과
+type LeaderboardResult struct {
+	Board   *model.LeaderboardBoard  `json:"board"`
+	Total   int64                    `json:"total"`
+	Entries []model.LeaderboardEntry `json:"entries"`
+}
+
+// 게임/난이도/기간별 리더보드를 관리하는 서비스
+// 순위는 Redis ZSET으로 제공하며, 원본은 DB의 정상(accepted) 점수 기록이다.
+// 저장소 반영에 실패해도 점수 기록은 유지되므로 재구축으로 복구할 수 있다.
+type LeaderboardService struct {
+	db      *gorm.DB
+	store   LeaderboardStore
+	friends *FriendService
+
+	now func() time.Time
+}
+
+// 새로운 LeaderboardService 인스턴스를 생성
+func NewLeaderboardService(db *gorm.DB, store LeaderboardStore) *LeaderboardService {
+	return &LeaderboardService{
+		db:      db,
+		store:   store,
+		friends: NewFriendService(db),
+		now:     time.Now,
+	}
+}
+
+// 점수를 해당 게임/난이도의 모든 기간 리더보드에 반영
+// 정상 점수만 반영하며, 기존 기록보다 높을 때만 교체된다.
+func (s *LeaderboardService) RecordScore(score *model.Score) error {
+	if !score.IsRanked() {
+		return nil
+	}
+
+	boards := make([]*model.LeaderboardBoard, 0, len(model.LeaderboardWindows)+1)
+	for _, window := range model.LeaderboardWindows {
+		board, err := model.NewLeaderboardBoard(score.GameID, score.Difficulty, window, score.CreatedAt)
+		if err != nil {
+			return err
+		}
+		boards = append(boards, board)
+	}
+
+	// 점수를 달성한 시각에 진행 중이던 시즌
+	season, err := findActiveSeason(s.db, score.GameID, score.CreatedAt)
+	if err != nil && !errors.Is(err, model.ErrNoActiveSeason) {
+		return err
+	}
+	if season != nil {
+		board, err := model.NewSeasonLeaderboardBoard(season, score.Difficulty)
+		if err != nil {
+			return err
+		}
+		boards = append(boards, board)
+	}
+
+	ctx := context.Background()
+	entry := model.LeaderboardEntry{UserID: score.UserID, Score: score.GetTotalScore(), AchievedAt: score.CreatedAt}
+	now := s.now()
+	for _, board := range boards {
+		ttl := board.Retention(now)
+		if ttl < 0 {
+			continue
+		}
+		if _, err := s.store.Submit(ctx, board.Key(), entry, ttl); err != nil {
+			return fmt.Errorf("리더보드 반영 중 오류 발생 (%s): %w", board.Key(), err)
+		}
+	}
+	return nil
+}
+
+// 상위 N명을 조회
+func (s *LeaderboardService) GetTop(gameID uint, difficulty model.GameDifficulty, window model.LeaderboardWindow, limit int) (*LeaderboardResult, error) {
+	board, err := s.board(gameID, difficulty, window, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	entries, err := s.store.Range(ctx, board.Key(), 0, int64(limit)-1)
+	if err != nil {
+		return nil, fmt.Errorf("리더보드 조회 중 오류 발생: %w", err)
+	}
+	return s.result(ctx, board, entries)
+}
+
+// 내 순위와 앞뒤 k명을 조회
+func (s *LeaderboardService) GetAround(gameID uint, difficulty model.GameDifficulty, window model.LeaderboardWindow, userID uint, k int) (*LeaderboardResult, error) {
+	board, err := s.board(gameID, difficulty, window, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	mine, err := s.store.Rank(ctx, board.Key(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	start := mine.Rank - 1 - int64(k)
+	if start < 0 {
+		start = 0
+	}
+	entries, err := s.store.Range(ctx, board.Key(), start, mine.Rank-1+int64(k))
+	if err != nil {
+		return nil, fmt.Errorf("리더보드 조회 중 오류 발생: %w", err)
+	}
+	return s.result(ctx, board, entries)
+}
+
+// 나와 친구들의 기록만 순위순으로 조회 (Rank는 전체 순위)
+func (s *LeaderboardService) GetFriends(gameID uint, difficulty model.GameDifficulty, window model.LeaderboardWindow, userID uint) (*LeaderboardResult, error) {
+	board, err := s.board(gameID, difficulty, window, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	friendIDs, err := s.friends.GetFriendIDs(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	entries, err := s.store.Entries(ctx, board.Key(), append(friendIDs, userID))
+	if err != nil {
+		return nil, fmt.Errorf("리더보드 조회 중 오류 발생: %w", err)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Rank < entries[j].Rank })
+	return s.result(ctx, board, entries)
+}
+
+// DB 기록으로 리더보드를 재구축 (기준 시각이 속한 기간)
+func (s *LeaderboardService) Rebuild(gameID uint, difficulty model.GameDifficulty, window model.LeaderboardWindow, at time.Time) (int, error) {
+	board, err := s.board(gameID, difficulty, window, at)
+	if err != nil {
+		return 0, err
+	}
+
+	entries, err := rankScores(s.db, gameID, difficulty, board.Start, board.End)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.store.Replace(context.Background(), board.Key(), entries, board.Retention(s.now())); err != nil {
+		return 0, fmt.Errorf("리더보드 재구축 중 오류 발생 (%s): %w", board.Key(), err)
+	}
+	return len(entries), nil
+}
+
+// 점수 기록이 있는 모든 게임/난이도의 현재 기간 리더보드를 재구축
+// gameID가 0이면 모든 게임을 대상으로 한다. 진행 중인 시즌이 있으면 시즌 리더보드도 재구축한다.
+// 재구축한 리더보드 수를 반환한다.
+func (s *LeaderboardService) RebuildAll(gameID uint, at time.Time) (int, error) {
+	query := s.db.Model(&model.Score{}).Distinct("game_id", "difficulty").Where("review_status = ?", model.ScoreAccepted)
+	if gameID != 0 {
+		query = query.Where("game_id = ?", gameID)
+	}
+
+	var targets []struct {
+		GameID     uint
+		Difficulty model.GameDifficulty
+	}
+	if err := query.Scan(&targets).Error; err != nil {
+		return 0, fmt.Errorf("리더보드 대상 조회 중 오류 발생: %w", err)
+	}
+
+	count := 0
+	for _, target := range targets {
+		windows := model.LeaderboardWindows
+		if _, err := findActiveSeason(s.db, target.GameID, at); err == nil {
+			windows = append(windows[:len(windows):len(windows)], model.LeaderboardSeason)
+		} else if !errors.Is(err, model.ErrNoActiveSeason) {
+			return count, err
+		}
+
+		for _, window := range windows {
+			if _, err := s.Rebuild(target.GameID, target.Difficulty, window, at); err != nil {
+				return count, err
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+// 마감된 시즌의 리더보드를 모두 삭제
+func (s *LeaderboardService) ResetSeason(season *model.Season) error {
+	ctx := context.Background()
+	for _, difficulty := range model.GameDifficulties {
+		board, err := model.NewSeasonLeaderboardBoard(season, difficulty)
+		if err != nil {
+			return err
+		}
+		if err := s.store.Replace(ctx, board.Key(), nil, 0); err != nil {
+			return fmt.Errorf("시즌 리더보드 초기화 중 오류 발생 (%s): %w", board.Key(), err)
+		}
+	}
+	return nil
+}
+
+// 기준 시각의 리더보드를 반환 (시즌은 진행 중인 시즌을 조회)
+func (s *LeaderboardService) board(gameID uint, difficulty model.GameDifficulty, window model.LeaderboardWindow, at time.Time) (*model.LeaderboardBoard, error) {
+	if window != model.LeaderboardSeason {
+		return model.NewLeaderboardBoard(gameID, difficulty, window, at)
+	}
+
+	season, err := findActiveSeason(s.db, gameID, at)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewSeasonLeaderboardBoard(season, difficulty)
+}
+
+// 조회 결과에 전체 인원과 닉네임을 채움
+func (s *LeaderboardService) result(ctx context.Context, board *model.LeaderboardBoard, entries []model.LeaderboardEntry) (*LeaderboardResult, error) {
+	total, err := s.store.Count(ctx, board.Key())
+	if err != nil {
+		return nil, fmt.Errorf("리더보드 인원 조회 중 오류 발생: %w", err)
+	}
+
+	if len(entries) > 0 {
+		userIDs := make([]uint, len(entries))
+		for i := range entries {
+			userIDs[i] = entries[i].UserID
+		}
+		var users []model.User
+		if err := s.db.Select("id", "nickname").Where("id IN ?", userIDs).Find(&users).Error; err != nil {
+			return nil, fmt.Errorf("사용자 조회 중 오류 발생: %w", err)
+		}
+		nicknames := make(map[uint]string, len(users))
+		for _, user := range users {
+			nicknames[user.ID] = user.Nickname
+		}
+		for i := range entries {
+			entries[i].Nickname = nicknames[entries[i].UserID]
+		}
+	}
+
+	if entries == nil {
+		entries = []model.LeaderboardEntry{}
+	}
+	return &LeaderboardResult{Board: board, Total: total, Entries: entries}, nil
+}
+
+// 정상 점수 기록으로 순위를 계산 (start, end가 nil이면 전체 기간)
+// 사용자별 최고 점수 중 가장 먼저 달성한 기록을 사용하며, 동점이면 먼저 달성한 기록이 앞선다.
+func rankScores(db *gorm.DB, gameID uint, difficulty model.GameDifficulty, start, end *time.Time) ([]model.LeaderboardEntry, error) {
+	query := db.Model(&model.Score{}).
+		Select("user_id, score + bonus_score - penalty_score AS total, created_at").
+		Where("game_id = ? AND difficulty = ? AND review_status = ?", gameID, difficulty, model.ScoreAccepted)
+	if start != nil && end != nil {
+		query = query.Where("created_at >= ? AND created_at < ?", *start, *end)
+	}
+
+	var rows []struct {
+		UserID    uint
+		Total     int
+		CreatedAt time.Time
+	}
+	if err := query.Order("user_id ASC, total DESC, created_at ASC").Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("점수 기록 조회 중 오류 발생: %w", err)
+	}
+
+	// 사용자별 첫 행이 최고 점수 중 가장 먼저 달성한 기록
+	var entries []model.LeaderboardEntry
+	for i, row := range rows {
+		if i > 0 && rows[i-1].UserID == row.UserID {
+			continue
+		}
+		entries = append(entries, model.LeaderboardEntry{UserID: row.UserID, Score: row.Total, AchievedAt: row.CreatedAt})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		return entries[i].AchievedAt.Before(entries[j].AchievedAt)
+	})
+	for i := range entries {
+		entries[i].Rank = int64(i) + 1
+	}
+	return entries, nil
+}
+
+// 기준 시각에 진행 중인 게임 시즌을 조회
+func findActiveSeason(db *gorm.DB, gameID uint, at time.Time) (*model.Season, error) {
+	var season model.Season
+	err := db.Where("game_id = ? AND closed_at IS NULL AND start_at <= ? AND end_at > ?", gameID, at, at).
+		Order("start_at DESC").First(&season).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrNoActiveSeason
+		}
+		return nil, fmt.Errorf("시즌 조회 중 오류 발생: %w", err)
+	}
+	return &season, nil
+}
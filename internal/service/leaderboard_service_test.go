@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"sort"
+	"testing"
+	"time"
+)
+
+// 테스트용 메모리 리더보드 저장소
+type memoryLeaderboardStore struct {
+	boards map[string]map[uint]model.LeaderboardEntry
+}
+
+func newMemoryLeaderboardStore() *memoryLeaderboardStore {
+	return &memoryLeaderboardStore{boards: make(map[string]map[uint]model.LeaderboardEntry)}
+}
+
+// 점수 내림차순, 달성 시각 오름차순으로 정렬된 기록
+func (s *memoryLeaderboardStore) sorted(key string) []model.LeaderboardEntry {
+	var entries []model.LeaderboardEntry
+	for _, entry := range s.boards[key] {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		return entries[i].AchievedAt.Before(entries[j].AchievedAt)
+	})
+	for i := range entries {
+		entries[i].Rank = int64(i) + 1
+	}
+	return entries
+}
+
+func (s *memoryLeaderboardStore) Submit(ctx context.Context, key string, entry model.LeaderboardEntry, ttl time.Duration) (bool, error) {
+	board, ok := s.boards[key]
+	if !ok {
+		board = make(map[uint]model.LeaderboardEntry)
+		s.boards[key] = board
+	}
+	if old, ok := board[entry.UserID]; ok && old.Score >= entry.Score {
+		return false, nil
+	}
+	board[entry.UserID] = entry
+	return true, nil
+}
+
+func (s *memoryLeaderboardStore) Range(ctx context.Context, key string, start, stop int64) ([]model.LeaderboardEntry, error) {
+	entries := s.sorted(key)
+	if start >= int64(len(entries)) {
+		return nil, nil
+	}
+	if stop >= int64(len(entries)) {
+		stop = int64(len(entries)) - 1
+	}
+	return entries[start : stop+1], nil
+}
+
+func (s *memoryLeaderboardStore) Rank(ctx context.Context, key string, userID uint) (*model.LeaderboardEntry, error) {
+	entries, _ := s.Entries(ctx, key, []uint{userID})
+	if len(entries) == 0 {
+		return nil, model.ErrNotRanked
+	}
+	return &entries[0], nil
+}
+
+func (s *memoryLeaderboardStore) Entries(ctx context.Context, key string, userIDs []uint) ([]model.LeaderboardEntry, error) {
+	var entries []model.LeaderboardEntry
+	for _, entry := range s.sorted(key) {
+		for _, userID := range userIDs {
+			if entry.UserID == userID {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	return entries, nil
+}
+
+func (s *memoryLeaderboardStore) Count(ctx context.Context, key string) (int64, error) {
+	return int64(len(s.boards[key])), nil
+}
+
+func (s *memoryLeaderboardStore) Replace(ctx context.Context, key string, entries []model.LeaderboardEntry, ttl time.Duration) error {
+	board := make(map[uint]model.LeaderboardEntry, len(entries))
+	for _, entry := range entries {
+		board[entry.UserID] = entry
+	}
+	s.boards[key] = board
+	return nil
+}
+
+func setupLeaderboardTest(t *testing.T) (*gorm.DB, *LeaderboardService, *memoryLeaderboardStore, *model.Game, time.Time) {
+	db := setupGameTestDB(t, &model.Game{}, &model.Score{}, &model.ScoreFlag{}, &model.Friendship{}, &model.Season{})
+	store := newMemoryLeaderboardStore()
+	service := NewLeaderboardService(db, store)
+	now := time.Date(2025, 3, 12, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+
+	game := newTestGame("Tetris", model.GameCategoryPuzzle)
+	require.NoError(t, db.Create(game).Error)
+	return db, service, store, game, now
+}
+
+// 지정한 시각에 달성한 정상 점수를 저장
+func seedRankedScore(t *testing.T, db *gorm.DB, userID, gameID uint, score int, at time.Time) *model.Score {
+	record := seedScore(t, db, userID, gameID, score, 60, "")
+	require.NoError(t, db.Model(record).Update("created_at", at).Error)
+	record.CreatedAt = at
+	return record
+}
+
+// 점수 반영과 동점 처리, 기간별 리더보드 테스트
+func TestLeaderboardService_RecordScore(t *testing.T) {
+	db, service, _, game, now := setupLeaderboardTest(t)
+	alice := seedUser(t, db, "alice", 0)
+	bob := seedUser(t, db, "bob", 0)
+	carol := seedUser(t, db, "carol", 0)
+
+	// 같은 점수면 먼저 달성한 기록이 앞선다
+	require.NoError(t, service.RecordScore(seedRankedScore(t, db, bob.ID, game.ID, 500, now.Add(-time.Hour))))
+	require.NoError(t, service.RecordScore(seedRankedScore(t, db, alice.ID, game.ID, 500, now.Add(-2*time.Hour))))
+	require.NoError(t, service.RecordScore(seedRankedScore(t, db, carol.ID, game.ID, 300, now.Add(-time.Hour))))
+
+	// 지난달 기록은 전체 기간에만 남고 현재 월간 리더보드에는 없다
+	require.NoError(t, service.RecordScore(seedRankedScore(t, db, carol.ID, game.ID, 900, now.AddDate(0, -1, 0))))
+
+	// 낮은 점수와 격리된 점수는 반영하지 않음
+	require.NoError(t, service.RecordScore(seedRankedScore(t, db, alice.ID, game.ID, 100, now)))
+	quarantined := seedRankedScore(t, db, bob.ID, game.ID, 10000, now)
+	quarantined.ReviewStatus = model.ScoreQuarantined
+	require.NoError(t, service.RecordScore(quarantined))
+
+	monthly, err := service.GetTop(game.ID, model.GameDifficultyNormal, model.LeaderboardMonthly, 10)
+	require.NoError(t, err)
+	require.Len(t, monthly.Entries, 3)
+	assert.Equal(t, int64(3), monthly.Total)
+	assert.Equal(t, []uint{alice.ID, bob.ID, carol.ID}, []uint{monthly.Entries[0].UserID, monthly.Entries[1].UserID, monthly.Entries[2].UserID})
+	assert.Equal(t, 500, monthly.Entries[0].Score)
+	assert.Equal(t, alice.Nickname, monthly.Entries[0].Nickname)
+	assert.Equal(t, "2025-03", monthly.Board.Period)
+
+	allTime, err := service.GetTop(game.ID, model.GameDifficultyNormal, model.LeaderboardAllTime, 1)
+	require.NoError(t, err)
+	require.Len(t, allTime.Entries, 1)
+	assert.Equal(t, carol.ID, allTime.Entries[0].UserID)
+	assert.Equal(t, 900, allTime.Entries[0].Score)
+
+	_, err = service.GetTop(game.ID, model.GameDifficultyNormal, "yearly", 10)
+	assert.ErrorIs(t, err, model.ErrInvalidLeaderboard)
+}
+
+// 내 주변 순위와 친구 리더보드 테스트
+func TestLeaderboardService_AroundAndFriends(t *testing.T) {
+	db, service, _, game, now := setupLeaderboardTest(t)
+	users := make([]*model.User, 6)
+	for i := range users {
+		users[i] = seedUser(t, db, string(rune('a'+i))+"-player", 0)
+		require.NoError(t, service.RecordScore(seedRankedScore(t, db, users[i].ID, game.ID, 600-i*100, now.Add(-time.Minute))))
+	}
+
+	around, err := service.GetAround(game.ID, model.GameDifficultyNormal, model.LeaderboardDaily, users[3].ID, 1)
+	require.NoError(t, err)
+	require.Len(t, around.Entries, 3)
+	assert.Equal(t, []int64{3, 4, 5}, []int64{around.Entries[0].Rank, around.Entries[1].Rank, around.Entries[2].Rank})
+
+	top, err := service.GetAround(game.ID, model.GameDifficultyNormal, model.LeaderboardDaily, users[0].ID, 2)
+	require.NoError(t, err)
+	assert.Len(t, top.Entries, 3, "1위는 앞쪽 기록이 없어야 합니다")
+
+	outsider := seedUser(t, db, "outsider", 0)
+	_, err = service.GetAround(game.ID, model.GameDifficultyNormal, model.LeaderboardDaily, outsider.ID, 1)
+	assert.ErrorIs(t, err, model.ErrNotRanked)
+
+	// 친구가 된 사용자와 본인의 기록만 전체 순위 순서로 조회
+	friends := NewFriendService(db)
+	for _, friend := range []*model.User{users[4], users[1]} {
+		_, err = friends.SendRequest(users[2].ID, friend.ID)
+		require.NoError(t, err)
+		_, err = friends.SendRequest(friend.ID, users[2].ID)
+		require.NoError(t, err)
+	}
+	_, err = friends.SendRequest(users[2].ID, users[0].ID)
+	require.NoError(t, err)
+
+	result, err := service.GetFriends(game.ID, model.GameDifficultyNormal, model.LeaderboardWeekly, users[2].ID)
+	require.NoError(t, err)
+	require.Len(t, result.Entries, 3, "수락되지 않은 요청은 제외해야 합니다")
+	assert.Equal(t, []int64{2, 3, 5}, []int64{result.Entries[0].Rank, result.Entries[1].Rank, result.Entries[2].Rank})
+	assert.Equal(t, int64(6), result.Total)
+}
+
+// DB 기록으로 리더보드 재구축 테스트
+func TestLeaderboardService_Rebuild(t *testing.T) {
+	db, service, store, game, now := setupLeaderboardTest(t)
+	alice := seedUser(t, db, "alice", 0)
+	bob := seedUser(t, db, "bob", 0)
+
+	seedRankedScore(t, db, alice.ID, game.ID, 400, now.Add(-3*time.Hour))
+	seedRankedScore(t, db, alice.ID, game.ID, 700, now.Add(-2*time.Hour))
+	seedRankedScore(t, db, alice.ID, game.ID, 700, now.Add(-time.Hour))
+	seedRankedScore(t, db, bob.ID, game.ID, 800, now.AddDate(0, 0, -2))
+	rejected := seedRankedScore(t, db, bob.ID, game.ID, 9000, now)
+	require.NoError(t, db.Model(rejected).Update("review_status", model.ScoreRejected).Error)
+
+	// 저장소가 비어 있어도 DB 기록으로 복구
+	count, err := service.Rebuild(game.ID, model.GameDifficultyNormal, model.LeaderboardDaily, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "일간 리더보드에는 오늘 기록만 포함해야 합니다")
+
+	board, err := model.NewLeaderboardBoard(game.ID, model.GameDifficultyNormal, model.LeaderboardDaily, now)
+	require.NoError(t, err)
+	entries := store.sorted(board.Key())
+	require.Len(t, entries, 1)
+	assert.Equal(t, 700, entries[0].Score)
+	assert.True(t, entries[0].AchievedAt.Equal(now.Add(-2*time.Hour)), "같은 최고 점수 중 먼저 달성한 기록을 사용해야 합니다")
+
+	boards, err := service.RebuildAll(0, now)
+	require.NoError(t, err)
+	assert.Equal(t, len(model.LeaderboardWindows), boards)
+
+	allTime, err := service.GetTop(game.ID, model.GameDifficultyNormal, model.LeaderboardAllTime, 10)
+	require.NoError(t, err)
+	require.Len(t, allTime.Entries, 2)
+	assert.Equal(t, bob.ID, allTime.Entries[0].UserID)
+	assert.Equal(t, 800, allTime.Entries[0].Score)
+}
+
+// Redis 멤버 인코딩과 동점 정렬 순서 테스트
+func TestLeaderboardMemberEncoding(t *testing.T) {
+	earlier := model.LeaderboardEntry{UserID: 42, Score: 500, AchievedAt: time.UnixMilli(1700000000000)}
+	later := model.LeaderboardEntry{UserID: 7, Score: 500, AchievedAt: time.UnixMilli(1700000000001)}
+
+	// 역순 조회 시 사전순으로 큰 멤버가 앞서므로 먼저 달성한 기록의 멤버가 더 커야 한다
+	assert.Greater(t, encodeMember(earlier), encodeMember(later))
+
+	decoded, err := decodeMember(encodeMember(earlier), 500)
+	require.NoError(t, err)
+	assert.Equal(t, earlier.UserID, decoded.UserID)
+	assert.Equal(t, earlier.Score, decoded.Score)
+	assert.True(t, decoded.AchievedAt.Equal(earlier.AchievedAt))
+
+	_, err = decodeMember("broken", 1)
+	assert.Error(t, err)
+}
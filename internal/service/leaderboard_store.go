@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 리더보드 순위 저장소
+// 사용자당 최고 기록 하나를 보관하며, 점수가 같으면 먼저 달성한 기록이 높은 순위가 된다.
+// 순위(Rank)는 1부터 시작한다.
+type LeaderboardStore interface {
+	// 기존 기록보다 높은 점수일 때만 기록을 교체 (교체되면 true)
+	Submit(ctx context.Context, key string, entry model.LeaderboardEntry, ttl time.Duration) (bool, error)
+
+	// 순위 구간의 기록을 조회 (start, stop은 0부터 시작하며 stop 포함)
+	Range(ctx context.Context, key string, start, stop int64) ([]model.LeaderboardEntry, error)
+
+	// 사용자의 기록과 순위를 조회 (없으면 model.ErrNotRanked)
+	Rank(ctx context.Context, key string, userID uint) (*model.LeaderboardEntry, error)
+
+	// 여러 사용자의 기록과 순위를 조회 (기록이 없는 사용자는 제외)
+	Entries(ctx context.Context, key string, userIDs []uint) ([]model.LeaderboardEntry, error)
+
+	// 전체 기록 수
+	Count(ctx context.Context, key string) (int64, error)
+
+	// 리더보드 전체를 교체 (재구축용)
+	Replace(ctx context.Context, key string, entries []model.LeaderboardEntry, ttl time.Duration) error
+}
+
+// 동점 정렬용 시각 기준값 (밀리초, 약 2286년)
+const leaderboardMaxMillis = 9999999999999
+
+// Redis 정렬 집합(ZSET) 기반 리더보드 저장소
+// ZSET 점수에는 총점을, 멤버에는 "반전된 달성 시각:사용자 ID"를 저장한다.
+// 점수가 같으면 Redis가 멤버를 사전순으로 정렬하므로 역순 조회 시 먼저 달성한 기록이 앞선다.
+// 사용자별 현재 멤버는 별도 해시(key:members)에 보관한다.
+type redisLeaderboardStore struct {
+	client *redis.Client
+}
+
+// Redis 클라이언트로 리더보드 저장소를 생성
+func NewRedisLeaderboardStore(client *redis.Client) LeaderboardStore {
+	return &redisLeaderboardStore{client: client}
+}
+
+// 기존 기록보다 높을 때만 교체하는 스크립트
+// KEYS: 순위 ZSET, 멤버 해시 / ARGV: 사용자 ID, 점수, 멤버, TTL(초)
+var leaderboardSubmitScript = redis.NewScript(`
+local old = redis.call('HGET', KEYS[2], ARGV[1])
+if old then
+	local oldScore = redis.call('ZSCORE', KEYS[1], old)
+	if oldScore and tonumber(oldScore) >= tonumber(ARGV[2]) then
+		return 0
+	end
+	redis.call('ZREM', KEYS[1], old)
+end
+redis.call('ZADD', KEYS[1], ARGV[2], ARGV[3])
+redis.call('HSET', KEYS[2], ARGV[1], ARGV[3])
+if tonumber(ARGV[4]) > 0 then
+	redis.call('EXPIRE', KEYS[1], ARGV[4])
+	redis.call('EXPIRE', KEYS[2], ARGV[4])
+end
+return 1
+`)
+
+func (s *redisLeaderboardStore) Submit(ctx context.Context, key string, entry model.LeaderboardEntry, ttl time.Duration) (bool, error) {
+	replaced, err := leaderboardSubmitScript.Run(ctx, s.client, []string{key, membersKey(key)},
+		entry.UserID, entry.Score, encodeMember(entry), int64(ttl.Seconds())).Int()
+	if err != nil {
+		return false, err
+	}
+	return replaced == 1, nil
+}
+
+func (s *redisLeaderboardStore) Range(ctx context.Context, key string, start, stop int64) ([]model.LeaderboardEntry, error) {
+	members, err := s.client.ZRevRangeWithScores(ctx, key, start, stop).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]model.LeaderboardEntry, 0, len(members))
+	for i, member := range members {
+		entry, err := decodeMember(member.Member.(string), member.Score)
+		if err != nil {
+			return nil, err
+		}
+		entry.Rank = start + int64(i) + 1
+		entries = append(entries, *entry)
+	}
+	return entries, nil
+}
+
+func (s *redisLeaderboardStore) Rank(ctx context.Context, key string, userID uint) (*model.LeaderboardEntry, error) {
+	entries, err := s.Entries(ctx, key, []uint{userID})
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, model.ErrNotRanked
+	}
+	return &entries[0], nil
+}
+
+func (s *redisLeaderboardStore) Entries(ctx context.Context, key string, userIDs []uint) ([]model.LeaderboardEntry, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	fields := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		fields[i] = strconv.FormatUint(uint64(userID), 10)
+	}
+	members, err := s.client.HMGet(ctx, membersKey(key), fields...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	type lookup struct {
+		member string
+		score  *redis.FloatCmd
+		rank   *redis.IntCmd
+	}
+	var lookups []lookup
+	pipe := s.client.Pipeline()
+	for _, member := range members {
+		if member == nil {
+			continue
+		}
+		m := member.(string)
+		lookups = append(lookups, lookup{member: m, score: pipe.ZScore(ctx, key, m), rank: pipe.ZRevRank(ctx, key, m)})
+	}
+	if len(lookups) == 0 {
+		return nil, nil
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	entries := make([]model.LeaderboardEntry, 0, len(lookups))
+	for _, l := range lookups {
+		score, err := l.score.Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		entry, err := decodeMember(l.member, score)
+		if err != nil {
+			return nil, err
+		}
+		entry.Rank = l.rank.Val() + 1
+		entries = append(entries, *entry)
+	}
+	return entries, nil
+}
+
+func (s *redisLeaderboardStore) Count(ctx context.Context, key string) (int64, error) {
+	return s.client.ZCard(ctx, key).Result()
+}
+
+func (s *redisLeaderboardStore) Replace(ctx context.Context, key string, entries []model.LeaderboardEntry, ttl time.Duration) error {
+	if len(entries) == 0 {
+		return s.client.Del(ctx, key, membersKey(key)).Err()
+	}
+
+	// 임시 키에 채운 뒤 교체하여 재구축 중에도 기존 순위를 조회할 수 있게 한다
+	tmpKey := key + ":rebuild"
+	members := make([]redis.Z, len(entries))
+	fields := make(map[string]interface{}, len(entries))
+	for i, entry := range entries {
+		member := encodeMember(entry)
+		members[i] = redis.Z{Score: float64(entry.Score), Member: member}
+		fields[strconv.FormatUint(uint64(entry.UserID), 10)] = member
+	}
+
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, tmpKey, membersKey(tmpKey))
+		pipe.ZAdd(ctx, tmpKey, members...)
+		pipe.HSet(ctx, membersKey(tmpKey), fields)
+		pipe.Rename(ctx, tmpKey, key)
+		pipe.Rename(ctx, membersKey(tmpKey), membersKey(key))
+		if ttl > 0 {
+			pipe.Expire(ctx, key, ttl)
+			pipe.Expire(ctx, membersKey(key), ttl)
+		}
+		return nil
+	})
+	return err
+}
+
+// 사용자별 멤버 해시 키
+func membersKey(key string) string {
+	return key + ":members"
+}
+
+// 기록을 ZSET 멤버로 변환
+func encodeMember(entry model.LeaderboardEntry) string {
+	return fmt.Sprintf("%013d:%d", leaderboardMaxMillis-entry.AchievedAt.UnixMilli(), entry.UserID)
+}
+
+// ZSET 멤버와 점수를 기록으로 변환
+func decodeMember(member string, score float64) (*model.LeaderboardEntry, error) {
+	inverted, userID, ok := strings.Cut(member, ":")
+	if !ok {
+		return nil, fmt.Errorf("잘못된 리더보드 멤버: %s", member)
+	}
+	millis, err := strconv.ParseInt(inverted, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("잘못된 리더보드 멤버: %s", member)
+	}
+	id, err := strconv.ParseUint(userID, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("잘못된 리더보드 멤버: %s", member)
+	}
+	return &model.LeaderboardEntry{
+		UserID:     uint(id),
+		Score:      int(score),
+		AchievedAt: time.UnixMilli(leaderboardMaxMillis - millis),
+	}, nil
+}
@@ -0,0 +1,508 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"time"
+)
+
+const (
+	// 판매 등록 수수료율 (판매가 또는 경매 시작가 기준)
+	marketListingFeeRate = 0.01
+
+	// 판매세율 (판매 대금 기준)
+	marketSalesTaxRate = 0.05
+
+	// 기본 판매 기간
+	defaultListingDuration = 48 * time.Hour
+
+	// 최소 판매 기간
+	minListingDuration = time.Hour
+
+	// 최대 판매 기간
+	maxListingDuration = 7 * 24 * time.Hour
+)
+
+// 거래소 판매 등록 요청 정보
+type CreateListingInput struct {
+	SellerID    uint
+	InventoryID uint
+	Quantity    int
+	ListingType model.ListingType
+	Price       int
+	StartingBid int
+	Duration    time.Duration
+}
+
+// 거래소 검색 조건
+type ListingSearchFilter struct {
+	ItemID      string
+	ItemType    string
+	Rarity      string
+	ListingType model.ListingType
+	MinLevel    int
+	MaxLevel    int
+	SortBy      string // price_asc, price_desc, ending_soon, newest
+	Limit       int
+	Offset      int
+}
+
+// 아이템 가격 이력 항목
+type PricePoint struct {
+	ListingID   uint              `json:"listing_id"`
+	ListingType model.ListingType `json:"listing_type"`
+	Quantity    int               `json:"quantity"`
+	SoldPrice   int               `json:"sold_price"`
+	UnitPrice   float64           `json:"unit_price"`
+	SoldAt      time.Time         `json:"sold_at"`
+}
+
+// 아이템 가격 이력 요약
+type PriceHistory struct {
+	ItemID       string       `json:"item_id"`
+	Sales        []PricePoint `json:"sales"`
+	TotalSales   int          `json:"total_sales"`
+	AverageUnit  float64      `json:"average_unit_price"`
+	MinUnitPrice float64      `json:"min_unit_price"`
+	MaxUnitPrice float64      `json:"max_unit_price"`
+}
+
+// 거래소(경매장) 비즈니스 로직을 처리하는 서비스
+// 등록된 아이템과 입찰 골드를 에스크로로 보관하며, 등록 수수료와 판매세를 골드 회수 수단으로 부과한다.
+type MarketService struct {
+	db *gorm.DB
+
+	// 인벤토리 통계 캐시 (설정하지 않으면 무효화하지 않음)
+	inventoryStats
+}
+
+// 새로운 MarketService 인스턴스를 생성
+func NewMarketService(db *gorm.DB) *MarketService {
+	return &MarketService{
+		db: db,
+	}
+}
+
+// 아이템을 거래소에 등록
+// 판매자 인벤토리에서 아이템을 차감하고 등록 수수료를 부과한다.
+func (s *MarketService) CreateListing(input *CreateListingInput) (*model.MarketListing, error) {
+	duration := input.Duration
+	if duration <= 0 {
+		duration = defaultListingDuration
+	}
+	if duration < minListingDuration {
+		duration = minListingDuration
+	}
+	if duration > maxListingDuration {
+		duration = maxListingDuration
+	}
+
+	listing := &model.MarketListing{
+		SellerID:    input.SellerID,
+		Quantity:    input.Quantity,
+		ListingType: input.ListingType,
+		Status:      model.ListingStatusActive,
+		EndsAt:      time.Now().Add(duration),
+	}
+	if input.ListingType == model.ListingTypeFixed {
+		listing.Price = input.Price
+	} else {
+		listing.StartingBid = input.StartingBid
+	}
+
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		inventory, err := lockOwnedInventory(tx, input.SellerID, input.InventoryID)
+		if err != nil {
+			return err
+		}
+		if !inventory.CanTrade() {
+			return fmt.Errorf("%w: %s", model.ErrItemNotTradeable, inventory.ItemID)
+		}
+
+		listing.ItemID = inventory.ItemID
+		listing.ItemName = inventory.ItemName
+		listing.ItemType = inventory.ItemType
+		listing.Rarity = inventory.Rarity
+		listing.Level = inventory.Level
+		if err := listing.Validate(); err != nil {
+			return err
+		}
+
+		if err := removeInventoryQuantity(tx, inventory, input.Quantity, model.NewInventoryChange(model.InventorySourceMarket, input.SellerID, "", 0)); err != nil {
+			return err
+		}
+
+		listing.ListingFee = calculateListingFee(listing)
+		if err := adjustUserGold(tx, input.SellerID, -listing.ListingFee); err != nil {
+			return err
+		}
+
+		if err := tx.Create(listing).Error; err != nil {
+			return fmt.Errorf("판매 등록 생성 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return listing, nil
+}
+
+// 고정가 물품을 즉시 구매
+func (s *MarketService) BuyListing(listingID, buyerID uint) (*model.MarketListing, error) {
+	var listing *model.MarketListing
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		var err error
+		listing, err = s.lockActiveListing(tx, listingID)
+		if err != nil {
+			return err
+		}
+		if listing.IsAuction() {
+			return model.ErrNotFixedListing
+		}
+		if listing.SellerID == buyerID {
+			return model.ErrOwnListing
+		}
+
+		if err := adjustUserGold(tx, buyerID, -listing.Price); err != nil {
+			return err
+		}
+		return s.completeSale(tx, listing, buyerID, listing.Price)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return listing, nil
+}
+
+// 경매 물품에 입찰
+// 입찰 골드는 에스크로로 보관되고, 기존 최고 입찰자의 골드는 즉시 반환된다.
+func (s *MarketService) PlaceBid(listingID, bidderID uint, amount int) (*model.MarketListing, error) {
+	var listing *model.MarketListing
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		listing, err = s.lockActiveListing(tx, listingID)
+		if err != nil {
+			return err
+		}
+		if !listing.IsAuction() {
+			return model.ErrNotAuctionListing
+		}
+		if listing.SellerID == bidderID {
+			return model.ErrOwnListing
+		}
+		if amount < listing.MinimumNextBid() {
+			return fmt.Errorf("%w: 최소 %d", model.ErrBidTooLow, listing.MinimumNextBid())
+		}
+
+		if err := adjustUserGold(tx, bidderID, -amount); err != nil {
+			return err
+		}
+
+		// 기존 최고 입찰자에게 골드 반환
+		if listing.HasBids() {
+			if err := adjustUserGold(tx, *listing.CurrentBidderID, listing.CurrentBid); err != nil {
+				return err
+			}
+			if err := tx.Model(&model.MarketBid{}).
+				Where("listing_id = ? AND bidder_id = ? AND amount = ?", listing.ID, *listing.CurrentBidderID, listing.CurrentBid).
+				Update("refunded", true).Error; err != nil {
+				return fmt.Errorf("입찰 반환 기록 중 오류 발생: %w", err)
+			}
+		}
+
+		bid := &model.MarketBid{
+			ListingID: listing.ID,
+			BidderID:  bidderID,
+			Amount:    amount,
+		}
+		if err := tx.Create(bid).Error; err != nil {
+			return fmt.Errorf("입찰 기록 생성 중 오류 발생: %w", err)
+		}
+
+		listing.CurrentBid = amount
+		listing.CurrentBidderID = &bidderID
+		listing.BidCount++
+		if err := tx.Model(listing).Updates(map[string]interface{}{
+			"current_bid":       listing.CurrentBid,
+			"current_bidder_id": listing.CurrentBidderID,
+			"bid_count":         listing.BidCount,
+		}).Error; err != nil {
+			return fmt.Errorf("입찰 정보 업데이트 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return listing, nil
+}
+
+// 판매 등록을 취소하고 아이템을 판매자에게 반환
+// 등록 수수료는 반환되지 않으며, 입찰이 있는 경매는 취소할 수 없다.
+func (s *MarketService) CancelListing(listingID, sellerID uint) error {
+	return s.transaction(s.db, func(tx *gorm.DB) error {
+		var listing model.MarketListing
+		if err := lockForUpdate(tx).First(&listing, listingID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.ErrListingNotFound
+			}
+			return fmt.Errorf("판매 등록 조회 중 오류 발생: %w", err)
+		}
+		if listing.SellerID != sellerID {
+			return model.ErrNotListingSeller
+		}
+		if !listing.IsActive() {
+			return model.ErrListingNotActive
+		}
+		if listing.HasBids() {
+			return model.ErrListingHasBids
+		}
+
+		return s.returnToSeller(tx, &listing, model.ListingStatusCancelled)
+	})
+}
+
+// 판매 기간이 끝난 물품을 처리
+// 입찰이 있는 경매는 낙찰 처리하고, 그 외에는 아이템을 판매자에게 반환한다.
+// 백그라운드 작업에서 주기적으로 호출되며, 처리한 등록 수를 반환
+func (s *MarketService) ProcessEndedListings() (int, error) {
+	var ids []uint
+	if err := s.db.Model(&model.MarketListing{}).
+		Where("status = ? AND ends_at <= ?", model.ListingStatusActive, time.Now()).
+		Pluck("id", &ids).Error; err != nil {
+		return 0, fmt.Errorf("종료된 판매 등록 조회 중 오류 발생: %w", err)
+	}
+
+	processed := 0
+	for _, id := range ids {
+		err := s.transaction(s.db, func(tx *gorm.DB) error {
+			var listing model.MarketListing
+			if err := lockForUpdate(tx).First(&listing, id).Error; err != nil {
+				return err
+			}
+			if !listing.IsActive() || !listing.IsEnded(time.Now()) {
+				return nil
+			}
+
+			processed++
+			if listing.IsAuction() && listing.HasBids() {
+				// 입찰 골드는 이미 에스크로되어 있으므로 낙찰자 차감 없이 정산
+				return s.completeSale(tx, &listing, *listing.CurrentBidderID, listing.CurrentBid)
+			}
+			return s.returnToSeller(tx, &listing, model.ListingStatusExpired)
+		})
+		if err != nil {
+			return processed, fmt.Errorf("판매 종료 처리 중 오류 발생 (listing_id=%d): %w", id, err)
+		}
+	}
+
+	return processed, nil
+}
+
+// ID로 판매 등록을 조회
+func (s *MarketService) GetListingByID(id uint) (*model.MarketListing, error) {
+	var listing model.MarketListing
+	if err := s.db.First(&listing, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrListingNotFound
+		}
+		return nil, fmt.Errorf("판매 등록 조회 중 오류 발생: %w", err)
+	}
+	return &listing, nil
+}
+
+// 판매 중인 물품을 검색
+func (s *MarketService) SearchListings(filter *ListingSearchFilter) ([]model.MarketListing, int64, error) {
+	query := s.db.Model(&model.MarketListing{}).
+		Where("status = ? AND ends_at > ?", model.ListingStatusActive, time.Now())
+
+	if filter.ItemID != "" {
+		query = query.Where("item_id = ?", filter.ItemID)
+	}
+	if filter.ItemType != "" {
+		query = query.Where("item_type = ?", filter.ItemType)
+	}
+	if filter.Rarity != "" {
+		query = query.Where("rarity = ?", filter.Rarity)
+	}
+	if filter.ListingType != "" {
+		query = query.Where("listing_type = ?", filter.ListingType)
+	}
+	if filter.MinLevel > 0 {
+		query = query.Where("level >= ?", filter.MinLevel)
+	}
+	if filter.MaxLevel > 0 {
+		query = query.Where("level <= ?", filter.MaxLevel)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("판매 물품 수 조회 중 오류 발생: %w", err)
+	}
+
+	// 고정가는 판매가, 경매는 현재가(입찰 없으면 시작가) 기준으로 정렬
+	effectivePrice := "CASE WHEN listing_type = 'fixed' THEN price WHEN current_bid > 0 THEN current_bid ELSE starting_bid END"
+	switch filter.SortBy {
+	case "price_asc":
+		query = query.Order(effectivePrice + " ASC")
+	case "price_desc":
+		query = query.Order(effectivePrice + " DESC")
+	case "ending_soon":
+		query = query.Order("ends_at ASC")
+	default:
+		query = query.Order("created_at DESC")
+	}
+
+	var listings []model.MarketListing
+	if err := query.Limit(filter.Limit).Offset(filter.Offset).Find(&listings).Error; err != nil {
+		return nil, 0, fmt.Errorf("판매 물품 검색 중 오류 발생: %w", err)
+	}
+
+	return listings, total, nil
+}
+
+// 판매자의 등록 내역을 최신순으로 조회
+func (s *MarketService) GetSellerListings(sellerID uint, limit, offset int) ([]model.MarketListing, error) {
+	var listings []model.MarketListing
+	if err := s.db.Where("seller_id = ?", sellerID).
+		Order("created_at DESC").
+		Limit(limit).Offset(offset).
+		Find(&listings).Error; err != nil {
+		return nil, fmt.Errorf("판매 등록 내역 조회 중 오류 발생: %w", err)
+	}
+	return listings, nil
+}
+
+// 아이템의 판매 가격 이력을 조회
+func (s *MarketService) GetPriceHistory(itemID string, limit int) (*PriceHistory, error) {
+	var listings []model.MarketListing
+	if err := s.db.Where("item_id = ? AND status = ?", itemID, model.ListingStatusSold).
+		Order("sold_at DESC").
+		Limit(limit).
+		Find(&listings).Error; err != nil {
+		return nil, fmt.Errorf("가격 이력 조회 중 오류 발생: %w", err)
+	}
+
+	history := &PriceHistory{
+		ItemID: itemID,
+		Sales:  make([]PricePoint, 0, len(listings)),
+	}
+
+	var totalUnit float64
+	for i, listing := range listings {
+		unit := listing.UnitPrice()
+		point := PricePoint{
+			ListingID:   listing.ID,
+			ListingType: listing.ListingType,
+			Quantity:    listing.Quantity,
+			SoldPrice:   listing.SoldPrice,
+			UnitPrice:   unit,
+		}
+		if listing.SoldAt != nil {
+			point.SoldAt = *listing.SoldAt
+		}
+		history.Sales = append(history.Sales, point)
+
+		totalUnit += unit
+		if i == 0 || unit < history.MinUnitPrice {
+			history.MinUnitPrice = unit
+		}
+		if unit > history.MaxUnitPrice {
+			history.MaxUnitPrice = unit
+		}
+	}
+
+	history.TotalSales = len(listings)
+	if history.TotalSales > 0 {
+		history.AverageUnit = totalUnit / float64(history.TotalSales)
+	}
+
+	return history, nil
+}
+
+// 판매 중인 등록을 잠금 상태로 조회
+func (s *MarketService) lockActiveListing(tx *gorm.DB, listingID uint) (*model.MarketListing, error) {
+	var listing model.MarketListing
+	if err := lockForUpdate(tx).First(&listing, listingID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrListingNotFound
+		}
+		return nil, fmt.Errorf("판매 등록 조회 중 오류 발생: %w", err)
+	}
+	if !listing.IsActive() {
+		return nil, model.ErrListingNotActive
+	}
+	if listing.IsEnded(time.Now()) {
+		return nil, model.ErrListingEnded
+	}
+	return &listing, nil
+}
+
+// 판매를 완료: 구매자에게 아이템을, 판매자에게 세금을 뺀 대금을 지급
+// 구매자의 골드는 호출 전에 이미 차감(또는 에스크로)되어 있어야 한다.
+func (s *MarketService) completeSale(tx *gorm.DB, listing *model.MarketListing, buyerID uint, price int) error {
+	if _, err := grantInventoryItem(tx, buyerID, listing.ToInventory(buyerID), listing.Quantity,
+		model.NewInventoryChange(model.InventorySourcePurchase, buyerID, "listing", listing.ID)); err != nil {
+		return err
+	}
+
+	tax := calculateSalesTax(price)
+	if err := adjustUserGold(tx, listing.SellerID, price-tax); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	listing.Status = model.ListingStatusSold
+	listing.BuyerID = &buyerID
+	listing.SoldPrice = price
+	listing.TaxAmount = tax
+	listing.SoldAt = &now
+	if err := tx.Model(listing).Updates(map[string]interface{}{
+		"status":     listing.Status,
+		"buyer_id":   listing.BuyerID,
+		"sold_price": listing.SoldPrice,
+		"tax_amount": listing.TaxAmount,
+		"sold_at":    listing.SoldAt,
+	}).Error; err != nil {
+		return fmt.Errorf("판매 완료 처리 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 아이템을 판매자에게 반환하고 판매를 종료
+func (s *MarketService) returnToSeller(tx *gorm.DB, listing *model.MarketListing, status model.ListingStatus) error {
+	if _, err := grantInventoryItem(tx, listing.SellerID, listing.ToInventory(listing.SellerID), listing.Quantity,
+		model.NewInventoryChange(model.InventorySourceMarket, 0, "listing", listing.ID)); err != nil {
+		return err
+	}
+
+	listing.Status = status
+	if err := tx.Model(listing).Update("status", listing.Status).Error; err != nil {
+		return fmt.Errorf("판매 상태 업데이트 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 등록 수수료 계산 (최소 1골드)
+func calculateListingFee(listing *model.MarketListing) int {
+	base := listing.Price
+	if listing.IsAuction() {
+		base = listing.StartingBid
+	}
+	fee := int(float64(base) * marketListingFeeRate)
+	if fee < 1 {
+		fee = 1
+	}
+	return fee
+}
+
+// 판매세 계산
+func calculateSalesTax(price int) int {
+	return int(float64(price) * marketSalesTaxRate)
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"g_dev/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// 판매 기간을 강제로 종료시킴
+func endListing(t *testing.T, db *gorm.DB, listingID uint) {
+	require.NoError(t, db.Model(&model.MarketListing{}).
+		Where("id = ?", listingID).
+		Update("ends_at", time.Now().Add(-time.Minute)).Error)
+}
+
+// 판매 등록 시 아이템 보관과 수수료 부과 테스트
+func TestMarketService_CreateListing(t *testing.T) {
+	db := setupGameTestDB(t, &model.MarketListing{}, &model.MarketBid{})
+	service := NewMarketService(db)
+
+	seller := seedUser(t, db, "seller", 1000)
+	sword := seedItem(t, db, seller.ID, "sword", "rare", 3)
+
+	listing, err := service.CreateListing(&CreateListingInput{
+		SellerID:    seller.ID,
+		InventoryID: sword.ID,
+		Quantity:    2,
+		ListingType: model.ListingTypeFixed,
+		Price:       500,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, model.ListingStatusActive, listing.Status)
+	assert.Equal(t, "rare", listing.Rarity, "아이템 정보가 스냅샷되어야 합니다")
+	assert.Equal(t, 5, listing.ListingFee, "등록 수수료는 판매가의 1%여야 합니다")
+	assert.Equal(t, 995, userGold(t, db, seller.ID))
+	assert.Equal(t, 1, itemQuantity(t, db, seller.ID, "sword"), "등록한 수량만큼 차감되어야 합니다")
+
+	t.Run("귀속 아이템 등록", func(t *testing.T) {
+		bound := seedItem(t, db, seller.ID, "bound_ring", "epic", 1)
+		require.NoError(t, db.Model(bound).Update("is_bound", true).Error)
+
+		_, err := service.CreateListing(&CreateListingInput{
+			SellerID:    seller.ID,
+			InventoryID: bound.ID,
+			Quantity:    1,
+			ListingType: model.ListingTypeFixed,
+			Price:       100,
+		})
+		assert.ErrorIs(t, err, model.ErrItemNotTradeable)
+	})
+
+	t.Run("지정한 행의 아이템 등록", func(t *testing.T) {
+		enhanced := seedItem(t, db, seller.ID, "sword", "rare", 1)
+		require.NoError(t, db.Model(enhanced).Update("level", 7).Error)
+
+		listing, err := service.CreateListing(&CreateListingInput{
+			SellerID:    seller.ID,
+			InventoryID: enhanced.ID,
+			Quantity:    1,
+			ListingType: model.ListingTypeFixed,
+			Price:       900,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 7, listing.Level, "요청한 행의 강화 레벨로 등록되어야 합니다")
+		assert.Equal(t, 1, itemQuantity(t, db, seller.ID, "sword"), "다른 행은 그대로 남아야 합니다")
+
+		var remaining int64
+		require.NoError(t, db.Model(&model.Inventory{}).Where("id = ?", enhanced.ID).Count(&remaining).Error)
+		assert.Equal(t, int64(0), remaining)
+	})
+
+	t.Run("다른 사용자의 아이템 등록", func(t *testing.T) {
+		other := seedUser(t, db, "other", 1000)
+		_, err := service.CreateListing(&CreateListingInput{
+			SellerID:    other.ID,
+			InventoryID: sword.ID,
+			Quantity:    1,
+			ListingType: model.ListingTypeFixed,
+			Price:       100,
+		})
+		assert.ErrorIs(t, err, ErrItemNotOwned)
+	})
+
+	t.Run("보유하지 않은 아이템 등록", func(t *testing.T) {
+		_, err := service.CreateListing(&CreateListingInput{
+			SellerID:    seller.ID,
+			InventoryID: 999,
+			Quantity:    1,
+			ListingType: model.ListingTypeFixed,
+			Price:       100,
+		})
+		assert.ErrorIs(t, err, ErrItemNotOwned)
+	})
+}
+
+// 고정가 구매 시 대금 정산과 판매세 테스트
+func TestMarketService_BuyListing(t *testing.T) {
+	db := setupGameTestDB(t, &model.MarketListing{}, &model.MarketBid{})
+	service := NewMarketService(db)
+
+	seller := seedUser(t, db, "seller", 100)
+	buyer := seedUser(t, db, "buyer", 1000)
+	sword := seedItem(t, db, seller.ID, "sword", "rare", 1)
+
+	listing, err := service.CreateListing(&CreateListingInput{
+		SellerID:    seller.ID,
+		InventoryID: sword.ID,
+		Quantity:    1,
+		ListingType: model.ListingTypeFixed,
+		Price:       400,
+	})
+	require.NoError(t, err)
+
+	_, err = service.BuyListing(listing.ID, seller.ID)
+	assert.ErrorIs(t, err, model.ErrOwnListing, "자신의 물품은 구매할 수 없어야 합니다")
+
+	sold, err := service.BuyListing(listing.ID, buyer.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, model.ListingStatusSold, sold.Status)
+	assert.Equal(t, 20, sold.TaxAmount, "판매세는 판매가의 5%여야 합니다")
+	assert.Equal(t, 600, userGold(t, db, buyer.ID))
+	assert.Equal(t, 100-4+380, userGold(t, db, seller.ID), "판매자는 수수료와 세금을 제외한 금액을 받아야 합니다")
+	assert.Equal(t, 1, itemQuantity(t, db, buyer.ID, "sword"))
+
+	_, err = service.BuyListing(listing.ID, buyer.ID)
+	assert.ErrorIs(t, err, model.ErrListingNotActive, "판매 완료된 물품은 다시 구매할 수 없어야 합니다")
+
+	history, err := service.GetPriceHistory("sword", 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, history.TotalSales)
+	assert.Equal(t, 400.0, history.AverageUnit)
+}
+
+// 경매 입찰과 낙찰 처리 테스트
+func TestMarketService_Auction(t *testing.T) {
+	db := setupGameTestDB(t, &model.MarketListing{}, &model.MarketBid{})
+	service := NewMarketService(db)
+
+	seller := seedUser(t, db, "seller", 100)
+	alice := seedUser(t, db, "alice", 1000)
+	bob := seedUser(t, db, "bob", 1000)
+	sword := seedItem(t, db, seller.ID, "sword", "epic", 1)
+
+	listing, err := service.CreateListing(&CreateListingInput{
+		SellerID:    seller.ID,
+		InventoryID: sword.ID,
+		Quantity:    1,
+		ListingType: model.ListingTypeAuction,
+		StartingBid: 200,
+	})
+	require.NoError(t, err)
+
+	_, err = service.PlaceBid(listing.ID, alice.ID, 150)
+	assert.ErrorIs(t, err, model.ErrBidTooLow, "시작가보다 낮은 입찰은 거부되어야 합니다")
+
+	_, err = service.PlaceBid(listing.ID, alice.ID, 200)
+	require.NoError(t, err)
+	assert.Equal(t, 800, userGold(t, db, alice.ID), "입찰 골드가 에스크로되어야 합니다")
+
+	_, err = service.PlaceBid(listing.ID, bob.ID, 205)
+	assert.ErrorIs(t, err, model.ErrBidTooLow, "최소 증가폭 미만의 입찰은 거부되어야 합니다")
+
+	updated, err := service.PlaceBid(listing.ID, bob.ID, 300)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.BidCount)
+	assert.Equal(t, 1000, userGold(t, db, alice.ID), "상위 입찰 시 이전 입찰자 골드가 반환되어야 합니다")
+	assert.Equal(t, 700, userGold(t, db, bob.ID))
+
+	assert.ErrorIs(t, service.CancelListing(listing.ID, seller.ID), model.ErrListingHasBids)
+
+	endListing(t, db, listing.ID)
+	count, err := service.ProcessEndedListings()
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	result, err := service.GetListingByID(listing.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.ListingStatusSold, result.Status)
+	assert.Equal(t, bob.ID, *result.BuyerID)
+	assert.Equal(t, 1, itemQuantity(t, db, bob.ID, "sword"), "낙찰자에게 아이템이 지급되어야 합니다")
+	assert.Equal(t, 700, userGold(t, db, bob.ID), "낙찰 시 추가 차감이 없어야 합니다")
+	assert.Equal(t, 100-2+285, userGold(t, db, seller.ID))
+}
+
+// 만료 및 취소 시 아이템 반환 테스트
+func TestMarketService_ReturnToSeller(t *testing.T) {
+	db := setupGameTestDB(t, &model.MarketListing{}, &model.MarketBid{})
+	service := NewMarketService(db)
+
+	seller := seedUser(t, db, "seller", 100)
+	other := seedUser(t, db, "other", 100)
+	potion := seedItem(t, db, seller.ID, "potion", "common", 10)
+
+	expiring, err := service.CreateListing(&CreateListingInput{
+		SellerID:    seller.ID,
+		InventoryID: potion.ID,
+		Quantity:    4,
+		ListingType: model.ListingTypeAuction,
+		StartingBid: 10,
+	})
+	require.NoError(t, err)
+
+	cancelling, err := service.CreateListing(&CreateListingInput{
+		SellerID:    seller.ID,
+		InventoryID: potion.ID,
+		Quantity:    6,
+		ListingType: model.ListingTypeFixed,
+		Price:       60,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, itemQuantity(t, db, seller.ID, "potion"))
+
+	assert.ErrorIs(t, service.CancelListing(cancelling.ID, other.ID), model.ErrNotListingSeller)
+	require.NoError(t, service.CancelListing(cancelling.ID, seller.ID))
+	assert.Equal(t, 6, itemQuantity(t, db, seller.ID, "potion"), "취소 시 아이템이 반환되어야 합니다")
+
+	endListing(t, db, expiring.ID)
+	count, err := service.ProcessEndedListings()
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 10, itemQuantity(t, db, seller.ID, "potion"), "만료 시 아이템이 반환되어야 합니다")
+	assert.Equal(t, 98, userGold(t, db, seller.ID), "등록 수수료는 반환되지 않아야 합니다")
+
+	listings, total, err := service.SearchListings(&ListingSearchFilter{Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, listings)
+	assert.Equal(t, int64(0), total)
+}
+
+// 판매 물품 검색 필터와 정렬 테스트
+func TestMarketService_SearchListings(t *testing.T) {
+	db := setupGameTestDB(t, &model.MarketListing{}, &model.MarketBid{})
+	service := NewMarketService(db)
+
+	seller := seedUser(t, db, "seller", 1000)
+	sword := seedItem(t, db, seller.ID, "sword", "rare", 2)
+	axe := seedItem(t, db, seller.ID, "axe", "epic", 1)
+
+	for _, input := range []*CreateListingInput{
+		{SellerID: seller.ID, InventoryID: sword.ID, Quantity: 1, ListingType: model.ListingTypeFixed, Price: 300},
+		{SellerID: seller.ID, InventoryID: sword.ID, Quantity: 1, ListingType: model.ListingTypeFixed, Price: 100},
+		{SellerID: seller.ID, InventoryID: axe.ID, Quantity: 1, ListingType: model.ListingTypeAuction, StartingBid: 200},
+	} {
+		_, err := service.CreateListing(input)
+		require.NoError(t, err)
+	}
+
+	listings, total, err := service.SearchListings(&ListingSearchFilter{Rarity: "rare", SortBy: "price_asc", Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, listings, 2)
+	assert.Equal(t, 100, listings[0].Price, "가격 오름차순으로 정렬되어야 합니다")
+
+	listings, _, err = service.SearchListings(&ListingSearchFilter{SortBy: "price_desc", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, listings, 3)
+	assert.Equal(t, "sword", listings[0].ItemID)
+	assert.Equal(t, "axe", listings[1].ItemID, "경매는 시작가 기준으로 정렬되어야 합니다")
+
+	listings, _, err = service.SearchListings(&ListingSearchFilter{ListingType: model.ListingTypeAuction, Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, listings, 1)
+}
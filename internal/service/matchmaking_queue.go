@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 매치메이킹 대기열 식별자 (게임/모드)
+type MatchmakingQueueKey struct {
+	GameID uint
+	Mode   string
+}
+
+// 매치메이킹 대기열 저장소
+// 티켓은 대기 시작 순으로 보관하며, 사용자는 한 번에 하나의 티켓에만 속할 수 있다.
+type MatchmakingQueue interface {
+	// 티켓을 대기열에 추가 (구성원 중 이미 대기 중인 사용자가 있으면 model.ErrAlreadyQueued)
+	Enqueue(ctx context.Context, ticket *model.MatchmakingTicket, ttl time.Duration) error
+
+	// 사용자가 속한 티켓을 조회 (없으면 model.ErrNotQueued)
+	TicketFor(ctx context.Context, userID uint) (*model.MatchmakingTicket, error)
+
+	// 대기열의 티켓을 대기 시작 순으로 조회 (만료된 티켓은 정리)
+	Tickets(ctx context.Context, key MatchmakingQueueKey) ([]model.MatchmakingTicket, error)
+
+	// 티켓이 모두 대기 중일 때만 함께 제거 (제거되면 true)
+	Claim(ctx context.Context, tickets []model.MatchmakingTicket) (bool, error)
+
+	// 대기 중인 티켓이 있는 대기열 목록
+	Queues(ctx context.Context) ([]MatchmakingQueueKey, error)
+
+	// 사용자의 매칭 결과 알림을 저장/조회/삭제 (없으면 nil)
+	SaveMatchFound(ctx context.Context, userID uint, found *MatchFound, ttl time.Duration) error
+	MatchFound(ctx context.Context, userID uint) (*MatchFound, error)
+	ClearMatchFound(ctx context.Context, userID uint) error
+}
+
+// 매치메이킹 Redis 키
+const (
+	matchmakingQueuesKey = "matchmaking:queues"
+)
+
+// Redis 기반 매치메이킹 대기열
+// 대기열은 대기 시작 시각을 점수로 하는 ZSET, 티켓 내용과 사용자별 티켓 ID는 만료 시간이 있는 문자열 키로 보관한다.
+// 추가와 제거는 Lua 스크립트로 처리해 여러 서버가 같은 티켓을 중복으로 매칭하지 않게 한다.
+type redisMatchmakingQueue struct {
+	client *redis.Client
+}
+
+// Redis 클라이언트로 매치메이킹 대기열을 생성
+func NewRedisMatchmakingQueue(client *redis.Client) MatchmakingQueue {
+	return &redisMatchmakingQueue{client: client}
+}
+
+// 구성원이 모두 대기 중이 아닐 때만 티켓을 추가하는 스크립트
+// KEYS: 대기열 ZSET, 티켓 키, 대기열 목록 SET, 사용자 키... / ARGV: 티켓 ID, 티켓 JSON, 대기 시작(ms), TTL(초), 대기열 이름
+var matchmakingEnqueueScript = redis.NewScript(`
+for i = 4, #KEYS do
+	local existing = redis.call('GET', KEYS[i])
+	if existing and redis.call('EXISTS', 'matchmaking:ticket:' .. existing) == 1 then
+		return 0
+	end
+end
+for i = 4, #KEYS do
+	redis.call('SET', KEYS[i], ARGV[1], 'EX', ARGV[4])
+end
+redis.call('SET', KEYS[2], ARGV[2], 'EX', ARGV[4])
+redis.call('ZADD', KEYS[1], ARGV[3], ARGV[1])
+redis.call('SADD', KEYS[3], ARGV[5])
+return 1
+`)
+
+// 티켓이 모두 대기 중일 때만 함께 제거하는 스크립트
+// KEYS: 대기열 ZSET..., 티켓 키..., 사용자 키... / ARGV: 티켓 수, 티켓 ID..., 사용자 키별 티켓 ID...
+var matchmakingClaimScript = redis.NewScript(`
+local n = tonumber(ARGV[1])
+for i = 1, n do
+	if not redis.call('ZSCORE', KEYS[i], ARGV[1 + i]) then
+		return 0
+	end
+end
+for i = 1, n do
+	redis.call('ZREM', KEYS[i], ARGV[1 + i])
+	redis.call('DEL', KEYS[n + i])
+end
+for i = 2 * n + 1, #KEYS do
+	if redis.call('GET', KEYS[i]) == ARGV[1 + n + (i - 2 * n)] then
+		redis.call('DEL', KEYS[i])
+	end
+end
+return 1
+`)
+
+// 비어 있는 대기열을 목록에서 제거하는 스크립트
+// KEYS: 대기열 ZSET, 대기열 목록 SET / ARGV: 대기열 이름
+var matchmakingPruneScript = redis.NewScript(`
+if redis.call('ZCARD', KEYS[1]) == 0 then
+	redis.call('SREM', KEYS[2], ARGV[1])
+end
+return 1
+`)
+
+func (q *redisMatchmakingQueue) Enqueue(ctx context.Context, ticket *model.MatchmakingTicket, ttl time.Duration) error {
+	data, err := json.Marshal(ticket)
+	if err != nil {
+		return err
+	}
+
+	key := MatchmakingQueueKey{GameID: ticket.GameID, Mode: ticket.Mode}
+	keys := []string{queueKey(key), ticketKey(ticket.TicketID), matchmakingQueuesKey}
+	for _, userID := range ticket.UserIDs {
+		keys = append(keys, matchmakingUserKey(userID))
+	}
+
+	added, err := matchmakingEnqueueScript.Run(ctx, q.client, keys,
+		ticket.TicketID, data, ticket.JoinedAt.UnixMilli(), int64(ttl.Seconds()), key.String()).Int()
+	if err != nil {
+		return err
+	}
+	if added == 0 {
+		return model.ErrAlreadyQueued
+	}
+	return nil
+}
+
+func (q *redisMatchmakingQueue) TicketFor(ctx context.Context, userID uint) (*model.MatchmakingTicket, error) {
+	ticketID, err := q.client.Get(ctx, matchmakingUserKey(userID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, model.ErrNotQueued
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := q.client.Get(ctx, ticketKey(ticketID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, model.ErrNotQueued
+	}
+	if err != nil {
+		return nil, err
+	}
+	var ticket model.MatchmakingTicket
+	if err := json.Unmarshal([]byte(data), &ticket); err != nil {
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+func (q *redisMatchmakingQueue) Tickets(ctx context.Context, key MatchmakingQueueKey) ([]model.MatchmakingTicket, error) {
+	ticketIDs, err := q.client.ZRange(ctx, queueKey(key), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(ticketIDs) == 0 {
+		return nil, matchmakingPruneScript.Run(ctx, q.client, []string{queueKey(key), matchmakingQueuesKey}, key.String()).Err()
+	}
+
+	keys := make([]string, len(ticketIDs))
+	for i, ticketID := range ticketIDs {
+		keys[i] = ticketKey(ticketID)
+	}
+	values, err := q.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	tickets := make([]model.MatchmakingTicket, 0, len(values))
+	var expired []interface{}
+	for i, value := range values {
+		if value == nil {
+			expired = append(expired, ticketIDs[i])
+			continue
+		}
+		var ticket model.MatchmakingTicket
+		if err := json.Unmarshal([]byte(value.(string)), &ticket); err != nil {
+			return nil, err
+		}
+		tickets = append(tickets, ticket)
+	}
+
+	// 만료 시간이 지나 내용이 사라진 티켓은 대기열에서도 제거
+	if len(expired) > 0 {
+		if err := q.client.ZRem(ctx, queueKey(key), expired...).Err(); err != nil {
+			return nil, err
+		}
+	}
+	return tickets, nil
+}
+
+func (q *redisMatchmakingQueue) Claim(ctx context.Context, tickets []model.MatchmakingTicket) (bool, error) {
+	if len(tickets) == 0 {
+		return false, nil
+	}
+
+	var queueKeys, ticketKeys, userKeys []string
+	args := []interface{}{len(tickets)}
+	var owners []interface{}
+	for _, ticket := range tickets {
+		queueKeys = append(queueKeys, queueKey(MatchmakingQueueKey{GameID: ticket.GameID, Mode: ticket.Mode}))
+		ticketKeys = append(ticketKeys, ticketKey(ticket.TicketID))
+		args = append(args, ticket.TicketID)
+		for _, userID := range ticket.UserIDs {
+			userKeys = append(userKeys, matchmakingUserKey(userID))
+			owners = append(owners, ticket.TicketID)
+		}
+	}
+	keys := append(append(queueKeys, ticketKeys...), userKeys...)
+
+	claimed, err := matchmakingClaimScript.Run(ctx, q.client, keys, append(args, owners...)...).Int()
+	if err != nil {
+		return false, err
+	}
+	return claimed == 1, nil
+}
+
+func (q *redisMatchmakingQueue) Queues(ctx context.Context) ([]MatchmakingQueueKey, error) {
+	names, err := q.client.SMembers(ctx, matchmakingQueuesKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]MatchmakingQueueKey, 0, len(names))
+	for _, name := range names {
+		key, err := parseQueueKey(name)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (q *redisMatchmakingQueue) SaveMatchFound(ctx context.Context, userID uint, found *MatchFound, ttl time.Duration) error {
+	data, err := json.Marshal(found)
+	if err != nil {
+		return err
+	}
+	return q.client.Set(ctx, matchFoundKey(userID), data, ttl).Err()
+}
+
+func (q *redisMatchmakingQueue) MatchFound(ctx context.Context, userID uint) (*MatchFound, error) {
+	data, err := q.client.Get(ctx, matchFoundKey(userID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var found MatchFound
+	if err := json.Unmarshal([]byte(data), &found); err != nil {
+		return nil, err
+	}
+	return &found, nil
+}
+
+func (q *redisMatchmakingQueue) ClearMatchFound(ctx context.Context, userID uint) error {
+	return q.client.Del(ctx, matchFoundKey(userID)).Err()
+}
+
+// 대기열 이름 ("게임 ID:모드")
+func (k MatchmakingQueueKey) String() string {
+	return fmt.Sprintf("%d:%s", k.GameID, k.Mode)
+}
+
+// 대기열 이름을 식별자로 변환
+func parseQueueKey(name string) (MatchmakingQueueKey, error) {
+	gameID, mode, ok := strings.Cut(name, ":")
+	if !ok {
+		return MatchmakingQueueKey{}, fmt.Errorf("잘못된 매치메이킹 대기열: %s", name)
+	}
+	id, err := strconv.ParseUint(gameID, 10, 32)
+	if err != nil {
+		return MatchmakingQueueKey{}, fmt.Errorf("잘못된 매치메이킹 대기열: %s", name)
+	}
+	return MatchmakingQueueKey{GameID: uint(id), Mode: mode}, nil
+}
+
+// 대기열 ZSET 키
+func queueKey(key MatchmakingQueueKey) string {
+	return "matchmaking:queue:" + key.String()
+}
+
+// 티켓 내용 키
+func ticketKey(ticketID string) string {
+	return "matchmaking:ticket:" + ticketID
+}
+
+// 사용자별 티켓 ID 키
+func matchmakingUserKey(userID uint) string {
+	return "matchmaking:user:" + strconv.FormatUint(uint64(userID), 10)
+}
+
+// 사용자별 매칭 결과 알림 키
+func matchFoundKey(userID uint) string {
+	return "matchmaking:found:" + strconv.FormatUint(uint64(userID), 10)
+}
@@ -0,0 +1,573 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"log"
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	// 대기열 티켓 유지 시간 (이 시간 안에 매칭되지 않으면 대기열에서 빠짐)
+	matchmakingTicketTTL = 10 * time.Minute
+
+	// 매칭 결과 알림 유지 시간
+	matchFoundTTL = 5 * time.Minute
+)
+
+// 매치메이킹 상태
+const (
+	MatchmakingIdle    = "idle"    // 대기 중이 아님
+	MatchmakingQueued  = "queued"  // 매칭 대기 중
+	MatchmakingMatched = "matched" // 매치가 만들어짐 (세션 토큰 포함)
+)
+
+// 매칭 결과 (참가자별 게임 세션과 토큰 포함)
+type MatchFound struct {
+	Match   *model.Match       `json:"match"`
+	Session *model.GameSession `json:"session"`
+	Token   string             `json:"token"`
+}
+
+// 사용자의 매치메이킹 상태
+type MatchmakingStatus struct {
+	State  string                   `json:"state"`
+	Ticket *model.MatchmakingTicket `json:"ticket,omitempty"`
+
+	// 대기 시간(초)과 현재 허용 레이팅 차이
+	WaitSeconds int     `json:"wait_seconds,omitempty"`
+	Tolerance   float64 `json:"tolerance,omitempty"`
+
+	Match *MatchFound `json:"match,omitempty"`
+}
+
+// 매칭 결과 알림 함수
+type MatchFoundNotifier func(userID uint, found *MatchFound) error
+
+// 매치 생성 중 특정 참가자 때문에 실패한 경우
+type matchParticipantError struct {
+	userID uint
+	err    error
+}
+
+func (e *matchParticipantError) Error() string {
+	return fmt.Sprintf("매치 참가자(user_id=%d) 세션 생성 실패: %v", e.userID, e.err)
+}
+
+func (e *matchParticipantError) Unwrap() error {
+	return e.err
+}
+
+// 멀티플레이 게임 매치메이킹 서비스
+// 게임/모드별 Redis 대기열에서 레이팅이 비슷한 티켓을 게임 최대 인원만큼 묶어 매치를 만들고,
+// 참가자 전원의 게임 세션을 한 트랜잭션에서 생성한다. 허용 레이팅 차이는 대기 시간에 따라 넓어진다.
+// 레이팅은 토너먼트와 같은 게임별 스킬 레이팅을 사용하며, 참가자 전원이 점수를 제출하면 스킬 레이팅에 반영한다.
+type MatchmakingService struct {
+	db       *gorm.DB
+	queue    MatchmakingQueue
+	sessions *GameSessionService
+	friends  *FriendService
+
+	// 스킬 레이팅 (설정하지 않으면 매치 결과를 스킬 레이팅에 반영하지 않음)
+	skillRatings *SkillRatingService
+
+	// 매칭 결과 알림 훅 (nil이면 상태 조회로만 확인)
+	notifier MatchFoundNotifier
+
+	now func() time.Time
+}
+
+// 새로운 MatchmakingService 인스턴스를 생성
+func NewMatchmakingService(db *gorm.DB, queue MatchmakingQueue, sessions *GameSessionService) *MatchmakingService {
+	return &MatchmakingService{
+		db:       db,
+		queue:    queue,
+		sessions: sessions,
+		friends:  NewFriendService(db),
+		now:      time.Now,
+	}
+}
+
+// 매칭 결과 알림 훅을 설정
+func (s *MatchmakingService) SetNotifier(notifier MatchFoundNotifier) {
+	s.notifier = notifier
+}
+
+// 스킬 레이팅 서비스를 설정
+func (s *MatchmakingService) SetSkillRatings(skillRatings *SkillRatingService) {
+	s.skillRatings = skillRatings
+}
+
+// 매치메이킹 대기열에 참가 (파티원은 요청자의 친구여야 함)
+// 참가 직후 대기열을 처리하므로 조건에 맞는 상대가 있으면 바로 매칭된다.
+func (s *MatchmakingService) JoinQueue(userID, gameID uint, mode string, partyIDs []uint) (*model.MatchmakingTicket, error) {
+	if !model.IsMatchMode(mode) {
+		return nil, model.ErrMatchmakingUnsupported
+	}
+
+	var game model.Game
+	if err := s.db.First(&game, gameID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrGameNotFound
+		}
+		return nil, fmt.Errorf("게임 조회 중 오류 발생: %w", err)
+	}
+	if !game.IsPlayable() {
+		return nil, model.ErrGameNotPlayable
+	}
+	if game.MaxPlayers < 2 {
+		return nil, model.ErrMatchmakingUnsupported
+	}
+
+	members, err := s.partyMembers(userID, partyIDs, game.MaxPlayers)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []model.User
+	if err := s.db.Select("id", "level").Where("id IN ?", members).Find(&users).Error; err != nil {
+		return nil, fmt.Errorf("사용자 조회 중 오류 발생: %w", err)
+	}
+	if len(users) != len(members) {
+		return nil, model.ErrInvalidParty
+	}
+	for _, user := range users {
+		if user.Level < game.MinLevel {
+			return nil, model.ErrLevelTooLow
+		}
+	}
+
+	ratings, err := matchRatings(s.db, members, gameID)
+	if err != nil {
+		return nil, err
+	}
+	total := 0.0
+	for _, member := range members {
+		total += ratings[member]
+	}
+
+	ticketID, err := newSessionID()
+	if err != nil {
+		return nil, err
+	}
+	ticket := &model.MatchmakingTicket{
+		TicketID: ticketID,
+		GameID:   gameID,
+		Mode:     mode,
+		LeaderID: userID,
+		UserIDs:  members,
+		Rating:   math.Round(total/float64(len(members))*100) / 100,
+		JoinedAt: s.now(),
+	}
+
+	ctx := context.Background()
+	if err := s.queue.Enqueue(ctx, ticket, matchmakingTicketTTL); err != nil {
+		return nil, err
+	}
+	// 이전 매치의 알림은 새로 대기하면서 정리
+	for _, member := range members {
+		if err := s.queue.ClearMatchFound(ctx, member); err != nil {
+			log.Printf("매칭 알림 정리 실패 (user_id=%d): %v", member, err)
+		}
+	}
+
+	if _, err := s.ProcessQueue(MatchmakingQueueKey{GameID: gameID, Mode: mode}, ticket.JoinedAt); err != nil {
+		log.Printf("매치메이킹 대기열 처리 실패 (game_id=%d, mode=%s): %v", gameID, mode, err)
+	}
+	return ticket, nil
+}
+
+// 매치메이킹 대기열에서 나감 (파티원 누구든 나가면 파티 전체가 빠짐)
+func (s *MatchmakingService) LeaveQueue(userID uint) error {
+	ctx := context.Background()
+	ticket, err := s.queue.TicketFor(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	claimed, err := s.queue.Claim(ctx, []model.MatchmakingTicket{*ticket})
+	if err != nil {
+		return fmt.Errorf("매치메이킹 대기열 처리 중 오류 발생: %w", err)
+	}
+	if !claimed {
+		// 그 사이 매칭되었거나 만료됨
+		return model.ErrNotQueued
+	}
+	return nil
+}
+
+// 사용자의 매치메이킹 상태를 조회
+func (s *MatchmakingService) GetStatus(userID uint) (*MatchmakingStatus, error) {
+	ctx := context.Background()
+	found, err := s.queue.MatchFound(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("매칭 결과 조회 중 오류 발생: %w", err)
+	}
+	if found != nil {
+		return &MatchmakingStatus{State: MatchmakingMatched, Match: found}, nil
+	}
+
+	ticket, err := s.queue.TicketFor(ctx, userID)
+	if errors.Is(err, model.ErrNotQueued) {
+		return &MatchmakingStatus{State: MatchmakingIdle}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("매치메이킹 티켓 조회 중 오류 발생: %w", err)
+	}
+
+	now := s.now()
+	return &MatchmakingStatus{
+		State:       MatchmakingQueued,
+		Ticket:      ticket,
+		WaitSeconds: int(now.Sub(ticket.JoinedAt).Seconds()),
+		Tolerance:   ticket.Tolerance(now),
+	}, nil
+}
+
+// 대기 중인 모든 대기열을 처리하고 만든 매치 수를 반환
+func (s *MatchmakingService) ProcessQueues(now time.Time) (int, error) {
+	keys, err := s.queue.Queues(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("매치메이킹 대기열 조회 중 오류 발생: %w", err)
+	}
+
+	count := 0
+	for _, key := range keys {
+		matched, err := s.ProcessQueue(key, now)
+		count += matched
+		if err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+// 대기열의 티켓을 매치로 묶고 만든 매치 수를 반환
+// 오래 기다린 티켓부터 기준으로 삼아 허용 레이팅 차이 안에서 가까운 티켓으로 인원을 채운다.
+func (s *MatchmakingService) ProcessQueue(key MatchmakingQueueKey, now time.Time) (int, error) {
+	ctx := context.Background()
+	tickets, err := s.queue.Tickets(ctx, key)
+	if err != nil {
+		return 0, fmt.Errorf("매치메이킹 대기열 조회 중 오류 발생: %w", err)
+	}
+	if len(tickets) == 0 {
+		return 0, nil
+	}
+
+	var game model.Game
+	if err := s.db.First(&game, key.GameID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, model.ErrGameNotFound
+		}
+		return 0, fmt.Errorf("게임 조회 중 오류 발생: %w", err)
+	}
+
+	count := 0
+	used := make(map[string]bool)
+	for i := range tickets {
+		if used[tickets[i].TicketID] {
+			continue
+		}
+		group := formMatchGroup(&tickets[i], tickets, used, game.MaxPlayers, now)
+		if group == nil {
+			continue
+		}
+
+		claimed, err := s.queue.Claim(ctx, group)
+		if err != nil {
+			return count, fmt.Errorf("매치메이킹 대기열 처리 중 오류 발생: %w", err)
+		}
+		for _, ticket := range group {
+			used[ticket.TicketID] = true
+		}
+		if !claimed {
+			// 다른 서버가 먼저 매칭했거나 대기열에서 나감
+			continue
+		}
+
+		if err := s.createMatch(&game, key.Mode, group); err != nil {
+			log.Printf("매치 생성 실패 (game_id=%d, mode=%s): %v", key.GameID, key.Mode, err)
+			s.requeue(group, err)
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// 기준 티켓과 허용 레이팅 차이 안의 티켓으로 정확히 매치 인원을 채움 (채우지 못하면 nil)
+// 기준 티켓이 가장 오래 기다렸으므로 기준 티켓의 허용 범위를 사용한다.
+func formMatchGroup(anchor *model.MatchmakingTicket, tickets []model.MatchmakingTicket, used map[string]bool, size int, now time.Time) []model.MatchmakingTicket {
+	tolerance := anchor.Tolerance(now)
+	var candidates []model.MatchmakingTicket
+	for _, ticket := range tickets {
+		if ticket.TicketID == anchor.TicketID || used[ticket.TicketID] {
+			continue
+		}
+		if math.Abs(ticket.Rating-anchor.Rating) <= tolerance {
+			candidates = append(candidates, ticket)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return math.Abs(candidates[i].Rating-anchor.Rating) < math.Abs(candidates[j].Rating-anchor.Rating)
+	})
+
+	group := []model.MatchmakingTicket{*anchor}
+	players := anchor.Size()
+	for _, candidate := range candidates {
+		if players == size {
+			break
+		}
+		if players+candidate.Size() <= size {
+			group = append(group, candidate)
+			players += candidate.Size()
+		}
+	}
+	if players != size {
+		return nil
+	}
+	return group
+}
+
+// 매치와 참가자 전원의 게임 세션을 생성하고 참가자에게 알림
+func (s *MatchmakingService) createMatch(game *model.Game, mode string, group []model.MatchmakingTicket) error {
+	size := 0
+	var userIDs []uint
+	for _, ticket := range group {
+		size += ticket.Size()
+		userIDs = append(userIDs, ticket.UserIDs...)
+	}
+
+	match := &model.Match{GameID: game.ID, Mode: mode, Status: model.MatchInProgress}
+	starts := make(map[uint]*GameSessionStart, size)
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Players").Create(match).Error; err != nil {
+			return fmt.Errorf("매치 생성 중 오류 발생: %w", err)
+		}
+
+		ratings, err := matchRatings(tx, userIDs, game.ID)
+		if err != nil {
+			return err
+		}
+		for _, ticket := range group {
+			for _, userID := range ticket.UserIDs {
+				start, err := s.sessions.OpenSession(tx, userID, &StartSessionRequest{GameID: game.ID, GameMode: mode, PlayerCount: size})
+				if err != nil {
+					return &matchParticipantError{userID: userID, err: err}
+				}
+				starts[userID] = start
+
+				player := model.MatchPlayer{
+					MatchID:   match.ID,
+					UserID:    userID,
+					TicketID:  ticket.TicketID,
+					SessionID: start.Session.SessionID,
+					Rating:    ratings[userID],
+				}
+				if err := tx.Create(&player).Error; err != nil {
+					return fmt.Errorf("매치 참가자 저장 중 오류 발생: %w", err)
+				}
+				match.Players = append(match.Players, player)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := fillMatchNicknames(s.db, match); err != nil {
+		log.Printf("매치 참가자 닉네임 조회 실패 (match_id=%d): %v", match.ID, err)
+	}
+	ctx := context.Background()
+	for _, userID := range userIDs {
+		found := &MatchFound{Match: match, Session: starts[userID].Session, Token: starts[userID].Token}
+		if err := s.queue.SaveMatchFound(ctx, userID, found, matchFoundTTL); err != nil {
+			log.Printf("매칭 알림 저장 실패 (user_id=%d): %v", userID, err)
+		}
+		if s.notifier != nil {
+			if err := s.notifier(userID, found); err != nil {
+				log.Printf("매칭 알림 전송 실패 (user_id=%d): %v", userID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// 매치 생성에 실패한 티켓을 원래 대기 순서로 다시 대기열에 넣음
+// 실패 원인이 된 참가자의 티켓은 다시 넣지 않는다.
+func (s *MatchmakingService) requeue(group []model.MatchmakingTicket, cause error) {
+	var participantErr *matchParticipantError
+	hasParticipant := errors.As(cause, &participantErr)
+
+	ctx := context.Background()
+	for i := range group {
+		ticket := &group[i]
+		if hasParticipant && containsUserID(ticket.UserIDs, participantErr.userID) {
+			continue
+		}
+		if err := s.queue.Enqueue(ctx, ticket, matchmakingTicketTTL); err != nil {
+			log.Printf("매치메이킹 티켓 복구 실패 (ticket_id=%s): %v", ticket.TicketID, err)
+		}
+	}
+}
+
+// 매치 세션의 점수를 기록하고, 참가자 전원이 제출하면 레이팅을 갱신
+// 정상으로 확정된 점수의 제출 또는 검토와 같은 트랜잭션에서 호출된다. 매치 세션이 아니면 아무것도 하지 않는다.
+func (s *MatchmakingService) RecordScore(tx *gorm.DB, score *model.Score) error {
+	return s.recordMatchScore(tx, score.SessionID, score.Score)
+}
+
+// 부정 점수로 확정된 참가자를 0점(기권)으로 기록
+func (s *MatchmakingService) ForfeitScore(tx *gorm.DB, score *model.Score) error {
+	return s.ForfeitSession(tx, score.SessionID)
+}
+
+// 점수를 제출하지 않고 세션이 만료된 참가자를 0점(기권)으로 기록
+func (s *MatchmakingService) ForfeitSession(tx *gorm.DB, sessionID string) error {
+	return s.recordMatchScore(tx, sessionID, 0)
+}
+
+// 세션의 매치 참가자 점수를 기록하고, 모두 기록되면 레이팅을 반영해 매치를 완료
+func (s *MatchmakingService) recordMatchScore(tx *gorm.DB, sessionID string, value int) error {
+	if sessionID == "" {
+		return nil
+	}
+
+	var player model.MatchPlayer
+	err := tx.Where("session_id = ?", sessionID).First(&player).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("매치 참가자 조회 중 오류 발생: %w", err)
+	}
+
+	var match model.Match
+	if err := lockForUpdate(tx).First(&match, player.MatchID).Error; err != nil {
+		return fmt.Errorf("매치 조회 중 오류 발생: %w", err)
+	}
+	if err := tx.Model(&player).Update("score", value).Error; err != nil {
+		return fmt.Errorf("매치 점수 저장 중 오류 발생: %w", err)
+	}
+	if match.Status != model.MatchInProgress {
+		return nil
+	}
+
+	if err := tx.Where("match_id = ?", match.ID).Order("id ASC").Find(&match.Players).Error; err != nil {
+		return fmt.Errorf("매치 참가자 조회 중 오류 발생: %w", err)
+	}
+	if !match.AllScored() {
+		return nil
+	}
+
+	if s.skillRatings != nil {
+		results := make([]MatchResult, len(match.Players))
+		for i := range match.Players {
+			results[i] = MatchResult{UserID: match.Players[i].UserID, Score: *match.Players[i].Score}
+		}
+		ratings, err := s.skillRatings.RecordMatch(tx, match.GameID, &match.ID, results)
+		if err != nil {
+			return err
+		}
+
+		// 매치 시작 시 레이팅 대비 변동량을 참가자 기록에 남김
+		updated := make(map[uint]float64, len(ratings))
+		for _, rating := range ratings {
+			updated[rating.UserID] = rating.Rating
+		}
+		for i := range match.Players {
+			participant := &match.Players[i]
+			delta := math.Round((updated[participant.UserID]-participant.Rating)*100) / 100
+			if err := tx.Model(participant).Update("rating_delta", delta).Error; err != nil {
+				return fmt.Errorf("매치 레이팅 변동 저장 중 오류 발생: %w", err)
+			}
+		}
+	}
+
+	now := s.now()
+	err = tx.Model(&match).Updates(map[string]interface{}{"status": model.MatchCompleted, "completed_at": now}).Error
+	if err != nil {
+		return fmt.Errorf("매치 완료 처리 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 요청자와 파티원으로 구성원 목록을 만듦 (요청자가 첫 번째)
+func (s *MatchmakingService) partyMembers(userID uint, partyIDs []uint, maxPlayers int) ([]uint, error) {
+	members := []uint{userID}
+	for _, id := range partyIDs {
+		if !containsUserID(members, id) {
+			members = append(members, id)
+		}
+	}
+	if len(members) > maxPlayers {
+		return nil, model.ErrInvalidParty
+	}
+	if len(members) == 1 {
+		return members, nil
+	}
+
+	friends, err := s.friends.GetFriendIDs(userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members[1:] {
+		if !containsUserID(friends, member) {
+			return nil, model.ErrInvalidParty
+		}
+	}
+	return members, nil
+}
+
+// 사용자들의 게임 스킬 레이팅을 조회 (기록이 없으면 기본 레이팅)
+func matchRatings(db *gorm.DB, userIDs []uint, gameID uint) (map[uint]float64, error) {
+	var records []model.SkillRating
+	if err := db.Where("user_id IN ? AND game_id = ?", userIDs, gameID).Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("스킬 레이팅 조회 중 오류 발생: %w", err)
+	}
+
+	ratings := make(map[uint]float64, len(userIDs))
+	for _, userID := range userIDs {
+		ratings[userID] = model.DefaultSkillRating
+	}
+	for _, record := range records {
+		ratings[record.UserID] = record.Rating
+	}
+	return ratings, nil
+}
+
+// 매치 참가자 닉네임을 채움
+func fillMatchNicknames(db *gorm.DB, match *model.Match) error {
+	userIDs := make([]uint, len(match.Players))
+	for i := range match.Players {
+		userIDs[i] = match.Players[i].UserID
+	}
+	var users []model.User
+	if err := db.Select("id", "nickname").Where("id IN ?", userIDs).Find(&users).Error; err != nil {
+		return fmt.Errorf("사용자 조회 중 오류 발생: %w", err)
+	}
+	nicknames := make(map[uint]string, len(users))
+	for _, user := range users {
+		nicknames[user.ID] = user.Nickname
+	}
+	for i := range match.Players {
+		match.Players[i].Nickname = nicknames[match.Players[i].UserID]
+	}
+	return nil
+}
+
+// ID 목록에 포함되어 있는지 확인
+func containsUserID(ids []uint, id uint) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
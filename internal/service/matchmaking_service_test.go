@@ -0,0 +1,345 @@
+package service
+
+import (
+	"context"
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"math"
+	"sort"
+	"testing"
+	"time"
+)
+
+// 테스트용 메모리 매치메이킹 대기열 (만료 시간은 무시)
+type memoryMatchmakingQueue struct {
+	tickets map[string]model.MatchmakingTicket
+	users   map[uint]string
+	found   map[uint]*MatchFound
+}
+
+func newMemoryMatchmakingQueue() *memoryMatchmakingQueue {
+	return &memoryMatchmakingQueue{
+		tickets: make(map[string]model.MatchmakingTicket),
+		users:   make(map[uint]string),
+		found:   make(map[uint]*MatchFound),
+	}
+}
+
+func (q *memoryMatchmakingQueue) Enqueue(ctx context.Context, ticket *model.MatchmakingTicket, ttl time.Duration) error {
+	for _, userID := range ticket.UserIDs {
+		if _, ok := q.users[userID]; ok {
+			return model.ErrAlreadyQueued
+		}
+	}
+	for _, userID := range ticket.UserIDs {
+		q.users[userID] = ticket.TicketID
+	}
+	q.tickets[ticket.TicketID] = *ticket
+	return nil
+}
+
+func (q *memoryMatchmakingQueue) TicketFor(ctx context.Context, userID uint) (*model.MatchmakingTicket, error) {
+	ticketID, ok := q.users[userID]
+	if !ok {
+		return nil, model.ErrNotQueued
+	}
+	ticket := q.tickets[ticketID]
+	return &ticket, nil
+}
+
+func (q *memoryMatchmakingQueue) Tickets(ctx context.Context, key MatchmakingQueueKey) ([]model.MatchmakingTicket, error) {
+	var tickets []model.MatchmakingTicket
+	for _, ticket := range q.tickets {
+		if ticket.GameID == key.GameID && ticket.Mode == key.Mode {
+			tickets = append(tickets, ticket)
+		}
+	}
+	sort.Slice(tickets, func(i, j int) bool { return tickets[i].JoinedAt.Before(tickets[j].JoinedAt) })
+	return tickets, nil
+}
+
+func (q *memoryMatchmakingQueue) Claim(ctx context.Context, tickets []model.MatchmakingTicket) (bool, error) {
+	for _, ticket := range tickets {
+		if _, ok := q.tickets[ticket.TicketID]; !ok {
+			return false, nil
+		}
+	}
+	for _, ticket := range tickets {
+		delete(q.tickets, ticket.TicketID)
+		for _, userID := range ticket.UserIDs {
+			delete(q.users, userID)
+		}
+	}
+	return true, nil
+}
+
+func (q *memoryMatchmakingQueue) Queues(ctx context.Context) ([]MatchmakingQueueKey, error) {
+	seen := make(map[MatchmakingQueueKey]bool)
+	var keys []MatchmakingQueueKey
+	for _, ticket := range q.tickets {
+		key := MatchmakingQueueKey{GameID: ticket.GameID, Mode: ticket.Mode}
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (q *memoryMatchmakingQueue) SaveMatchFound(ctx context.Context, userID uint, found *MatchFound, ttl time.Duration) error {
+	q.found[userID] = found
+	return nil
+}
+
+func (q *memoryMatchmakingQueue) MatchFound(ctx context.Context, userID uint) (*MatchFound, error) {
+	return q.found[userID], nil
+}
+
+func (q *memoryMatchmakingQueue) ClearMatchFound(ctx context.Context, userID uint) error {
+	delete(q.found, userID)
+	return nil
+}
+
+func setupMatchmakingTest(t *testing.T) (*gorm.DB, *MatchmakingService, *GameSessionService, *model.User, *model.Game, time.Time) {
+	db, sessions, alice, tetris := setupGameSessionTest(t)
+	require.NoError(t, db.AutoMigrate(&model.Friendship{}, &model.Match{}, &model.MatchPlayer{},
+		&model.SkillRating{}, &model.SkillRatingHistory{}))
+	service := NewMatchmakingService(db, newMemoryMatchmakingQueue(), sessions)
+	service.SetSkillRatings(NewSkillRatingService(db, &model.EloRating{K: model.MatchRatingK}, 7*24*time.Hour))
+	sessions.SetMatchmaking(service)
+
+	now := time.Date(2025, 3, 12, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+	return db, service, sessions, alice, tetris, now
+}
+
+// 테스트용 스킬 레이팅 저장
+func seedSkillRating(t *testing.T, db *gorm.DB, userID, gameID uint, value float64, now time.Time) {
+	rating := model.NewSkillRating(userID, gameID, now)
+	rating.Rating = value
+	rating.Matches = 3
+	require.NoError(t, db.Create(rating).Error)
+}
+
+// 대기열 참가 조건, 대기 시간에 따른 허용 범위 확대, 매치 세션 생성, 레이팅 갱신 테스트
+func TestMatchmakingService_MatchAndRate(t *testing.T) {
+	db, service, sessions, alice, tetris, now := setupMatchmakingTest(t)
+	bob := seedUser(t, db, "bob", 0)
+	seedSkillRating(t, db, bob.ID, tetris.ID, 1800, now)
+
+	solo := newTestGame("Solitaire", model.GameCategoryCard)
+	solo.Status = model.GameStatusActive
+	solo.PlayURL = "https://example.com/solitaire"
+	require.NoError(t, db.Create(solo).Error)
+
+	_, err := service.JoinQueue(alice.ID, tetris.ID, "casual", nil)
+	assert.ErrorIs(t, err, model.ErrMatchmakingUnsupported)
+	_, err = service.JoinQueue(alice.ID, solo.ID, model.MatchModeMultiplayer, nil)
+	assert.ErrorIs(t, err, model.ErrMatchmakingUnsupported, "1인용 게임은 매치메이킹을 지원하지 않아야 합니다")
+	_, err = service.JoinQueue(alice.ID, 999, model.MatchModeMultiplayer, nil)
+	assert.ErrorIs(t, err, model.ErrGameNotFound)
+	_, err = service.JoinQueue(alice.ID, tetris.ID, model.MatchModeMultiplayer, []uint{bob.ID})
+	assert.ErrorIs(t, err, model.ErrInvalidParty, "친구가 아닌 사용자와는 파티를 만들 수 없어야 합니다")
+
+	ticket, err := service.JoinQueue(alice.ID, tetris.ID, model.MatchModeMultiplayer, nil)
+	require.NoError(t, err)
+	assert.Equal(t, float64(model.DefaultSkillRating), ticket.Rating)
+	_, err = service.JoinQueue(alice.ID, tetris.ID, model.MatchModeMultiplayer, nil)
+	assert.ErrorIs(t, err, model.ErrAlreadyQueued)
+
+	// 레이팅 차이(300)가 허용 범위(100)보다 커서 바로 매칭되지 않음
+	_, err = service.JoinQueue(bob.ID, tetris.ID, model.MatchModeMultiplayer, nil)
+	require.NoError(t, err)
+	status, err := service.GetStatus(bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, MatchmakingQueued, status.State)
+	assert.Equal(t, 1800.0, status.Ticket.Rating)
+
+	count, err := service.ProcessQueues(now.Add(30 * time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 0, count, "30초 대기 후 허용 범위는 250이므로 매칭되지 않아야 합니다")
+
+	count, err = service.ProcessQueues(now.Add(40 * time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	aliceStatus, err := service.GetStatus(alice.ID)
+	require.NoError(t, err)
+	bobStatus, err := service.GetStatus(bob.ID)
+	require.NoError(t, err)
+	require.Equal(t, MatchmakingMatched, aliceStatus.State)
+	require.Equal(t, MatchmakingMatched, bobStatus.State)
+	assert.Equal(t, aliceStatus.Match.Match.ID, bobStatus.Match.Match.ID)
+	require.Len(t, aliceStatus.Match.Match.Players, 2)
+	assert.Equal(t, alice.Nickname, aliceStatus.Match.Match.Players[0].Nickname)
+	assert.Equal(t, model.MatchModeMultiplayer, aliceStatus.Match.Session.GameMode)
+	assert.Equal(t, 2, aliceStatus.Match.Session.PlayerCount)
+
+	// 한 명만 점수를 제출하면 레이팅은 그대로
+	_, err = sessions.EndSession(aliceStatus.Match.Token, alice.ID, &ScoreSubmission{Score: 500})
+	require.NoError(t, err)
+	var match model.Match
+	require.NoError(t, db.First(&match, aliceStatus.Match.Match.ID).Error)
+	assert.Equal(t, model.MatchInProgress, match.Status)
+
+	_, err = sessions.EndSession(bobStatus.Match.Token, bob.ID, &ScoreSubmission{Score: 300})
+	require.NoError(t, err)
+	require.NoError(t, db.Preload("Players").First(&match, match.ID).Error)
+	assert.Equal(t, model.MatchCompleted, match.Status)
+	require.NotNil(t, match.CompletedAt)
+
+	// 레이팅이 낮은 쪽이 이겼으므로 스킬 레이팅이 크게 오름
+	gain := math.Round(model.MatchRatingK*(1-1/(1+math.Pow(10, 300.0/400)))*100) / 100
+	var aliceRating, bobRating model.SkillRating
+	require.NoError(t, db.Where("user_id = ? AND game_id = ?", alice.ID, tetris.ID).First(&aliceRating).Error)
+	require.NoError(t, db.Where("user_id = ? AND game_id = ?", bob.ID, tetris.ID).First(&bobRating).Error)
+	assert.InDelta(t, model.DefaultSkillRating+gain, aliceRating.Rating, 1e-9)
+	assert.Equal(t, 1, aliceRating.Matches)
+	assert.InDelta(t, 1800-gain, bobRating.Rating, 1e-9)
+	assert.Equal(t, 4, bobRating.Matches)
+	for _, player := range match.Players {
+		if player.UserID == alice.ID {
+			assert.InDelta(t, gain, player.RatingDelta, 1e-9, "참가자 기록에 변동량이 남아야 합니다")
+		} else {
+			assert.InDelta(t, -gain, player.RatingDelta, 1e-9)
+		}
+	}
+
+	var history model.SkillRatingHistory
+	require.NoError(t, db.Where("user_id = ?", bob.ID).First(&history).Error)
+	assert.Equal(t, match.ID, *history.MatchID)
+	assert.InDelta(t, -gain, history.RatingDelta, 1e-9)
+}
+
+// 대기열 취소와 친구 파티 매칭 테스트
+func TestMatchmakingService_LeaveAndParty(t *testing.T) {
+	db, service, _, alice, tetris, now := setupMatchmakingTest(t)
+	bob := seedUser(t, db, "bob", 0)
+	carol := seedUser(t, db, "carol", 0)
+
+	_, err := service.JoinQueue(alice.ID, tetris.ID, model.MatchModeTournament, nil)
+	require.NoError(t, err)
+	require.NoError(t, service.LeaveQueue(alice.ID))
+	assert.ErrorIs(t, service.LeaveQueue(alice.ID), model.ErrNotQueued)
+	status, err := service.GetStatus(alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, MatchmakingIdle, status.State)
+
+	_, err = service.JoinQueue(alice.ID, tetris.ID, model.MatchModeMultiplayer, []uint{bob.ID, carol.ID})
+	assert.ErrorIs(t, err, model.ErrInvalidParty, "파티 인원이 게임 최대 인원을 넘으면 거부해야 합니다")
+
+	require.NoError(t, db.Create(&model.Friendship{UserID: alice.ID, FriendID: bob.ID, Status: model.FriendshipAccepted}).Error)
+	seedSkillRating(t, db, bob.ID, tetris.ID, 1400, now)
+
+	// 최대 인원을 채운 파티는 바로 매칭됨
+	ticket, err := service.JoinQueue(alice.ID, tetris.ID, model.MatchModeMultiplayer, []uint{bob.ID, bob.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []uint{alice.ID, bob.ID}, ticket.UserIDs)
+	assert.Equal(t, 1450.0, ticket.Rating)
+
+	status, err = service.GetStatus(bob.ID)
+	require.NoError(t, err)
+	require.Equal(t, MatchmakingMatched, status.State)
+	for _, player := range status.Match.Match.Players {
+		assert.Equal(t, ticket.TicketID, player.TicketID)
+	}
+}
+
+// 격리된 매치 점수는 검토 결과에 따라 반영되고 매치가 마무리되는지 테스트
+func TestMatchmakingService_QuarantinedScore(t *testing.T) {
+	db, service, sessions, alice, tetris, _ := setupMatchmakingTest(t)
+	require.NoError(t, db.AutoMigrate(&model.ScoreFlag{}))
+	bob := seedUser(t, db, "bob", 0)
+
+	// 섀도우 밴 규칙만 사용하는 검사기
+	validator := NewScoreValidationService(db)
+	validator.rules = nil
+	validator.RegisterRule(model.ScoreRuleShadowBan, checkShadowBan)
+	validator.SetMatchmaking(service)
+	sessions.SetScoreValidator(validator)
+	require.NoError(t, validator.SetShadowBan(bob.ID, true))
+
+	require.NoError(t, db.Create(&model.Friendship{UserID: alice.ID, FriendID: bob.ID, Status: model.FriendshipAccepted}).Error)
+	_, err := service.JoinQueue(alice.ID, tetris.ID, model.MatchModeMultiplayer, []uint{bob.ID})
+	require.NoError(t, err)
+	aliceStatus, err := service.GetStatus(alice.ID)
+	require.NoError(t, err)
+	bobStatus, err := service.GetStatus(bob.ID)
+	require.NoError(t, err)
+	require.Equal(t, MatchmakingMatched, bobStatus.State)
+
+	_, err = sessions.EndSession(aliceStatus.Match.Token, alice.ID, &ScoreSubmission{Score: 300})
+	require.NoError(t, err)
+	_, err = sessions.EndSession(bobStatus.Match.Token, bob.ID, &ScoreSubmission{Score: 900})
+	require.NoError(t, err)
+
+	// 섀도우 밴 사용자의 점수는 매치에 반영하지 않음
+	var match model.Match
+	require.NoError(t, db.Preload("Players").First(&match, aliceStatus.Match.Match.ID).Error)
+	assert.Equal(t, model.MatchInProgress, match.Status)
+	for _, player := range match.Players {
+		if player.UserID == bob.ID {
+			assert.Nil(t, player.Score, "격리된 점수는 매치 점수로 기록하지 않아야 합니다")
+		}
+	}
+
+	// 부정 점수로 확정되면 기권으로 기록하고 매치를 완료
+	var quarantined model.Score
+	require.NoError(t, db.Where("user_id = ? AND review_status = ?", bob.ID, model.ScoreQuarantined).First(&quarantined).Error)
+	_, err = validator.RejectScore(quarantined.ID, alice.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, db.Preload("Players").First(&match, match.ID).Error)
+	assert.Equal(t, model.MatchCompleted, match.Status)
+	for _, player := range match.Players {
+		require.NotNil(t, player.Score)
+		if player.UserID == bob.ID {
+			assert.Equal(t, 0, *player.Score)
+			assert.Negative(t, player.RatingDelta)
+		} else {
+			assert.Equal(t, 300, *player.Score)
+			assert.Positive(t, player.RatingDelta)
+		}
+	}
+}
+
+// 점수를 내지 않고 나간 참가자는 세션 만료 시 기권으로 기록되어 매치가 마무리되는지 테스트
+func TestMatchmakingService_AbandonedMatch(t *testing.T) {
+	db, service, sessions, alice, tetris, _ := setupMatchmakingTest(t)
+	bob := seedUser(t, db, "bob", 0)
+
+	require.NoError(t, db.Create(&model.Friendship{UserID: alice.ID, FriendID: bob.ID, Status: model.FriendshipAccepted}).Error)
+	_, err := service.JoinQueue(alice.ID, tetris.ID, model.MatchModeMultiplayer, []uint{bob.ID})
+	require.NoError(t, err)
+	aliceStatus, err := service.GetStatus(alice.ID)
+	require.NoError(t, err)
+	require.Equal(t, MatchmakingMatched, aliceStatus.State)
+
+	_, err = sessions.EndSession(aliceStatus.Match.Token, alice.ID, &ScoreSubmission{Score: 300})
+	require.NoError(t, err)
+
+	// bob은 점수를 제출하지 않고 하트비트가 끊김
+	count, err := sessions.ExpireStaleSessions(sessions.now().Add(model.GameSessionHeartbeatTimeout))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	var match model.Match
+	require.NoError(t, db.Preload("Players").First(&match, aliceStatus.Match.Match.ID).Error)
+	assert.Equal(t, model.MatchCompleted, match.Status)
+	for _, player := range match.Players {
+		require.NotNil(t, player.Score)
+		if player.UserID == bob.ID {
+			assert.Equal(t, 0, *player.Score)
+			assert.Negative(t, player.RatingDelta, "기권한 참가자는 패배로 반영해야 합니다")
+		} else {
+			assert.Positive(t, player.RatingDelta)
+		}
+	}
+
+	// 이미 만료된 세션은 다시 처리하지 않음
+	count, err = sessions.ExpireStaleSessions(sessions.now().Add(2 * model.GameSessionHeartbeatTimeout))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
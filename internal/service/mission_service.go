@@ -0,0 +1,498 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"hash/fnv"
+	"sort"
+	"time"
+)
+
+// 주기별로 한 번에 배정하는 기본 미션 수
+const (
+	DefaultDailyMissionCount  = 3
+	DefaultWeeklyMissionCount = 3
+)
+
+// 플레이어에게 보여줄 미션 진행 상황
+type PlayerMission struct {
+	model.Mission
+	PeriodKey   string     `json:"period_key"`
+	Progress    int        `json:"progress"`
+	CompletedAt *time.Time `json:"completed_at"`
+	ClaimedAt   *time.Time `json:"claimed_at"`
+
+	// 진행도가 초기화되는 시각 (이벤트 미션은 종료 시각)
+	ResetAt time.Time `json:"reset_at"`
+}
+
+// 미션 보상 수령 결과
+type MissionClaimResult struct {
+	MissionID  uint             `json:"mission_id"`
+	PeriodKey  string           `json:"period_key"`
+	Gold       int              `json:"gold"`
+	Diamond    int              `json:"diamond"`
+	Experience int              `json:"experience"`
+	Level      int              `json:"level"`
+	Item       *model.Inventory `json:"item,omitempty"`
+}
+
+// 현재 주기에 사용자에게 배정된 미션
+type assignedMission struct {
+	mission   model.Mission
+	periodKey string
+	resetAt   time.Time
+}
+
+// 미션 템플릿, 주기별 배정, 진행도와 보상 수령을 관리하는 서비스
+// 일간/주간 미션은 사용자 시간대의 자정을 기준으로 초기화되며, 진행도는 게임 플레이나 아이템 사용 같은 이벤트가 발생한 트랜잭션 안에서 갱신된다.
+type MissionService struct {
+	db *gorm.DB
+
+	// 주기별 배정 미션 수 (0 이하이면 활성 미션 전체)
+	rotation map[model.MissionPeriod]int
+
+	// 보상 수령 시 패스 경험치를 지급할 배틀패스 (설정하지 않으면 지급하지 않음)
+	battlePass *BattlePassService
+
+	now func() time.Time
+
+	// 인벤토리 통계 캐시 (설정하지 않으면 무효화하지 않음)
+	inventoryStats
+}
+
+// 새로운 MissionService 인스턴스를 생성
+func NewMissionService(db *gorm.DB) *MissionService {
+	return &MissionService{
+		db: db,
+		rotation: map[model.MissionPeriod]int{
+			model.MissionPeriodDaily:  DefaultDailyMissionCount,
+			model.MissionPeriodWeekly: DefaultWeeklyMissionCount,
+		},
+		now: time.Now,
+	}
+}
+
+// 주기별 배정 미션 수를 변경
+func (s *MissionService) SetRotationSize(period model.MissionPeriod, count int) {
+	s.rotation[period] = count
+}
+
+// 배틀패스 서비스를 설정
+func (s *MissionService) SetBattlePass(battlePass *BattlePassService) {
+	s.battlePass = battlePass
+}
+
+// 이벤트로 미션 진행도를 갱신하고 이번에 완료한 미션을 반환
+// 호출자의 트랜잭션 안에서 실행된다.
+func (s *MissionService) Record(tx *gorm.DB, userID uint, events ...model.MissionEvent) ([]model.Mission, error) {
+	user, err := lockUser(tx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.record(tx, user, events)
+}
+
+// 확정된 점수로 플레이 횟수, 획득 골드, 레벨 미션을 갱신
+// 점수 보상이 지급된 뒤에 호출해야 현재 레벨이 반영된다.
+func (s *MissionService) RecordScore(tx *gorm.DB, score *model.Score) ([]model.Mission, error) {
+	var game model.Game
+	if err := tx.Select("id", "category").First(&game, score.GameID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrGameNotFound
+		}
+		return nil, fmt.Errorf("게임 조회 중 오류 발생: %w", err)
+	}
+	user, err := lockUser(tx, score.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.record(tx, user, []model.MissionEvent{
+		{Objective: model.MissionPlayGames, Category: game.Category, Amount: 1},
+		{Objective: model.MissionEarnGold, Amount: score.EarnedGold},
+		{Objective: model.MissionReachLevel, Amount: user.Level},
+	})
+}
+
+// 사용자의 현재 미션 목록을 조회 (일간, 주간, 이벤트 순)
+func (s *MissionService) GetUserMissions(userID uint) ([]PlayerMission, error) {
+	var user model.User
+	if err := s.db.First(&user, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrInvalidUserID
+		}
+		return nil, fmt.Errorf("사용자 조회 중 오류 발생: %w", err)
+	}
+
+	assigned, err := s.assign(s.db, &user, s.now())
+	if err != nil {
+		return nil, err
+	}
+	progresses, err := loadUserMissions(s.db, user.ID, assigned)
+	if err != nil {
+		return nil, err
+	}
+
+	missions := make([]PlayerMission, len(assigned))
+	for i, a := range assigned {
+		missions[i] = PlayerMission{Mission: a.mission, PeriodKey: a.periodKey, ResetAt: a.resetAt}
+		if progress := progresses[userMissionKey(a.mission.ID, a.periodKey)]; progress != nil {
+			missions[i].Progress = progress.Progress
+			missions[i].CompletedAt = progress.CompletedAt
+			missions[i].ClaimedAt = progress.ClaimedAt
+		}
+	}
+	return missions, nil
+}
+
+// 완료한 미션의 보상을 수령
+// 현재 주기에 배정된 미션만 수령할 수 있으며, 초기화된 지난 주기의 미션은 수령할 수 없다.
+func (s *MissionService) ClaimReward(userID, missionID uint) (*MissionClaimResult, error) {
+	var result *MissionClaimResult
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		user, err := lockUser(tx, userID)
+		if err != nil {
+			return err
+		}
+
+		assigned, err := s.assign(tx, user, s.now())
+		if err != nil {
+			return err
+		}
+		var target *assignedMission
+		for i := range assigned {
+			if assigned[i].mission.ID == missionID {
+				target = &assigned[i]
+				break
+			}
+		}
+		if target == nil {
+			return model.ErrMissionNotFound
+		}
+
+		var progress model.UserMission
+		err = lockForUpdate(tx).
+			Where("user_id = ? AND mission_id = ? AND period_key = ?", userID, missionID, target.periodKey).
+			First(&progress).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.ErrMissionNotCompleted
+		}
+		if err != nil {
+			return fmt.Errorf("미션 진행도 조회 중 오류 발생: %w", err)
+		}
+		if !progress.IsCompleted() {
+			return model.ErrMissionNotCompleted
+		}
+		if progress.IsClaimed() {
+			return model.ErrMissionAlreadyClaimed
+		}
+
+		result, err = grantMissionReward(tx, &target.mission, userID)
+		if err != nil {
+			return err
+		}
+		result.PeriodKey = target.periodKey
+
+		now := s.now()
+		progress.ClaimedAt = &now
+		if err := tx.Model(&progress).Update("claimed_at", progress.ClaimedAt).Error; err != nil {
+			return fmt.Errorf("미션 보상 수령 기록 중 오류 발생: %w", err)
+		}
+		if s.battlePass != nil {
+			if err := s.battlePass.RecordMission(tx, userID); err != nil {
+				return err
+			}
+		}
+
+		// 경험치 보상으로 오른 레벨을 레벨 미션에 반영
+		user.Level = result.Level
+		_, err = s.record(tx, user, []model.MissionEvent{{Objective: model.MissionReachLevel, Amount: result.Level}})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// 새로운 미션을 생성 (기간을 지정하면 그 기간에만 배정)
+func (s *MissionService) CreateMission(mission *model.Mission) error {
+	if err := mission.Validate(); err != nil {
+		return err
+	}
+
+	var count int64
+	if err := s.db.Model(&model.Mission{}).Where("code = ?", mission.Code).Count(&count).Error; err != nil {
+		return fmt.Errorf("미션 코드 확인 중 오류 발생: %w", err)
+	}
+	if count > 0 {
+		return model.ErrDuplicateMissionCode
+	}
+
+	if err := s.db.Create(mission).Error; err != nil {
+		return fmt.Errorf("미션 생성 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 미션을 수정
+// 진행 중인 사용자의 진행도는 유지되며, 바뀐 목표 수치는 다음 이벤트부터 적용된다.
+func (s *MissionService) UpdateMission(id uint, updated *model.Mission) (*model.Mission, error) {
+	if err := updated.Validate(); err != nil {
+		return nil, err
+	}
+
+	var mission model.Mission
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&mission, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.ErrMissionNotFound
+			}
+			return fmt.Errorf("미션 조회 중 오류 발생: %w", err)
+		}
+
+		if updated.Code != mission.Code {
+			var count int64
+			if err := tx.Model(&model.Mission{}).Where("code = ? AND id <> ?", updated.Code, id).Count(&count).Error; err != nil {
+				return fmt.Errorf("미션 코드 확인 중 오류 발생: %w", err)
+			}
+			if count > 0 {
+				return model.ErrDuplicateMissionCode
+			}
+		}
+
+		err := tx.Model(&mission).Select(
+			"code", "name", "description", "period", "objective", "category", "item_id", "target",
+			"start_at", "end_at", "is_active", "reward_gold", "reward_diamond", "reward_experience",
+			"reward_item_id", "reward_item_name", "reward_item_type", "reward_rarity", "reward_quantity",
+		).Updates(&model.Mission{
+			Code:             updated.Code,
+			Name:             updated.Name,
+			Description:      updated.Description,
+			Period:           updated.Period,
+			Objective:        updated.Objective,
+			Category:         updated.Category,
+			ItemID:           updated.ItemID,
+			Target:           updated.Target,
+			StartAt:          updated.StartAt,
+			EndAt:            updated.EndAt,
+			IsActive:         updated.IsActive,
+			RewardGold:       updated.RewardGold,
+			RewardDiamond:    updated.RewardDiamond,
+			RewardExperience: updated.RewardExperience,
+			RewardItemID:     updated.RewardItemID,
+			RewardItemName:   updated.RewardItemName,
+			RewardItemType:   updated.RewardItemType,
+			RewardRarity:     updated.RewardRarity,
+			RewardQuantity:   updated.RewardQuantity,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("미션 수정 중 오류 발생: %w", err)
+		}
+		return tx.First(&mission, id).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mission, nil
+}
+
+// 미션을 삭제 (소프트 삭제)
+func (s *MissionService) DeleteMission(id uint) error {
+	result := s.db.Delete(&model.Mission{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("미션 삭제 중 오류 발생: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return model.ErrMissionNotFound
+	}
+	return nil
+}
+
+// 전체 미션 목록을 조회 (관리자용, 비활성/기간 외 포함, period가 비어 있으면 모든 주기)
+func (s *MissionService) GetAllMissions(period model.MissionPeriod, limit, offset int) ([]model.Mission, error) {
+	query := s.db.Model(&model.Mission{})
+	if period != "" {
+		query = query.Where("period = ?", period)
+	}
+
+	var missions []model.Mission
+	if err := query.Order("id ASC").Limit(limit).Offset(offset).Find(&missions).Error; err != nil {
+		return nil, fmt.Errorf("미션 목록 조회 중 오류 발생: %w", err)
+	}
+	return missions, nil
+}
+
+// 이벤트를 배정된 미션에 반영
+func (s *MissionService) record(tx *gorm.DB, user *model.User, events []model.MissionEvent) ([]model.Mission, error) {
+	now := s.now()
+	assigned, err := s.assign(tx, user, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []assignedMission
+	for _, a := range assigned {
+		for i := range events {
+			if a.mission.Matches(&events[i]) {
+				targets = append(targets, a)
+				break
+			}
+		}
+	}
+	if len(targets) == 0 {
+		return nil, nil
+	}
+
+	progresses, err := loadUserMissions(tx, user.ID, targets)
+	if err != nil {
+		return nil, err
+	}
+
+	var completed []model.Mission
+	for i := range targets {
+		mission := &targets[i].mission
+		progress := progresses[userMissionKey(mission.ID, targets[i].periodKey)]
+		if progress == nil {
+			progress = &model.UserMission{UserID: user.ID, MissionID: mission.ID, PeriodKey: targets[i].periodKey}
+		}
+		if progress.IsCompleted() {
+			continue
+		}
+
+		before := progress.Progress
+		done := false
+		for j := range events {
+			if mission.Apply(progress, &events[j], now) {
+				done = true
+				break
+			}
+		}
+		if progress.Progress == before && !done {
+			continue
+		}
+		if err := tx.Save(progress).Error; err != nil {
+			return nil, fmt.Errorf("미션 진행도 저장 중 오류 발생: %w", err)
+		}
+		if done {
+			completed = append(completed, *mission)
+		}
+	}
+	return completed, nil
+}
+
+// 사용자에게 현재 주기에 배정된 미션을 계산
+// 일간/주간 미션은 주기 키로 섞은 순서에서 앞의 일부를 고르므로, 같은 주기에는 항상 같은 미션이 배정된다.
+func (s *MissionService) assign(db *gorm.DB, user *model.User, now time.Time) ([]assignedMission, error) {
+	var missions []model.Mission
+	if err := db.Where("is_active = ?", true).Order("id ASC").Find(&missions).Error; err != nil {
+		return nil, fmt.Errorf("미션 조회 중 오류 발생: %w", err)
+	}
+
+	loc := model.UserLocation(user)
+	pools := make(map[model.MissionPeriod][]model.Mission)
+	for _, mission := range missions {
+		if mission.IsAvailable(now) {
+			pools[mission.Period] = append(pools[mission.Period], mission)
+		}
+	}
+
+	var assigned []assignedMission
+	for _, period := range []model.MissionPeriod{model.MissionPeriodDaily, model.MissionPeriodWeekly, model.MissionPeriodEvent} {
+		pool := pools[period]
+		if len(pool) == 0 {
+			continue
+		}
+		if period != model.MissionPeriodEvent {
+			periodKey, _ := pool[0].Window(now, loc)
+			pool = rotateMissions(pool, periodKey, s.rotation[period])
+		}
+		for _, mission := range pool {
+			periodKey, resetAt := mission.Window(now, loc)
+			assigned = append(assigned, assignedMission{mission: mission, periodKey: periodKey, resetAt: resetAt})
+		}
+	}
+	return assigned, nil
+}
+
+// 주기 키로 섞은 순서에서 count개를 골라 ID 순으로 반환
+func rotateMissions(pool []model.Mission, periodKey string, count int) []model.Mission {
+	if count <= 0 || len(pool) <= count {
+		return pool
+	}
+
+	order := func(mission *model.Mission) uint32 {
+		h := fnv.New32a()
+		h.Write([]byte(periodKey + ":" + mission.Code))
+		return h.Sum32()
+	}
+	shuffled := append([]model.Mission(nil), pool...)
+	sort.SliceStable(shuffled, func(i, j int) bool {
+		return order(&shuffled[i]) < order(&shuffled[j])
+	})
+
+	selected := shuffled[:count]
+	sort.Slice(selected, func(i, j int) bool { return selected[i].ID < selected[j].ID })
+	return selected
+}
+
+// 배정된 미션의 현재 주기 진행도를 조회
+func loadUserMissions(db *gorm.DB, userID uint, assigned []assignedMission) (map[string]*model.UserMission, error) {
+	progresses := make(map[string]*model.UserMission)
+	if len(assigned) == 0 {
+		return progresses, nil
+	}
+
+	// 지난 주기의 진행도까지 읽지 않도록 배정된 (미션, 주기 키) 쌍으로만 조회
+	pairs := make([][]interface{}, len(assigned))
+	for i, a := range assigned {
+		pairs[i] = []interface{}{a.mission.ID, a.periodKey}
+	}
+
+	var rows []model.UserMission
+	if err := db.Where("user_id = ? AND (mission_id, period_key) IN ?", userID, pairs).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("미션 진행도 조회 중 오류 발생: %w", err)
+	}
+	for i := range rows {
+		progresses[userMissionKey(rows[i].MissionID, rows[i].PeriodKey)] = &rows[i]
+	}
+	return progresses, nil
+}
+
+// 미션 진행도 조회 키
+func userMissionKey(missionID uint, periodKey string) string {
+	return fmt.Sprintf("%d:%s", missionID, periodKey)
+}
+
+// 미션 보상을 지급
+func grantMissionReward(tx *gorm.DB, mission *model.Mission, userID uint) (*MissionClaimResult, error) {
+	result := &MissionClaimResult{
+		MissionID:  mission.ID,
+		Gold:       mission.RewardGold,
+		Diamond:    mission.RewardDiamond,
+		Experience: mission.RewardExperience,
+	}
+
+	if err := adjustUserGold(tx, userID, mission.RewardGold); err != nil {
+		return nil, err
+	}
+	if err := adjustUserDiamond(tx, userID, mission.RewardDiamond); err != nil {
+		return nil, err
+	}
+	user, err := adjustUserExperience(tx, userID, mission.RewardExperience)
+	if err != nil {
+		return nil, err
+	}
+	result.Level = user.Level
+
+	if mission.HasItemReward() {
+		change := model.NewInventoryChange(model.InventorySourceMission, userID, "mission", mission.ID)
+		if result.Item, err = grantInventoryItem(tx, userID, mission.ToInventory(userID), mission.RewardQuantity, change); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func setupMissionTest(t *testing.T) (*gorm.DB, *MissionService, *GameSessionService, *model.User, *model.Game) {
+	db, sessions, user, game := setupGameSessionTest(t)
+	require.NoError(t, db.AutoMigrate(&model.Mission{}, &model.UserMission{}))
+
+	service := NewMissionService(db)
+	sessions.SetMissions(service)
+	return db, service, sessions, user, game
+}
+
+// 게임 플레이로 미션을 완료하고 보상을 수령하는 흐름 테스트
+func TestMissionService_ScoreEvents(t *testing.T) {
+	db, service, sessions, alice, game := setupMissionTest(t)
+
+	play := &model.Mission{Code: "puzzle_1", Name: "퍼즐 1판", Period: model.MissionPeriodDaily, Objective: model.MissionPlayGames, Category: model.GameCategoryPuzzle, Target: 1, IsActive: true,
+		RewardGold: 30, RewardItemID: "ticket", RewardItemName: "티켓", RewardItemType: "consumable", RewardRarity: "common", RewardQuantity: 2}
+	gold := &model.Mission{Code: "gold_1000", Name: "골드 1000", Period: model.MissionPeriodWeekly, Objective: model.MissionEarnGold, Target: 1000, IsActive: true}
+	racing := &model.Mission{Code: "racing_1", Name: "레이싱 1판", Period: model.MissionPeriodDaily, Objective: model.MissionPlayGames, Category: model.GameCategoryRacing, Target: 1, IsActive: true}
+	for _, mission := range []*model.Mission{play, gold, racing} {
+		require.NoError(t, service.CreateMission(mission))
+	}
+	assert.ErrorIs(t, service.CreateMission(&model.Mission{Code: "gold_1000", Name: "중복", Period: model.MissionPeriodDaily, Objective: model.MissionEarnGold, Target: 1}), model.ErrDuplicateMissionCode)
+
+	_, err := service.ClaimReward(alice.ID, play.ID)
+	assert.ErrorIs(t, err, model.ErrMissionNotCompleted)
+
+	result := playSession(t, sessions, alice.ID, game.ID, &ScoreSubmission{Score: 500, Completed: true})
+	require.Len(t, result.CompletedMissions, 1)
+	assert.Equal(t, "puzzle_1", result.CompletedMissions[0].Code)
+	goldAfterPlay := userGold(t, db, alice.ID)
+
+	missions, err := service.GetUserMissions(alice.ID)
+	require.NoError(t, err)
+	require.Len(t, missions, 3)
+	progress := make(map[string]PlayerMission)
+	for _, mission := range missions {
+		progress[mission.Code] = mission
+	}
+	assert.NotNil(t, progress["puzzle_1"].CompletedAt)
+	assert.Equal(t, result.Gold, progress["gold_1000"].Progress, "획득 골드가 누적되어야 합니다")
+	assert.Zero(t, progress["racing_1"].Progress, "다른 카테고리 미션은 진행되지 않아야 합니다")
+	assert.True(t, progress["puzzle_1"].ResetAt.After(time.Now()))
+
+	claimed, err := service.ClaimReward(alice.ID, play.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 30, claimed.Gold)
+	require.NotNil(t, claimed.Item)
+	assert.Equal(t, goldAfterPlay+30, userGold(t, db, alice.ID))
+
+	var record model.InventoryLog
+	require.NoError(t, db.Where("user_id = ? AND item_id = ?", alice.ID, "ticket").First(&record).Error)
+	assert.Equal(t, model.InventorySourceMission, record.Source)
+	assert.Equal(t, 2, record.QuantityDelta())
+
+	_, err = service.ClaimReward(alice.ID, play.ID)
+	assert.ErrorIs(t, err, model.ErrMissionAlreadyClaimed)
+	_, err = service.ClaimReward(alice.ID, 999)
+	assert.ErrorIs(t, err, model.ErrMissionNotFound)
+}
+
+// 현지 자정 초기화와 일간 미션 로테이션 테스트
+func TestMissionService_Rotation(t *testing.T) {
+	db, service, _, alice, _ := setupMissionTest(t)
+	require.NoError(t, db.Model(alice).Update("time_zone", "Asia/Seoul").Error)
+	service.SetRotationSize(model.MissionPeriodDaily, 2)
+
+	for _, code := range []string{"d1", "d2", "d3", "d4", "d5"} {
+		require.NoError(t, service.CreateMission(&model.Mission{Code: code, Name: code, Period: model.MissionPeriodDaily, Objective: model.MissionEarnGold, Target: 100, IsActive: true}))
+	}
+
+	// 서울 기준 3월 10일 23시 59분
+	now := time.Date(2025, 3, 10, 14, 59, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+
+	record := func() []model.Mission {
+		var completed []model.Mission
+		require.NoError(t, db.Transaction(func(tx *gorm.DB) error {
+			var err error
+			completed, err = service.Record(tx, alice.ID, model.MissionEvent{Objective: model.MissionEarnGold, Amount: 100})
+			return err
+		}))
+		return completed
+	}
+
+	today, err := service.GetUserMissions(alice.ID)
+	require.NoError(t, err)
+	require.Len(t, today, 2, "주기마다 설정한 수만큼 배정해야 합니다")
+	assert.Equal(t, "2025-03-10", today[0].PeriodKey)
+	assert.True(t, today[0].ResetAt.Equal(time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)), "서울 자정에 초기화되어야 합니다")
+
+	again, err := service.GetUserMissions(alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, today[0].ID, again[0].ID, "같은 주기에는 같은 미션이 배정되어야 합니다")
+	assert.Equal(t, today[1].ID, again[1].ID)
+
+	assert.Len(t, record(), 2)
+
+	// 서울 자정이 지나면 새 주기로 초기화
+	now = now.Add(time.Minute)
+	tomorrow, err := service.GetUserMissions(alice.ID)
+	require.NoError(t, err)
+	require.Len(t, tomorrow, 2)
+	assert.Equal(t, "2025-03-11", tomorrow[0].PeriodKey)
+	for _, mission := range tomorrow {
+		assert.Zero(t, mission.Progress)
+		assert.Nil(t, mission.CompletedAt)
+	}
+
+	// 진행도는 배정된 주기의 기록만 조회
+	loaded, err := loadUserMissions(db, alice.ID, []assignedMission{
+		{mission: model.Mission{BaseModel: model.BaseModel{ID: today[0].ID}}, periodKey: "2025-03-11"},
+		{mission: model.Mission{BaseModel: model.BaseModel{ID: today[1].ID}}, periodKey: "2025-03-10"},
+	})
+	require.NoError(t, err)
+	require.Len(t, loaded, 1, "지난 주기의 진행도는 조회하지 않아야 합니다")
+	assert.Contains(t, loaded, userMissionKey(today[1].ID, "2025-03-10"))
+
+	// 다른 날에는 다른 조합이 배정됨
+	seen := make(map[uint]bool)
+	for day := 0; day < 7; day++ {
+		missions, err := service.GetUserMissions(alice.ID)
+		require.NoError(t, err)
+		for _, mission := range missions {
+			seen[mission.ID] = true
+		}
+		now = now.AddDate(0, 0, 1)
+	}
+	assert.Greater(t, len(seen), 2)
+
+	// 초기화된 지난 주기의 보상은 수령할 수 없음
+	_, err = service.ClaimReward(alice.ID, today[0].ID)
+	assert.Error(t, err)
+}
+
+// 이벤트 퀘스트 기간과 아이템 사용 미션 테스트
+func TestMissionService_EventAndItemUse(t *testing.T) {
+	db, service, _, alice, _ := setupMissionTest(t)
+	require.NoError(t, db.AutoMigrate(&model.ItemEffect{}, &model.ContainerDrop{}))
+	effects := NewItemEffectService(db)
+	effects.SetMissions(service)
+
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+	start, end := now.Add(time.Hour), now.AddDate(0, 0, 7)
+
+	event := &model.Mission{Code: "spring_potion", Name: "봄 물약", Period: model.MissionPeriodEvent, Objective: model.MissionUseItem, ItemID: "potion", Target: 2,
+		StartAt: &start, EndAt: &end, IsActive: true, RewardDiamond: 10}
+	require.NoError(t, service.CreateMission(event))
+	assert.ErrorIs(t, service.CreateMission(&model.Mission{Code: "no_period", Name: "기간 없음", Period: model.MissionPeriodEvent, Objective: model.MissionUseItem, Target: 1}), model.ErrInvalidMission)
+
+	seedItem(t, db, alice.ID, "potion", "common", 3)
+	_, err := effects.UseItem(alice.ID, "potion")
+	require.NoError(t, err)
+
+	missions, err := service.GetUserMissions(alice.ID)
+	require.NoError(t, err)
+	assert.Empty(t, missions, "시작 전 이벤트 퀘스트는 배정되지 않아야 합니다")
+
+	now = start.Add(time.Minute)
+	_, err = effects.UseItem(alice.ID, "potion")
+	require.NoError(t, err)
+	result, err := effects.UseItem(alice.ID, "potion")
+	require.NoError(t, err)
+	require.Len(t, result.CompletedMissions, 1)
+	assert.Equal(t, "spring_potion", result.CompletedMissions[0].Code)
+
+	missions, err = service.GetUserMissions(alice.ID)
+	require.NoError(t, err)
+	require.Len(t, missions, 1)
+	assert.Equal(t, "event", missions[0].PeriodKey)
+	assert.True(t, missions[0].ResetAt.Equal(end))
+
+	claimed, err := service.ClaimReward(alice.ID, event.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 10, claimed.Diamond)
+
+	now = end
+	missions, err = service.GetUserMissions(alice.ID)
+	require.NoError(t, err)
+	assert.Empty(t, missions, "종료된 이벤트 퀘스트는 배정되지 않아야 합니다")
+
+	updated, err := service.UpdateMission(event.ID, &model.Mission{Code: "spring_potion", Name: "봄 물약", Period: model.MissionPeriodDaily, Objective: model.MissionUseItem, Target: 3})
+	require.NoError(t, err)
+	assert.False(t, updated.IsActive)
+	assert.Nil(t, updated.StartAt)
+
+	listed, err := service.GetAllMissions(model.MissionPeriodDaily, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, listed, 1)
+	assert.NoError(t, service.DeleteMission(event.ID))
+	assert.ErrorIs(t, service.DeleteMission(event.ID), model.ErrMissionNotFound)
+}
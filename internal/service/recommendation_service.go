@@ -0,0 +1,318 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"math"
+	"sort"
+	"strings"
+)
+
+// 유사도 계산 기준
+const (
+	similarityMinCoPlayers = 2   // 유사도를 보관하는 최소 공동 플레이어 수
+	similarityTopN         = 20  // 게임마다 보관하는 유사 게임 수
+	similarityBatchSize    = 500 // 한 번에 저장하는 유사도 수
+)
+
+// 추천 점수 가중치
+const (
+	recommendSimilarityWeight = 1.0
+	recommendCategoryWeight   = 0.5
+	recommendDifficultyWeight = 0.2
+	recommendPopularityWeight = 0.1
+)
+
+// 추천 이유 (점수에 가장 크게 기여한 요소)
+const (
+	RecommendReasonSimilar    = "similar_players" // 함께 플레이한 사용자가 많은 게임
+	RecommendReasonCategory   = "category"        // 자주 플레이한 카테고리
+	RecommendReasonDifficulty = "difficulty"      // 자주 플레이한 난이도
+	RecommendReasonPopular    = "popular"         // 인기 게임 (플레이 기록이 없는 사용자)
+)
+
+// 추천 게임
+type GameRecommendation struct {
+	Game   model.Game `json:"game"`
+	Score  float64    `json:"score"`
+	Reason string     `json:"reason"`
+
+	// 유사도 추천의 기준이 된 플레이한 게임
+	BasedOnGameID *uint `json:"based_on_game_id,omitempty"`
+}
+
+// 추천 결과
+type RecommendationResult struct {
+	Recommendations []GameRecommendation `json:"recommendations"`
+
+	// 플레이 기록이 없어 인기도로만 추천했는지 여부
+	ColdStart bool `json:"cold_start"`
+}
+
+// 플레이 기록 기반 개인화 게임 추천 서비스
+// 카테고리/난이도 선호도와 오프라인 배치로 만든 게임 간 공동 플레이 유사도를 합산하며,
+// 플레이 기록이 없는 사용자에게는 인기도 순으로 추천한다.
+type RecommendationService struct {
+	db *gorm.DB
+}
+
+// 새로운 RecommendationService 인스턴스를 생성
+func NewRecommendationService(db *gorm.DB) *RecommendationService {
+	return &RecommendationService{
+		db: db,
+	}
+}
+
+// 사용자에게 추천할 게임 목록을 조회
+// 이미 플레이한 게임, 최소 레벨이 사용자 레벨보다 높은 게임, 지정한 플랫폼을 지원하지 않는 게임은 제외한다.
+func (s *RecommendationService) Recommend(userID uint, platform string, limit int) (*RecommendationResult, error) {
+	var user model.User
+	if err := s.db.Select("id", "level").First(&user, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrInvalidUserID
+		}
+		return nil, fmt.Errorf("사용자 조회 중 오류 발생: %w", err)
+	}
+
+	var history []struct {
+		GameID uint
+		Plays  int
+	}
+	err := s.db.Model(&model.Score{}).Select("game_id, COUNT(*) AS plays").
+		Where("user_id = ? AND review_status = ?", userID, model.ScoreAccepted).
+		Group("game_id").
+		Scan(&history).Error
+	if err != nil {
+		return nil, fmt.Errorf("플레이 기록 조회 중 오류 발생: %w", err)
+	}
+
+	// 게임별 플레이 비중
+	totalPlays := 0
+	playedIDs := make([]uint, len(history))
+	for i, played := range history {
+		playedIDs[i] = played.GameID
+		totalPlays += played.Plays
+	}
+	playShare := make(map[uint]float64, len(history))
+	for _, played := range history {
+		playShare[played.GameID] = float64(played.Plays) / float64(totalPlays)
+	}
+
+	candidates, err := s.candidates(user.Level, platform, playedIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, difficulties, err := s.affinities(playedIDs, playShare)
+	if err != nil {
+		return nil, err
+	}
+	similar, basedOn, err := s.similarScores(playedIDs, playShare)
+	if err != nil {
+		return nil, err
+	}
+
+	maxPopularity := 0.0
+	for i := range candidates {
+		maxPopularity = math.Max(maxPopularity, candidates[i].PopularityScore)
+	}
+
+	recommendations := make([]GameRecommendation, len(candidates))
+	for i := range candidates {
+		game := &candidates[i]
+		popularity := 0.0
+		if maxPopularity > 0 {
+			popularity = game.PopularityScore / maxPopularity
+		}
+
+		// 기여도가 큰 요소를 추천 이유로 (동률이면 앞선 요소)
+		parts := []struct {
+			reason string
+			score  float64
+		}{
+			{RecommendReasonSimilar, similar[game.ID] * recommendSimilarityWeight},
+			{RecommendReasonCategory, categories[game.Category] * recommendCategoryWeight},
+			{RecommendReasonDifficulty, difficulties[game.Difficulty] * recommendDifficultyWeight},
+			{RecommendReasonPopular, popularity * recommendPopularityWeight},
+		}
+		recommendation := GameRecommendation{Game: *game, Reason: RecommendReasonPopular}
+		best := 0.0
+		for _, part := range parts {
+			recommendation.Score += part.score
+			if part.score > best {
+				best = part.score
+				recommendation.Reason = part.reason
+			}
+		}
+		recommendation.Score = math.Round(recommendation.Score*10000) / 10000
+		if recommendation.Reason == RecommendReasonSimilar {
+			source := basedOn[game.ID]
+			recommendation.BasedOnGameID = &source
+		}
+		recommendations[i] = recommendation
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		a, b := &recommendations[i], &recommendations[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Game.PopularityScore != b.Game.PopularityScore {
+			return a.Game.PopularityScore > b.Game.PopularityScore
+		}
+		return a.Game.ID < b.Game.ID
+	})
+	if len(recommendations) > limit {
+		recommendations = recommendations[:limit]
+	}
+
+	return &RecommendationResult{
+		Recommendations: recommendations,
+		ColdStart:       len(history) == 0,
+	}, nil
+}
+
+// 추천 후보 게임을 조회
+func (s *RecommendationService) candidates(level int, platform string, playedIDs []uint) ([]model.Game, error) {
+	query := s.db.Where("status <> ? AND min_level <= ?", model.GameStatusInactive, level)
+	if platform = strings.ToLower(strings.TrimSpace(platform)); platform != "" {
+		query = query.Where("id IN (?)", s.db.Model(&model.GamePlatform{}).Select("game_id").Where("platform = ?", platform))
+	}
+	if len(playedIDs) > 0 {
+		query = query.Where("id NOT IN ?", playedIDs)
+	}
+
+	var games []model.Game
+	if err := query.Find(&games).Error; err != nil {
+		return nil, fmt.Errorf("추천 후보 게임 조회 중 오류 발생: %w", err)
+	}
+	return games, nil
+}
+
+// 플레이한 게임의 카테고리/난이도별 플레이 비중을 계산
+func (s *RecommendationService) affinities(playedIDs []uint, playShare map[uint]float64) (map[model.GameCategory]float64, map[model.GameDifficulty]float64, error) {
+	categories := make(map[model.GameCategory]float64)
+	difficulties := make(map[model.GameDifficulty]float64)
+	if len(playedIDs) == 0 {
+		return categories, difficulties, nil
+	}
+
+	var played []model.Game
+	if err := s.db.Select("id", "category", "difficulty").Where("id IN ?", playedIDs).Find(&played).Error; err != nil {
+		return nil, nil, fmt.Errorf("플레이한 게임 조회 중 오류 발생: %w", err)
+	}
+	for _, game := range played {
+		categories[game.Category] += playShare[game.ID]
+		difficulties[game.Difficulty] += playShare[game.ID]
+	}
+	return categories, difficulties, nil
+}
+
+// 플레이한 게임과 유사한 게임의 점수를 플레이 비중으로 합산
+// 가장 크게 기여한 플레이한 게임을 함께 반환
+func (s *RecommendationService) similarScores(playedIDs []uint, playShare map[uint]float64) (map[uint]float64, map[uint]uint, error) {
+	scores := make(map[uint]float64)
+	basedOn := make(map[uint]uint)
+	if len(playedIDs) == 0 {
+		return scores, basedOn, nil
+	}
+
+	var similarities []model.GameSimilarity
+	if err := s.db.Where("game_id IN ?", playedIDs).Order("game_id ASC").Find(&similarities).Error; err != nil {
+		return nil, nil, fmt.Errorf("게임 유사도 조회 중 오류 발생: %w", err)
+	}
+
+	best := make(map[uint]float64)
+	for _, similarity := range similarities {
+		contribution := similarity.Score * playShare[similarity.GameID]
+		scores[similarity.SimilarGameID] += contribution
+		if contribution > best[similarity.SimilarGameID] {
+			best[similarity.SimilarGameID] = contribution
+			basedOn[similarity.SimilarGameID] = similarity.GameID
+		}
+	}
+	return scores, basedOn, nil
+}
+
+// 정상 점수 기록으로 게임 간 공동 플레이 유사도를 다시 만듦 (오프라인 배치)
+// 저장한 유사도 수를 반환
+func (s *RecommendationService) BuildSimilarities() (int, error) {
+	var plays []struct {
+		UserID uint
+		GameID uint
+	}
+	err := s.db.Model(&model.Score{}).Distinct("user_id", "game_id").
+		Where("review_status = ?", model.ScoreAccepted).
+		Scan(&plays).Error
+	if err != nil {
+		return 0, fmt.Errorf("플레이 기록 조회 중 오류 발생: %w", err)
+	}
+
+	players := make(map[uint]int)
+	gamesByUser := make(map[uint][]uint)
+	for _, play := range plays {
+		players[play.GameID]++
+		gamesByUser[play.UserID] = append(gamesByUser[play.UserID], play.GameID)
+	}
+
+	// 사용자별로 함께 플레이한 게임 쌍을 집계 (작은 ID가 앞)
+	type gamePair struct{ a, b uint }
+	coPlayers := make(map[gamePair]int)
+	for _, games := range gamesByUser {
+		for i := 0; i < len(games); i++ {
+			for j := i + 1; j < len(games); j++ {
+				pair := gamePair{games[i], games[j]}
+				if pair.a > pair.b {
+					pair.a, pair.b = pair.b, pair.a
+				}
+				coPlayers[pair]++
+			}
+		}
+	}
+
+	byGame := make(map[uint][]model.GameSimilarity)
+	for pair, count := range coPlayers {
+		if count < similarityMinCoPlayers {
+			continue
+		}
+		score := math.Round(model.CoPlaySimilarity(count, players[pair.a], players[pair.b])*10000) / 10000
+		byGame[pair.a] = append(byGame[pair.a], model.GameSimilarity{GameID: pair.a, SimilarGameID: pair.b, Score: score, CoPlayers: count})
+		byGame[pair.b] = append(byGame[pair.b], model.GameSimilarity{GameID: pair.b, SimilarGameID: pair.a, Score: score, CoPlayers: count})
+	}
+
+	var similarities []model.GameSimilarity
+	for _, list := range byGame {
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Score != list[j].Score {
+				return list[i].Score > list[j].Score
+			}
+			if list[i].CoPlayers != list[j].CoPlayers {
+				return list[i].CoPlayers > list[j].CoPlayers
+			}
+			return list[i].SimilarGameID < list[j].SimilarGameID
+		})
+		if len(list) > similarityTopN {
+			list = list[:similarityTopN]
+		}
+		similarities = append(similarities, list...)
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&model.GameSimilarity{}).Error; err != nil {
+			return fmt.Errorf("게임 유사도 삭제 중 오류 발생: %w", err)
+		}
+		if len(similarities) == 0 {
+			return nil
+		}
+		if err := tx.CreateInBatches(similarities, similarityBatchSize).Error; err != nil {
+			return fmt.Errorf("게임 유사도 저장 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(similarities), nil
+}
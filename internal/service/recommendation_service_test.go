@@ -0,0 +1,84 @@
+package service
+
+import (
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// 공동 플레이 유사도 생성과 개인화 추천, 인기도 대체 추천 테스트
+func TestRecommendationService_Recommend(t *testing.T) {
+	db, _, alice, tetris := setupGameSessionTest(t)
+	require.NoError(t, db.AutoMigrate(&model.GamePlatform{}, &model.GameSimilarity{}))
+	service := NewRecommendationService(db)
+
+	newGame := func(name string, category model.GameCategory, minLevel int, popularity float64, platforms ...string) *model.Game {
+		game := newTestGame(name, category)
+		game.MinLevel = minLevel
+		game.PopularityScore = popularity
+		require.NoError(t, db.Create(game).Error)
+		for _, platform := range platforms {
+			require.NoError(t, db.Create(&model.GamePlatform{GameID: game.ID, Platform: platform}).Error)
+		}
+		return game
+	}
+	chess := newGame("Chess", model.GameCategoryBoard, 1, 10, "web", "mobile")
+	blocks := newGame("Blocks", model.GameCategoryPuzzle, 1, 5, "web")
+	shooter := newGame("Shooter", model.GameCategoryAction, 1, 100, "web")
+	newGame("Dungeon", model.GameCategoryRPG, 10, 200, "web")
+
+	bob := seedUser(t, db, "bob", 0)
+	carol := seedUser(t, db, "carol", 0)
+	erin := seedUser(t, db, "erin", 0)
+	dave := seedUser(t, db, "dave", 0)
+	seedScore(t, db, alice.ID, tetris.ID, 100, 60, "")
+	for _, user := range []*model.User{bob, carol} {
+		seedScore(t, db, user.ID, tetris.ID, 100, 60, "")
+		seedScore(t, db, user.ID, chess.ID, 100, 60, "")
+	}
+	// 함께 플레이한 사용자가 한 명뿐인 게임 쌍은 유사도로 보관하지 않음
+	seedScore(t, db, erin.ID, tetris.ID, 100, 60, "")
+	seedScore(t, db, erin.ID, blocks.ID, 100, 60, "")
+
+	count, err := service.BuildSimilarities()
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	count, err = service.BuildSimilarities()
+	require.NoError(t, err)
+	assert.Equal(t, 2, count, "다시 만들어도 결과가 같아야 합니다")
+
+	var similarity model.GameSimilarity
+	require.NoError(t, db.Where("game_id = ? AND similar_game_id = ?", tetris.ID, chess.ID).First(&similarity).Error)
+	assert.InDelta(t, 0.7071, similarity.Score, 1e-4)
+	assert.Equal(t, 2, similarity.CoPlayers)
+
+	result, err := service.Recommend(alice.ID, "", 10)
+	require.NoError(t, err)
+	assert.False(t, result.ColdStart)
+	require.Len(t, result.Recommendations, 3, "플레이한 게임과 최소 레벨에 못 미치는 게임은 제외해야 합니다")
+	assert.Equal(t, chess.ID, result.Recommendations[0].Game.ID)
+	assert.Equal(t, RecommendReasonSimilar, result.Recommendations[0].Reason)
+	require.NotNil(t, result.Recommendations[0].BasedOnGameID)
+	assert.Equal(t, tetris.ID, *result.Recommendations[0].BasedOnGameID)
+	assert.Equal(t, blocks.ID, result.Recommendations[1].Game.ID)
+	assert.Equal(t, RecommendReasonCategory, result.Recommendations[1].Reason)
+	assert.Equal(t, shooter.ID, result.Recommendations[2].Game.ID)
+
+	result, err = service.Recommend(alice.ID, "Mobile", 10)
+	require.NoError(t, err)
+	require.Len(t, result.Recommendations, 1)
+	assert.Equal(t, chess.ID, result.Recommendations[0].Game.ID)
+
+	// 플레이 기록이 없으면 인기도 순
+	result, err = service.Recommend(dave.ID, "", 2)
+	require.NoError(t, err)
+	assert.True(t, result.ColdStart)
+	require.Len(t, result.Recommendations, 2)
+	assert.Equal(t, shooter.ID, result.Recommendations[0].Game.ID)
+	assert.Equal(t, RecommendReasonPopular, result.Recommendations[0].Reason)
+	assert.Equal(t, chess.ID, result.Recommendations[1].Game.ID)
+
+	_, err = service.Recommend(999, "", 10)
+	assert.ErrorIs(t, err, model.ErrInvalidUserID)
+}
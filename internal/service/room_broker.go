@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"github.com/redis/go-redis/v9"
+	"log"
+	"time"
+)
+
+// 서버 간 룸 이벤트 종류
+type RoomEventKind string
+
+const (
+	RoomEventDeliver    RoomEventKind = "deliver"    // 다른 서버에 연결된 사용자에게 메시지 전달
+	RoomEventCommand    RoomEventKind = "command"    // 룸을 가진 서버에 사용자 요청 전달
+	RoomEventConnect    RoomEventKind = "connect"    // 사용자가 다른 서버에 (재)접속
+	RoomEventDisconnect RoomEventKind = "disconnect" // 사용자 연결이 끊김
+)
+
+// 서버 간 룸 이벤트
+type RoomEvent struct {
+	Kind   RoomEventKind `json:"kind"`
+	Origin string        `json:"origin"`
+
+	// 받을 서버 (비어 있으면 모든 서버)
+	Target string `json:"target,omitempty"`
+
+	// deliver: 받을 사용자 목록, 그 외: 요청한 사용자
+	UserIDs []uint `json:"user_ids,omitempty"`
+	UserID  uint   `json:"user_id,omitempty"`
+
+	Message *model.RoomMessage `json:"message,omitempty"`
+}
+
+// 여러 서버가 룸을 나눠 가질 때 이벤트를 주고받는 브로커
+// 룸은 만든 서버의 메모리에만 있으며, 다른 서버는 룸 소유 정보로 요청을 보낼 서버를 찾는다.
+type RoomBroker interface {
+	// 모든 서버에 이벤트 발행
+	Publish(ctx context.Context, event *RoomEvent) error
+
+	// 이벤트를 구독해 handle을 호출 (ctx가 끝날 때까지 블로킹)
+	Subscribe(ctx context.Context, handle func(event *RoomEvent)) error
+
+	// 룸을 가진 서버 정보를 저장/조회/삭제 (없으면 빈 문자열)
+	SetRoomOwner(ctx context.Context, roomID, instanceID string, ttl time.Duration) error
+	RoomOwner(ctx context.Context, roomID string) (string, error)
+	RemoveRoomOwner(ctx context.Context, roomID string) error
+}
+
+// 룸 Redis 키
+const (
+	roomEventsChannel  = "rooms:events"
+	roomOwnerKeyPrefix = "rooms:owner:"
+)
+
+// Redis pub/sub 기반 룸 브로커
+type redisRoomBroker struct {
+	client *redis.Client
+}
+
+// Redis 클라이언트로 룸 브로커를 생성
+func NewRedisRoomBroker(client *redis.Client) RoomBroker {
+	return &redisRoomBroker{client: client}
+}
+
+func (b *redisRoomBroker) Publish(ctx context.Context, event *RoomEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("룸 이벤트 직렬화 중 오류 발생: %w", err)
+	}
+	if err := b.client.Publish(ctx, roomEventsChannel, data).Err(); err != nil {
+		return fmt.Errorf("룸 이벤트 발행 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+func (b *redisRoomBroker) Subscribe(ctx context.Context, handle func(event *RoomEvent)) error {
+	pubsub := b.client.Subscribe(ctx, roomEventsChannel)
+	defer pubsub.Close()
+
+	// 구독이 완료된 뒤부터 이벤트를 받음
+	if _, err := pubsub.Receive(ctx); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("룸 이벤트 구독 중 오류 발생: %w", err)
+	}
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			var event RoomEvent
+			if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+				log.Printf("룸 이벤트 해석 실패: %v", err)
+				continue
+			}
+			handle(&event)
+		}
+	}
+}
+
+func (b *redisRoomBroker) SetRoomOwner(ctx context.Context, roomID, instanceID string, ttl time.Duration) error {
+	if err := b.client.Set(ctx, roomOwnerKeyPrefix+roomID, instanceID, ttl).Err(); err != nil {
+		return fmt.Errorf("룸 소유 정보 저장 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+func (b *redisRoomBroker) RoomOwner(ctx context.Context, roomID string) (string, error) {
+	owner, err := b.client.Get(ctx, roomOwnerKeyPrefix+roomID).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("룸 소유 정보 조회 중 오류 발생: %w", err)
+	}
+	return owner, nil
+}
+
+func (b *redisRoomBroker) RemoveRoomOwner(ctx context.Context, roomID string) error {
+	if err := b.client.Del(ctx, roomOwnerKeyPrefix+roomID).Err(); err != nil {
+		return fmt.Errorf("룸 소유 정보 삭제 중 오류 발생: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,878 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// 룸 소유 정보 유지 시간 (정리 작업마다 갱신하므로 서버가 내려가면 곧 만료됨)
+	roomOwnerTTL = time.Minute
+
+	// 브로커 요청 제한 시간
+	roomBrokerTimeout = 3 * time.Second
+
+	// 틱 사이에 쌓아 두는 룸별 최대 입력 수 (넘으면 버림)
+	maxRoomInputs = 1024
+)
+
+// 룸 참가자 연결 (웹소켓 등)
+// Send는 블로킹하지 않아야 한다. 서비스가 잠금 없이 호출하지만 여러 고루틴에서 동시에 호출될 수 있다.
+type RoomConnection interface {
+	Send(msg *model.RoomMessage) error
+	Close()
+}
+
+// 틱 훅에 전달하는 룸 상태
+type RoomTickContext struct {
+	Room   *model.Room
+	Tick   uint64
+	Inputs []model.RoomInput
+	Now    time.Time
+}
+
+// 서버 권한 게임 로직 훅
+// 틱마다 지난 틱 이후의 입력을 받아 참가자에게 보낼 상태를 반환한다.
+// model.ErrRoomGameOver를 반환하면 함께 반환한 상태로 게임 종료를 알리고 룸을 대기 상태로 되돌린다.
+type RoomTickHook func(ctx *RoomTickContext) (json.RawMessage, error)
+
+// 메모리에 보관하는 룸과 다음 틱에 넘길 입력
+type roomState struct {
+	room   *model.Room
+	inputs []model.RoomInput
+}
+
+// 보낼 메시지와 받을 사용자
+type roomDelivery struct {
+	userIDs []uint
+	message *model.RoomMessage
+}
+
+// 실시간 멀티플레이 룸 서비스
+// 룸은 만든 서버의 메모리에만 두고, 참가 인원은 게임 최대 인원으로 제한한다.
+// 참가자 전원이 준비되면 게임을 시작하고, 게임에 틱 훅이 등록되어 있으면 틱마다 입력을 넘겨 결과를 방송한다.
+// 연결이 끊긴 참가자는 재접속 유예 시간 동안 자리를 유지한다.
+// 브로커를 설정하면 다른 서버에 연결된 사용자도 룸 ID로 참가할 수 있다.
+type RoomService struct {
+	db *gorm.DB
+
+	mu    sync.Mutex
+	rooms map[string]*roomState
+
+	// 사용자가 참가한 룸 ID (다른 서버의 룸은 전달받은 메시지로 추적)
+	memberships map[uint]string
+
+	// 이 서버에 연결된 사용자
+	conns map[uint]RoomConnection
+
+	hooks map[uint]RoomTickHook
+
+	// 서버 간 이벤트 브로커 (nil이면 이 서버의 룸만 사용)
+	broker     RoomBroker
+	instanceID string
+
+	reconnectGrace time.Duration
+
+	now func() time.Time
+}
+
+// 새로운 RoomService 인스턴스를 생성
+func NewRoomService(db *gorm.DB, reconnectGrace time.Duration) *RoomService {
+	return &RoomService{
+		db:             db,
+		rooms:          make(map[string]*roomState),
+		memberships:    make(map[uint]string),
+		conns:          make(map[uint]RoomConnection),
+		hooks:          make(map[uint]RoomTickHook),
+		reconnectGrace: reconnectGrace,
+		now:            time.Now,
+	}
+}
+
+// 서버 간 이벤트 브로커를 설정 (이 서버를 구분할 인스턴스 ID를 새로 만듦)
+func (s *RoomService) SetBroker(broker RoomBroker) error {
+	instanceID, err := newSessionID()
+	if err != nil {
+		return fmt.Errorf("룸 서버 인스턴스 ID 생성 중 오류 발생: %w", err)
+	}
+	s.broker = broker
+	s.instanceID = instanceID
+	return nil
+}
+
+// 게임의 틱 훅을 등록 (등록하지 않은 게임은 입력을 다른 참가자에게 그대로 전달)
+func (s *RoomService) RegisterTickHook(gameID uint, hook RoomTickHook) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hooks[gameID] = hook
+}
+
+// 이 서버의 룸 조회
+func (s *RoomService) GetRoom(roomID string) (*model.Room, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.rooms[roomID]
+	if !ok {
+		return nil, model.ErrRoomNotFound
+	}
+	return state.room.Snapshot(), nil
+}
+
+// 사용자 연결을 등록 (기존 연결은 닫음)
+// 재접속 유예 중인 참가자면 룸에 다시 연결하고 룸 상태를 보낸다.
+func (s *RoomService) Connect(userID uint, conn RoomConnection) {
+	s.mu.Lock()
+	old := s.conns[userID]
+	s.conns[userID] = conn
+	deliveries, local := s.reconnectLocked(userID)
+	s.mu.Unlock()
+
+	if old != nil && old != conn {
+		old.Close()
+	}
+	s.dispatch(deliveries)
+
+	// 다른 서버의 룸에 참가 중이었을 수 있으므로 알림
+	if !local {
+		s.publish(&RoomEvent{Kind: RoomEventConnect, UserID: userID})
+	}
+}
+
+// 사용자 연결을 해제 (이미 새 연결로 바뀌었으면 무시)
+// 룸에 참가 중이면 재접속 유예를 시작한다.
+func (s *RoomService) Disconnect(userID uint, conn RoomConnection) {
+	s.mu.Lock()
+	if s.conns[userID] != conn {
+		s.mu.Unlock()
+		return
+	}
+	delete(s.conns, userID)
+	deliveries, deleted, local := s.disconnectLocked(userID)
+	s.mu.Unlock()
+
+	s.dispatch(deliveries)
+	s.removeOwners(deleted)
+	if !local {
+		s.publish(&RoomEvent{Kind: RoomEventDisconnect, UserID: userID})
+	}
+}
+
+// 클라이언트 메시지 처리
+// 다른 서버의 룸에 대한 요청은 그 서버로 전달하며, 처리 실패는 그 서버가 에러 메시지로 알린다.
+func (s *RoomService) HandleMessage(userID uint, msg *model.RoomMessage) error {
+	switch msg.Type {
+	case model.RoomMessagePing:
+		s.dispatch([]roomDelivery{{userIDs: []uint{userID}, message: &model.RoomMessage{Type: model.RoomMessagePong}}})
+		return nil
+	case model.RoomMessageCreate:
+		if current := s.membership(userID); current != "" {
+			return model.ErrAlreadyInRoom
+		}
+		return s.create(userID, msg.GameID)
+	case model.RoomMessageJoin:
+		current := s.membership(userID)
+		if msg.RoomID == "" {
+			if current != "" {
+				return model.ErrAlreadyInRoom
+			}
+			return s.quickJoin(userID, msg.GameID)
+		}
+		if current != "" && current != msg.RoomID {
+			return model.ErrAlreadyInRoom
+		}
+		return s.route(userID, msg.RoomID, msg)
+	case model.RoomMessageLeave, model.RoomMessageReady, model.RoomMessageRelay, model.RoomMessageInput:
+		roomID := s.membership(userID)
+		if roomID == "" {
+			return model.ErrNotInRoom
+		}
+		return s.route(userID, roomID, msg)
+	default:
+		return model.ErrInvalidRoomMessage
+	}
+}
+
+// 재접속 유예가 지난 참가자를 내보내고 이 서버가 가진 룸의 소유 정보를 갱신
+func (s *RoomService) Sweep(now time.Time) error {
+	s.mu.Lock()
+	var deliveries []roomDelivery
+	var deleted, owned []string
+	for id, state := range s.rooms {
+		for _, member := range state.room.Snapshot().Members {
+			if member.Connected || member.DisconnectedAt == nil || now.Sub(*member.DisconnectedAt) < s.reconnectGrace {
+				continue
+			}
+			left, removed := s.leaveLocked(state, member.UserID)
+			deliveries = append(deliveries, left...)
+			if removed {
+				deleted = append(deleted, id)
+				break
+			}
+		}
+		if _, ok := s.rooms[id]; ok {
+			owned = append(owned, id)
+		}
+	}
+	s.mu.Unlock()
+
+	s.dispatch(deliveries)
+	s.removeOwners(deleted)
+
+	if s.broker == nil {
+		return nil
+	}
+	for _, id := range owned {
+		if err := s.setOwner(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 게임이 진행 중인 룸의 틱 훅을 한 번씩 실행
+// 훅은 잠금 밖에서 실행하므로 오래 걸리는 훅이 다른 룸의 메시지 처리를 막지 않는다.
+func (s *RoomService) Tick() {
+	type tickJob struct {
+		roomID string
+		hook   RoomTickHook
+		ctx    *RoomTickContext
+	}
+
+	now := s.now()
+	s.mu.Lock()
+	var jobs []tickJob
+	for id, state := range s.rooms {
+		hook, ok := s.hooks[state.room.GameID]
+		if !ok || state.room.Status != model.RoomPlaying {
+			continue
+		}
+		state.room.Tick++
+		jobs = append(jobs, tickJob{
+			roomID: id,
+			hook:   hook,
+			ctx:    &RoomTickContext{Room: state.room.Snapshot(), Tick: state.room.Tick, Inputs: state.inputs, Now: now},
+		})
+		state.inputs = nil
+	}
+	s.mu.Unlock()
+
+	for _, job := range jobs {
+		payload, err := job.hook(job.ctx)
+		if err != nil && !errors.Is(err, model.ErrRoomGameOver) {
+			log.Printf("룸 틱 처리 실패 (room_id=%s, tick=%d): %v", job.roomID, job.ctx.Tick, err)
+			continue
+		}
+
+		s.mu.Lock()
+		state, ok := s.rooms[job.roomID]
+		if !ok || state.room.Status != model.RoomPlaying {
+			s.mu.Unlock()
+			continue
+		}
+		room := state.room
+		message := &model.RoomMessage{Type: model.RoomMessageTick, RoomID: room.ID, Tick: job.ctx.Tick, Payload: payload}
+		if err != nil {
+			room.Status = model.RoomWaiting
+			room.StartedAt = nil
+			for i := range room.Members {
+				room.Members[i].Ready = false
+			}
+			state.inputs = nil
+			message = &model.RoomMessage{Type: model.RoomMessageGameOver, RoomID: room.ID, Tick: job.ctx.Tick, Payload: payload, Room: room.Snapshot()}
+		}
+		deliveries := []roomDelivery{{userIDs: room.MemberIDs(), message: message}}
+		s.mu.Unlock()
+
+		s.dispatch(deliveries)
+	}
+}
+
+// ctx가 끝날 때까지 주기적으로 틱을 실행
+func (s *RoomService) RunTicks(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Tick()
+		}
+	}
+}
+
+// 룸과 관계없이 사용자에게 알림을 보냄 (payload는 JSON으로 변환)
+// 다른 서버에 연결된 사용자에게는 브로커로 전달하며, 연결이 없으면 버려진다.
+func (s *RoomService) Notify(userID uint, msgType model.RoomMessageType, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("룸 알림 변환 중 오류 발생: %w", err)
+	}
+	s.dispatch([]roomDelivery{{userIDs: []uint{userID}, message: &model.RoomMessage{Type: msgType, UserID: userID, Payload: data}}})
+	return nil
+}
+
+// 다른 서버의 룸 이벤트를 ctx가 끝날 때까지 처리 (브로커가 없으면 바로 반환)
+func (s *RoomService) Listen(ctx context.Context) error {
+	if s.broker == nil {
+		return nil
+	}
+	return s.broker.Subscribe(ctx, s.handleEvent)
+}
+
+// 다른 서버에서 온 이벤트 처리
+func (s *RoomService) handleEvent(event *RoomEvent) {
+	if event.Origin == s.instanceID || (event.Target != "" && event.Target != s.instanceID) {
+		return
+	}
+
+	switch event.Kind {
+	case RoomEventDeliver:
+		if event.Message == nil {
+			return
+		}
+		for _, userID := range event.UserIDs {
+			s.deliverLocal(userID, event.Message)
+		}
+	case RoomEventCommand:
+		if event.Message == nil {
+			return
+		}
+		if err := s.apply(event.UserID, event.Message); err != nil {
+			s.dispatch([]roomDelivery{{userIDs: []uint{event.UserID}, message: roomErrorMessage(err)}})
+		}
+	case RoomEventConnect:
+		s.mu.Lock()
+		deliveries, _ := s.reconnectLocked(event.UserID)
+		s.mu.Unlock()
+		s.dispatch(deliveries)
+	case RoomEventDisconnect:
+		s.mu.Lock()
+		deliveries, deleted, _ := s.disconnectLocked(event.UserID)
+		s.mu.Unlock()
+		s.dispatch(deliveries)
+		s.removeOwners(deleted)
+	}
+}
+
+// 룸을 가진 서버에서 요청 처리 (이 서버의 룸이 아니면 소유 서버로 전달)
+func (s *RoomService) route(userID uint, roomID string, msg *model.RoomMessage) error {
+	s.mu.Lock()
+	_, local := s.rooms[roomID]
+	s.mu.Unlock()
+	if local {
+		return s.apply(userID, msg)
+	}
+	if s.broker == nil {
+		return model.ErrRoomNotFound
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), roomBrokerTimeout)
+	defer cancel()
+	owner, err := s.broker.RoomOwner(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	if owner == "" || owner == s.instanceID {
+		return model.ErrRoomNotFound
+	}
+
+	forwarded := *msg
+	forwarded.RoomID = roomID
+	if err := s.broker.Publish(ctx, &RoomEvent{Kind: RoomEventCommand, Origin: s.instanceID, Target: owner, UserID: userID, Message: &forwarded}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// 이 서버의 룸에 대한 요청 처리
+func (s *RoomService) apply(userID uint, msg *model.RoomMessage) error {
+	switch msg.Type {
+	case model.RoomMessageJoin:
+		return s.join(userID, msg.RoomID)
+	case model.RoomMessageLeave:
+		return s.leave(userID)
+	case model.RoomMessageReady:
+		var payload model.RoomReadyPayload
+		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+			return model.ErrInvalidRoomMessage
+		}
+		return s.setReady(userID, payload.Ready)
+	case model.RoomMessageRelay:
+		return s.relay(userID, msg)
+	case model.RoomMessageInput:
+		return s.input(userID, msg.Payload)
+	default:
+		return model.ErrInvalidRoomMessage
+	}
+}
+
+// 룸 생성 (만든 사용자가 방장)
+func (s *RoomService) create(userID, gameID uint) error {
+	game, err := s.roomGame(gameID)
+	if err != nil {
+		return err
+	}
+	nickname, err := s.nickname(userID)
+	if err != nil {
+		return err
+	}
+	roomID, err := newSessionID()
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+	room := &model.Room{
+		ID:         roomID,
+		GameID:     game.ID,
+		HostID:     userID,
+		MaxPlayers: game.MaxPlayers,
+		Status:     model.RoomWaiting,
+		Members:    []model.RoomMember{{UserID: userID, Nickname: nickname, Connected: true, JoinedAt: now}},
+		CreatedAt:  now,
+	}
+
+	s.mu.Lock()
+	if _, ok := s.memberships[userID]; ok {
+		s.mu.Unlock()
+		return model.ErrAlreadyInRoom
+	}
+	s.rooms[roomID] = &roomState{room: room}
+	s.memberships[userID] = roomID
+	snapshot := room.Snapshot()
+	s.mu.Unlock()
+
+	if s.broker != nil {
+		if err := s.setOwner(roomID); err != nil {
+			log.Printf("룸 소유 정보 저장 실패 (room_id=%s): %v", roomID, err)
+		}
+	}
+	s.dispatch([]roomDelivery{{userIDs: []uint{userID}, message: &model.RoomMessage{Type: model.RoomMessageState, RoomID: roomID, Room: snapshot}}})
+	return nil
+}
+
+// 게임의 대기 중인 룸 중 가장 오래된 룸에 참가 (없으면 새로 만듦)
+// 이 서버의 룸만 찾는다.
+func (s *RoomService) quickJoin(userID, gameID uint) error {
+	if gameID == 0 {
+		return model.ErrInvalidRoomMessage
+	}
+
+	s.mu.Lock()
+	var candidates []*model.Room
+	for _, state := range s.rooms {
+		if state.room.GameID == gameID && state.room.IsJoinable() {
+			candidates = append(candidates, state.room)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].CreatedAt.Before(candidates[j].CreatedAt)
+	})
+	roomID := ""
+	if len(candidates) > 0 {
+		roomID = candidates[0].ID
+	}
+	s.mu.Unlock()
+
+	if roomID == "" {
+		return s.create(userID, gameID)
+	}
+	return s.join(userID, roomID)
+}
+
+// 룸 참가 (이미 참가한 룸이면 룸 상태만 다시 보냄)
+func (s *RoomService) join(userID uint, roomID string) error {
+	nickname, err := s.nickname(userID)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	state, ok := s.rooms[roomID]
+	if !ok {
+		s.mu.Unlock()
+		return model.ErrRoomNotFound
+	}
+	room := state.room
+	if current, ok := s.memberships[userID]; ok {
+		s.mu.Unlock()
+		if current != roomID {
+			return model.ErrAlreadyInRoom
+		}
+		s.dispatch([]roomDelivery{{userIDs: []uint{userID}, message: &model.RoomMessage{Type: model.RoomMessageState, RoomID: roomID, Room: s.snapshot(roomID)}}})
+		return nil
+	}
+	if room.Status != model.RoomWaiting {
+		s.mu.Unlock()
+		return model.ErrRoomPlaying
+	}
+	if len(room.Members) >= room.MaxPlayers {
+		s.mu.Unlock()
+		return model.ErrRoomFull
+	}
+
+	others := room.MemberIDs()
+	room.Members = append(room.Members, model.RoomMember{UserID: userID, Nickname: nickname, Connected: true, JoinedAt: s.now()})
+	s.memberships[userID] = roomID
+	snapshot := room.Snapshot()
+	s.mu.Unlock()
+
+	s.dispatch([]roomDelivery{
+		{userIDs: []uint{userID}, message: &model.RoomMessage{Type: model.RoomMessageState, RoomID: roomID, Room: snapshot}},
+		{userIDs: others, message: &model.RoomMessage{Type: model.RoomMessageMemberJoined, RoomID: roomID, UserID: userID, Room: snapshot}},
+	})
+	return nil
+}
+
+// 룸 나가기 (마지막 참가자가 나가면 룸 삭제)
+func (s *RoomService) leave(userID uint) error {
+	s.mu.Lock()
+	state, err := s.memberRoomLocked(userID)
+	if err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	deliveries, removed := s.leaveLocked(state, userID)
+	s.mu.Unlock()
+
+	s.dispatch(deliveries)
+	if removed {
+		s.removeOwners([]string{state.room.ID})
+	}
+	return nil
+}
+
+// 준비 상태 변경 (전원이 준비되면 게임 시작)
+func (s *RoomService) setReady(userID uint, ready bool) error {
+	s.mu.Lock()
+	state, err := s.memberRoomLocked(userID)
+	if err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	room := state.room
+	if room.Status != model.RoomWaiting {
+		s.mu.Unlock()
+		return model.ErrRoomPlaying
+	}
+
+	room.Member(userID).Ready = ready
+	deliveries := []roomDelivery{{
+		userIDs: room.MemberIDs(),
+		message: &model.RoomMessage{Type: model.RoomMessageMemberReady, RoomID: room.ID, UserID: userID, Room: room.Snapshot()},
+	}}
+	if room.AllReady() {
+		startedAt := s.now()
+		room.Status = model.RoomPlaying
+		room.Tick = 0
+		room.StartedAt = &startedAt
+		state.inputs = nil
+		deliveries = append(deliveries, roomDelivery{
+			userIDs: room.MemberIDs(),
+			message: &model.RoomMessage{Type: model.RoomMessageGameStarted, RoomID: room.ID, Room: room.Snapshot()},
+		})
+	}
+	s.mu.Unlock()
+
+	s.dispatch(deliveries)
+	return nil
+}
+
+// 다른 참가자에게 메시지 전달 (대상이 있으면 그 참가자에게만)
+func (s *RoomService) relay(userID uint, msg *model.RoomMessage) error {
+	s.mu.Lock()
+	state, err := s.memberRoomLocked(userID)
+	if err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	room := state.room
+	var recipients []uint
+	if msg.TargetUserID != 0 {
+		if msg.TargetUserID == userID || room.Member(msg.TargetUserID) == nil {
+			s.mu.Unlock()
+			return model.ErrInvalidRoomMessage
+		}
+		recipients = []uint{msg.TargetUserID}
+	} else {
+		recipients = otherMembers(room, userID)
+	}
+	s.mu.Unlock()
+
+	s.dispatch([]roomDelivery{{
+		userIDs: recipients,
+		message: &model.RoomMessage{Type: model.RoomMessageRelay, RoomID: room.ID, UserID: userID, TargetUserID: msg.TargetUserID, Payload: msg.Payload},
+	}})
+	return nil
+}
+
+// 게임 입력 처리
+// 틱 훅이 있는 게임은 다음 틱에 넘기고, 없는 게임은 다른 참가자에게 그대로 전달한다.
+func (s *RoomService) input(userID uint, payload json.RawMessage) error {
+	s.mu.Lock()
+	state, err := s.memberRoomLocked(userID)
+	if err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	room := state.room
+	if room.Status != model.RoomPlaying {
+		s.mu.Unlock()
+		return model.ErrRoomNotPlaying
+	}
+	if _, ok := s.hooks[room.GameID]; ok {
+		if len(state.inputs) < maxRoomInputs {
+			state.inputs = append(state.inputs, model.RoomInput{UserID: userID, Payload: payload, ReceivedAt: s.now()})
+		}
+		s.mu.Unlock()
+		return nil
+	}
+	recipients := otherMembers(room, userID)
+	s.mu.Unlock()
+
+	s.dispatch([]roomDelivery{{
+		userIDs: recipients,
+		message: &model.RoomMessage{Type: model.RoomMessageInput, RoomID: room.ID, UserID: userID, Payload: payload},
+	}})
+	return nil
+}
+
+// 참가자를 룸에서 제거하고 보낼 메시지와 룸 삭제 여부를 반환 (잠금을 잡은 상태에서 호출)
+// 나간 참가자에게도 알려 다른 서버가 참가 정보를 정리할 수 있게 한다.
+func (s *RoomService) leaveLocked(state *roomState, userID uint) ([]roomDelivery, bool) {
+	room := state.room
+	if !room.RemoveMember(userID) {
+		return nil, false
+	}
+	delete(s.memberships, userID)
+
+	recipients := append(room.MemberIDs(), userID)
+	deliveries := []roomDelivery{{
+		userIDs: recipients,
+		message: &model.RoomMessage{Type: model.RoomMessageMemberLeft, RoomID: room.ID, UserID: userID, Room: room.Snapshot()},
+	}}
+	if len(room.Members) == 0 {
+		delete(s.rooms, room.ID)
+		return deliveries, true
+	}
+	return deliveries, false
+}
+
+// 참가자를 다시 연결 상태로 바꾸고 보낼 메시지와 이 서버의 룸인지를 반환 (잠금을 잡은 상태에서 호출)
+func (s *RoomService) reconnectLocked(userID uint) ([]roomDelivery, bool) {
+	state, err := s.memberRoomLocked(userID)
+	if err != nil {
+		return nil, false
+	}
+	room := state.room
+	member := room.Member(userID)
+	wasDisconnected := !member.Connected
+	member.Connected = true
+	member.DisconnectedAt = nil
+
+	snapshot := room.Snapshot()
+	deliveries := []roomDelivery{{
+		userIDs: []uint{userID},
+		message: &model.RoomMessage{Type: model.RoomMessageState, RoomID: room.ID, Room: snapshot},
+	}}
+	if wasDisconnected {
+		deliveries = append(deliveries, roomDelivery{
+			userIDs: otherMembers(room, userID),
+			message: &model.RoomMessage{Type: model.RoomMessageReconnected, RoomID: room.ID, UserID: userID, Room: snapshot},
+		})
+	}
+	return deliveries, true
+}
+
+// 참가자를 연결 끊김 상태로 바꾸고 보낼 메시지, 삭제된 룸, 이 서버의 룸인지를 반환 (잠금을 잡은 상태에서 호출)
+// 재접속 유예 시간이 없으면 바로 룸에서 내보낸다.
+func (s *RoomService) disconnectLocked(userID uint) ([]roomDelivery, []string, bool) {
+	state, err := s.memberRoomLocked(userID)
+	if err != nil {
+		return nil, nil, false
+	}
+	room := state.room
+	if s.reconnectGrace <= 0 {
+		deliveries, removed := s.leaveLocked(state, userID)
+		if removed {
+			return deliveries, []string{room.ID}, true
+		}
+		return deliveries, nil, true
+	}
+
+	member := room.Member(userID)
+	if !member.Connected {
+		return nil, nil, true
+	}
+	disconnectedAt := s.now()
+	member.Connected = false
+	member.DisconnectedAt = &disconnectedAt
+	return []roomDelivery{{
+		userIDs: otherMembers(room, userID),
+		message: &model.RoomMessage{Type: model.RoomMessageDisconnected, RoomID: room.ID, UserID: userID, Room: room.Snapshot()},
+	}}, nil, true
+}
+
+// 사용자가 참가한 이 서버의 룸 (잠금을 잡은 상태에서 호출)
+func (s *RoomService) memberRoomLocked(userID uint) (*roomState, error) {
+	state, ok := s.rooms[s.memberships[userID]]
+	if !ok || state.room.Member(userID) == nil {
+		return nil, model.ErrNotInRoom
+	}
+	return state, nil
+}
+
+// 사용자가 참가한 룸 ID (없으면 빈 문자열)
+func (s *RoomService) membership(userID uint) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.memberships[userID]
+}
+
+// 룸 복사본 (없으면 nil)
+func (s *RoomService) snapshot(roomID string) *model.Room {
+	room, err := s.GetRoom(roomID)
+	if err != nil {
+		return nil
+	}
+	return room
+}
+
+// 메시지를 보냄 (이 서버에 연결되지 않은 사용자는 브로커로 다른 서버에 전달)
+func (s *RoomService) dispatch(deliveries []roomDelivery) {
+	now := s.now()
+	for _, delivery := range deliveries {
+		if len(delivery.userIDs) == 0 {
+			continue
+		}
+		if delivery.message.SentAt.IsZero() {
+			delivery.message.SentAt = now
+		}
+
+		var remote []uint
+		for _, userID := range delivery.userIDs {
+			if !s.deliverLocal(userID, delivery.message) {
+				remote = append(remote, userID)
+			}
+		}
+		if len(remote) > 0 {
+			s.publish(&RoomEvent{Kind: RoomEventDeliver, UserIDs: remote, Message: delivery.message})
+		}
+	}
+}
+
+// 이 서버에 연결된 사용자에게 메시지를 보내고 연결이 있었는지 반환
+// 다른 서버의 룸에 참가한 사용자의 참가 정보도 메시지로 갱신한다.
+func (s *RoomService) deliverLocal(userID uint, msg *model.RoomMessage) bool {
+	s.mu.Lock()
+	conn, ok := s.conns[userID]
+	if _, owned := s.rooms[msg.RoomID]; !owned && msg.RoomID != "" {
+		switch {
+		case ok && msg.Type == model.RoomMessageState:
+			s.memberships[userID] = msg.RoomID
+		case msg.Type == model.RoomMessageMemberLeft && msg.UserID == userID && s.memberships[userID] == msg.RoomID:
+			delete(s.memberships, userID)
+		}
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		return false
+	}
+	if err := conn.Send(msg); err != nil {
+		log.Printf("룸 메시지 전송 실패 (user_id=%d, type=%s): %v", userID, msg.Type, err)
+	}
+	return true
+}
+
+// 브로커로 이벤트 발행 (브로커가 없으면 무시)
+func (s *RoomService) publish(event *RoomEvent) {
+	if s.broker == nil {
+		return
+	}
+	event.Origin = s.instanceID
+	ctx, cancel := context.WithTimeout(context.Background(), roomBrokerTimeout)
+	defer cancel()
+	if err := s.broker.Publish(ctx, event); err != nil {
+		log.Printf("룸 이벤트 발행 실패 (kind=%s): %v", event.Kind, err)
+	}
+}
+
+// 이 서버가 룸을 가지고 있음을 저장
+func (s *RoomService) setOwner(roomID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), roomBrokerTimeout)
+	defer cancel()
+	return s.broker.SetRoomOwner(ctx, roomID, s.instanceID, roomOwnerTTL)
+}
+
+// 삭제된 룸의 소유 정보 정리
+func (s *RoomService) removeOwners(roomIDs []string) {
+	if s.broker == nil {
+		return
+	}
+	for _, roomID := range roomIDs {
+		ctx, cancel := context.WithTimeout(context.Background(), roomBrokerTimeout)
+		if err := s.broker.RemoveRoomOwner(ctx, roomID); err != nil {
+			log.Printf("룸 소유 정보 삭제 실패 (room_id=%s): %v", roomID, err)
+		}
+		cancel()
+	}
+}
+
+// 룸을 만들 수 있는 게임 조회 (플레이 가능하고 두 명 이상 참가 가능해야 함)
+func (s *RoomService) roomGame(gameID uint) (*model.Game, error) {
+	if gameID == 0 {
+		return nil, model.ErrInvalidRoomMessage
+	}
+	var game model.Game
+	if err := s.db.First(&game, gameID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrGameNotFound
+		}
+		return nil, fmt.Errorf("게임 조회 중 오류 발생: %w", err)
+	}
+	if !game.IsPlayable() {
+		return nil, model.ErrGameNotPlayable
+	}
+	if game.MaxPlayers < 2 {
+		return nil, model.ErrRoomUnsupported
+	}
+	return &game, nil
+}
+
+// 참가자 닉네임 조회
+func (s *RoomService) nickname(userID uint) (string, error) {
+	var nicknames []string
+	if err := s.db.Model(&model.User{}).Where("id = ?", userID).Pluck("nickname", &nicknames).Error; err != nil {
+		return "", fmt.Errorf("사용자 조회 중 오류 발생: %w", err)
+	}
+	if len(nicknames) == 0 {
+		return "", nil
+	}
+	return nicknames[0], nil
+}
+
+// 룸 에러를 클라이언트에 보낼 메시지로 변환
+func roomErrorMessage(err error) *model.RoomMessage {
+	return &model.RoomMessage{Type: model.RoomMessageError, Error: err.Error()}
+}
+
+// 본인을 제외한 참가자 ID 목록
+func otherMembers(room *model.Room, userID uint) []uint {
+	var ids []uint
+	for _, member := range room.Members {
+		if member.UserID != userID {
+			ids = append(ids, member.UserID)
+		}
+	}
+	return ids
+}
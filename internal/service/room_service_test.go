@@ -0,0 +1,436 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 테스트용 룸 연결 (보낸 메시지를 기록)
+type fakeRoomConnection struct {
+	mu       sync.Mutex
+	messages []*model.RoomMessage
+	closed   bool
+}
+
+func (c *fakeRoomConnection) Send(msg *model.RoomMessage) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.messages = append(c.messages, msg)
+	return nil
+}
+
+func (c *fakeRoomConnection) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+}
+
+// 지정한 종류의 마지막 메시지 (없으면 nil)
+func (c *fakeRoomConnection) last(msgType model.RoomMessageType) *model.RoomMessage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := len(c.messages) - 1; i >= 0; i-- {
+		if c.messages[i].Type == msgType {
+			return c.messages[i]
+		}
+	}
+	return nil
+}
+
+// 지정한 종류의 메시지 수
+func (c *fakeRoomConnection) count(msgType model.RoomMessageType) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	count := 0
+	for _, msg := range c.messages {
+		if msg.Type == msgType {
+			count++
+		}
+	}
+	return count
+}
+
+// 테스트용 메모리 룸 브로커 (같은 버스를 쓰는 서비스끼리 이벤트를 바로 전달, 만료 시간은 무시)
+type memoryRoomBus struct {
+	mu       sync.Mutex
+	handlers []func(event *RoomEvent)
+	owners   map[string]string
+}
+
+type memoryRoomBroker struct {
+	bus *memoryRoomBus
+}
+
+func (b *memoryRoomBroker) Publish(ctx context.Context, event *RoomEvent) error {
+	b.bus.mu.Lock()
+	handlers := append([]func(event *RoomEvent){}, b.bus.handlers...)
+	b.bus.mu.Unlock()
+	for _, handle := range handlers {
+		handle(event)
+	}
+	return nil
+}
+
+func (b *memoryRoomBroker) Subscribe(ctx context.Context, handle func(event *RoomEvent)) error {
+	b.bus.mu.Lock()
+	b.bus.handlers = append(b.bus.handlers, handle)
+	b.bus.mu.Unlock()
+	<-ctx.Done()
+	return nil
+}
+
+func (b *memoryRoomBroker) SetRoomOwner(ctx context.Context, roomID, instanceID string, ttl time.Duration) error {
+	b.bus.mu.Lock()
+	defer b.bus.mu.Unlock()
+	b.bus.owners[roomID] = instanceID
+	return nil
+}
+
+func (b *memoryRoomBroker) RoomOwner(ctx context.Context, roomID string) (string, error) {
+	b.bus.mu.Lock()
+	defer b.bus.mu.Unlock()
+	return b.bus.owners[roomID], nil
+}
+
+func (b *memoryRoomBroker) RemoveRoomOwner(ctx context.Context, roomID string) error {
+	b.bus.mu.Lock()
+	defer b.bus.mu.Unlock()
+	delete(b.bus.owners, roomID)
+	return nil
+}
+
+// 룸 테스트 환경 (테트리스는 최대 2인)
+func setupRoomTest(t *testing.T) (*RoomService, *model.User, *model.User, *model.Game, time.Time) {
+	db, _, alice, game := setupGameSessionTest(t)
+	bob := seedUser(t, db, "bob", 100)
+	require.NoError(t, db.Model(bob).Update("nickname", "밥").Error)
+
+	now := time.Date(2025, 3, 12, 12, 0, 0, 0, time.UTC)
+	service := NewRoomService(db, 30*time.Second)
+	service.now = func() time.Time { return now }
+	return service, alice, bob, game, now
+}
+
+// 사용자를 연결하고 연결을 반환
+func connectRoomUser(service *RoomService, userID uint) *fakeRoomConnection {
+	conn := &fakeRoomConnection{}
+	service.Connect(userID, conn)
+	return conn
+}
+
+// 준비 상태 변경 메시지
+func readyMessage(ready bool) *model.RoomMessage {
+	payload, _ := json.Marshal(model.RoomReadyPayload{Ready: ready})
+	return &model.RoomMessage{Type: model.RoomMessageReady, Payload: payload}
+}
+
+// 룸 생성, 참가 인원 제한, 준비 완료 후 게임 시작 테스트
+func TestRoomService_CreateJoinReady(t *testing.T) {
+	service, alice, bob, game, _ := setupRoomTest(t)
+	aliceConn := connectRoomUser(service, alice.ID)
+	bobConn := connectRoomUser(service, bob.ID)
+
+	require.NoError(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageCreate, GameID: game.ID}))
+	state := aliceConn.last(model.RoomMessageState)
+	require.NotNil(t, state)
+	roomID := state.RoomID
+	assert.Equal(t, alice.ID, state.Room.HostID)
+	assert.Equal(t, 2, state.Room.MaxPlayers)
+	assert.ErrorIs(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageCreate, GameID: game.ID}), model.ErrAlreadyInRoom)
+
+	// game_id만 보내면 대기 중인 룸에 빠른 참가
+	require.NoError(t, service.HandleMessage(bob.ID, &model.RoomMessage{Type: model.RoomMessageJoin, GameID: game.ID}))
+	assert.Equal(t, roomID, bobConn.last(model.RoomMessageState).RoomID)
+	joined := aliceConn.last(model.RoomMessageMemberJoined)
+	require.NotNil(t, joined)
+	assert.Equal(t, bob.ID, joined.UserID)
+	assert.Equal(t, "밥", joined.Room.Member(bob.ID).Nickname)
+
+	// 최대 인원 초과
+	carol := seedUser(t, service.db, "carol", 100)
+	connectRoomUser(service, carol.ID)
+	assert.ErrorIs(t, service.HandleMessage(carol.ID, &model.RoomMessage{Type: model.RoomMessageJoin, RoomID: roomID}), model.ErrRoomFull)
+
+	// 한 명만 준비하면 시작하지 않음
+	require.NoError(t, service.HandleMessage(alice.ID, readyMessage(true)))
+	assert.Equal(t, alice.ID, bobConn.last(model.RoomMessageMemberReady).UserID)
+	assert.Nil(t, aliceConn.last(model.RoomMessageGameStarted))
+
+	require.NoError(t, service.HandleMessage(bob.ID, readyMessage(true)))
+	started := aliceConn.last(model.RoomMessageGameStarted)
+	require.NotNil(t, started)
+	assert.Equal(t, model.RoomPlaying, started.Room.Status)
+	assert.NotNil(t, bobConn.last(model.RoomMessageGameStarted))
+
+	// 진행 중인 룸에는 참가하거나 준비 상태를 바꿀 수 없음
+	assert.ErrorIs(t, service.HandleMessage(carol.ID, &model.RoomMessage{Type: model.RoomMessageJoin, RoomID: roomID}), model.ErrRoomPlaying)
+	assert.ErrorIs(t, service.HandleMessage(bob.ID, readyMessage(false)), model.ErrRoomPlaying)
+}
+
+// 룸을 만들 수 없는 게임과 잘못된 요청 테스트
+func TestRoomService_InvalidRequests(t *testing.T) {
+	service, alice, _, game, _ := setupRoomTest(t)
+	aliceConn := connectRoomUser(service, alice.ID)
+
+	assert.ErrorIs(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageCreate, GameID: 999}), model.ErrGameNotFound)
+	assert.ErrorIs(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageCreate}), model.ErrInvalidRoomMessage)
+	assert.ErrorIs(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: "dance"}), model.ErrInvalidRoomMessage)
+	assert.ErrorIs(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageLeave}), model.ErrNotInRoom)
+	assert.ErrorIs(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageJoin, RoomID: "missing"}), model.ErrRoomNotFound)
+
+	require.NoError(t, service.db.Model(game).Update("max_players", 1).Error)
+	assert.ErrorIs(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageCreate, GameID: game.ID}), model.ErrRoomUnsupported)
+
+	require.NoError(t, service.db.Model(game).Updates(map[string]interface{}{"max_players": 2, "status": model.GameStatusMaintenance}).Error)
+	assert.ErrorIs(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageCreate, GameID: game.ID}), model.ErrGameNotPlayable)
+
+	require.NoError(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessagePing}))
+	assert.NotNil(t, aliceConn.last(model.RoomMessagePong))
+}
+
+// 메시지 전달, 방장 이전, 마지막 참가자가 나가면 룸 삭제 테스트
+func TestRoomService_RelayAndLeave(t *testing.T) {
+	service, alice, bob, game, _ := setupRoomTest(t)
+	aliceConn := connectRoomUser(service, alice.ID)
+	bobConn := connectRoomUser(service, bob.ID)
+	require.NoError(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageCreate, GameID: game.ID}))
+	roomID := aliceConn.last(model.RoomMessageState).RoomID
+	require.NoError(t, service.HandleMessage(bob.ID, &model.RoomMessage{Type: model.RoomMessageJoin, RoomID: roomID}))
+
+	require.NoError(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageRelay, Payload: json.RawMessage(`{"chat":"안녕"}`)}))
+	relayed := bobConn.last(model.RoomMessageRelay)
+	require.NotNil(t, relayed)
+	assert.Equal(t, alice.ID, relayed.UserID)
+	assert.JSONEq(t, `{"chat":"안녕"}`, string(relayed.Payload))
+	assert.Nil(t, aliceConn.last(model.RoomMessageRelay), "보낸 사람에게는 다시 보내지 않음")
+
+	// 대상 지정 (룸에 없는 대상이나 자기 자신은 거부)
+	require.NoError(t, service.HandleMessage(bob.ID, &model.RoomMessage{Type: model.RoomMessageRelay, TargetUserID: alice.ID, Payload: json.RawMessage(`1`)}))
+	assert.Equal(t, alice.ID, aliceConn.last(model.RoomMessageRelay).TargetUserID)
+	assert.ErrorIs(t, service.HandleMessage(bob.ID, &model.RoomMessage{Type: model.RoomMessageRelay, TargetUserID: 999}), model.ErrInvalidRoomMessage)
+	assert.ErrorIs(t, service.HandleMessage(bob.ID, &model.RoomMessage{Type: model.RoomMessageRelay, TargetUserID: bob.ID}), model.ErrInvalidRoomMessage)
+
+	// 방장이 나가면 남은 참가자가 방장
+	require.NoError(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageLeave}))
+	left := bobConn.last(model.RoomMessageMemberLeft)
+	require.NotNil(t, left)
+	assert.Equal(t, bob.ID, left.Room.HostID)
+	assert.NotNil(t, aliceConn.last(model.RoomMessageMemberLeft), "나간 참가자도 알림을 받음")
+
+	room, err := service.GetRoom(roomID)
+	require.NoError(t, err)
+	assert.Len(t, room.Members, 1)
+
+	require.NoError(t, service.HandleMessage(bob.ID, &model.RoomMessage{Type: model.RoomMessageLeave}))
+	_, err = service.GetRoom(roomID)
+	assert.ErrorIs(t, err, model.ErrRoomNotFound)
+
+	// 나간 뒤에는 새 룸을 만들 수 있음
+	assert.NoError(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageCreate, GameID: game.ID}))
+}
+
+// 틱 훅에 입력을 넘기고 결과를 방송, 게임 종료 시 대기 상태로 복귀 테스트
+func TestRoomService_TickHook(t *testing.T) {
+	service, alice, bob, game, _ := setupRoomTest(t)
+	aliceConn := connectRoomUser(service, alice.ID)
+	bobConn := connectRoomUser(service, bob.ID)
+	require.NoError(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageCreate, GameID: game.ID}))
+	roomID := aliceConn.last(model.RoomMessageState).RoomID
+	require.NoError(t, service.HandleMessage(bob.ID, &model.RoomMessage{Type: model.RoomMessageJoin, RoomID: roomID}))
+
+	// 훅이 없는 게임의 입력은 다른 참가자에게 그대로 전달
+	require.NoError(t, service.HandleMessage(alice.ID, readyMessage(true)))
+	require.NoError(t, service.HandleMessage(bob.ID, readyMessage(true)))
+	require.NoError(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageInput, Payload: json.RawMessage(`"left"`)}))
+	assert.Equal(t, alice.ID, bobConn.last(model.RoomMessageInput).UserID)
+
+	var received [][]model.RoomInput
+	service.RegisterTickHook(game.ID, func(ctx *RoomTickContext) (json.RawMessage, error) {
+		received = append(received, ctx.Inputs)
+		payload, _ := json.Marshal(map[string]int{"inputs": len(ctx.Inputs)})
+		if ctx.Tick == 2 {
+			return payload, model.ErrRoomGameOver
+		}
+		return payload, nil
+	})
+
+	require.NoError(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageInput, Payload: json.RawMessage(`"left"`)}))
+	require.NoError(t, service.HandleMessage(bob.ID, &model.RoomMessage{Type: model.RoomMessageInput, Payload: json.RawMessage(`"right"`)}))
+	assert.Equal(t, 1, bobConn.count(model.RoomMessageInput), "훅이 있으면 입력을 전달하지 않음")
+
+	service.Tick()
+	require.Len(t, received, 1)
+	require.Len(t, received[0], 2)
+	assert.Equal(t, alice.ID, received[0][0].UserID)
+	tick := bobConn.last(model.RoomMessageTick)
+	require.NotNil(t, tick)
+	assert.Equal(t, uint64(1), tick.Tick)
+	assert.JSONEq(t, `{"inputs":2}`, string(tick.Payload))
+
+	// 두 번째 틱에서 게임 종료
+	service.Tick()
+	assert.Empty(t, received[1], "처리한 입력은 다시 넘기지 않음")
+	over := aliceConn.last(model.RoomMessageGameOver)
+	require.NotNil(t, over)
+	assert.Equal(t, model.RoomWaiting, over.Room.Status)
+	assert.False(t, over.Room.Member(alice.ID).Ready)
+
+	// 대기 상태에서는 입력도 틱도 없음
+	assert.ErrorIs(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageInput}), model.ErrRoomNotPlaying)
+	service.Tick()
+	assert.Len(t, received, 2)
+}
+
+// 연결 끊김 후 유예 시간 안의 재접속과 유예 만료 테스트
+func TestRoomService_Reconnect(t *testing.T) {
+	service, alice, bob, game, now := setupRoomTest(t)
+	aliceConn := connectRoomUser(service, alice.ID)
+	bobConn := connectRoomUser(service, bob.ID)
+	require.NoError(t, service.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageCreate, GameID: game.ID}))
+	roomID := aliceConn.last(model.RoomMessageState).RoomID
+	require.NoError(t, service.HandleMessage(bob.ID, &model.RoomMessage{Type: model.RoomMessageJoin, RoomID: roomID}))
+
+	// 이미 바뀐 연결의 해제는 무시
+	service.Disconnect(bob.ID, &fakeRoomConnection{})
+	assert.Nil(t, aliceConn.last(model.RoomMessageDisconnected))
+
+	service.Disconnect(bob.ID, bobConn)
+	disconnected := aliceConn.last(model.RoomMessageDisconnected)
+	require.NotNil(t, disconnected)
+	assert.False(t, disconnected.Room.Member(bob.ID).Connected)
+
+	// 연결이 끊긴 참가자가 있으면 전원 준비여도 시작하지 않음
+	require.NoError(t, service.HandleMessage(alice.ID, readyMessage(true)))
+	require.NoError(t, service.Sweep(now.Add(29*time.Second)))
+	room, err := service.GetRoom(roomID)
+	require.NoError(t, err)
+	assert.Len(t, room.Members, 2, "유예 시간 안에는 자리를 유지")
+
+	// 재접속하면 룸 상태를 다시 받음
+	newConn := connectRoomUser(service, bob.ID)
+	assert.Equal(t, roomID, newConn.last(model.RoomMessageState).RoomID)
+	assert.Equal(t, bob.ID, aliceConn.last(model.RoomMessageReconnected).UserID)
+
+	// 새 연결이 들어오면 기존 연결은 닫힘
+	replacement := connectRoomUser(service, bob.ID)
+	assert.True(t, newConn.closed)
+	assert.Equal(t, 1, aliceConn.count(model.RoomMessageReconnected), "연결이 유지된 상태의 교체는 재접속 알림 없음")
+
+	// 유예 시간이 지나면 룸에서 내보냄
+	service.Disconnect(bob.ID, replacement)
+	require.NoError(t, service.Sweep(now.Add(31*time.Second)))
+	assert.Equal(t, bob.ID, aliceConn.last(model.RoomMessageMemberLeft).UserID)
+	room, err = service.GetRoom(roomID)
+	require.NoError(t, err)
+	assert.Len(t, room.Members, 1)
+	assert.NoError(t, service.HandleMessage(bob.ID, &model.RoomMessage{Type: model.RoomMessageCreate, GameID: game.ID}))
+}
+
+// 브로커로 연결된 두 서버 사이의 룸 참가와 메시지 전달 테스트
+func TestRoomService_Broker(t *testing.T) {
+	first, alice, bob, game, _ := setupRoomTest(t)
+	second := NewRoomService(first.db, 30*time.Second)
+	second.now = first.now
+
+	bus := &memoryRoomBus{owners: map[string]string{}}
+	require.NoError(t, first.SetBroker(&memoryRoomBroker{bus: bus}))
+	require.NoError(t, second.SetBroker(&memoryRoomBroker{bus: bus}))
+	assert.NotEqual(t, first.instanceID, second.instanceID)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go first.Listen(ctx)
+	go second.Listen(ctx)
+	require.Eventually(t, func() bool {
+		bus.mu.Lock()
+		defer bus.mu.Unlock()
+		return len(bus.handlers) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// 첫 번째 서버에 룸 생성, 두 번째 서버에 연결된 사용자가 룸 ID로 참가
+	aliceConn := connectRoomUser(first, alice.ID)
+	bobConn := connectRoomUser(second, bob.ID)
+	require.NoError(t, first.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageCreate, GameID: game.ID}))
+	roomID := aliceConn.last(model.RoomMessageState).RoomID
+	assert.Equal(t, first.instanceID, bus.owners[roomID])
+
+	assert.ErrorIs(t, second.HandleMessage(bob.ID, &model.RoomMessage{Type: model.RoomMessageJoin, RoomID: "missing"}), model.ErrRoomNotFound)
+	require.NoError(t, second.HandleMessage(bob.ID, &model.RoomMessage{Type: model.RoomMessageJoin, RoomID: roomID}))
+	require.NotNil(t, bobConn.last(model.RoomMessageState))
+	assert.Equal(t, bob.ID, aliceConn.last(model.RoomMessageMemberJoined).UserID)
+	_, err := second.GetRoom(roomID)
+	assert.ErrorIs(t, err, model.ErrRoomNotFound, "룸은 만든 서버에만 있음")
+
+	// 두 번째 서버에서 보낸 요청이 룸을 가진 서버로 전달됨
+	require.NoError(t, second.HandleMessage(bob.ID, &model.RoomMessage{Type: model.RoomMessageRelay, Payload: json.RawMessage(`"hi"`)}))
+	assert.Equal(t, bob.ID, aliceConn.last(model.RoomMessageRelay).UserID)
+	require.NoError(t, second.HandleMessage(bob.ID, readyMessage(true)))
+	require.NoError(t, first.HandleMessage(alice.ID, readyMessage(true)))
+	assert.NotNil(t, bobConn.last(model.RoomMessageGameStarted))
+
+	// 처리 실패는 에러 메시지로 돌아옴
+	require.NoError(t, second.HandleMessage(bob.ID, readyMessage(false)))
+	assert.Equal(t, model.ErrRoomPlaying.Error(), bobConn.last(model.RoomMessageError).Error)
+
+	// 연결이 끊기면 룸을 가진 서버가 재접속 유예를 시작하고, 다른 서버로 재접속해도 자리를 되찾음
+	second.Disconnect(bob.ID, bobConn)
+	assert.Equal(t, bob.ID, aliceConn.last(model.RoomMessageDisconnected).UserID)
+	bobFirst := connectRoomUser(first, bob.ID)
+	assert.Equal(t, roomID, bobFirst.last(model.RoomMessageState).RoomID)
+	assert.Equal(t, bob.ID, aliceConn.last(model.RoomMessageReconnected).UserID)
+
+	// 마지막 참가자가 나가면 룸 소유 정보도 삭제
+	require.NoError(t, first.HandleMessage(bob.ID, &model.RoomMessage{Type: model.RoomMessageLeave}))
+	require.NoError(t, first.HandleMessage(alice.ID, &model.RoomMessage{Type: model.RoomMessageLeave}))
+	assert.Empty(t, bus.owners)
+}
+
+// 룸과 관계없는 사용자 알림 테스트 (다른 서버에 연결된 사용자는 브로커로 전달)
+func TestRoomService_Notify(t *testing.T) {
+	first, alice, bob, _, now := setupRoomTest(t)
+	second := NewRoomService(first.db, 30*time.Second)
+	second.now = first.now
+
+	// 연결이 없으면 버려짐
+	require.NoError(t, first.Notify(alice.ID, model.RoomMessageMatchFound, &MatchFound{Token: "offline"}))
+
+	aliceConn := connectRoomUser(first, alice.ID)
+	require.NoError(t, first.Notify(alice.ID, model.RoomMessageMatchFound, &MatchFound{Match: &model.Match{GameID: 3}, Token: "alice-token"}))
+	msg := aliceConn.last(model.RoomMessageMatchFound)
+	require.NotNil(t, msg)
+	assert.Equal(t, alice.ID, msg.UserID)
+	assert.Empty(t, msg.RoomID)
+	assert.Equal(t, now, msg.SentAt)
+	var found MatchFound
+	require.NoError(t, json.Unmarshal(msg.Payload, &found))
+	assert.Equal(t, "alice-token", found.Token)
+	assert.Equal(t, uint(3), found.Match.GameID)
+	assert.Equal(t, 1, aliceConn.count(model.RoomMessageMatchFound))
+
+	assert.Error(t, first.Notify(alice.ID, model.RoomMessageMatchFound, make(chan int)))
+
+	bus := &memoryRoomBus{owners: map[string]string{}}
+	require.NoError(t, first.SetBroker(&memoryRoomBroker{bus: bus}))
+	require.NoError(t, second.SetBroker(&memoryRoomBroker{bus: bus}))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go second.Listen(ctx)
+	require.Eventually(t, func() bool {
+		bus.mu.Lock()
+		defer bus.mu.Unlock()
+		return len(bus.handlers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	bobConn := connectRoomUser(second, bob.ID)
+	require.NoError(t, first.Notify(bob.ID, model.RoomMessageMatchFound, &MatchFound{Token: "bob-token"}))
+	msg = bobConn.last(model.RoomMessageMatchFound)
+	require.NotNil(t, msg)
+	require.NoError(t, json.Unmarshal(msg.Payload, &found))
+	assert.Equal(t, "bob-token", found.Token)
+}
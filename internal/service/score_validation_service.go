@@ -0,0 +1,423 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"log"
+	"sync"
+	"time"
+)
+
+// 부정행위 검사 기준
+type ScoreValidationConfig struct {
+	// 완료 기록의 플레이 시간이 예상 플레이 시간 대비 이 비율보다 짧으면 의심
+	MinPlayTimeRatio float64
+
+	// 분당 점수가 게임 평균의 이 배수를 넘으면 의심 (평균은 표본이 MinSamples개 이상일 때만 사용)
+	ScoreRateFactor float64
+	MinSamples      int
+
+	// 초당 액션 수 상한, 정확도 100%일 때의 초당 액션 수 상한
+	MaxSpeed             float64
+	PerfectAccuracySpeed float64
+
+	// 같은 IP에서 IPWindow 동안 허용하는 제출 수
+	MaxSubmissionsPerIP int
+	IPWindow            time.Duration
+
+	// ShadowBanWindow 동안 격리된 점수가 이 개수 이상이면 자동 섀도우 밴 (0이면 사용 안 함)
+	AutoShadowBanThreshold int
+	ShadowBanWindow        time.Duration
+}
+
+// 기본 부정행위 검사 기준
+func DefaultScoreValidationConfig() ScoreValidationConfig {
+	return ScoreValidationConfig{
+		MinPlayTimeRatio:       0.1,
+		ScoreRateFactor:        5,
+		MinSamples:             10,
+		MaxSpeed:               20,
+		PerfectAccuracySpeed:   5,
+		MaxSubmissionsPerIP:    60,
+		IPWindow:               time.Hour,
+		AutoShadowBanThreshold: 3,
+		ShadowBanWindow:        7 * 24 * time.Hour,
+	}
+}
+
+// 검사 중인 점수 정보
+type ScoreCheck struct {
+	Score  *model.Score
+	Game   *model.Game
+	User   *model.User
+	Now    time.Time
+	Config ScoreValidationConfig
+}
+
+// 부정행위 검사 규칙
+// 의심되면 사유를, 정상이면 빈 문자열을 반환한다. 점수는 이미 저장된 상태로 전달된다.
+type ScoreRule func(tx *gorm.DB, check *ScoreCheck) (string, error)
+
+type namedScoreRule struct {
+	name string
+	rule ScoreRule
+}
+
+// 제출된 점수를 규칙별로 검사하고 의심 점수를 격리하는 서비스
+type ScoreValidationService struct {
+	db     *gorm.DB
+	config ScoreValidationConfig
+
+	mu    sync.RWMutex
+	rules []namedScoreRule
+
+	// 승인된 점수를 반영할 리더보드 (설정하지 않으면 반영하지 않음)
+	leaderboard *LeaderboardService
+
+	// 승인된 점수로 평가할 업적 (설정하지 않으면 평가하지 않음)
+	achievements *AchievementService
+
+	// 승인된 점수로 갱신할 미션 (설정하지 않으면 갱신하지 않음)
+	missions *MissionService
+
+	// 승인된 점수로 패스 경험치를 지급할 배틀패스 (설정하지 않으면 지급하지 않음)
+	battlePass *BattlePassService
+
+	// 검토가 끝난 점수를 반영할 매치메이킹 (설정하지 않으면 반영하지 않음)
+	matchmaking *MatchmakingService
+
+	now func() time.Time
+
+	// 인벤토리 통계 캐시 (설정하지 않으면 무효화하지 않음)
+	inventoryStats
+}
+
+// 새로운 ScoreValidationService 인스턴스를 생성 (기본 규칙 등록)
+func NewScoreValidationService(db *gorm.DB) *ScoreValidationService {
+	s := &ScoreValidationService{
+		db:     db,
+		config: DefaultScoreValidationConfig(),
+		now:    time.Now,
+	}
+
+	s.RegisterRule(model.ScoreRuleShadowBan, checkShadowBan)
+	s.RegisterRule(model.ScoreRuleSessionReplay, checkSessionReplay)
+	s.RegisterRule(model.ScoreRulePlayTime, checkPlayTime)
+	s.RegisterRule(model.ScoreRuleScoreRate, checkScoreRate)
+	s.RegisterRule(model.ScoreRuleAccuracySpeed, checkAccuracySpeed)
+	s.RegisterRule(model.ScoreRuleIPRate, checkIPRate)
+	return s
+}
+
+// 리더보드 서비스를 설정
+func (s *ScoreValidationService) SetLeaderboard(leaderboard *LeaderboardService) {
+	s.leaderboard = leaderboard
+}
+
+// 업적 서비스를 설정
+func (s *ScoreValidationService) SetAchievements(achievements *AchievementService) {
+	s.achievements = achievements
+}
+
+// 미션 서비스를 설정
+func (s *ScoreValidationService) SetMissions(missions *MissionService) {
+	s.missions = missions
+}
+
+// 매치메이킹 서비스를 설정
+func (s *ScoreValidationService) SetMatchmaking(matchmaking *MatchmakingService) {
+	s.matchmaking = matchmaking
+}
+
+// 배틀패스 서비스를 설정
+func (s *ScoreValidationService) SetBattlePass(battlePass *BattlePassService) {
+	s.battlePass = battlePass
+}
+
+// 검사 기준을 변경
+func (s *ScoreValidationService) SetConfig(config ScoreValidationConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config = config
+}
+
+// 검사 규칙을 등록 (같은 이름이면 교체, 등록 순서대로 실행)
+func (s *ScoreValidationService) RegisterRule(name string, rule ScoreRule) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.rules {
+		if s.rules[i].name == name {
+			s.rules[i].rule = rule
+			return
+		}
+	}
+	s.rules = append(s.rules, namedScoreRule{name: name, rule: rule})
+}
+
+// 저장된 점수를 검사하고 의심되면 격리
+// 호출자의 트랜잭션 안에서 실행되며, 격리된 경우 기록된 의심 사유를 반환한다.
+func (s *ScoreValidationService) Screen(tx *gorm.DB, score *model.Score, game *model.Game) ([]model.ScoreFlag, error) {
+	s.mu.RLock()
+	rules := append([]namedScoreRule(nil), s.rules...)
+	config := s.config
+	s.mu.RUnlock()
+
+	var user model.User
+	if err := tx.First(&user, score.UserID).Error; err != nil {
+		return nil, fmt.Errorf("사용자 조회 중 오류 발생: %w", err)
+	}
+
+	check := &ScoreCheck{Score: score, Game: game, User: &user, Now: s.now(), Config: config}
+	var flags []model.ScoreFlag
+	for _, r := range rules {
+		reason, err := r.rule(tx, check)
+		if err != nil {
+			return nil, fmt.Errorf("점수 검사 실패 (%s): %w", r.name, err)
+		}
+		if reason != "" {
+			flags = append(flags, model.ScoreFlag{ScoreID: score.ID, Rule: r.name, Reason: reason})
+		}
+	}
+	if len(flags) == 0 {
+		return nil, nil
+	}
+
+	score.ReviewStatus = model.ScoreQuarantined
+	if err := tx.Model(score).Update("review_status", score.ReviewStatus).Error; err != nil {
+		return nil, fmt.Errorf("점수 격리 중 오류 발생: %w", err)
+	}
+	if err := tx.Create(&flags).Error; err != nil {
+		return nil, fmt.Errorf("의심 사유 기록 중 오류 발생: %w", err)
+	}
+
+	if !user.ShadowBanned && config.AutoShadowBanThreshold > 0 {
+		var quarantined int64
+		err := tx.Model(&model.Score{}).
+			Where("user_id = ? AND review_status = ? AND created_at > ?", user.ID, model.ScoreQuarantined, check.Now.Add(-config.ShadowBanWindow)).
+			Count(&quarantined).Error
+		if err != nil {
+			return nil, fmt.Errorf("격리 점수 조회 중 오류 발생: %w", err)
+		}
+		if quarantined >= int64(config.AutoShadowBanThreshold) {
+			if err := setShadowBan(tx, user.ID, true); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return flags, nil
+}
+
+// 검토 대기 중인 점수 목록을 조회 (오래된 순)
+func (s *ScoreValidationService) GetReviewQueue(limit, offset int) ([]model.Score, int64, error) {
+	query := s.db.Model(&model.Score{}).Where("review_status = ?", model.ScoreQuarantined)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("검토 대기 점수 수 조회 중 오류 발생: %w", err)
+	}
+
+	var scores []model.Score
+	err := query.Preload("Flags").Order("id ASC").Limit(limit).Offset(offset).Find(&scores).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("검토 대기 점수 조회 중 오류 발생: %w", err)
+	}
+	return scores, total, nil
+}
+
+// 격리된 점수를 정상으로 승인 (보류된 보상 지급과 최고 점수 갱신)
+func (s *ScoreValidationService) ApproveScore(scoreID, moderatorID uint) (*model.Score, error) {
+	return s.review(scoreID, moderatorID, model.ScoreAccepted)
+}
+
+// 격리된 점수를 부정 점수로 확정
+func (s *ScoreValidationService) RejectScore(scoreID, moderatorID uint) (*model.Score, error) {
+	return s.review(scoreID, moderatorID, model.ScoreRejected)
+}
+
+// 사용자의 섀도우 밴 여부를 설정
+func (s *ScoreValidationService) SetShadowBan(userID uint, banned bool) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockUser(tx, userID); err != nil {
+			return err
+		}
+		return setShadowBan(tx, userID, banned)
+	})
+}
+
+// 검토 결과를 반영
+func (s *ScoreValidationService) review(scoreID, moderatorID uint, status model.ScoreReviewStatus) (*model.Score, error) {
+	var score model.Score
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		if err := lockForUpdate(tx).Preload("Flags").First(&score, scoreID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.ErrScoreNotFound
+			}
+			return fmt.Errorf("점수 조회 중 오류 발생: %w", err)
+		}
+		if score.ReviewStatus != model.ScoreQuarantined {
+			return model.ErrScoreNotInReview
+		}
+
+		now := s.now()
+		score.ReviewStatus = status
+		score.ReviewedBy = &moderatorID
+		score.ReviewedAt = &now
+		err := tx.Model(&score).Updates(map[string]interface{}{
+			"review_status": score.ReviewStatus,
+			"reviewed_by":   score.ReviewedBy,
+			"reviewed_at":   score.ReviewedAt,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("점수 검토 결과 저장 중 오류 발생: %w", err)
+		}
+
+		if status == model.ScoreAccepted {
+			if _, _, err := creditScore(tx, &score); err != nil {
+				return err
+			}
+			if s.achievements != nil {
+				if _, err := s.achievements.Record(tx, model.NewScoreAchievementEvent(&score)); err != nil {
+					return err
+				}
+			}
+			if s.missions != nil {
+				if _, err := s.missions.RecordScore(tx, &score); err != nil {
+					return err
+				}
+			}
+			if s.battlePass != nil {
+				if err := s.battlePass.RecordScore(tx, &score); err != nil {
+					return err
+				}
+			}
+		}
+
+		// 매치 점수는 승인되면 그대로, 거부되면 기권으로 기록해 매치를 마무리
+		if s.matchmaking != nil {
+			if status == model.ScoreAccepted {
+				return s.matchmaking.RecordScore(tx, &score)
+			}
+			return s.matchmaking.ForfeitScore(tx, &score)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if status == model.ScoreAccepted && s.leaderboard != nil {
+		if err := s.leaderboard.RecordScore(&score); err != nil {
+			log.Printf("리더보드 반영 실패 (score_id=%d): %v", score.ID, err)
+		}
+	}
+	return &score, nil
+}
+
+// 사용자의 섀도우 밴 여부를 저장
+func setShadowBan(tx *gorm.DB, userID uint, banned bool) error {
+	if err := tx.Model(&model.User{}).Where("id = ?", userID).Update("shadow_banned", banned).Error; err != nil {
+		return fmt.Errorf("섀도우 밴 설정 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 섀도우 밴 사용자의 점수는 모두 격리
+func checkShadowBan(tx *gorm.DB, check *ScoreCheck) (string, error) {
+	if check.User.ShadowBanned {
+		return "섀도우 밴 사용자의 점수입니다", nil
+	}
+	return "", nil
+}
+
+// 같은 세션 ID로 기록된 다른 점수가 있으면 재전송으로 판단
+func checkSessionReplay(tx *gorm.DB, check *ScoreCheck) (string, error) {
+	if check.Score.SessionID == "" {
+		return "게임 세션 없이 제출된 점수입니다", nil
+	}
+
+	var count int64
+	err := tx.Model(&model.Score{}).
+		Where("session_id = ? AND id <> ?", check.Score.SessionID, check.Score.ID).
+		Count(&count).Error
+	if err != nil {
+		return "", err
+	}
+	if count > 0 {
+		return fmt.Sprintf("이미 사용된 세션 ID입니다 (%d건)", count), nil
+	}
+	return "", nil
+}
+
+// 예상 플레이 시간에 비해 너무 빨리 완료한 기록
+func checkPlayTime(tx *gorm.DB, check *ScoreCheck) (string, error) {
+	if !check.Score.Completed || check.Game.EstimatedPlayTime <= 0 {
+		return "", nil
+	}
+
+	minSeconds := float64(check.Game.EstimatedPlayTime*60) * check.Config.MinPlayTimeRatio
+	if float64(check.Score.PlayTime) < minSeconds {
+		return fmt.Sprintf("플레이 시간 %d초가 최소 기준 %.0f초보다 짧습니다", check.Score.PlayTime, minSeconds), nil
+	}
+	return "", nil
+}
+
+// 게임 평균 대비 분당 점수 이상치
+func checkScoreRate(tx *gorm.DB, check *ScoreCheck) (string, error) {
+	rate := check.Score.GetScorePerMinute()
+	if rate <= 0 || check.Config.ScoreRateFactor <= 0 {
+		return "", nil
+	}
+
+	var stats struct {
+		Samples int64
+		Average float64
+	}
+	err := tx.Model(&model.Score{}).
+		Select("COUNT(*) AS samples, COALESCE(AVG((score + bonus_score - penalty_score) * 60.0 / play_time), 0) AS average").
+		Where("game_id = ? AND review_status = ? AND play_time > 0 AND id <> ?", check.Score.GameID, model.ScoreAccepted, check.Score.ID).
+		Scan(&stats).Error
+	if err != nil {
+		return "", err
+	}
+	if stats.Samples < int64(check.Config.MinSamples) || stats.Average <= 0 {
+		return "", nil
+	}
+
+	if rate > stats.Average*check.Config.ScoreRateFactor {
+		return fmt.Sprintf("분당 점수 %.1f가 게임 평균 %.1f의 %.0f배를 넘습니다", rate, stats.Average, check.Config.ScoreRateFactor), nil
+	}
+	return "", nil
+}
+
+// 사람이 낼 수 없는 속도나 정확도
+func checkAccuracySpeed(tx *gorm.DB, check *ScoreCheck) (string, error) {
+	score := check.Score
+	if check.Config.MaxSpeed > 0 && score.Speed > check.Config.MaxSpeed {
+		return fmt.Sprintf("초당 액션 수 %.1f가 상한 %.1f를 넘습니다", score.Speed, check.Config.MaxSpeed), nil
+	}
+	if check.Config.PerfectAccuracySpeed > 0 && score.Accuracy >= 100 && score.Speed > check.Config.PerfectAccuracySpeed {
+		return fmt.Sprintf("정확도 100%%에서 초당 액션 수 %.1f는 비정상입니다", score.Speed), nil
+	}
+	return "", nil
+}
+
+// 같은 IP에서 짧은 시간에 너무 많이 제출
+func checkIPRate(tx *gorm.DB, check *ScoreCheck) (string, error) {
+	if check.Score.IPAddress == "" || check.Config.MaxSubmissionsPerIP <= 0 {
+		return "", nil
+	}
+
+	var count int64
+	err := tx.Model(&model.Score{}).
+		Where("ip_address = ? AND created_at > ?", check.Score.IPAddress, check.Now.Add(-check.Config.IPWindow)).
+		Count(&count).Error
+	if err != nil {
+		return "", err
+	}
+	if count > int64(check.Config.MaxSubmissionsPerIP) {
+		return fmt.Sprintf("같은 IP에서 %s 동안 %d건이 제출되었습니다", check.Config.IPWindow, count), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"fmt"
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func setupScoreValidationTest(t *testing.T) (*gorm.DB, *ScoreValidationService, *model.User, *model.Game) {
+	db := setupGameTestDB(t, &model.Game{}, &model.Score{}, &model.ScoreFlag{})
+	service := NewScoreValidationService(db)
+	user := seedUser(t, db, "alice", 100)
+
+	game := newTestGame("Tetris", model.GameCategoryPuzzle)
+	game.EstimatedPlayTime = 10
+	require.NoError(t, db.Create(game).Error)
+	return db, service, user, game
+}
+
+// 테스트용 점수 저장
+func seedScore(t *testing.T, db *gorm.DB, userID, gameID uint, score, playTime int, sessionID string) *model.Score {
+	record := &model.Score{
+		UserID:       userID,
+		GameID:       gameID,
+		Score:        score,
+		PlayTime:     playTime,
+		Difficulty:   model.GameDifficultyNormal,
+		GameMode:     "single",
+		SessionID:    sessionID,
+		ReviewStatus: model.ScoreAccepted,
+		EarnedGold:   10,
+	}
+	require.NoError(t, db.Omit("User", "Game", "Flags").Create(record).Error)
+	return record
+}
+
+// 점수를 검사하고 기록된 의심 규칙 이름을 반환
+func screenRules(t *testing.T, db *gorm.DB, service *ScoreValidationService, score *model.Score, game *model.Game) []string {
+	var rules []string
+	err := db.Transaction(func(tx *gorm.DB) error {
+		flags, err := service.Screen(tx, score, game)
+		for _, flag := range flags {
+			rules = append(rules, flag.Rule)
+		}
+		return err
+	})
+	require.NoError(t, err)
+	return rules
+}
+
+// 규칙별 의심 점수 판정 테스트
+func TestScoreValidationService_Rules(t *testing.T) {
+	db, service, user, game := setupScoreValidationTest(t)
+	config := DefaultScoreValidationConfig()
+	config.AutoShadowBanThreshold = 0
+	service.SetConfig(config)
+
+	// 정상 기록은 통과
+	normal := seedScore(t, db, user.ID, game.ID, 100, 600, "s-normal")
+	normal.Completed = true
+	assert.Empty(t, screenRules(t, db, service, normal, game))
+
+	// 예상 10분 게임을 30초 만에 완료
+	fast := seedScore(t, db, user.ID, game.ID, 100, 30, "s-fast")
+	fast.Completed = true
+	assert.Equal(t, []string{model.ScoreRulePlayTime}, screenRules(t, db, service, fast, game))
+
+	// 이미 사용된 세션 ID
+	replay := seedScore(t, db, user.ID, game.ID, 100, 600, "s-normal")
+	assert.Equal(t, []string{model.ScoreRuleSessionReplay}, screenRules(t, db, service, replay, game))
+
+	// 불가능한 속도, 정확도 100%에서의 높은 속도
+	speedy := seedScore(t, db, user.ID, game.ID, 100, 600, "s-speed")
+	speedy.Speed = 25
+	assert.Equal(t, []string{model.ScoreRuleAccuracySpeed}, screenRules(t, db, service, speedy, game))
+	perfect := seedScore(t, db, user.ID, game.ID, 100, 600, "s-perfect")
+	perfect.Accuracy = 100
+	perfect.Speed = 8
+	assert.Equal(t, []string{model.ScoreRuleAccuracySpeed}, screenRules(t, db, service, perfect, game))
+
+	var flagged model.Score
+	require.NoError(t, db.Preload("Flags").First(&flagged, fast.ID).Error)
+	assert.Equal(t, model.ScoreQuarantined, flagged.ReviewStatus)
+	require.Len(t, flagged.Flags, 1)
+	assert.NotEmpty(t, flagged.Flags[0].Reason)
+}
+
+// 분당 점수 이상치와 IP 제출 수 제한 테스트
+func TestScoreValidationService_RateRules(t *testing.T) {
+	db, service, user, game := setupScoreValidationTest(t)
+	config := DefaultScoreValidationConfig()
+	config.MinSamples = 3
+	config.MaxSubmissionsPerIP = 5
+	config.AutoShadowBanThreshold = 0
+	service.SetConfig(config)
+
+	// 표본이 부족하면 이상치 판정을 하지 않음
+	early := seedScore(t, db, user.ID, game.ID, 10000, 60, "s-early")
+	assert.Empty(t, screenRules(t, db, service, early, game))
+	require.NoError(t, db.Delete(early).Error)
+
+	for i := 0; i < 3; i++ {
+		seedScore(t, db, user.ID, game.ID, 100, 60, fmt.Sprintf("s-%d", i))
+	}
+	outlier := seedScore(t, db, user.ID, game.ID, 10000, 60, "s-outlier")
+	assert.Equal(t, []string{model.ScoreRuleScoreRate}, screenRules(t, db, service, outlier, game))
+
+	var last *model.Score
+	for i := 0; i < 6; i++ {
+		last = seedScore(t, db, user.ID, game.ID, 100, 60, fmt.Sprintf("s-ip-%d", i))
+		require.NoError(t, db.Model(last).Update("ip_address", "10.0.0.1").Error)
+		last.IPAddress = "10.0.0.1"
+	}
+	assert.Equal(t, []string{model.ScoreRuleIPRate}, screenRules(t, db, service, last, game))
+}
+
+// 자동 섀도우 밴과 섀도우 밴 사용자 점수 격리 테스트
+func TestScoreValidationService_ShadowBan(t *testing.T) {
+	db, service, user, game := setupScoreValidationTest(t)
+
+	// 첫 기록은 정상, 이후 같은 세션 ID로 제출된 3건은 격리
+	for i := 0; i < 4; i++ {
+		replay := seedScore(t, db, user.ID, game.ID, 100, 600, "s-replayed")
+		screenRules(t, db, service, replay, game)
+	}
+
+	var banned model.User
+	require.NoError(t, db.First(&banned, user.ID).Error)
+	assert.True(t, banned.ShadowBanned, "격리된 점수가 기준을 넘으면 자동으로 섀도우 밴해야 합니다")
+
+	clean := seedScore(t, db, user.ID, game.ID, 100, 600, "s-clean")
+	assert.Equal(t, []string{model.ScoreRuleShadowBan}, screenRules(t, db, service, clean, game))
+
+	require.NoError(t, service.SetShadowBan(user.ID, false))
+	require.NoError(t, db.First(&banned, user.ID).Error)
+	assert.False(t, banned.ShadowBanned)
+	assert.ErrorIs(t, service.SetShadowBan(999, true), model.ErrInvalidUserID)
+}
+
+// 검토 대기열과 승인/거부 테스트
+func TestScoreValidationService_Review(t *testing.T) {
+	db, service, user, game := setupScoreValidationTest(t)
+	moderator := seedUser(t, db, "mod", 0)
+
+	first := seedScore(t, db, user.ID, game.ID, 100, 600, "s-dup")
+	second := seedScore(t, db, user.ID, game.ID, 200, 600, "s-dup")
+	screenRules(t, db, service, first, game)
+	screenRules(t, db, service, second, game)
+
+	queue, total, err := service.GetReviewQueue(10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, queue, 2)
+	assert.NotEmpty(t, queue[0].Flags)
+
+	approved, err := service.ApproveScore(first.ID, moderator.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.ScoreAccepted, approved.ReviewStatus)
+	assert.Equal(t, moderator.ID, *approved.ReviewedBy)
+	assert.Equal(t, 110, userGold(t, db, user.ID), "승인하면 보류된 보상을 지급해야 합니다")
+
+	var high model.Score
+	require.NoError(t, db.First(&high, first.ID).Error)
+	assert.True(t, high.IsHighScore)
+
+	rejected, err := service.RejectScore(second.ID, moderator.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.ScoreRejected, rejected.ReviewStatus)
+	assert.Equal(t, 110, userGold(t, db, user.ID))
+
+	_, err = service.ApproveScore(second.ID, moderator.ID)
+	assert.ErrorIs(t, err, model.ErrScoreNotInReview)
+	_, err = service.RejectScore(999, moderator.ID)
+	assert.ErrorIs(t, err, model.ErrScoreNotFound)
+
+	_, total, err = service.GetReviewQueue(10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+}
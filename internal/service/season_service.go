@@ -0,0 +1,279 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"log"
+	"time"
+)
+
+// 시즌 마감 결과
+type SeasonCloseResult struct {
+	Season    *model.Season `json:"season"`
+	Standings int           `json:"standings"`
+	Rewarded  int           `json:"rewarded"`
+}
+
+// 게임별 경쟁 시즌을 관리하는 서비스
+// 시즌 마감 시 난이도별 최종 순위를 DB 점수 기록으로 계산해 보관하고, 보상 구간에 든 사용자에게 보상을 지급한다.
+type SeasonService struct {
+	db *gorm.DB
+
+	// 마감한 시즌의 리더보드를 초기화할 서비스 (설정하지 않으면 초기화하지 않음)
+	leaderboard *LeaderboardService
+
+	now func() time.Time
+
+	// 인벤토리 통계 캐시 (설정하지 않으면 무효화하지 않음)
+	inventoryStats
+}
+
+// 새로운 SeasonService 인스턴스를 생성
+func NewSeasonService(db *gorm.DB) *SeasonService {
+	return &SeasonService{db: db, now: time.Now}
+}
+
+// 리더보드 서비스를 설정
+func (s *SeasonService) SetLeaderboard(leaderboard *LeaderboardService) {
+	s.leaderboard = leaderboard
+}
+
+// 시즌을 생성 (보상 구간이 없으면 기본 구간 사용)
+func (s *SeasonService) CreateSeason(season *model.Season) error {
+	if len(season.Rewards) == 0 {
+		season.Rewards = model.DefaultSeasonRewards()
+	}
+	if err := season.Validate(); err != nil {
+		return err
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockGame(tx, season.GameID); err != nil {
+			return err
+		}
+
+		var overlapping int64
+		err := tx.Model(&model.Season{}).
+			Where("game_id = ? AND start_at < ? AND end_at > ?", season.GameID, season.EndAt, season.StartAt).
+			Count(&overlapping).Error
+		if err != nil {
+			return fmt.Errorf("시즌 조회 중 오류 발생: %w", err)
+		}
+		if overlapping > 0 {
+			return model.ErrSeasonOverlap
+		}
+
+		if err := tx.Create(season).Error; err != nil {
+			return fmt.Errorf("시즌 생성 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+}
+
+// 시즌 목록을 조회 (최근 시작 순, gameID가 0이면 모든 게임)
+func (s *SeasonService) GetSeasons(gameID uint) ([]model.Season, error) {
+	query := s.db.Preload("Rewards")
+	if gameID != 0 {
+		query = query.Where("game_id = ?", gameID)
+	}
+
+	var seasons []model.Season
+	if err := query.Order("start_at DESC").Find(&seasons).Error; err != nil {
+		return nil, fmt.Errorf("시즌 목록 조회 중 오류 발생: %w", err)
+	}
+	return seasons, nil
+}
+
+// 시즌을 조회
+func (s *SeasonService) GetSeason(id uint) (*model.Season, error) {
+	var season model.Season
+	if err := s.db.Preload("Rewards").First(&season, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrSeasonNotFound
+		}
+		return nil, fmt.Errorf("시즌 조회 중 오류 발생: %w", err)
+	}
+	return &season, nil
+}
+
+// 시즌 최종 순위를 조회 (순위순)
+func (s *SeasonService) GetStandings(seasonID uint, difficulty model.GameDifficulty, limit, offset int) ([]model.SeasonStanding, int64, error) {
+	if _, err := s.GetSeason(seasonID); err != nil {
+		return nil, 0, err
+	}
+
+	query := s.db.Model(&model.SeasonStanding{}).Where("season_id = ? AND difficulty = ?", seasonID, difficulty)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("시즌 순위 수 조회 중 오류 발생: %w", err)
+	}
+
+	var standings []model.SeasonStanding
+	if err := query.Order("`rank` ASC").Limit(limit).Offset(offset).Find(&standings).Error; err != nil {
+		return nil, 0, fmt.Errorf("시즌 순위 조회 중 오류 발생: %w", err)
+	}
+	return standings, total, nil
+}
+
+// 사용자의 지난 시즌 기록을 조회 (최근 시즌 순, gameID가 0이면 모든 게임)
+func (s *SeasonService) GetUserStandings(userID, gameID uint) ([]model.SeasonStanding, error) {
+	query := s.db.Preload("Season").
+		Joins("JOIN seasons ON seasons.id = season_standings.season_id").
+		Where("season_standings.user_id = ?", userID)
+	if gameID != 0 {
+		query = query.Where("seasons.game_id = ?", gameID)
+	}
+
+	var standings []model.SeasonStanding
+	if err := query.Order("seasons.end_at DESC, season_standings.difficulty ASC").Find(&standings).Error; err != nil {
+		return nil, fmt.Errorf("시즌 기록 조회 중 오류 발생: %w", err)
+	}
+	return standings, nil
+}
+
+// 종료된 시즌을 마감
+// 난이도별 최종 순위를 보관하고 보상을 지급한 뒤 시즌 리더보드를 초기화한다.
+func (s *SeasonService) CloseSeason(id uint) (*SeasonCloseResult, error) {
+	result := &SeasonCloseResult{}
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		var season model.Season
+		if err := lockForUpdate(tx).First(&season, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.ErrSeasonNotFound
+			}
+			return fmt.Errorf("시즌 조회 중 오류 발생: %w", err)
+		}
+		if season.ClosedAt != nil {
+			return model.ErrSeasonClosed
+		}
+		now := s.now()
+		if !season.IsEnded(now) {
+			return model.ErrSeasonNotEnded
+		}
+		if err := tx.Where("season_id = ?", season.ID).Order("id ASC").Find(&season.Rewards).Error; err != nil {
+			return fmt.Errorf("시즌 보상 조회 중 오류 발생: %w", err)
+		}
+
+		for _, difficulty := range model.GameDifficulties {
+			entries, err := rankScores(tx, season.GameID, difficulty, &season.StartAt, &season.EndAt)
+			if err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				rewarded, err := archiveStanding(tx, &season, difficulty, entry, int64(len(entries)))
+				if err != nil {
+					return err
+				}
+				result.Standings++
+				if rewarded {
+					result.Rewarded++
+				}
+			}
+		}
+
+		season.ClosedAt = &now
+		if err := tx.Model(&season).Update("closed_at", season.ClosedAt).Error; err != nil {
+			return fmt.Errorf("시즌 마감 중 오류 발생: %w", err)
+		}
+		result.Season = &season
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 최종 순위는 DB에 보관되었으므로 초기화 실패가 마감을 실패시키지 않는다
+	if s.leaderboard != nil {
+		if err := s.leaderboard.ResetSeason(result.Season); err != nil {
+			log.Printf("시즌 리더보드 초기화 실패 (season_id=%d): %v", result.Season.ID, err)
+		}
+	}
+	return result, nil
+}
+
+// 기간이 끝난 시즌을 모두 마감하고 마감한 시즌 수를 반환
+func (s *SeasonService) CloseEndedSeasons(now time.Time) (int, error) {
+	var ids []uint
+	err := s.db.Model(&model.Season{}).
+		Where("closed_at IS NULL AND end_at <= ?", now).
+		Order("end_at ASC").Pluck("id", &ids).Error
+	if err != nil {
+		return 0, fmt.Errorf("종료된 시즌 조회 중 오류 발생: %w", err)
+	}
+
+	closed := 0
+	for _, id := range ids {
+		if _, err := s.CloseSeason(id); err != nil {
+			if errors.Is(err, model.ErrSeasonClosed) {
+				continue
+			}
+			return closed, fmt.Errorf("시즌 마감 실패 (season_id=%d): %w", id, err)
+		}
+		closed++
+	}
+	return closed, nil
+}
+
+// 최종 순위를 보관하고 보상 구간에 들면 보상을 지급
+// 탈퇴한 사용자는 순위만 보관한다.
+func archiveStanding(tx *gorm.DB, season *model.Season, difficulty model.GameDifficulty, entry model.LeaderboardEntry, total int64) (bool, error) {
+	standing := model.SeasonStanding{
+		SeasonID:   season.ID,
+		Difficulty: difficulty,
+		UserID:     entry.UserID,
+		Rank:       entry.Rank,
+		Score:      entry.Score,
+		AchievedAt: entry.AchievedAt,
+	}
+
+	reward := season.RewardFor(entry.Rank, total)
+	if reward != nil {
+		err := grantSeasonReward(tx, season, reward, entry.UserID)
+		switch {
+		case errors.Is(err, model.ErrInvalidUserID):
+			reward = nil
+		case err != nil:
+			return false, err
+		default:
+			standing.RewardName = reward.Name
+			standing.RewardGold = reward.Gold
+			standing.RewardDiamond = reward.Diamond
+			standing.RewardExperience = reward.Experience
+			standing.RewardItemID = reward.ItemID
+			if reward.ItemID != "" {
+				standing.RewardQuantity = reward.Quantity
+			}
+		}
+	}
+
+	if err := tx.Create(&standing).Error; err != nil {
+		return false, fmt.Errorf("시즌 순위 보관 중 오류 발생: %w", err)
+	}
+	return reward != nil, nil
+}
+
+// 시즌 보상을 지급
+func grantSeasonReward(tx *gorm.DB, season *model.Season, reward *model.SeasonReward, userID uint) error {
+	if _, err := lockUser(tx, userID); err != nil {
+		return err
+	}
+	if err := adjustUserGold(tx, userID, reward.Gold); err != nil {
+		return err
+	}
+	if err := adjustUserDiamond(tx, userID, reward.Diamond); err != nil {
+		return err
+	}
+	if _, err := adjustUserExperience(tx, userID, reward.Experience); err != nil {
+		return err
+	}
+	if reward.ItemID != "" {
+		change := model.NewInventoryChange(model.InventorySourceSeason, 0, "season", season.ID)
+		if _, err := grantInventoryItem(tx, userID, reward.ToInventory(userID), reward.Quantity, change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"fmt"
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func setupSeasonTest(t *testing.T) (*gorm.DB, *SeasonService, *LeaderboardService, *memoryLeaderboardStore, *model.Game, time.Time) {
+	db := setupGameTestDB(t, &model.Game{}, &model.Score{}, &model.ScoreFlag{}, &model.Friendship{},
+		&model.Season{}, &model.SeasonReward{}, &model.SeasonStanding{})
+	now := time.Date(2025, 3, 12, 12, 0, 0, 0, time.UTC)
+
+	store := newMemoryLeaderboardStore()
+	leaderboard := NewLeaderboardService(db, store)
+	leaderboard.now = func() time.Time { return now }
+
+	service := NewSeasonService(db)
+	service.now = func() time.Time { return now }
+	service.SetLeaderboard(leaderboard)
+
+	game := newTestGame("Tetris", model.GameCategoryPuzzle)
+	require.NoError(t, db.Create(game).Error)
+	return db, service, leaderboard, store, game, now
+}
+
+// 시즌 생성과 기간 중복 검사 테스트
+func TestSeasonService_CreateSeason(t *testing.T) {
+	_, service, _, _, game, now := setupSeasonTest(t)
+
+	season := &model.Season{GameID: game.ID, Name: "시즌 1", StartAt: now.AddDate(0, -1, 0), EndAt: now.AddDate(0, 1, 0)}
+	require.NoError(t, service.CreateSeason(season))
+	assert.Len(t, season.Rewards, 3, "보상 구간이 없으면 기본 구간을 사용해야 합니다")
+
+	overlap := &model.Season{GameID: game.ID, Name: "시즌 2", StartAt: now, EndAt: now.AddDate(0, 2, 0)}
+	assert.ErrorIs(t, service.CreateSeason(overlap), model.ErrSeasonOverlap)
+
+	next := &model.Season{GameID: game.ID, Name: "시즌 2", StartAt: season.EndAt, EndAt: season.EndAt.AddDate(0, 1, 0)}
+	require.NoError(t, service.CreateSeason(next))
+
+	invalid := &model.Season{GameID: game.ID, Name: "거꾸로", StartAt: now, EndAt: now.Add(-time.Hour)}
+	assert.ErrorIs(t, service.CreateSeason(invalid), model.ErrInvalidSeason)
+	missing := &model.Season{GameID: 999, Name: "없는 게임", StartAt: now, EndAt: now.Add(time.Hour)}
+	assert.ErrorIs(t, service.CreateSeason(missing), model.ErrGameNotFound)
+
+	seasons, err := service.GetSeasons(game.ID)
+	require.NoError(t, err)
+	require.Len(t, seasons, 2)
+	assert.Equal(t, next.ID, seasons[0].ID)
+}
+
+// 시즌 리더보드와 시즌 마감(순위 보관, 보상 지급, 리더보드 초기화) 테스트
+func TestSeasonService_CloseSeason(t *testing.T) {
+	db, service, leaderboard, store, game, now := setupSeasonTest(t)
+
+	season := &model.Season{
+		GameID:  game.ID,
+		Name:    "시즌 1",
+		StartAt: now.AddDate(0, 0, -7),
+		EndAt:   now.Add(time.Hour),
+		Rewards: []model.SeasonReward{
+			{Name: "1위", MaxRank: 1, Gold: 1000, Diamond: 10, ItemID: "crown", ItemName: "왕관", ItemType: "cosmetic", Rarity: "legendary", Quantity: 1},
+			{Name: "상위 50%", TopPercent: 50, Gold: 100},
+		},
+	}
+	require.NoError(t, service.CreateSeason(season))
+
+	users := make([]*model.User, 4)
+	for i := range users {
+		users[i] = seedUser(t, db, fmt.Sprintf("player%d", i), 0)
+		require.NoError(t, leaderboard.RecordScore(seedRankedScore(t, db, users[i].ID, game.ID, 400-i*100, now.Add(-time.Hour))))
+	}
+	// 시즌 시작 전 기록은 제외
+	require.NoError(t, leaderboard.RecordScore(seedRankedScore(t, db, users[3].ID, game.ID, 9999, now.AddDate(0, 0, -8))))
+
+	top, err := leaderboard.GetTop(game.ID, model.GameDifficultyNormal, model.LeaderboardSeason, 10)
+	require.NoError(t, err)
+	require.Len(t, top.Entries, 4)
+	assert.Equal(t, users[0].ID, top.Entries[0].UserID)
+	assert.Equal(t, fmt.Sprintf("s%d", season.ID), top.Board.Period)
+
+	_, err = service.CloseSeason(season.ID)
+	assert.ErrorIs(t, err, model.ErrSeasonNotEnded)
+
+	later := now.Add(2 * time.Hour)
+	service.now = func() time.Time { return later }
+	closed, err := service.CloseEndedSeasons(later)
+	require.NoError(t, err)
+	assert.Equal(t, 1, closed)
+	_, err = service.CloseSeason(season.ID)
+	assert.ErrorIs(t, err, model.ErrSeasonClosed)
+
+	// 1위는 1위 보상만, 2위는 상위 50% 보상, 나머지는 순위만 보관
+	standings, total, err := service.GetStandings(season.ID, model.GameDifficultyNormal, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+	require.Len(t, standings, 4)
+	assert.Equal(t, "1위", standings[0].RewardName)
+	assert.Equal(t, "상위 50%", standings[1].RewardName)
+	assert.Empty(t, standings[2].RewardName)
+	assert.Equal(t, 100, standings[3].Score)
+
+	assert.Equal(t, 1000, userGold(t, db, users[0].ID))
+	assert.Equal(t, 100, userGold(t, db, users[1].ID))
+	assert.Equal(t, 0, userGold(t, db, users[2].ID))
+	var crown model.Inventory
+	require.NoError(t, db.Where("user_id = ? AND item_id = ?", users[0].ID, "crown").First(&crown).Error)
+	var record model.InventoryLog
+	require.NoError(t, db.Where("inventory_id = ?", crown.ID).First(&record).Error)
+	assert.Equal(t, model.InventorySourceSeason, record.Source)
+
+	// 시즌 리더보드는 초기화되고 진행 중인 시즌이 없으면 404 대상 에러
+	board, err := model.NewSeasonLeaderboardBoard(season, model.GameDifficultyNormal)
+	require.NoError(t, err)
+	assert.Empty(t, store.boards[board.Key()])
+	_, err = leaderboard.GetTop(game.ID, model.GameDifficultyNormal, model.LeaderboardSeason, 10)
+	assert.ErrorIs(t, err, model.ErrNoActiveSeason)
+
+	history, err := service.GetUserStandings(users[1].ID, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, int64(2), history[0].Rank)
+	require.NotNil(t, history[0].Season)
+	assert.Equal(t, "시즌 1", history[0].Season.Name)
+}
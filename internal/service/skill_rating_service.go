@@ -0,0 +1,293 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"math"
+	"sort"
+	"time"
+)
+
+// 편차 감쇠 시 한 번에 처리하는 레이팅 수
+const skillDecayBatchSize = 500
+
+// 매치 참가자의 결과
+type MatchResult struct {
+	UserID uint
+	Score  int
+}
+
+// 게임별 플레이어 스킬 레이팅 서비스
+// 멀티플레이 매치 결과로 레이팅과 편차를 갱신하고, 경기가 없던 레이팅 기간만큼 편차를 늘린다.
+// 레이팅 계산은 model.RatingAlgorithm 구현(Glicko-2, Elo)으로 교체할 수 있다.
+type SkillRatingService struct {
+	db        *gorm.DB
+	algorithm model.RatingAlgorithm
+
+	// 레이팅 기간 (이 기간 동안 경기가 없으면 편차가 한 번 늘어남)
+	period time.Duration
+
+	now func() time.Time
+}
+
+// 새로운 SkillRatingService 인스턴스를 생성
+func NewSkillRatingService(db *gorm.DB, algorithm model.RatingAlgorithm, period time.Duration) *SkillRatingService {
+	return &SkillRatingService{
+		db:        db,
+		algorithm: algorithm,
+		period:    period,
+		now:       time.Now,
+	}
+}
+
+// 매치 결과로 참가자의 레이팅을 갱신하고 변동 기록을 남김
+// 점수 제출과 같은 트랜잭션에서 호출된다. 참가자는 다른 모든 참가자와 점수로 대결한 것으로 본다.
+func (s *SkillRatingService) RecordMatch(tx *gorm.DB, gameID uint, matchID *uint, results []MatchResult) ([]model.SkillRating, error) {
+	if len(results) < 2 {
+		return nil, nil
+	}
+
+	// 교착 상태를 피하기 위해 사용자 ID 순으로 잠금
+	sorted := make([]MatchResult, len(results))
+	copy(sorted, results)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].UserID < sorted[j].UserID })
+
+	now := s.now()
+	ratings := make([]*model.SkillRating, len(sorted))
+	before := make([]model.RatingState, len(sorted))
+	for i, result := range sorted {
+		rating, err := s.lockRating(tx, result.UserID, gameID, now)
+		if err != nil {
+			return nil, err
+		}
+		// 매치 전까지 경기가 없던 기간의 편차 증가를 먼저 반영
+		s.decay(rating, now)
+		ratings[i] = rating
+		before[i] = rating.State()
+	}
+
+	updated := make([]model.SkillRating, len(sorted))
+	for i, result := range sorted {
+		outcomes := make([]model.RatingOutcome, 0, len(sorted)-1)
+		for j, opponent := range sorted {
+			if i != j {
+				outcomes = append(outcomes, model.RatingOutcome{Opponent: before[j], Score: model.OutcomeScore(result.Score, opponent.Score)})
+			}
+		}
+
+		rating := ratings[i]
+		rating.Apply(roundRatingState(s.algorithm.Update(before[i], outcomes)))
+		rating.Matches++
+		rating.RatedAt = now
+		rating.LastPlayedAt = &now
+		if err := tx.Save(rating).Error; err != nil {
+			return nil, fmt.Errorf("스킬 레이팅 저장 중 오류 발생: %w", err)
+		}
+
+		history := model.SkillRatingHistory{
+			UserID:      rating.UserID,
+			GameID:      gameID,
+			MatchID:     matchID,
+			Rating:      rating.Rating,
+			Deviation:   rating.Deviation,
+			RatingDelta: math.Round((rating.Rating-before[i].Rating)*100) / 100,
+			Tier:        rating.Tier,
+			CreatedAt:   now,
+		}
+		if err := tx.Create(&history).Error; err != nil {
+			return nil, fmt.Errorf("스킬 레이팅 기록 저장 중 오류 발생: %w", err)
+		}
+		updated[i] = *rating
+	}
+	return updated, nil
+}
+
+// 경기가 없던 레이팅 기간만큼 편차를 늘리고 처리한 레이팅 수를 반환
+// 조회 후 갱신 전에 매치 결과가 반영될 수 있으므로, 레이팅마다 잠금 후 다시 읽어 감쇠한다.
+// 매치 처리와의 교착 상태를 피하기 위해 한 트랜잭션에서 레이팅 하나만 잠근다.
+func (s *SkillRatingService) DecayInactive(now time.Time) (int, error) {
+	count := 0
+	var batch []model.SkillRating
+	err := s.db.Select("id").
+		Where("rated_at <= ? AND deviation < ?", now.Add(-s.period), model.DefaultSkillDeviation).
+		FindInBatches(&batch, skillDecayBatchSize, func(_ *gorm.DB, _ int) error {
+			for i := range batch {
+				decayed, err := s.decayRating(batch[i].ID, now)
+				if err != nil {
+					return err
+				}
+				if decayed {
+					count++
+				}
+			}
+			return nil
+		}).Error
+	if err != nil {
+		return count, err
+	}
+	return count, nil
+}
+
+// 레이팅 하나를 잠금 상태로 다시 읽어 편차를 늘림
+func (s *SkillRatingService) decayRating(ratingID uint, now time.Time) (bool, error) {
+	decayed := false
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var rating model.SkillRating
+		if err := lockForUpdate(tx).First(&rating, ratingID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return fmt.Errorf("스킬 레이팅 조회 중 오류 발생: %w", err)
+		}
+		if !s.decay(&rating, now) {
+			return nil
+		}
+		err := tx.Model(&rating).Updates(map[string]interface{}{
+			"deviation": rating.Deviation,
+			"rated_at":  rating.RatedAt,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("스킬 레이팅 편차 갱신 중 오류 발생: %w", err)
+		}
+		decayed = true
+		return nil
+	})
+	return decayed, err
+}
+
+// 사용자의 게임 스킬 레이팅을 조회 (매치 기록이 없으면 기본 레이팅)
+func (s *SkillRatingService) GetRating(userID, gameID uint) (*model.SkillRating, error) {
+	if err := s.ensureGame(gameID); err != nil {
+		return nil, err
+	}
+
+	var rating model.SkillRating
+	err := s.db.Where("user_id = ? AND game_id = ?", userID, gameID).First(&rating).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return model.NewSkillRating(userID, gameID, s.now()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("스킬 레이팅 조회 중 오류 발생: %w", err)
+	}
+	rating.Provisional = rating.IsProvisional()
+	return &rating, nil
+}
+
+// 사용자의 게임 스킬 레이팅 변동 기록을 최신순으로 조회
+func (s *SkillRatingService) GetHistory(userID, gameID uint, limit, offset int) ([]model.SkillRatingHistory, int64, error) {
+	if err := s.ensureGame(gameID); err != nil {
+		return nil, 0, err
+	}
+
+	query := s.db.Model(&model.SkillRatingHistory{}).Where("user_id = ? AND game_id = ?", userID, gameID)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("스킬 레이팅 기록 조회 중 오류 발생: %w", err)
+	}
+
+	var history []model.SkillRatingHistory
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&history).Error; err != nil {
+		return nil, 0, fmt.Errorf("스킬 레이팅 기록 조회 중 오류 발생: %w", err)
+	}
+	return history, total, nil
+}
+
+// 게임의 스킬 레이팅 순위를 조회 (배치 중인 플레이어 제외, 티어 지정 시 해당 티어만)
+func (s *SkillRatingService) GetRankings(gameID uint, tier model.RankTier, limit, offset int) ([]model.SkillRating, int64, error) {
+	if tier != "" && !model.IsRankTier(tier) {
+		return nil, 0, model.ErrInvalidRankTier
+	}
+	if err := s.ensureGame(gameID); err != nil {
+		return nil, 0, err
+	}
+
+	query := s.db.Model(&model.SkillRating{}).Where("game_id = ? AND deviation <= ?", gameID, model.ProvisionalSkillDeviation)
+	if tier != "" {
+		query = query.Where("tier = ?", tier)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("스킬 레이팅 순위 조회 중 오류 발생: %w", err)
+	}
+
+	var ratings []model.SkillRating
+	if err := query.Order("rating DESC, id ASC").Limit(limit).Offset(offset).Find(&ratings).Error; err != nil {
+		return nil, 0, fmt.Errorf("스킬 레이팅 순위 조회 중 오류 발생: %w", err)
+	}
+	if len(ratings) == 0 {
+		return ratings, total, nil
+	}
+
+	userIDs := make([]uint, len(ratings))
+	for i := range ratings {
+		userIDs[i] = ratings[i].UserID
+	}
+	var users []model.User
+	if err := s.db.Select("id", "nickname").Where("id IN ?", userIDs).Find(&users).Error; err != nil {
+		return nil, 0, fmt.Errorf("사용자 조회 중 오류 발생: %w", err)
+	}
+	nicknames := make(map[uint]string, len(users))
+	for _, user := range users {
+		nicknames[user.ID] = user.Nickname
+	}
+	for i := range ratings {
+		ratings[i].Nickname = nicknames[ratings[i].UserID]
+		ratings[i].Provisional = ratings[i].IsProvisional()
+	}
+	return ratings, total, nil
+}
+
+// 레이팅을 잠그고 조회 (없으면 기본 레이팅으로 생성)
+func (s *SkillRatingService) lockRating(tx *gorm.DB, userID, gameID uint, now time.Time) (*model.SkillRating, error) {
+	var rating model.SkillRating
+	err := lockForUpdate(tx).Where("user_id = ? AND game_id = ?", userID, gameID).First(&rating).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		created := model.NewSkillRating(userID, gameID, now)
+		if err := tx.Create(created).Error; err != nil {
+			return nil, fmt.Errorf("스킬 레이팅 생성 중 오류 발생: %w", err)
+		}
+		return created, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("스킬 레이팅 조회 중 오류 발생: %w", err)
+	}
+	return &rating, nil
+}
+
+// 마지막 반영 이후 지난 레이팅 기간만큼 편차를 늘림 (지난 기간이 없으면 false)
+// 반영 시각은 처리한 기간만큼만 옮겨 남은 시간이 다음 감쇠에 이어지게 한다.
+func (s *SkillRatingService) decay(rating *model.SkillRating, now time.Time) bool {
+	if s.period <= 0 {
+		return false
+	}
+	periods := int(now.Sub(rating.RatedAt) / s.period)
+	if periods <= 0 {
+		return false
+	}
+	rating.Apply(roundRatingState(s.algorithm.Decay(rating.State(), periods)))
+	rating.RatedAt = rating.RatedAt.Add(time.Duration(periods) * s.period)
+	return true
+}
+
+// 게임이 있는지 확인
+func (s *SkillRatingService) ensureGame(gameID uint) error {
+	var count int64
+	if err := s.db.Model(&model.Game{}).Where("id = ?", gameID).Count(&count).Error; err != nil {
+		return fmt.Errorf("게임 조회 중 오류 발생: %w", err)
+	}
+	if count == 0 {
+		return model.ErrGameNotFound
+	}
+	return nil
+}
+
+// 저장할 레이팅 값을 반올림 (레이팅/편차는 소수 둘째 자리, 변동성은 여섯째 자리)
+func roundRatingState(state model.RatingState) model.RatingState {
+	return model.RatingState{
+		Rating:     math.Round(state.Rating*100) / 100,
+		Deviation:  math.Round(state.Deviation*100) / 100,
+		Volatility: math.Round(state.Volatility*1e6) / 1e6,
+	}
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func setupSkillRatingTest(t *testing.T) (*gorm.DB, *SkillRatingService, *model.User, *model.Game, time.Time) {
+	db, _, alice, tetris := setupGameSessionTest(t)
+	require.NoError(t, db.AutoMigrate(&model.SkillRating{}, &model.SkillRatingHistory{}))
+	service := NewSkillRatingService(db, &model.Glicko2Rating{Tau: model.Glicko2DefaultTau}, 7*24*time.Hour)
+
+	now := time.Date(2025, 3, 12, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+	return db, service, alice, tetris, now
+}
+
+// 매치 결과로 레이팅 갱신과 변동 기록 저장 테스트
+func TestSkillRatingService_RecordMatch(t *testing.T) {
+	db, service, user, tetris, now := setupSkillRatingTest(t)
+	alice, bob, carol := user.ID, seedUser(t, db, "bob", 0).ID, seedUser(t, db, "carol", 0).ID
+	matchID := uint(7)
+
+	var updated []model.SkillRating
+	err := db.Transaction(func(tx *gorm.DB) error {
+		ratings, err := service.RecordMatch(tx, tetris.ID, &matchID, []MatchResult{{UserID: carol, Score: 100}, {UserID: alice, Score: 300}, {UserID: bob, Score: 100}})
+		updated = ratings
+		return err
+	})
+	require.NoError(t, err)
+	require.Len(t, updated, 3)
+
+	// 같은 기본 레이팅에서 시작했으므로 알고리즘 결과와 같아야 함
+	initial := model.NewSkillRating(0, 0, now).State()
+	winner := roundRatingState(service.algorithm.Update(initial, []model.RatingOutcome{
+		{Opponent: initial, Score: 1}, {Opponent: initial, Score: 1},
+	}))
+
+	rating, err := service.GetRating(alice, tetris.ID)
+	require.NoError(t, err)
+	assert.Equal(t, winner.Rating, rating.Rating)
+	assert.Equal(t, winner.Deviation, rating.Deviation)
+	assert.Equal(t, 1, rating.Matches)
+	assert.Equal(t, model.RankTierFor(winner.Rating), rating.Tier)
+	assert.True(t, rating.Provisional)
+
+	bobRating, err := service.GetRating(bob, tetris.ID)
+	require.NoError(t, err)
+	carolRating, err := service.GetRating(carol, tetris.ID)
+	require.NoError(t, err)
+	assert.Less(t, bobRating.Rating, float64(model.DefaultSkillRating))
+	assert.Equal(t, bobRating.Rating, carolRating.Rating, "같은 점수는 같은 결과여야 합니다")
+
+	history, total, err := service.GetHistory(alice, tetris.ID, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, history, 1)
+	assert.Equal(t, matchID, *history[0].MatchID)
+	assert.InDelta(t, winner.Rating-model.DefaultSkillRating, history[0].RatingDelta, 0.01)
+
+	// 혼자 남은 결과는 반영하지 않음
+	ratings, err := service.RecordMatch(db, tetris.ID, nil, []MatchResult{{UserID: alice, Score: 10}})
+	require.NoError(t, err)
+	assert.Nil(t, ratings)
+
+	// 기록이 없는 사용자는 기본 레이팅
+	rating, err = service.GetRating(999, tetris.ID)
+	require.NoError(t, err)
+	assert.Equal(t, float64(model.DefaultSkillRating), rating.Rating)
+	assert.Equal(t, 0, rating.Matches)
+
+	_, err = service.GetRating(alice, 999)
+	assert.ErrorIs(t, err, model.ErrGameNotFound)
+}
+
+// 비활동 편차 증가와 순위 조회 테스트
+func TestSkillRatingService_DecayAndRankings(t *testing.T) {
+	db, service, _, tetris, now := setupSkillRatingTest(t)
+	bob := seedUser(t, db, "bob", 0)
+	carol := seedUser(t, db, "carol", 0)
+	dave := seedUser(t, db, "dave", 0)
+
+	seed := func(userID uint, rating, deviation float64) *model.SkillRating {
+		record := model.NewSkillRating(userID, tetris.ID, now)
+		record.Apply(model.RatingState{Rating: rating, Deviation: deviation, Volatility: model.DefaultSkillVolatility})
+		record.Matches = 10
+		require.NoError(t, db.Create(record).Error)
+		return record
+	}
+	seed(bob.ID, 1850, 60)
+	seed(carol.ID, 1650, 90)
+	seed(dave.ID, 2100, 200) // 배치 중
+
+	ratings, total, err := service.GetRankings(tetris.ID, "", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total, "배치 중인 플레이어는 제외해야 합니다")
+	require.Len(t, ratings, 2)
+	assert.Equal(t, bob.ID, ratings[0].UserID)
+	assert.Equal(t, bob.Nickname, ratings[0].Nickname)
+	assert.Equal(t, model.RankTierDiamond, ratings[0].Tier)
+
+	ratings, _, err = service.GetRankings(tetris.ID, model.RankTierPlatinum, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, ratings, 1)
+	assert.Equal(t, carol.ID, ratings[0].UserID)
+
+	_, _, err = service.GetRankings(tetris.ID, "grandmaster", 10, 0)
+	assert.ErrorIs(t, err, model.ErrInvalidRankTier)
+
+	// 레이팅 기간이 지나기 전에는 편차가 그대로
+	count, err := service.DecayInactive(now.Add(6 * 24 * time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// 두 기간이 지나면 두 번 늘어나고, 남은 시간은 다음 감쇠로 이어짐
+	later := now.Add(15 * 24 * time.Hour)
+	count, err = service.DecayInactive(later)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	expected := roundRatingState(service.algorithm.Decay(model.RatingState{Rating: 1850, Deviation: 60, Volatility: model.DefaultSkillVolatility}, 2))
+	rating, err := service.GetRating(bob.ID, tetris.ID)
+	require.NoError(t, err)
+	assert.Equal(t, expected.Deviation, rating.Deviation)
+	assert.Greater(t, rating.Deviation, 60.0)
+	assert.Equal(t, 1850.0, rating.Rating)
+	assert.True(t, now.Add(14*24*time.Hour).Equal(rating.RatedAt))
+
+	count, err = service.DecayInactive(later)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count, "같은 기간을 두 번 반영하지 않아야 합니다")
+
+	// 조회 후 매치로 갱신된 레이팅은 잠금 후 다시 읽어 감쇠하지 않음
+	require.NoError(t, db.Model(&model.SkillRating{}).Where("id = ?", rating.ID).
+		Updates(map[string]interface{}{"deviation": 50, "rated_at": later}).Error)
+	decayed, err := service.decayRating(rating.ID, later.Add(3*24*time.Hour))
+	require.NoError(t, err)
+	assert.False(t, decayed)
+	rating, err = service.GetRating(bob.ID, tetris.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, rating.Deviation, "매치로 갱신된 편차를 덮어쓰지 않아야 합니다")
+}
@@ -0,0 +1,1080 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"log"
+	"sort"
+	"time"
+)
+
+// 엘리미네이션 우승자의 탈락 단계 (최종 순위 계산 시 가장 높음)
+const championStage = 1 << 20
+
+// 토너먼트 대진표 조회 결과
+type TournamentBracketView struct {
+	Tournament   *model.Tournament             `json:"tournament"`
+	Participants []model.TournamentParticipant `json:"participants"`
+	Matches      []model.TournamentMatch       `json:"matches"`
+}
+
+// 토너먼트를 관리하는 서비스
+// 참가 신청 기간 동안 참가비를 받아 상금에 더하고, 마감 후 스킬 레이팅으로 시드를 정해 대진을 생성한다.
+// 매치 결과는 두 참가자의 보고가 일치하면 확정되고, 다르면 이의 제기로 넘어가 관리자가 판정한다.
+type TournamentService struct {
+	db *gorm.DB
+
+	now func() time.Time
+}
+
+// 새로운 TournamentService 인스턴스를 생성
+func NewTournamentService(db *gorm.DB) *TournamentService {
+	return &TournamentService{db: db, now: time.Now}
+}
+
+// 토너먼트를 생성 (운영 지원 상금으로 상금을 시작)
+func (s *TournamentService) CreateTournament(tournament *model.Tournament) error {
+	if tournament.EntryCurrency == "" {
+		tournament.EntryCurrency = model.TournamentCurrencyGold
+	}
+	if tournament.MinParticipants == 0 {
+		tournament.MinParticipants = 2
+	}
+	tournament.Status = model.TournamentRegistration
+	tournament.PrizePool = tournament.BasePrize
+	tournament.CurrentRound = 0
+	if err := tournament.Validate(); err != nil {
+		return err
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockGame(tx, tournament.GameID); err != nil {
+			return err
+		}
+		if err := tx.Create(tournament).Error; err != nil {
+			return fmt.Errorf("토너먼트 생성 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+}
+
+// 토너먼트 목록을 조회 (참가 신청 마감 순, gameID가 0이거나 status가 비어 있으면 조건 없음)
+func (s *TournamentService) GetTournaments(gameID uint, status model.TournamentStatus, limit, offset int) ([]model.Tournament, int64, error) {
+	query := s.db.Model(&model.Tournament{})
+	if gameID != 0 {
+		query = query.Where("game_id = ?", gameID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("토너먼트 목록 조회 중 오류 발생: %w", err)
+	}
+
+	var tournaments []model.Tournament
+	if err := query.Order("registration_end_at DESC, id DESC").Limit(limit).Offset(offset).Find(&tournaments).Error; err != nil {
+		return nil, 0, fmt.Errorf("토너먼트 목록 조회 중 오류 발생: %w", err)
+	}
+	if err := s.fillParticipantCounts(tournaments); err != nil {
+		return nil, 0, err
+	}
+	return tournaments, total, nil
+}
+
+// 토너먼트를 조회
+func (s *TournamentService) GetTournament(id uint) (*model.Tournament, error) {
+	var tournament model.Tournament
+	if err := s.db.First(&tournament, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrTournamentNotFound
+		}
+		return nil, fmt.Errorf("토너먼트 조회 중 오류 발생: %w", err)
+	}
+
+	tournaments := []model.Tournament{tournament}
+	if err := s.fillParticipantCounts(tournaments); err != nil {
+		return nil, err
+	}
+	return &tournaments[0], nil
+}
+
+// 토너먼트 참가자와 대진표를 조회
+func (s *TournamentService) GetBracket(id uint) (*TournamentBracketView, error) {
+	tournament, err := s.GetTournament(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var participants []model.TournamentParticipant
+	err = s.db.Where("tournament_id = ?", id).Order("CASE WHEN seed = 0 THEN 1 ELSE 0 END, seed ASC, id ASC").Find(&participants).Error
+	if err != nil {
+		return nil, fmt.Errorf("토너먼트 참가자 조회 중 오류 발생: %w", err)
+	}
+	if err := s.fillNicknames(participants); err != nil {
+		return nil, err
+	}
+
+	var matches []model.TournamentMatch
+	if err := s.db.Where("tournament_id = ?", id).Order("id ASC").Find(&matches).Error; err != nil {
+		return nil, fmt.Errorf("토너먼트 매치 조회 중 오류 발생: %w", err)
+	}
+
+	return &TournamentBracketView{
+		Tournament:   tournament,
+		Participants: participants,
+		Matches:      matches,
+	}, nil
+}
+
+// 토너먼트에 참가 신청 (참가비를 차감해 상금에 더함)
+func (s *TournamentService) Register(userID, tournamentID uint) (*model.TournamentParticipant, error) {
+	var participant *model.TournamentParticipant
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		tournament, err := lockTournament(tx, tournamentID)
+		if err != nil {
+			return err
+		}
+		if !tournament.IsRegistrationOpen(s.now()) {
+			return model.ErrRegistrationClosed
+		}
+
+		var existing int64
+		if err := tx.Model(&model.TournamentParticipant{}).Where("tournament_id = ? AND user_id = ?", tournamentID, userID).Count(&existing).Error; err != nil {
+			return fmt.Errorf("토너먼트 참가자 조회 중 오류 발생: %w", err)
+		}
+		if existing > 0 {
+			return model.ErrAlreadyRegistered
+		}
+
+		var count int64
+		if err := tx.Model(&model.TournamentParticipant{}).Where("tournament_id = ?", tournamentID).Count(&count).Error; err != nil {
+			return fmt.Errorf("토너먼트 참가자 조회 중 오류 발생: %w", err)
+		}
+		if int(count) >= tournament.MaxParticipants {
+			return model.ErrTournamentFull
+		}
+
+		if _, err := lockUser(tx, userID); err != nil {
+			return err
+		}
+		if err := adjustTournamentCurrency(tx, tournament, userID, -tournament.EntryFee); err != nil {
+			return err
+		}
+		if err := updatePrizePool(tx, tournament, tournament.EntryFee); err != nil {
+			return err
+		}
+
+		participant = &model.TournamentParticipant{
+			TournamentID: tournamentID,
+			UserID:       userID,
+			Status:       model.ParticipantActive,
+			EntryPaid:    tournament.EntryFee,
+		}
+		if err := tx.Create(participant).Error; err != nil {
+			return fmt.Errorf("토너먼트 참가 신청 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return participant, nil
+}
+
+// 토너먼트 참가를 취소 (시작 전에만 가능, 참가비 환불)
+func (s *TournamentService) Withdraw(userID, tournamentID uint) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		tournament, err := lockTournament(tx, tournamentID)
+		if err != nil {
+			return err
+		}
+		if tournament.Status != model.TournamentRegistration {
+			return model.ErrRegistrationClosed
+		}
+
+		var participant model.TournamentParticipant
+		if err := tx.Where("tournament_id = ? AND user_id = ?", tournamentID, userID).First(&participant).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.ErrNotRegistered
+			}
+			return fmt.Errorf("토너먼트 참가자 조회 중 오류 발생: %w", err)
+		}
+
+		if err := adjustTournamentCurrency(tx, tournament, userID, participant.EntryPaid); err != nil {
+			return err
+		}
+		if err := updatePrizePool(tx, tournament, -participant.EntryPaid); err != nil {
+			return err
+		}
+		if err := tx.Delete(&participant).Error; err != nil {
+			return fmt.Errorf("토너먼트 참가 취소 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+}
+
+// 토너먼트를 바로 시작 (참가 인원이 최소 인원보다 적으면 시작할 수 없음)
+func (s *TournamentService) StartTournament(id uint) (*model.Tournament, error) {
+	var tournament *model.Tournament
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		tournament, err = s.start(tx, id, false)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tournament, nil
+}
+
+// 참가 신청이 마감된 토너먼트를 시작하고 시작한 수를 반환
+// 참가 인원이 최소 인원보다 적은 토너먼트는 취소하고 참가비를 환불한다.
+func (s *TournamentService) StartDueTournaments(now time.Time) (int, error) {
+	var ids []uint
+	err := s.db.Model(&model.Tournament{}).
+		Where("status = ? AND registration_end_at <= ?", model.TournamentRegistration, now).
+		Order("registration_end_at ASC").
+		Pluck("id", &ids).Error
+	if err != nil {
+		return 0, fmt.Errorf("토너먼트 조회 중 오류 발생: %w", err)
+	}
+
+	started := 0
+	for _, id := range ids {
+		var tournament *model.Tournament
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			var err error
+			tournament, err = s.start(tx, id, true)
+			return err
+		})
+		if err != nil {
+			log.Printf("토너먼트 시작 실패: tournament_id=%d, error=%v", id, err)
+			continue
+		}
+		if tournament.Status == model.TournamentInProgress {
+			started++
+		}
+	}
+	return started, nil
+}
+
+// 토너먼트를 취소하고 참가비를 환불
+func (s *TournamentService) CancelTournament(id uint) (*model.Tournament, error) {
+	var tournament *model.Tournament
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		tournament, err = lockTournament(tx, id)
+		if err != nil {
+			return err
+		}
+		if tournament.Status != model.TournamentRegistration && tournament.Status != model.TournamentInProgress {
+			return model.ErrTournamentNotStartable
+		}
+		return s.cancel(tx, tournament)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tournament, nil
+}
+
+// 매치 결과를 보고 (내 점수와 상대 점수)
+// 먼저 보고한 결과를 상대가 같은 결과로 보고하면 확정하고, 다른 결과를 보고하면 이의 제기로 넘긴다.
+func (s *TournamentService) ReportResult(userID, tournamentID, matchID uint, myScore, opponentScore int) (*model.TournamentMatch, error) {
+	if myScore < 0 || opponentScore < 0 || myScore == opponentScore {
+		return nil, model.ErrInvalidMatchResult
+	}
+
+	var match *model.TournamentMatch
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		tournament, err := lockTournament(tx, tournamentID)
+		if err != nil {
+			return err
+		}
+		match, err = lockTournamentMatch(tx, tournamentID, matchID)
+		if err != nil {
+			return err
+		}
+
+		slot := match.SlotOf(userID)
+		if slot == 0 {
+			return model.ErrNotMatchParticipant
+		}
+		if tournament.Status != model.TournamentInProgress || !match.AcceptsReport() {
+			return model.ErrMatchNotReportable
+		}
+
+		score1, score2 := myScore, opponentScore
+		if slot == 2 {
+			score1, score2 = opponentScore, myScore
+		}
+
+		// 처음 보고했거나 같은 참가자가 다시 보고하면 결과를 덮어씀
+		if match.Status == model.TournamentMatchReady || *match.ReportedBy == userID {
+			match.Score1, match.Score2 = &score1, &score2
+			match.ReportedBy = &userID
+			match.Status = model.TournamentMatchReported
+			if err := tx.Save(match).Error; err != nil {
+				return fmt.Errorf("매치 결과 저장 중 오류 발생: %w", err)
+			}
+			return nil
+		}
+
+		if *match.Score1 == score1 && *match.Score2 == score2 {
+			return s.completeWithScores(tx, tournament, match, score1, score2)
+		}
+		_, err = s.openDispute(tx, match, userID, "양쪽이 보고한 결과가 다릅니다")
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
+// 상대가 보고한 매치 결과에 이의를 제기
+func (s *TournamentService) DisputeResult(userID, tournamentID, matchID uint, reason string) (*model.TournamentDispute, error) {
+	var dispute *model.TournamentDispute
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		tournament, err := lockTournament(tx, tournamentID)
+		if err != nil {
+			return err
+		}
+		match, err := lockTournamentMatch(tx, tournamentID, matchID)
+		if err != nil {
+			return err
+		}
+		if match.SlotOf(userID) == 0 {
+			return model.ErrNotMatchParticipant
+		}
+		if tournament.Status != model.TournamentInProgress || match.Status != model.TournamentMatchReported || *match.ReportedBy == userID {
+			return model.ErrMatchNotReportable
+		}
+
+		dispute, err = s.openDispute(tx, match, userID, reason)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dispute, nil
+}
+
+// 이의 제기 목록을 조회 (오래된 순, status가 비어 있으면 모든 상태)
+func (s *TournamentService) GetDisputes(status model.TournamentDisputeStatus, limit, offset int) ([]model.TournamentDispute, int64, error) {
+	query := s.db.Model(&model.TournamentDispute{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("이의 제기 조회 중 오류 발생: %w", err)
+	}
+
+	var disputes []model.TournamentDispute
+	if err := query.Order("created_at ASC, id ASC").Limit(limit).Offset(offset).Find(&disputes).Error; err != nil {
+		return nil, 0, fmt.Errorf("이의 제기 조회 중 오류 발생: %w", err)
+	}
+	return disputes, total, nil
+}
+
+// 이의 제기를 판정해 매치 결과를 확정
+func (s *TournamentService) ResolveDispute(adminID, disputeID uint, score1, score2 int, resolution string) (*model.TournamentDispute, error) {
+	if score1 < 0 || score2 < 0 || score1 == score2 {
+		return nil, model.ErrInvalidMatchResult
+	}
+
+	var dispute model.TournamentDispute
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockForUpdate(tx).First(&dispute, disputeID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.ErrDisputeNotFound
+			}
+			return fmt.Errorf("이의 제기 조회 중 오류 발생: %w", err)
+		}
+		if dispute.Status != model.DisputeOpen {
+			return model.ErrDisputeNotFound
+		}
+
+		tournament, err := lockTournament(tx, dispute.TournamentID)
+		if err != nil {
+			return err
+		}
+		match, err := lockTournamentMatch(tx, dispute.TournamentID, dispute.MatchID)
+		if err != nil {
+			return err
+		}
+		if tournament.Status != model.TournamentInProgress || match.Status != model.TournamentMatchDisputed {
+			return model.ErrMatchNotReportable
+		}
+
+		now := s.now()
+		dispute.Status = model.DisputeResolved
+		dispute.ResolvedBy = &adminID
+		dispute.Resolution = resolution
+		dispute.ResolvedAt = &now
+		if err := tx.Save(&dispute).Error; err != nil {
+			return fmt.Errorf("이의 제기 판정 중 오류 발생: %w", err)
+		}
+
+		// 같은 매치에 남은 이의 제기도 함께 닫음
+		if err := closeDisputes(tx, match.ID, adminID, resolution, now); err != nil {
+			return err
+		}
+		return s.completeWithScores(tx, tournament, match, score1, score2)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &dispute, nil
+}
+
+// 관리자가 매치 결과를 직접 확정 (보고하지 않거나 불참한 참가자는 상대 승리 점수로 몰수패 처리)
+// 경기 가능, 보고, 이의 제기 상태의 매치에 쓸 수 있으며 열린 이의 제기는 함께 닫는다.
+func (s *TournamentService) SetMatchResult(adminID, tournamentID, matchID uint, score1, score2 int, resolution string) (*model.TournamentMatch, error) {
+	if score1 < 0 || score2 < 0 || score1 == score2 {
+		return nil, model.ErrInvalidMatchResult
+	}
+
+	var match *model.TournamentMatch
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		tournament, err := lockTournament(tx, tournamentID)
+		if err != nil {
+			return err
+		}
+		match, err = lockTournamentMatch(tx, tournamentID, matchID)
+		if err != nil {
+			return err
+		}
+		if tournament.Status != model.TournamentInProgress || !(match.AcceptsReport() || match.Status == model.TournamentMatchDisputed) {
+			return model.ErrMatchNotReportable
+		}
+
+		if err := closeDisputes(tx, match.ID, adminID, resolution, s.now()); err != nil {
+			return err
+		}
+		return s.completeWithScores(tx, tournament, match, score1, score2)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
+// 토너먼트를 시작 (auto가 true면 최소 인원 미달 시 취소)
+func (s *TournamentService) start(tx *gorm.DB, id uint, auto bool) (*model.Tournament, error) {
+	tournament, err := lockTournament(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if tournament.Status != model.TournamentRegistration {
+		return nil, model.ErrTournamentNotStartable
+	}
+
+	participants, err := s.seedParticipants(tx, tournament)
+	if err != nil {
+		return nil, err
+	}
+	if len(participants) < tournament.MinParticipants {
+		if !auto {
+			return nil, model.ErrTournamentNotStartable
+		}
+		return tournament, s.cancel(tx, tournament)
+	}
+
+	now := s.now()
+	tournament.Status = model.TournamentInProgress
+	tournament.StartedAt = &now
+	if err := tx.Save(tournament).Error; err != nil {
+		return nil, fmt.Errorf("토너먼트 시작 중 오류 발생: %w", err)
+	}
+
+	if tournament.Format == model.TournamentSwiss {
+		return tournament, s.pairSwissRound(tx, tournament)
+	}
+
+	playerIDs := make([]uint, len(participants))
+	for i := range participants {
+		playerIDs[i] = participants[i].UserID
+	}
+	var matches []model.TournamentMatch
+	if tournament.Format == model.TournamentDoubleElimination {
+		matches = model.BuildDoubleElimination(playerIDs)
+	} else {
+		matches = model.BuildSingleElimination(playerIDs)
+	}
+	return tournament, s.createBracket(tx, tournament, matches)
+}
+
+// 스킬 레이팅 순으로 참가자 시드를 정함 (레이팅이 같으면 먼저 신청한 순)
+func (s *TournamentService) seedParticipants(tx *gorm.DB, tournament *model.Tournament) ([]model.TournamentParticipant, error) {
+	var participants []model.TournamentParticipant
+	if err := tx.Where("tournament_id = ?", tournament.ID).Order("created_at ASC, id ASC").Find(&participants).Error; err != nil {
+		return nil, fmt.Errorf("토너먼트 참가자 조회 중 오류 발생: %w", err)
+	}
+	if len(participants) == 0 {
+		return participants, nil
+	}
+
+	userIDs := make([]uint, len(participants))
+	for i := range participants {
+		userIDs[i] = participants[i].UserID
+	}
+	var ratings []model.SkillRating
+	if err := tx.Where("game_id = ? AND user_id IN ?", tournament.GameID, userIDs).Find(&ratings).Error; err != nil {
+		return nil, fmt.Errorf("스킬 레이팅 조회 중 오류 발생: %w", err)
+	}
+	byUser := make(map[uint]float64, len(ratings))
+	for _, rating := range ratings {
+		byUser[rating.UserID] = rating.Rating
+	}
+
+	for i := range participants {
+		participants[i].Rating = model.DefaultSkillRating
+		if rating, ok := byUser[participants[i].UserID]; ok {
+			participants[i].Rating = rating
+		}
+	}
+	sort.SliceStable(participants, func(i, j int) bool {
+		return participants[i].Rating > participants[j].Rating
+	})
+	for i := range participants {
+		participants[i].Seed = i + 1
+		err := tx.Model(&participants[i]).Updates(map[string]interface{}{
+			"seed":   participants[i].Seed,
+			"rating": participants[i].Rating,
+		}).Error
+		if err != nil {
+			return nil, fmt.Errorf("토너먼트 시드 저장 중 오류 발생: %w", err)
+		}
+	}
+	return participants, nil
+}
+
+// 엘리미네이션 대진을 저장하고 첫 라운드 부전승을 처리
+func (s *TournamentService) createBracket(tx *gorm.DB, tournament *model.Tournament, matches []model.TournamentMatch) error {
+	for i := range matches {
+		matches[i].TournamentID = tournament.ID
+		matches[i].Status = model.TournamentMatchPending
+		if err := tx.Create(&matches[i]).Error; err != nil {
+			return fmt.Errorf("토너먼트 대진 생성 중 오류 발생: %w", err)
+		}
+	}
+
+	for i := range matches {
+		next, nextSlot, loserNext, loserSlot := matches[i].BracketLinks()
+		if next < 0 && loserNext < 0 {
+			continue
+		}
+		if next >= 0 {
+			matches[i].NextMatchID = &matches[next].ID
+			matches[i].NextSlot = nextSlot
+		}
+		if loserNext >= 0 {
+			matches[i].LoserNextMatchID = &matches[loserNext].ID
+			matches[i].LoserNextSlot = loserSlot
+		}
+		if err := tx.Save(&matches[i]).Error; err != nil {
+			return fmt.Errorf("토너먼트 대진 연결 중 오류 발생: %w", err)
+		}
+	}
+
+	for i := range matches {
+		if matches[i].PendingSlots == 0 {
+			match := matches[i]
+			if err := s.resolveMatch(tx, tournament, &match); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// 자리가 모두 정해진 매치를 처리 (두 명이면 경기 가능, 한 명이면 부전승, 없으면 빈 매치로 완료)
+func (s *TournamentService) resolveMatch(tx *gorm.DB, tournament *model.Tournament, match *model.TournamentMatch) error {
+	switch {
+	case match.Player1ID != nil && match.Player2ID != nil:
+		match.Status = model.TournamentMatchReady
+		if err := tx.Save(match).Error; err != nil {
+			return fmt.Errorf("토너먼트 매치 저장 중 오류 발생: %w", err)
+		}
+		return nil
+	case match.Player1ID != nil:
+		return s.completeMatch(tx, tournament, match, match.Player1ID, nil)
+	case match.Player2ID != nil:
+		return s.completeMatch(tx, tournament, match, match.Player2ID, nil)
+	default:
+		return s.completeMatch(tx, tournament, match, nil, nil)
+	}
+}
+
+// 점수로 승패를 정해 매치를 확정
+func (s *TournamentService) completeWithScores(tx *gorm.DB, tournament *model.Tournament, match *model.TournamentMatch, score1, score2 int) error {
+	match.Score1, match.Score2 = &score1, &score2
+	if score1 > score2 {
+		return s.completeMatch(tx, tournament, match, match.Player1ID, match.Player2ID)
+	}
+	return s.completeMatch(tx, tournament, match, match.Player2ID, match.Player1ID)
+}
+
+// 매치를 확정하고 승자와 패자를 다음 매치로 진출시킴
+func (s *TournamentService) completeMatch(tx *gorm.DB, tournament *model.Tournament, match *model.TournamentMatch, winner, loser *uint) error {
+	now := s.now()
+	match.WinnerID, match.LoserID = winner, loser
+	match.Status = model.TournamentMatchCompleted
+	match.CompletedAt = &now
+	if err := tx.Save(match).Error; err != nil {
+		return fmt.Errorf("토너먼트 매치 저장 중 오류 발생: %w", err)
+	}
+
+	if winner != nil && loser != nil {
+		if err := recordParticipantResult(tx, tournament.ID, *winner, "wins"); err != nil {
+			return err
+		}
+		if err := recordParticipantResult(tx, tournament.ID, *loser, "losses"); err != nil {
+			return err
+		}
+	}
+
+	if tournament.Format == model.TournamentSwiss {
+		return s.advanceSwiss(tx, tournament)
+	}
+
+	// 더블 엘리미네이션 결승 첫 경기를 승자조 우승자가 이기면 리셋 매치는 빈 매치로 완료
+	if match.NextMatchID != nil && match.EndsGrandFinal(winner, loser) {
+		if err := s.skipMatch(tx, tournament, *match.NextMatchID); err != nil {
+			return err
+		}
+		return s.finishFinal(tx, tournament, winner, loser)
+	}
+	if match.NextMatchID == nil {
+		return s.finishFinal(tx, tournament, winner, loser)
+	}
+
+	if err := s.fillSlot(tx, tournament, *match.NextMatchID, match.NextSlot, winner); err != nil {
+		return err
+	}
+	if match.LoserNextMatchID != nil {
+		return s.fillSlot(tx, tournament, *match.LoserNextMatchID, match.LoserNextSlot, loser)
+	}
+	if loser != nil {
+		return eliminateParticipant(tx, tournament.ID, *loser, model.ParticipantEliminated, match.Round)
+	}
+	return nil
+}
+
+// 결승 결과로 승자 우승, 패자 준우승을 정하고 토너먼트를 마침
+func (s *TournamentService) finishFinal(tx *gorm.DB, tournament *model.Tournament, winner, loser *uint) error {
+	if loser != nil {
+		if err := eliminateParticipant(tx, tournament.ID, *loser, model.ParticipantEliminated, championStage-1); err != nil {
+			return err
+		}
+	}
+	if winner != nil {
+		if err := eliminateParticipant(tx, tournament.ID, *winner, model.ParticipantChampion, championStage); err != nil {
+			return err
+		}
+	}
+	return s.finishElimination(tx, tournament)
+}
+
+// 치르지 않게 된 매치를 참가자 없이 완료
+func (s *TournamentService) skipMatch(tx *gorm.DB, tournament *model.Tournament, matchID uint) error {
+	match, err := lockTournamentMatch(tx, tournament.ID, matchID)
+	if err != nil {
+		return err
+	}
+	now := s.now()
+	match.PendingSlots = 0
+	match.Status = model.TournamentMatchCompleted
+	match.CompletedAt = &now
+	if err := tx.Save(match).Error; err != nil {
+		return fmt.Errorf("토너먼트 매치 저장 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 앞 매치 결과로 다음 매치 자리를 채움 (player가 nil이면 부전승 자리)
+func (s *TournamentService) fillSlot(tx *gorm.DB, tournament *model.Tournament, matchID uint, slot int, player *uint) error {
+	match, err := lockTournamentMatch(tx, tournament.ID, matchID)
+	if err != nil {
+		return err
+	}
+	if player != nil {
+		match.SetPlayer(slot, *player)
+	}
+	match.PendingSlots--
+	if match.PendingSlots > 0 {
+		if err := tx.Save(match).Error; err != nil {
+			return fmt.Errorf("토너먼트 매치 저장 중 오류 발생: %w", err)
+		}
+		return nil
+	}
+	return s.resolveMatch(tx, tournament, match)
+}
+
+// 엘리미네이션 최종 순위를 정하고 상금을 지급 (늦게 탈락할수록 높은 순위, 같은 단계 탈락은 공동 순위)
+func (s *TournamentService) finishElimination(tx *gorm.DB, tournament *model.Tournament) error {
+	var participants []model.TournamentParticipant
+	if err := tx.Where("tournament_id = ?", tournament.ID).Order("seed ASC").Find(&participants).Error; err != nil {
+		return fmt.Errorf("토너먼트 참가자 조회 중 오류 발생: %w", err)
+	}
+
+	ranks := make([]int, len(participants))
+	for i := range participants {
+		ranks[i] = 1
+		for j := range participants {
+			if participants[j].EliminatedStage > participants[i].EliminatedStage {
+				ranks[i]++
+			}
+		}
+	}
+	return s.finish(tx, tournament, participants, ranks)
+}
+
+// 스위스 라운드가 끝났으면 다음 라운드 대진을 짜거나 토너먼트를 마침
+func (s *TournamentService) advanceSwiss(tx *gorm.DB, tournament *model.Tournament) error {
+	var open int64
+	err := tx.Model(&model.TournamentMatch{}).
+		Where("tournament_id = ? AND round = ? AND status <> ?", tournament.ID, tournament.CurrentRound, model.TournamentMatchCompleted).
+		Count(&open).Error
+	if err != nil {
+		return fmt.Errorf("토너먼트 매치 조회 중 오류 발생: %w", err)
+	}
+	if open > 0 {
+		return nil
+	}
+
+	participants, standings, err := s.swissStandings(tx, tournament)
+	if err != nil {
+		return err
+	}
+	if tournament.CurrentRound < tournament.SwissRoundCount(len(participants)) {
+		return s.pairSwissRound(tx, tournament)
+	}
+
+	// 승수와 상대 승수 합이 같으면 공동 순위
+	index := make(map[uint]int, len(participants))
+	for i := range participants {
+		index[participants[i].UserID] = i
+	}
+	ranks := make([]int, len(participants))
+	for i, standing := range standings {
+		rank := i + 1
+		if i > 0 && standing.Wins == standings[i-1].Wins && standing.Buchholz == standings[i-1].Buchholz {
+			rank = ranks[index[standings[i-1].UserID]]
+		}
+		ranks[index[standing.UserID]] = rank
+		participants[index[standing.UserID]].Status = model.ParticipantFinished
+	}
+	return s.finish(tx, tournament, participants, ranks)
+}
+
+// 다음 스위스 라운드 대진을 생성 (부전승은 바로 승리로 기록)
+func (s *TournamentService) pairSwissRound(tx *gorm.DB, tournament *model.Tournament) error {
+	_, standings, err := s.swissStandings(tx, tournament)
+	if err != nil {
+		return err
+	}
+
+	tournament.CurrentRound++
+	if err := tx.Model(tournament).Update("current_round", tournament.CurrentRound).Error; err != nil {
+		return fmt.Errorf("토너먼트 라운드 갱신 중 오류 발생: %w", err)
+	}
+
+	pairs, bye := model.PairSwissRound(standings)
+	for i, pair := range pairs {
+		match := model.TournamentMatch{
+			TournamentID: tournament.ID,
+			Bracket:      model.BracketSwiss,
+			Round:        tournament.CurrentRound,
+			Position:     i + 1,
+			Status:       model.TournamentMatchReady,
+		}
+		match.SetPlayer(1, pair[0])
+		match.SetPlayer(2, pair[1])
+		if err := tx.Create(&match).Error; err != nil {
+			return fmt.Errorf("토너먼트 대진 생성 중 오류 발생: %w", err)
+		}
+	}
+
+	if bye != nil {
+		now := s.now()
+		match := model.TournamentMatch{
+			TournamentID: tournament.ID,
+			Bracket:      model.BracketSwiss,
+			Round:        tournament.CurrentRound,
+			Position:     len(pairs) + 1,
+			WinnerID:     bye,
+			Status:       model.TournamentMatchCompleted,
+			CompletedAt:  &now,
+		}
+		match.SetPlayer(1, *bye)
+		if err := tx.Create(&match).Error; err != nil {
+			return fmt.Errorf("토너먼트 대진 생성 중 오류 발생: %w", err)
+		}
+		if err := recordParticipantResult(tx, tournament.ID, *bye, "wins"); err != nil {
+			return err
+		}
+		if len(pairs) == 0 {
+			return s.advanceSwiss(tx, tournament)
+		}
+	}
+	return nil
+}
+
+// 스위스 순위를 계산 (참가자는 시드 순, standings는 순위 순)
+func (s *TournamentService) swissStandings(tx *gorm.DB, tournament *model.Tournament) ([]model.TournamentParticipant, []model.SwissStanding, error) {
+	var participants []model.TournamentParticipant
+	if err := tx.Where("tournament_id = ?", tournament.ID).Order("seed ASC").Find(&participants).Error; err != nil {
+		return nil, nil, fmt.Errorf("토너먼트 참가자 조회 중 오류 발생: %w", err)
+	}
+	var matches []model.TournamentMatch
+	if err := tx.Where("tournament_id = ? AND status = ?", tournament.ID, model.TournamentMatchCompleted).Find(&matches).Error; err != nil {
+		return nil, nil, fmt.Errorf("토너먼트 매치 조회 중 오류 발생: %w", err)
+	}
+
+	standings := make([]model.SwissStanding, len(participants))
+	index := make(map[uint]int, len(participants))
+	for i, participant := range participants {
+		standings[i] = model.SwissStanding{UserID: participant.UserID, Seed: participant.Seed, Wins: participant.Wins}
+		index[participant.UserID] = i
+	}
+	for _, match := range matches {
+		if match.Player2ID == nil {
+			standings[index[*match.Player1ID]].HadBye = true
+			continue
+		}
+		p1, p2 := index[*match.Player1ID], index[*match.Player2ID]
+		standings[p1].Opponents = append(standings[p1].Opponents, *match.Player2ID)
+		standings[p2].Opponents = append(standings[p2].Opponents, *match.Player1ID)
+	}
+	for i := range standings {
+		for _, opponent := range standings[i].Opponents {
+			standings[i].Buchholz += standings[index[opponent]].Wins
+		}
+	}
+	model.SortSwissStandings(standings)
+	return participants, standings, nil
+}
+
+// 최종 순위를 저장하고 상금을 지급한 뒤 토너먼트를 완료
+func (s *TournamentService) finish(tx *gorm.DB, tournament *model.Tournament, participants []model.TournamentParticipant, ranks []int) error {
+	split, err := tournament.GetPrizeSplit()
+	if err != nil {
+		return err
+	}
+	prizes := model.DistributePrizes(tournament.PrizePool, split, ranks)
+
+	for i := range participants {
+		participant := &participants[i]
+		participant.FinalRank = &ranks[i]
+		participant.Prize = prizes[i]
+		if participant.Status == model.ParticipantActive {
+			participant.Status = model.ParticipantEliminated
+		}
+		err := tx.Model(participant).Updates(map[string]interface{}{
+			"final_rank": ranks[i],
+			"prize":      prizes[i],
+			"status":     participant.Status,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("토너먼트 순위 저장 중 오류 발생: %w", err)
+		}
+		if err := adjustTournamentCurrency(tx, tournament, participant.UserID, prizes[i]); err != nil {
+			return err
+		}
+	}
+
+	now := s.now()
+	tournament.Status = model.TournamentCompleted
+	tournament.CompletedAt = &now
+	if err := tx.Save(tournament).Error; err != nil {
+		return fmt.Errorf("토너먼트 완료 처리 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 토너먼트를 취소하고 참가비를 환불
+func (s *TournamentService) cancel(tx *gorm.DB, tournament *model.Tournament) error {
+	var participants []model.TournamentParticipant
+	if err := tx.Where("tournament_id = ?", tournament.ID).Find(&participants).Error; err != nil {
+		return fmt.Errorf("토너먼트 참가자 조회 중 오류 발생: %w", err)
+	}
+	for _, participant := range participants {
+		if err := adjustTournamentCurrency(tx, tournament, participant.UserID, participant.EntryPaid); err != nil {
+			return err
+		}
+	}
+
+	now := s.now()
+	tournament.Status = model.TournamentCancelled
+	tournament.CompletedAt = &now
+	if err := tx.Save(tournament).Error; err != nil {
+		return fmt.Errorf("토너먼트 취소 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 매치를 이의 제기 상태로 바꾸고 이의 제기를 남김
+func (s *TournamentService) openDispute(tx *gorm.DB, match *model.TournamentMatch, userID uint, reason string) (*model.TournamentDispute, error) {
+	match.Status = model.TournamentMatchDisputed
+	if err := tx.Model(match).Update("status", match.Status).Error; err != nil {
+		return nil, fmt.Errorf("토너먼트 매치 저장 중 오류 발생: %w", err)
+	}
+
+	dispute := &model.TournamentDispute{
+		TournamentID: match.TournamentID,
+		MatchID:      match.ID,
+		UserID:       userID,
+		Reason:       reason,
+		Status:       model.DisputeOpen,
+		CreatedAt:    s.now(),
+	}
+	if err := tx.Create(dispute).Error; err != nil {
+		return nil, fmt.Errorf("이의 제기 저장 중 오류 발생: %w", err)
+	}
+	return dispute, nil
+}
+
+// 토너먼트 참가 인원을 채움
+func (s *TournamentService) fillParticipantCounts(tournaments []model.Tournament) error {
+	if len(tournaments) == 0 {
+		return nil
+	}
+	ids := make([]uint, len(tournaments))
+	for i := range tournaments {
+		ids[i] = tournaments[i].ID
+	}
+
+	var rows []struct {
+		TournamentID uint
+		Count        int
+	}
+	err := s.db.Model(&model.TournamentParticipant{}).
+		Select("tournament_id, COUNT(*) AS count").
+		Where("tournament_id IN ?", ids).
+		Group("tournament_id").
+		Scan(&rows).Error
+	if err != nil {
+		return fmt.Errorf("토너먼트 참가자 수 조회 중 오류 발생: %w", err)
+	}
+	counts := make(map[uint]int, len(rows))
+	for _, row := range rows {
+		counts[row.TournamentID] = row.Count
+	}
+	for i := range tournaments {
+		tournaments[i].ParticipantCount = counts[tournaments[i].ID]
+	}
+	return nil
+}
+
+// 참가자 닉네임을 채움
+func (s *TournamentService) fillNicknames(participants []model.TournamentParticipant) error {
+	if len(participants) == 0 {
+		return nil
+	}
+	userIDs := make([]uint, len(participants))
+	for i := range participants {
+		userIDs[i] = participants[i].UserID
+	}
+	var users []model.User
+	if err := s.db.Select("id", "nickname").Where("id IN ?", userIDs).Find(&users).Error; err != nil {
+		return fmt.Errorf("사용자 조회 중 오류 발생: %w", err)
+	}
+	nicknames := make(map[uint]string, len(users))
+	for _, user := range users {
+		nicknames[user.ID] = user.Nickname
+	}
+	for i := range participants {
+		participants[i].Nickname = nicknames[participants[i].UserID]
+	}
+	return nil
+}
+
+// 토너먼트를 잠금 상태로 조회
+func lockTournament(tx *gorm.DB, id uint) (*model.Tournament, error) {
+	var tournament model.Tournament
+	if err := lockForUpdate(tx).First(&tournament, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrTournamentNotFound
+		}
+		return nil, fmt.Errorf("토너먼트 조회 중 오류 발생: %w", err)
+	}
+	return &tournament, nil
+}
+
+// 토너먼트 매치를 잠금 상태로 조회
+func lockTournamentMatch(tx *gorm.DB, tournamentID, matchID uint) (*model.TournamentMatch, error) {
+	var match model.TournamentMatch
+	if err := lockForUpdate(tx).Where("tournament_id = ?", tournamentID).First(&match, matchID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrTournamentMatchNotFound
+		}
+		return nil, fmt.Errorf("토너먼트 매치 조회 중 오류 발생: %w", err)
+	}
+	return &match, nil
+}
+
+// 참가비 재화로 사용자 잔액을 증감 (음수는 차감)
+func adjustTournamentCurrency(tx *gorm.DB, tournament *model.Tournament, userID uint, amount int) error {
+	if tournament.EntryCurrency == model.TournamentCurrencyDiamond {
+		return adjustUserDiamond(tx, userID, amount)
+	}
+	return adjustUserGold(tx, userID, amount)
+}
+
+// 상금을 증감
+func updatePrizePool(tx *gorm.DB, tournament *model.Tournament, amount int) error {
+	if amount == 0 {
+		return nil
+	}
+	tournament.PrizePool += amount
+	if err := tx.Model(tournament).Update("prize_pool", tournament.PrizePool).Error; err != nil {
+		return fmt.Errorf("토너먼트 상금 갱신 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 참가자 승수 또는 패수를 1 늘림
+func recordParticipantResult(tx *gorm.DB, tournamentID, userID uint, column string) error {
+	err := tx.Model(&model.TournamentParticipant{}).
+		Where("tournament_id = ? AND user_id = ?", tournamentID, userID).
+		Update(column, gorm.Expr(column+" + 1")).Error
+	if err != nil {
+		return fmt.Errorf("토너먼트 전적 갱신 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 매치에 열린 이의 제기를 모두 판정 완료로 닫음
+func closeDisputes(tx *gorm.DB, matchID, adminID uint, resolution string, now time.Time) error {
+	err := tx.Model(&model.TournamentDispute{}).
+		Where("match_id = ? AND status = ?", matchID, model.DisputeOpen).
+		Updates(map[string]interface{}{
+			"status":      model.DisputeResolved,
+			"resolved_by": adminID,
+			"resolution":  resolution,
+			"resolved_at": now,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("이의 제기 판정 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 참가자의 탈락 단계와 상태를 기록
+func eliminateParticipant(tx *gorm.DB, tournamentID, userID uint, status model.TournamentParticipantStatus, stage int) error {
+	err := tx.Model(&model.TournamentParticipant{}).
+		Where("tournament_id = ? AND user_id = ?", tournamentID, userID).
+		Updates(map[string]interface{}{"status": status, "eliminated_stage": stage}).Error
+	if err != nil {
+		return fmt.Errorf("토너먼트 참가자 갱신 중 오류 발생: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,558 @@
+package service
+
+import (
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func setupTournamentTest(t *testing.T) (*gorm.DB, *TournamentService, *model.User, *model.Game, time.Time) {
+	db, _, alice, tetris := setupGameSessionTest(t)
+	require.NoError(t, db.AutoMigrate(
+		&model.SkillRating{},
+		&model.Tournament{},
+		&model.TournamentParticipant{},
+		&model.TournamentMatch{},
+		&model.TournamentDispute{},
+	))
+	service := NewTournamentService(db)
+
+	now := time.Date(2025, 3, 12, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+	return db, service, alice, tetris, now
+}
+
+// 참가 신청 중인 테스트 토너먼트를 생성
+func createTestTournament(t *testing.T, service *TournamentService, gameID uint, format model.TournamentFormat, now time.Time) *model.Tournament {
+	tournament := &model.Tournament{
+		GameID:              gameID,
+		Name:                "테스트 컵",
+		Format:              format,
+		MaxParticipants:     8,
+		RegistrationStartAt: now.Add(-time.Hour),
+		RegistrationEndAt:   now.Add(time.Hour),
+		PrizeSplit:          "70,30",
+		CreatedBy:           1,
+	}
+	require.NoError(t, service.CreateTournament(tournament))
+	return tournament
+}
+
+// 경기 가능한 매치를 모두 1번 자리 승리로 보고해 토너먼트를 끝까지 진행
+func playOutTournament(t *testing.T, db *gorm.DB, service *TournamentService, tournamentID uint) {
+	for i := 0; i < 100; i++ {
+		var match model.TournamentMatch
+		err := db.Where("tournament_id = ? AND status = ?", tournamentID, model.TournamentMatchReady).Order("id ASC").First(&match).Error
+		if err == gorm.ErrRecordNotFound {
+			return
+		}
+		require.NoError(t, err)
+
+		_, err = service.ReportResult(*match.Player1ID, tournamentID, match.ID, 2, 1)
+		require.NoError(t, err)
+		_, err = service.ReportResult(*match.Player2ID, tournamentID, match.ID, 1, 2)
+		require.NoError(t, err)
+	}
+	t.Fatal("토너먼트가 끝나지 않았습니다")
+}
+
+// 최종 순위를 사용자 ID별로 조회
+func finalRanks(t *testing.T, db *gorm.DB, tournamentID uint) map[uint]int {
+	var participants []model.TournamentParticipant
+	require.NoError(t, db.Where("tournament_id = ?", tournamentID).Find(&participants).Error)
+	ranks := make(map[uint]int, len(participants))
+	for _, participant := range participants {
+		require.NotNil(t, participant.FinalRank)
+		ranks[participant.UserID] = *participant.FinalRank
+	}
+	return ranks
+}
+
+// 토너먼트 생성과 유효성 검사 테스트
+func TestTournamentService_CreateTournament(t *testing.T) {
+	_, service, _, tetris, now := setupTournamentTest(t)
+
+	tournament := createTestTournament(t, service, tetris.ID, model.TournamentSwiss, now)
+	assert.Equal(t, model.TournamentRegistration, tournament.Status)
+	assert.Equal(t, model.TournamentCurrencyGold, tournament.EntryCurrency)
+	assert.Equal(t, 2, tournament.MinParticipants)
+
+	invalid := &model.Tournament{GameID: tetris.ID, Name: "잘못된 컵", Format: "league", MaxParticipants: 4, RegistrationStartAt: now, RegistrationEndAt: now.Add(time.Hour)}
+	assert.ErrorIs(t, service.CreateTournament(invalid), model.ErrInvalidTournament)
+
+	missing := &model.Tournament{GameID: 999, Name: "없는 게임 컵", Format: model.TournamentSwiss, MaxParticipants: 4, RegistrationStartAt: now, RegistrationEndAt: now.Add(time.Hour)}
+	assert.ErrorIs(t, service.CreateTournament(missing), model.ErrGameNotFound)
+
+	tournaments, total, err := service.GetTournaments(tetris.ID, model.TournamentRegistration, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, tournaments, 1)
+	assert.Equal(t, 0, tournaments[0].ParticipantCount)
+
+	_, err = service.GetTournament(999)
+	assert.ErrorIs(t, err, model.ErrTournamentNotFound)
+}
+
+// 참가 신청과 취소 시 참가비 차감, 환불과 상금 변동 테스트
+func TestTournamentService_RegisterAndWithdraw(t *testing.T) {
+	db, service, alice, tetris, now := setupTournamentTest(t)
+	bob := seedUser(t, db, "bob", 50)
+
+	tournament := &model.Tournament{
+		GameID:              tetris.ID,
+		Name:                "참가비 컵",
+		Format:              model.TournamentSingleElimination,
+		MaxParticipants:     2,
+		RegistrationStartAt: now.Add(-time.Hour),
+		RegistrationEndAt:   now.Add(time.Hour),
+		EntryFee:            80,
+		BasePrize:           200,
+		PrizeSplit:          "100",
+	}
+	require.NoError(t, service.CreateTournament(tournament))
+	assert.Equal(t, 200, tournament.PrizePool)
+
+	participant, err := service.Register(alice.ID, tournament.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 80, participant.EntryPaid)
+	assert.Equal(t, 20, userGold(t, db, alice.ID))
+
+	_, err = service.Register(alice.ID, tournament.ID)
+	assert.ErrorIs(t, err, model.ErrAlreadyRegistered)
+
+	// 골드가 부족하면 참가할 수 없음
+	_, err = service.Register(bob.ID, tournament.ID)
+	assert.ErrorIs(t, err, ErrInsufficientGold)
+
+	carol := seedUser(t, db, "carol", 100)
+	_, err = service.Register(carol.ID, tournament.ID)
+	require.NoError(t, err)
+	dave := seedUser(t, db, "dave", 100)
+	_, err = service.Register(dave.ID, tournament.ID)
+	assert.ErrorIs(t, err, model.ErrTournamentFull)
+
+	loaded, err := service.GetTournament(tournament.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 360, loaded.PrizePool)
+	assert.Equal(t, 2, loaded.ParticipantCount)
+
+	// 취소하면 참가비 환불
+	require.NoError(t, service.Withdraw(alice.ID, tournament.ID))
+	assert.Equal(t, 100, userGold(t, db, alice.ID))
+	assert.ErrorIs(t, service.Withdraw(alice.ID, tournament.ID), model.ErrNotRegistered)
+
+	loaded, err = service.GetTournament(tournament.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 280, loaded.PrizePool)
+
+	// 신청 기간이 지나면 참가할 수 없음
+	service.now = func() time.Time { return now.Add(2 * time.Hour) }
+	_, err = service.Register(alice.ID, tournament.ID)
+	assert.ErrorIs(t, err, model.ErrRegistrationClosed)
+}
+
+// 다이아몬드 참가비 테스트
+func TestTournamentService_RegisterDiamond(t *testing.T) {
+	db, service, alice, tetris, now := setupTournamentTest(t)
+	tournament := createTestTournament(t, service, tetris.ID, model.TournamentSwiss, now)
+	require.NoError(t, db.Model(tournament).Updates(map[string]interface{}{"entry_currency": model.TournamentCurrencyDiamond, "entry_fee": 1_000_000}).Error)
+
+	_, err := service.Register(alice.ID, tournament.ID)
+	assert.ErrorIs(t, err, ErrInsufficientDiamond)
+}
+
+// 싱글 엘리미네이션: 레이팅 시드, 부전승, 결과 보고, 이의 제기와 상금 지급 테스트
+func TestTournamentService_SingleElimination(t *testing.T) {
+	db, service, alice, tetris, now := setupTournamentTest(t)
+	bob := seedUser(t, db, "bob", 500)
+	carol := seedUser(t, db, "carol", 500)
+	outsider := seedUser(t, db, "dave", 0)
+
+	tournament := &model.Tournament{
+		GameID:              tetris.ID,
+		Name:                "싱글 컵",
+		Format:              model.TournamentSingleElimination,
+		MaxParticipants:     8,
+		RegistrationStartAt: now.Add(-time.Hour),
+		RegistrationEndAt:   now.Add(time.Hour),
+		EntryFee:            100,
+		BasePrize:           300,
+		PrizeSplit:          "70,30",
+	}
+	require.NoError(t, service.CreateTournament(tournament))
+	for _, user := range []*model.User{alice, bob, carol} {
+		_, err := service.Register(user.ID, tournament.ID)
+		require.NoError(t, err)
+	}
+
+	// bob 1700, carol 기본 1500, alice 1400 순으로 시드
+	require.NoError(t, db.Create(&model.SkillRating{UserID: bob.ID, GameID: tetris.ID, Rating: 1700, Deviation: 80, Volatility: 0.06, Tier: model.RankTierPlatinum, RatedAt: now}).Error)
+	require.NoError(t, db.Create(&model.SkillRating{UserID: alice.ID, GameID: tetris.ID, Rating: 1400, Deviation: 80, Volatility: 0.06, Tier: model.RankTierGold, RatedAt: now}).Error)
+
+	started, err := service.StartTournament(tournament.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.TournamentInProgress, started.Status)
+
+	_, err = service.StartTournament(tournament.ID)
+	assert.ErrorIs(t, err, model.ErrTournamentNotStartable)
+
+	bracket, err := service.GetBracket(tournament.ID)
+	require.NoError(t, err)
+	require.Len(t, bracket.Participants, 3)
+	assert.Equal(t, bob.ID, bracket.Participants[0].UserID)
+	assert.Equal(t, 1, bracket.Participants[0].Seed)
+	assert.Equal(t, carol.ID, bracket.Participants[1].UserID)
+	assert.Equal(t, float64(model.DefaultSkillRating), bracket.Participants[1].Rating)
+	assert.Equal(t, bob.Nickname, bracket.Participants[0].Nickname)
+	require.Len(t, bracket.Matches, 3)
+
+	// 1번 시드는 부전승으로 결승에 올라감
+	bye, semi, final := bracket.Matches[0], bracket.Matches[1], bracket.Matches[2]
+	assert.Equal(t, model.TournamentMatchCompleted, bye.Status)
+	assert.Equal(t, bob.ID, *bye.WinnerID)
+	assert.Equal(t, model.TournamentMatchReady, semi.Status)
+	assert.Equal(t, carol.ID, *semi.Player1ID)
+	assert.Equal(t, alice.ID, *semi.Player2ID)
+	assert.Equal(t, model.TournamentMatchPending, final.Status)
+	assert.Equal(t, bob.ID, *final.Player1ID)
+
+	_, err = service.ReportResult(alice.ID, tournament.ID, final.ID, 1, 0)
+	assert.ErrorIs(t, err, model.ErrNotMatchParticipant)
+	_, err = service.ReportResult(bob.ID, tournament.ID, final.ID, 1, 0)
+	assert.ErrorIs(t, err, model.ErrMatchNotReportable)
+	_, err = service.ReportResult(outsider.ID, tournament.ID, semi.ID, 1, 0)
+	assert.ErrorIs(t, err, model.ErrNotMatchParticipant)
+	_, err = service.ReportResult(alice.ID, tournament.ID, semi.ID, 2, 2)
+	assert.ErrorIs(t, err, model.ErrInvalidMatchResult)
+
+	// 양쪽 보고가 다르면 이의 제기
+	reported, err := service.ReportResult(alice.ID, tournament.ID, semi.ID, 3, 1)
+	require.NoError(t, err)
+	assert.Equal(t, model.TournamentMatchReported, reported.Status)
+	assert.Equal(t, 1, *reported.Score1)
+	assert.Equal(t, 3, *reported.Score2)
+
+	disputed, err := service.ReportResult(carol.ID, tournament.ID, semi.ID, 3, 1)
+	require.NoError(t, err)
+	assert.Equal(t, model.TournamentMatchDisputed, disputed.Status)
+
+	disputes, total, err := service.GetDisputes(model.DisputeOpen, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, carol.ID, disputes[0].UserID)
+
+	_, err = service.ResolveDispute(99, disputes[0].ID, 1, 1, "")
+	assert.ErrorIs(t, err, model.ErrInvalidMatchResult)
+	resolved, err := service.ResolveDispute(99, disputes[0].ID, 1, 3, "영상 확인 결과 alice 승리")
+	require.NoError(t, err)
+	assert.Equal(t, model.DisputeResolved, resolved.Status)
+	_, err = service.ResolveDispute(99, disputes[0].ID, 1, 3, "")
+	assert.ErrorIs(t, err, model.ErrDisputeNotFound)
+
+	// 결승은 확정된 승자로 채워짐
+	var loaded model.TournamentMatch
+	require.NoError(t, db.First(&loaded, final.ID).Error)
+	assert.Equal(t, model.TournamentMatchReady, loaded.Status)
+	assert.Equal(t, alice.ID, *loaded.Player2ID)
+
+	_, err = service.ReportResult(bob.ID, tournament.ID, final.ID, 2, 0)
+	require.NoError(t, err)
+	completed, err := service.ReportResult(alice.ID, tournament.ID, final.ID, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, model.TournamentMatchCompleted, completed.Status)
+	assert.Equal(t, bob.ID, *completed.WinnerID)
+
+	finished, err := service.GetTournament(tournament.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.TournamentCompleted, finished.Status)
+	assert.Equal(t, map[uint]int{bob.ID: 1, alice.ID: 2, carol.ID: 3}, finalRanks(t, db, tournament.ID))
+
+	// 상금 600 = 운영 지원 300 + 참가비 300 (70%, 30%)
+	assert.Equal(t, 500-100+420, userGold(t, db, bob.ID))
+	assert.Equal(t, 100-100+180, userGold(t, db, alice.ID))
+	assert.Equal(t, 500-100, userGold(t, db, carol.ID))
+}
+
+// 상대가 보고한 결과에 이의 제기 테스트
+func TestTournamentService_DisputeResult(t *testing.T) {
+	db, service, alice, tetris, now := setupTournamentTest(t)
+	bob := seedUser(t, db, "bob", 0)
+
+	tournament := createTestTournament(t, service, tetris.ID, model.TournamentSingleElimination, now)
+	for _, user := range []*model.User{alice, bob} {
+		_, err := service.Register(user.ID, tournament.ID)
+		require.NoError(t, err)
+	}
+	_, err := service.StartTournament(tournament.ID)
+	require.NoError(t, err)
+
+	var match model.TournamentMatch
+	require.NoError(t, db.Where("tournament_id = ?", tournament.ID).First(&match).Error)
+
+	// 보고 전이나 내가 보고한 결과에는 이의 제기 불가
+	_, err = service.DisputeResult(bob.ID, tournament.ID, match.ID, "점수가 다릅니다")
+	assert.ErrorIs(t, err, model.ErrMatchNotReportable)
+	_, err = service.ReportResult(alice.ID, tournament.ID, match.ID, 5, 0)
+	require.NoError(t, err)
+	_, err = service.DisputeResult(alice.ID, tournament.ID, match.ID, "점수가 다릅니다")
+	assert.ErrorIs(t, err, model.ErrMatchNotReportable)
+
+	dispute, err := service.DisputeResult(bob.ID, tournament.ID, match.ID, "상대가 접속을 끊었습니다")
+	require.NoError(t, err)
+	assert.Equal(t, model.DisputeOpen, dispute.Status)
+	assert.Equal(t, match.ID, dispute.MatchID)
+
+	// 이의 제기 중에는 보고할 수 없음
+	_, err = service.ReportResult(bob.ID, tournament.ID, match.ID, 0, 5)
+	assert.ErrorIs(t, err, model.ErrMatchNotReportable)
+
+	_, err = service.ResolveDispute(99, dispute.ID, 0, 5, "bob 승리로 판정")
+	require.NoError(t, err)
+	assert.Equal(t, map[uint]int{bob.ID: 1, alice.ID: 2}, finalRanks(t, db, tournament.ID))
+}
+
+// 보고되지 않은 매치를 관리자가 몰수패로 확정하는 테스트
+func TestTournamentService_SetMatchResult(t *testing.T) {
+	db, service, alice, tetris, now := setupTournamentTest(t)
+	bob, carol := seedUser(t, db, "bob", 0), seedUser(t, db, "carol", 0)
+
+	tournament := createTestTournament(t, service, tetris.ID, model.TournamentSingleElimination, now)
+	for _, user := range []*model.User{alice, bob, carol} {
+		_, err := service.Register(user.ID, tournament.ID)
+		require.NoError(t, err)
+	}
+	_, err := service.StartTournament(tournament.ID)
+	require.NoError(t, err)
+
+	bracket, err := service.GetBracket(tournament.ID)
+	require.NoError(t, err)
+	bye, semi, final := bracket.Matches[0], bracket.Matches[1], bracket.Matches[2]
+
+	// 완료되었거나 자리가 정해지지 않은 매치, 무승부는 확정할 수 없음
+	_, err = service.SetMatchResult(99, tournament.ID, bye.ID, 1, 0, "")
+	assert.ErrorIs(t, err, model.ErrMatchNotReportable)
+	_, err = service.SetMatchResult(99, tournament.ID, final.ID, 1, 0, "")
+	assert.ErrorIs(t, err, model.ErrMatchNotReportable)
+	_, err = service.SetMatchResult(99, tournament.ID, semi.ID, 0, 0, "")
+	assert.ErrorIs(t, err, model.ErrInvalidMatchResult)
+	_, err = service.SetMatchResult(99, tournament.ID+1, semi.ID, 1, 0, "")
+	assert.ErrorIs(t, err, model.ErrTournamentNotFound)
+
+	// 아무도 보고하지 않은 준결승을 2번 자리 몰수승으로 확정
+	forfeited, err := service.SetMatchResult(99, tournament.ID, semi.ID, 0, 1, "1번 자리 불참")
+	require.NoError(t, err)
+	assert.Equal(t, model.TournamentMatchCompleted, forfeited.Status)
+	assert.Equal(t, *semi.Player2ID, *forfeited.WinnerID)
+
+	// 이의 제기 중인 결승도 확정하면서 이의 제기를 닫음
+	_, err = service.ReportResult(*final.Player1ID, tournament.ID, final.ID, 2, 0)
+	require.NoError(t, err)
+	dispute, err := service.DisputeResult(*semi.Player2ID, tournament.ID, final.ID, "점수가 다릅니다")
+	require.NoError(t, err)
+
+	_, err = service.SetMatchResult(99, tournament.ID, final.ID, 2, 0, "1번 자리 승리 확인")
+	require.NoError(t, err)
+	require.NoError(t, db.First(dispute, dispute.ID).Error)
+	assert.Equal(t, model.DisputeResolved, dispute.Status)
+	assert.Equal(t, uint(99), *dispute.ResolvedBy)
+
+	assert.Equal(t, map[uint]int{*final.Player1ID: 1, *semi.Player2ID: 2, *semi.Player1ID: 3}, finalRanks(t, db, tournament.ID))
+
+	_, err = service.SetMatchResult(99, tournament.ID, final.ID, 0, 2, "")
+	assert.ErrorIs(t, err, model.ErrMatchNotReportable)
+}
+
+// 더블 엘리미네이션 진행과 최종 순위 테스트
+func TestTournamentService_DoubleElimination(t *testing.T) {
+	db, service, alice, tetris, now := setupTournamentTest(t)
+	users := []*model.User{alice, seedUser(t, db, "bob", 0), seedUser(t, db, "carol", 0), seedUser(t, db, "dave", 0)}
+
+	tournament := createTestTournament(t, service, tetris.ID, model.TournamentDoubleElimination, now)
+	for _, user := range users {
+		_, err := service.Register(user.ID, tournament.ID)
+		require.NoError(t, err)
+	}
+	_, err := service.StartTournament(tournament.ID)
+	require.NoError(t, err)
+
+	playOutTournament(t, db, service, tournament.ID)
+
+	bracket, err := service.GetBracket(tournament.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.TournamentCompleted, bracket.Tournament.Status)
+	require.Len(t, bracket.Matches, 7)
+
+	// 승자조 우승자가 결승 첫 경기를 이겼으므로 리셋 매치는 참가자 없이 완료
+	reset := bracket.Matches[6]
+	assert.Equal(t, model.BracketGrandFinal, reset.Bracket)
+	assert.Equal(t, 2, reset.Round)
+	assert.Equal(t, model.TournamentMatchCompleted, reset.Status)
+	assert.Nil(t, reset.Player1ID)
+	assert.Nil(t, reset.WinnerID)
+
+	// 시드는 신청 순 (레이팅 동일), 항상 1번 자리가 이기므로
+	// 1시드 우승, 패자조를 올라온 4시드 준우승, 패자조 결승에서 진 2시드 3위, 패자조 1라운드에서 진 3시드 4위
+	seeds := make(map[int]uint)
+	for _, participant := range bracket.Participants {
+		seeds[participant.Seed] = participant.UserID
+	}
+	assert.Equal(t, map[uint]int{seeds[1]: 1, seeds[4]: 2, seeds[2]: 3, seeds[3]: 4}, finalRanks(t, db, tournament.ID))
+
+	var champion model.TournamentParticipant
+	require.NoError(t, db.Where("tournament_id = ? AND user_id = ?", tournament.ID, seeds[1]).First(&champion).Error)
+	assert.Equal(t, model.ParticipantChampion, champion.Status)
+	assert.Equal(t, 3, champion.Wins)
+	assert.Equal(t, 0, champion.Losses)
+}
+
+// 패자조 우승자가 결승 첫 경기를 이기면 리셋 매치를 치르는지 테스트
+func TestTournamentService_GrandFinalReset(t *testing.T) {
+	db, service, alice, tetris, now := setupTournamentTest(t)
+	users := []*model.User{alice, seedUser(t, db, "bob", 0), seedUser(t, db, "carol", 0), seedUser(t, db, "dave", 0)}
+
+	tournament := createTestTournament(t, service, tetris.ID, model.TournamentDoubleElimination, now)
+	for _, user := range users {
+		_, err := service.Register(user.ID, tournament.ID)
+		require.NoError(t, err)
+	}
+	_, err := service.StartTournament(tournament.ID)
+	require.NoError(t, err)
+
+	// 결승 첫 경기 전까지 1번 자리 승리로 진행
+	var final model.TournamentMatch
+	for i := 0; i < 10; i++ {
+		var match model.TournamentMatch
+		require.NoError(t, db.Where("tournament_id = ? AND status = ?", tournament.ID, model.TournamentMatchReady).Order("id ASC").First(&match).Error)
+		if match.Bracket == model.BracketGrandFinal {
+			final = match
+			break
+		}
+		_, err = service.ReportResult(*match.Player1ID, tournament.ID, match.ID, 2, 1)
+		require.NoError(t, err)
+		_, err = service.ReportResult(*match.Player2ID, tournament.ID, match.ID, 1, 2)
+		require.NoError(t, err)
+	}
+	require.NotZero(t, final.ID)
+	winnersChampion, losersChampion := *final.Player1ID, *final.Player2ID
+
+	// 패자조 우승자가 결승 첫 경기 승리
+	_, err = service.ReportResult(losersChampion, tournament.ID, final.ID, 3, 1)
+	require.NoError(t, err)
+	_, err = service.ReportResult(winnersChampion, tournament.ID, final.ID, 1, 3)
+	require.NoError(t, err)
+
+	var reset model.TournamentMatch
+	require.NoError(t, db.Where("tournament_id = ? AND bracket = ? AND round = ?", tournament.ID, model.BracketGrandFinal, 2).First(&reset).Error)
+	assert.Equal(t, model.TournamentMatchReady, reset.Status)
+	assert.Equal(t, winnersChampion, *reset.Player1ID)
+	assert.Equal(t, losersChampion, *reset.Player2ID)
+
+	got, err := service.GetTournament(tournament.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.TournamentInProgress, got.Status, "리셋 매치 전에는 끝나지 않아야 합니다")
+
+	// 리셋 매치는 승자조 우승자 승리
+	playOutTournament(t, db, service, tournament.ID)
+	assert.Equal(t, 1, finalRanks(t, db, tournament.ID)[winnersChampion])
+	assert.Equal(t, 2, finalRanks(t, db, tournament.ID)[losersChampion])
+
+	var champion model.TournamentParticipant
+	require.NoError(t, db.Where("tournament_id = ? AND user_id = ?", tournament.ID, winnersChampion).First(&champion).Error)
+	assert.Equal(t, model.ParticipantChampion, champion.Status)
+	assert.Equal(t, 3, champion.Wins)
+	assert.Equal(t, 1, champion.Losses)
+}
+
+// 스위스 라운드 진행, 부전승과 공동 순위 테스트
+func TestTournamentService_Swiss(t *testing.T) {
+	db, service, alice, tetris, now := setupTournamentTest(t)
+	bob, carol := seedUser(t, db, "bob", 0), seedUser(t, db, "carol", 0)
+
+	tournament := createTestTournament(t, service, tetris.ID, model.TournamentSwiss, now)
+	for _, user := range []*model.User{alice, bob, carol} {
+		_, err := service.Register(user.ID, tournament.ID)
+		require.NoError(t, err)
+	}
+	_, err := service.StartTournament(tournament.ID)
+	require.NoError(t, err)
+
+	// 1라운드: alice-bob, carol 부전승
+	bracket, err := service.GetBracket(tournament.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, bracket.Tournament.CurrentRound)
+	require.Len(t, bracket.Matches, 2)
+	assert.Equal(t, carol.ID, *bracket.Matches[1].WinnerID)
+	assert.Nil(t, bracket.Matches[1].Player2ID)
+
+	playOutTournament(t, db, service, tournament.ID)
+
+	// 2라운드(3명이면 2라운드): alice-carol, bob 부전승
+	bracket, err = service.GetBracket(tournament.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.TournamentCompleted, bracket.Tournament.Status)
+	assert.Equal(t, 2, bracket.Tournament.CurrentRound)
+	require.Len(t, bracket.Matches, 4)
+	assert.Equal(t, bob.ID, *bracket.Matches[3].WinnerID)
+
+	// alice 2승, carol과 bob은 1승에 상대 승수 합도 같아 공동 2위
+	assert.Equal(t, map[uint]int{alice.ID: 1, carol.ID: 2, bob.ID: 2}, finalRanks(t, db, tournament.ID))
+}
+
+// 마감된 토너먼트 자동 시작과 최소 인원 미달 취소 테스트
+func TestTournamentService_StartDueTournaments(t *testing.T) {
+	db, service, alice, tetris, now := setupTournamentTest(t)
+	bob := seedUser(t, db, "bob", 100)
+
+	due := createTestTournament(t, service, tetris.ID, model.TournamentSingleElimination, now)
+	short := &model.Tournament{
+		GameID:              tetris.ID,
+		Name:                "인원 미달 컵",
+		Format:              model.TournamentSingleElimination,
+		MinParticipants:     4,
+		MaxParticipants:     8,
+		RegistrationStartAt: now.Add(-time.Hour),
+		RegistrationEndAt:   now.Add(time.Hour),
+		EntryFee:            30,
+	}
+	require.NoError(t, service.CreateTournament(short))
+	later := createTestTournament(t, service, tetris.ID, model.TournamentSingleElimination, now.Add(24*time.Hour))
+
+	for _, user := range []*model.User{alice, bob} {
+		_, err := service.Register(user.ID, due.ID)
+		require.NoError(t, err)
+		_, err = service.Register(user.ID, short.ID)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 70, userGold(t, db, alice.ID))
+
+	// 최소 인원 미달이면 직접 시작할 수 없음
+	_, err := service.StartTournament(short.ID)
+	assert.ErrorIs(t, err, model.ErrTournamentNotStartable)
+
+	started, err := service.StartDueTournaments(now.Add(2 * time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, started)
+
+	loaded, err := service.GetTournament(due.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.TournamentInProgress, loaded.Status)
+
+	loaded, err = service.GetTournament(short.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.TournamentCancelled, loaded.Status)
+	assert.Equal(t, 100, userGold(t, db, alice.ID), "취소되면 참가비 환불")
+
+	loaded, err = service.GetTournament(later.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.TournamentRegistration, loaded.Status)
+
+	// 진행 중인 토너먼트도 취소 가능, 완료/취소된 토너먼트는 불가
+	cancelled, err := service.CancelTournament(due.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.TournamentCancelled, cancelled.Status)
+	_, err = service.CancelTournament(due.ID)
+	assert.ErrorIs(t, err, model.ErrTournamentNotStartable)
+}
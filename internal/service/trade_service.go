@@ -0,0 +1,485 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"time"
+)
+
+const (
+	// 거래 기본 유효 시간
+	defaultTradeExpiry = 10 * time.Minute
+
+	// 거래 최대 유효 시간
+	maxTradeExpiry = 24 * time.Hour
+
+	// 의심 거래로 분류하는 양측 가치 비율
+	suspiciousTradeValueRatio = 5.0
+
+	// 의심 거래 판정을 시작하는 최소 가치 (이보다 작은 거래는 무시)
+	suspiciousTradeMinValue = 1000
+)
+
+// 거래 아이템 요청 (같은 아이템도 레벨이 다른 행이 있으므로 인벤토리 행 ID로 지정)
+type TradeItemRequest struct {
+	InventoryID uint `json:"inventory_id"`
+	Quantity    int  `json:"quantity"`
+}
+
+// 거래 제안 정보
+type TradeProposal struct {
+	ProposerID     uint
+	TargetID       uint
+	OfferedItems   []TradeItemRequest
+	OfferedGold    int
+	RequestedItems []TradeItemRequest
+	RequestedGold  int
+	Message        string
+	ExpiresIn      time.Duration
+}
+
+// 플레이어 간 거래를 처리하는 서비스
+// 제안 시 제안자의 자산을, 대상자 확인 시 대상자의 자산을 에스크로에 보관하고
+// 양측이 모두 확인하면 하나의 트랜잭션으로 정산한다.
+type TradeService struct {
+	db *gorm.DB
+
+	// 인벤토리 통계 캐시 (설정하지 않으면 무효화하지 않음)
+	inventoryStats
+}
+
+// 새로운 TradeService 인스턴스를 생성
+func NewTradeService(db *gorm.DB) *TradeService {
+	return &TradeService{
+		db: db,
+	}
+}
+
+// 새로운 거래를 제안
+// 제안자가 내놓는 아이템과 골드는 즉시 에스크로에 보관된다.
+func (s *TradeService) ProposeTrade(proposal *TradeProposal) (*model.Trade, error) {
+	expiresIn := proposal.ExpiresIn
+	if expiresIn <= 0 {
+		expiresIn = defaultTradeExpiry
+	}
+	if expiresIn > maxTradeExpiry {
+		expiresIn = maxTradeExpiry
+	}
+
+	trade := &model.Trade{
+		ProposerID:   proposal.ProposerID,
+		TargetID:     proposal.TargetID,
+		ProposerGold: proposal.OfferedGold,
+		TargetGold:   proposal.RequestedGold,
+		Status:       model.TradeStatusPending,
+		Message:      proposal.Message,
+		ExpiresAt:    time.Now().Add(expiresIn),
+	}
+
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		// 대상자 존재 여부 확인
+		var target model.User
+		if err := tx.First(&target, proposal.TargetID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.ErrInvalidUserID
+			}
+			return fmt.Errorf("거래 대상자 조회 중 오류 발생: %w", err)
+		}
+
+		// 제안자 아이템은 즉시 에스크로에 보관
+		for _, req := range proposal.OfferedItems {
+			item, err := s.escrowItem(tx, proposal.ProposerID, req)
+			if err != nil {
+				return err
+			}
+			trade.Items = append(trade.Items, *item)
+		}
+
+		// 대상자에게 요청하는 아이템은 스냅샷만 저장 (대상자 확인 시 에스크로)
+		for _, req := range proposal.RequestedItems {
+			item, err := s.snapshotItem(tx, proposal.TargetID, req)
+			if err != nil {
+				return err
+			}
+			trade.Items = append(trade.Items, *item)
+		}
+
+		if err := trade.Validate(); err != nil {
+			return err
+		}
+
+		// 제안자 골드 에스크로
+		if err := adjustUserGold(tx, proposal.ProposerID, -proposal.OfferedGold); err != nil {
+			return err
+		}
+
+		s.evaluateSuspicion(trade)
+
+		if err := tx.Create(trade).Error; err != nil {
+			return fmt.Errorf("거래 생성 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return trade, nil
+}
+
+// 거래를 확인
+// 대상자가 처음 확인하면 대상자의 자산을 에스크로에 보관하고,
+// 양측이 모두 확인한 상태가 되면 즉시 정산한다.
+func (s *TradeService) ConfirmTrade(tradeID, userID uint) (*model.Trade, error) {
+	var trade *model.Trade
+	err := s.transaction(s.db, func(tx *gorm.DB) error {
+		var err error
+		trade, err = s.lockPendingTrade(tx, tradeID, userID)
+		if err != nil {
+			return err
+		}
+
+		if trade.IsExpired(time.Now()) {
+			return model.ErrTradeExpired
+		}
+
+		switch userID {
+		case trade.ProposerID:
+			if trade.ProposerConfirmed {
+				return model.ErrAlreadyConfirmed
+			}
+			trade.ProposerConfirmed = true
+		case trade.TargetID:
+			if trade.TargetConfirmed {
+				return model.ErrAlreadyConfirmed
+			}
+			if !trade.TargetEscrowed {
+				if err := s.escrowTargetSide(tx, trade); err != nil {
+					return err
+				}
+			}
+			trade.TargetConfirmed = true
+		}
+
+		if trade.IsFullyConfirmed() {
+			return s.settle(tx, trade)
+		}
+
+		if err := tx.Model(trade).Updates(map[string]interface{}{
+			"proposer_confirmed": trade.ProposerConfirmed,
+			"target_confirmed":   trade.TargetConfirmed,
+			"target_escrowed":    trade.TargetEscrowed,
+		}).Error; err != nil {
+			return fmt.Errorf("거래 확인 상태 업데이트 중 오류 발생: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return trade, nil
+}
+
+// 거래를 취소하고 에스크로된 자산을 원래 소유자에게 반환
+func (s *TradeService) CancelTrade(tradeID, userID uint) error {
+	return s.transaction(s.db, func(tx *gorm.DB) error {
+		trade, err := s.lockPendingTrade(tx, tradeID, userID)
+		if err != nil {
+			return err
+		}
+
+		trade.CancelledBy = &userID
+		return s.rollback(tx, trade, model.TradeStatusCancelled)
+	})
+}
+
+// 만료 시간이 지난 진행 중인 거래를 모두 만료 처리
+// 백그라운드 작업에서 주기적으로 호출되며, 처리한 거래 수를 반환
+func (s *TradeService) ExpireTrades() (int, error) {
+	var ids []uint
+	if err := s.db.Model(&model.Trade{}).
+		Where("status = ? AND expires_at <= ?", model.TradeStatusPending, time.Now()).
+		Pluck("id", &ids).Error; err != nil {
+		return 0, fmt.Errorf("만료 거래 조회 중 오류 발생: %w", err)
+	}
+
+	expired := 0
+	for _, id := range ids {
+		err := s.transaction(s.db, func(tx *gorm.DB) error {
+			var trade model.Trade
+			if err := lockForUpdate(tx).Preload("Items").First(&trade, id).Error; err != nil {
+				return err
+			}
+			// 잠금을 얻는 사이 다른 요청이 처리했을 수 있음
+			if !trade.IsPending() || !trade.IsExpired(time.Now()) {
+				return nil
+			}
+			expired++
+			return s.rollback(tx, &trade, model.TradeStatusExpired)
+		})
+		if err != nil {
+			return expired, fmt.Errorf("거래 만료 처리 중 오류 발생 (trade_id=%d): %w", id, err)
+		}
+	}
+
+	return expired, nil
+}
+
+// ID로 거래를 조회
+func (s *TradeService) GetTradeByID(id uint) (*model.Trade, error) {
+	var trade model.Trade
+	if err := s.db.Preload("Items").First(&trade, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrTradeNotFound
+		}
+		return nil, fmt.Errorf("거래 조회 중 오류 발생: %w", err)
+	}
+	return &trade, nil
+}
+
+// 사용자의 거래 내역을 최신순으로 조회
+func (s *TradeService) GetTradeHistory(userID uint, limit, offset int) ([]model.Trade, error) {
+	var trades []model.Trade
+	if err := s.db.Preload("Items").
+		Where("proposer_id = ? OR target_id = ?", userID, userID).
+		Order("created_at DESC").
+		Limit(limit).Offset(offset).
+		Find(&trades).Error; err != nil {
+		return nil, fmt.Errorf("거래 내역 조회 중 오류 발생: %w", err)
+	}
+	return trades, nil
+}
+
+// 검토가 필요한 의심 거래 목록을 조회
+func (s *TradeService) GetFlaggedTrades(limit, offset int) ([]model.Trade, error) {
+	var trades []model.Trade
+	if err := s.db.Preload("Items").
+		Where("is_flagged = ? AND reviewed_at IS NULL", true).
+		Order("created_at ASC").
+		Limit(limit).Offset(offset).
+		Find(&trades).Error; err != nil {
+		return nil, fmt.Errorf("의심 거래 조회 중 오류 발생: %w", err)
+	}
+	return trades, nil
+}
+
+// 의심 거래를 검토 완료로 표시
+func (s *TradeService) ReviewTrade(tradeID, reviewerID uint) error {
+	now := time.Now()
+	result := s.db.Model(&model.Trade{}).
+		Where("id = ? AND is_flagged = ?", tradeID, true).
+		Updates(map[string]interface{}{
+			"reviewed_at": &now,
+			"reviewed_by": reviewerID,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("거래 검토 처리 중 오류 발생: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return model.ErrTradeNotFound
+	}
+	return nil
+}
+
+// 진행 중인 거래를 잠금 상태로 조회하고 당사자 여부를 확인
+func (s *TradeService) lockPendingTrade(tx *gorm.DB, tradeID, userID uint) (*model.Trade, error) {
+	var trade model.Trade
+	if err := lockForUpdate(tx).Preload("Items").First(&trade, tradeID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrTradeNotFound
+		}
+		return nil, fmt.Errorf("거래 조회 중 오류 발생: %w", err)
+	}
+	if !trade.IsParticipant(userID) {
+		return nil, model.ErrNotTradeParticipant
+	}
+	if !trade.IsPending() {
+		return nil, model.ErrTradeNotPending
+	}
+	return &trade, nil
+}
+
+// 사용자의 아이템 정보를 스냅샷으로 만들어 거래 아이템을 생성
+func (s *TradeService) snapshotItem(tx *gorm.DB, ownerID uint, req TradeItemRequest) (*model.TradeItem, error) {
+	if req.Quantity <= 0 {
+		return nil, model.ErrInvalidQuantity
+	}
+
+	inventory, err := lockOwnedInventory(tx, ownerID, req.InventoryID)
+	if err != nil {
+		return nil, err
+	}
+	if !inventory.CanTrade() {
+		return nil, fmt.Errorf("%w: %s", model.ErrItemNotTradeable, inventory.ItemID)
+	}
+	if inventory.Quantity < req.Quantity {
+		return nil, fmt.Errorf("%w: %s", model.ErrInsufficientQuantity, inventory.ItemID)
+	}
+
+	return &model.TradeItem{
+		OwnerID:     ownerID,
+		InventoryID: inventory.ID,
+		ItemID:      inventory.ItemID,
+		ItemName:    inventory.ItemName,
+		ItemType:    inventory.ItemType,
+		Rarity:      inventory.Rarity,
+		Level:       inventory.Level,
+		Quantity:    req.Quantity,
+	}, nil
+}
+
+// 사용자의 아이템을 인벤토리에서 차감하여 에스크로에 보관
+func (s *TradeService) escrowItem(tx *gorm.DB, ownerID uint, req TradeItemRequest) (*model.TradeItem, error) {
+	item, err := s.snapshotItem(tx, ownerID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := lockOwnedInventory(tx, ownerID, item.InventoryID)
+	if err != nil {
+		return nil, err
+	}
+	if err := removeInventoryQuantity(tx, inventory, req.Quantity, model.NewInventoryChange(model.InventorySourceTrade, ownerID, "", 0)); err != nil {
+		return nil, err
+	}
+
+	item.Escrowed = true
+	return item, nil
+}
+
+// 대상자가 내놓을 자산을 에스크로에 보관
+func (s *TradeService) escrowTargetSide(tx *gorm.DB, trade *model.Trade) error {
+	for i := range trade.Items {
+		item := &trade.Items[i]
+		if item.OwnerID != trade.TargetID || item.Escrowed {
+			continue
+		}
+
+		inventory, err := s.lockTargetItem(tx, item)
+		if err != nil {
+			return err
+		}
+		if !inventory.CanTrade() {
+			return fmt.Errorf("%w: %s", model.ErrItemNotTradeable, item.ItemID)
+		}
+		change := model.NewInventoryChange(model.InventorySourceTrade, item.OwnerID, "trade", trade.ID)
+		if err := removeInventoryQuantity(tx, inventory, item.Quantity, change); err != nil {
+			return fmt.Errorf("%w: %s", err, item.ItemID)
+		}
+
+		item.Escrowed = true
+		if err := tx.Model(item).Update("escrowed", true).Error; err != nil {
+			return fmt.Errorf("거래 아이템 상태 업데이트 중 오류 발생: %w", err)
+		}
+	}
+
+	if err := adjustUserGold(tx, trade.TargetID, -trade.TargetGold); err != nil {
+		return err
+	}
+
+	trade.TargetEscrowed = true
+	return nil
+}
+
+// 대상자가 내놓을 아이템의 인벤토리 행을 잠금 상태로 조회
+// 제안 이후 강화 등으로 아이템이 바뀌었으면 제안 당시 스냅샷과 다르므로 거부한다.
+func (s *TradeService) lockTargetItem(tx *gorm.DB, item *model.TradeItem) (*model.Inventory, error) {
+	// 행 ID가 기록되기 전에 제안된 거래는 아이템 ID로 조회
+	if item.InventoryID == 0 {
+		return lockInventoryItem(tx, item.OwnerID, item.ItemID)
+	}
+
+	inventory, err := lockOwnedInventory(tx, item.OwnerID, item.InventoryID)
+	if err != nil {
+		return nil, err
+	}
+	if inventory.ItemID != item.ItemID || inventory.Level != item.Level {
+		return nil, fmt.Errorf("제안 이후 아이템이 변경되었습니다: %s: %w", item.ItemID, ErrItemNotOwned)
+	}
+	return inventory, nil
+}
+
+// 에스크로된 자산을 상대방에게 지급하고 거래를 완료
+func (s *TradeService) settle(tx *gorm.DB, trade *model.Trade) error {
+	change := model.NewInventoryChange(model.InventorySourceTrade, 0, "trade", trade.ID)
+	for _, item := range trade.Items {
+		receiver := trade.CounterpartOf(item.OwnerID)
+		if _, err := grantInventoryItem(tx, receiver, item.ToInventory(receiver), item.Quantity, change); err != nil {
+			return err
+		}
+	}
+
+	if err := adjustUserGold(tx, trade.TargetID, trade.ProposerGold); err != nil {
+		return err
+	}
+	if err := adjustUserGold(tx, trade.ProposerID, trade.TargetGold); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	trade.Status = model.TradeStatusCompleted
+	trade.SettledAt = &now
+	if err := tx.Model(trade).Updates(map[string]interface{}{
+		"status":             trade.Status,
+		"settled_at":         trade.SettledAt,
+		"proposer_confirmed": trade.ProposerConfirmed,
+		"target_confirmed":   trade.TargetConfirmed,
+		"target_escrowed":    trade.TargetEscrowed,
+	}).Error; err != nil {
+		return fmt.Errorf("거래 정산 상태 업데이트 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 에스크로된 자산을 원래 소유자에게 돌려주고 거래를 종료
+func (s *TradeService) rollback(tx *gorm.DB, trade *model.Trade, status model.TradeStatus) error {
+	change := model.NewInventoryChange(model.InventorySourceTrade, 0, "trade", trade.ID)
+	for _, item := range trade.Items {
+		if !item.Escrowed {
+			continue
+		}
+		if _, err := grantInventoryItem(tx, item.OwnerID, item.ToInventory(item.OwnerID), item.Quantity, change); err != nil {
+			return err
+		}
+	}
+
+	if err := adjustUserGold(tx, trade.ProposerID, trade.ProposerGold); err != nil {
+		return err
+	}
+	if trade.TargetEscrowed {
+		if err := adjustUserGold(tx, trade.TargetID, trade.TargetGold); err != nil {
+			return err
+		}
+	}
+
+	trade.Status = status
+	if err := tx.Model(trade).Updates(map[string]interface{}{
+		"status":       trade.Status,
+		"cancelled_by": trade.CancelledBy,
+	}).Error; err != nil {
+		return fmt.Errorf("거래 상태 업데이트 중 오류 발생: %w", err)
+	}
+	return nil
+}
+
+// 양측 가치 차이를 계산하여 의심 거래 여부를 표시
+func (s *TradeService) evaluateSuspicion(trade *model.Trade) {
+	trade.ProposerValue = trade.ValueOf(trade.ProposerID)
+	trade.TargetValue = trade.ValueOf(trade.TargetID)
+
+	high, low := trade.ProposerValue, trade.TargetValue
+	if low > high {
+		high, low = low, high
+	}
+
+	if high < suspiciousTradeMinValue {
+		return
+	}
+	if low == 0 || float64(high)/float64(low) >= suspiciousTradeValueRatio {
+		trade.IsFlagged = true
+		trade.FlagReason = fmt.Sprintf("양측 가치 차이가 큽니다 (제안자: %d, 대상자: %d)", trade.ProposerValue, trade.TargetValue)
+	}
+}
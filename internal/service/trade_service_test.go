@@ -0,0 +1,372 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"g_dev/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupGameTestDB는 지정한 모델들을 마이그레이션한 테스트용 데이터베이스를 설정.
+// 트랜잭션을 사용하는 서비스를 위해 인메모리 DB를 단일 연결로 제한.
+func setupGameTestDB(t *testing.T, models ...interface{}) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get sql database: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	models = append([]interface{}{&model.User{}, &model.Inventory{}, &model.InventoryLog{}}, models...)
+	if err := db.AutoMigrate(models...); err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+
+	return db
+}
+
+// seedUser는 지정한 골드를 가진 테스트 사용자를 생성.
+func seedUser(t *testing.T, db *gorm.DB, username string, gold int) *model.User {
+	user := createTestUser()
+	user.Username = username
+	user.Email = fmt.Sprintf("%s@example.com", username)
+	user.Gold = gold
+	require.NoError(t, db.Create(user).Error)
+	return user
+}
+
+// seedItem은 테스트 사용자에게 인벤토리 아이템을 생성.
+func seedItem(t *testing.T, db *gorm.DB, userID uint, itemID, rarity string, quantity int) *model.Inventory {
+	inventory := &model.Inventory{
+		UserID:   userID,
+		ItemID:   itemID,
+		ItemName: itemID,
+		Quantity: quantity,
+		ItemType: "weapon",
+		Rarity:   rarity,
+		Level:    1,
+	}
+	require.NoError(t, db.Create(inventory).Error)
+	return inventory
+}
+
+// userGold는 사용자의 현재 골드를 조회.
+func userGold(t *testing.T, db *gorm.DB, userID uint) int {
+	var user model.User
+	require.NoError(t, db.First(&user, userID).Error)
+	return user.Gold
+}
+
+// itemQuantity는 사용자의 아이템 수량을 조회. 아이템이 없으면 0을 반환.
+func itemQuantity(t *testing.T, db *gorm.DB, userID uint, itemID string) int {
+	var inventory model.Inventory
+	if err := db.Where("user_id = ? AND item_id = ?", userID, itemID).First(&inventory).Error; err != nil {
+		return 0
+	}
+	return inventory.Quantity
+}
+
+// 거래 제안 시 제안자의 자산이 에스크로되는지 테스트
+func TestTradeService_ProposeTrade(t *testing.T) {
+	db := setupGameTestDB(t, &model.Trade{}, &model.TradeItem{})
+	service := NewTradeService(db)
+
+	alice := seedUser(t, db, "alice", 1000)
+	bob := seedUser(t, db, "bob", 1000)
+	potion := seedItem(t, db, alice.ID, "potion", "common", 5)
+	sword := seedItem(t, db, bob.ID, "sword", "rare", 1)
+
+	trade, err := service.ProposeTrade(&TradeProposal{
+		ProposerID:     alice.ID,
+		TargetID:       bob.ID,
+		OfferedItems:   []TradeItemRequest{{InventoryID: potion.ID, Quantity: 3}},
+		OfferedGold:    200,
+		RequestedItems: []TradeItemRequest{{InventoryID: sword.ID, Quantity: 1}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, model.TradeStatusPending, trade.Status)
+	assert.Len(t, trade.Items, 2)
+	assert.Equal(t, 800, userGold(t, db, alice.ID), "제안자 골드가 에스크로되어야 합니다")
+	assert.Equal(t, 2, itemQuantity(t, db, alice.ID, "potion"), "제안자 아이템이 에스크로되어야 합니다")
+	assert.Equal(t, 1, itemQuantity(t, db, bob.ID, "sword"), "대상자 아이템은 확인 전까지 유지되어야 합니다")
+
+	t.Run("보유량보다 많은 아이템 제안", func(t *testing.T) {
+		_, err := service.ProposeTrade(&TradeProposal{
+			ProposerID:   alice.ID,
+			TargetID:     bob.ID,
+			OfferedItems: []TradeItemRequest{{InventoryID: potion.ID, Quantity: 10}},
+		})
+		assert.ErrorIs(t, err, model.ErrInsufficientQuantity)
+		assert.Equal(t, 2, itemQuantity(t, db, alice.ID, "potion"), "실패 시 아이템이 유지되어야 합니다")
+	})
+
+	t.Run("골드 부족", func(t *testing.T) {
+		_, err := service.ProposeTrade(&TradeProposal{
+			ProposerID:  alice.ID,
+			TargetID:    bob.ID,
+			OfferedGold: 5000,
+		})
+		assert.ErrorIs(t, err, ErrInsufficientGold)
+		assert.Equal(t, 800, userGold(t, db, alice.ID), "실패 시 골드가 유지되어야 합니다")
+	})
+
+	t.Run("귀속 아이템 거래 불가", func(t *testing.T) {
+		bound := seedItem(t, db, alice.ID, "bound_ring", "epic", 1)
+		require.NoError(t, db.Model(bound).Update("is_bound", true).Error)
+
+		_, err := service.ProposeTrade(&TradeProposal{
+			ProposerID:   alice.ID,
+			TargetID:     bob.ID,
+			OfferedItems: []TradeItemRequest{{InventoryID: bound.ID, Quantity: 1}},
+		})
+		assert.ErrorIs(t, err, model.ErrItemNotTradeable)
+	})
+
+	t.Run("다른 사용자의 아이템 제안", func(t *testing.T) {
+		_, err := service.ProposeTrade(&TradeProposal{
+			ProposerID:   alice.ID,
+			TargetID:     bob.ID,
+			OfferedItems: []TradeItemRequest{{InventoryID: sword.ID, Quantity: 1}},
+		})
+		assert.ErrorIs(t, err, ErrItemNotOwned)
+	})
+}
+
+// 양측 확인 후 정산 테스트
+func TestTradeService_ConfirmTrade(t *testing.T) {
+	db := setupGameTestDB(t, &model.Trade{}, &model.TradeItem{})
+	service := NewTradeService(db)
+
+	alice := seedUser(t, db, "alice", 1000)
+	bob := seedUser(t, db, "bob", 1000)
+	carol := seedUser(t, db, "carol", 1000)
+	potion := seedItem(t, db, alice.ID, "potion", "common", 5)
+	sword := seedItem(t, db, bob.ID, "sword", "rare", 1)
+
+	trade, err := service.ProposeTrade(&TradeProposal{
+		ProposerID:     alice.ID,
+		TargetID:       bob.ID,
+		OfferedItems:   []TradeItemRequest{{InventoryID: potion.ID, Quantity: 5}},
+		OfferedGold:    100,
+		RequestedItems: []TradeItemRequest{{InventoryID: sword.ID, Quantity: 1}},
+		RequestedGold:  50,
+	})
+	require.NoError(t, err)
+
+	_, err = service.ConfirmTrade(trade.ID, carol.ID)
+	assert.ErrorIs(t, err, model.ErrNotTradeParticipant, "제3자는 확인할 수 없어야 합니다")
+
+	// 대상자 확인: 대상자 자산 에스크로
+	updated, err := service.ConfirmTrade(trade.ID, bob.ID)
+	require.NoError(t, err)
+	assert.True(t, updated.TargetEscrowed)
+	assert.Equal(t, model.TradeStatusPending, updated.Status)
+	assert.Equal(t, 950, userGold(t, db, bob.ID))
+	assert.Equal(t, 0, itemQuantity(t, db, bob.ID, "sword"))
+
+	_, err = service.ConfirmTrade(trade.ID, bob.ID)
+	assert.ErrorIs(t, err, model.ErrAlreadyConfirmed)
+
+	// 제안자 확인: 정산
+	settled, err := service.ConfirmTrade(trade.ID, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.TradeStatusCompleted, settled.Status)
+	assert.NotNil(t, settled.SettledAt)
+
+	assert.Equal(t, 950, userGold(t, db, alice.ID), "제안자는 100을 주고 50을 받아야 합니다")
+	assert.Equal(t, 1050, userGold(t, db, bob.ID), "대상자는 50을 주고 100을 받아야 합니다")
+	assert.Equal(t, 1, itemQuantity(t, db, alice.ID, "sword"))
+	assert.Equal(t, 5, itemQuantity(t, db, bob.ID, "potion"))
+	assert.Equal(t, 0, itemQuantity(t, db, alice.ID, "potion"))
+
+	_, err = service.ConfirmTrade(trade.ID, alice.ID)
+	assert.ErrorIs(t, err, model.ErrTradeNotPending, "완료된 거래는 다시 확인할 수 없어야 합니다")
+}
+
+// 강화된 아이템은 같은 아이템의 다른 레벨 행과 합쳐지지 않아야 함
+func TestTradeService_EnhancedItemNotMerged(t *testing.T) {
+	db := setupGameTestDB(t, &model.Trade{}, &model.TradeItem{})
+	service := NewTradeService(db)
+
+	alice := seedUser(t, db, "alice", 1000)
+	bob := seedUser(t, db, "bob", 1000)
+	enhanced := seedItem(t, db, alice.ID, "sword", "rare", 1)
+	require.NoError(t, db.Model(enhanced).Update("level", 10).Error)
+	seedItem(t, db, bob.ID, "sword", "rare", 1)
+
+	trade, err := service.ProposeTrade(&TradeProposal{
+		ProposerID:   alice.ID,
+		TargetID:     bob.ID,
+		OfferedItems: []TradeItemRequest{{InventoryID: enhanced.ID, Quantity: 1}},
+	})
+	require.NoError(t, err)
+	_, err = service.ConfirmTrade(trade.ID, bob.ID)
+	require.NoError(t, err)
+	_, err = service.ConfirmTrade(trade.ID, alice.ID)
+	require.NoError(t, err)
+
+	var swords []model.Inventory
+	require.NoError(t, db.Where("user_id = ? AND item_id = ?", bob.ID, "sword").Order("level").Find(&swords).Error)
+	require.Len(t, swords, 2, "+10 아이템은 기존 +1 아이템과 별도 행이어야 합니다")
+	assert.Equal(t, 1, swords[0].Level)
+	assert.Equal(t, 1, swords[0].Quantity)
+	assert.Equal(t, 10, swords[1].Level)
+	assert.Equal(t, 1, swords[1].Quantity)
+
+	// 같은 아이템이 여러 레벨이면 요청한 행의 아이템을 거래
+	trade, err = service.ProposeTrade(&TradeProposal{
+		ProposerID:   bob.ID,
+		TargetID:     alice.ID,
+		OfferedItems: []TradeItemRequest{{InventoryID: swords[1].ID, Quantity: 1}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 10, trade.Items[0].Level)
+	assert.Equal(t, 1, itemQuantity(t, db, bob.ID, "sword"), "+1 아이템은 그대로 남아야 합니다")
+
+	// 제안 이후 요청한 대상자 아이템이 바뀌면 확인을 거부
+	trade, err = service.ProposeTrade(&TradeProposal{
+		ProposerID:     alice.ID,
+		TargetID:       bob.ID,
+		RequestedItems: []TradeItemRequest{{InventoryID: swords[0].ID, Quantity: 1}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Model(&swords[0]).Update("level", 2).Error)
+	_, err = service.ConfirmTrade(trade.ID, bob.ID)
+	assert.ErrorIs(t, err, ErrItemNotOwned)
+	assert.Equal(t, 1, itemQuantity(t, db, bob.ID, "sword"))
+}
+
+// 거래 취소 시 에스크로 반환 테스트
+func TestTradeService_CancelTrade(t *testing.T) {
+	db := setupGameTestDB(t, &model.Trade{}, &model.TradeItem{})
+	service := NewTradeService(db)
+
+	alice := seedUser(t, db, "alice", 1000)
+	bob := seedUser(t, db, "bob", 1000)
+	potion := seedItem(t, db, alice.ID, "potion", "common", 5)
+	sword := seedItem(t, db, bob.ID, "sword", "rare", 1)
+
+	trade, err := service.ProposeTrade(&TradeProposal{
+		ProposerID:     alice.ID,
+		TargetID:       bob.ID,
+		OfferedItems:   []TradeItemRequest{{InventoryID: potion.ID, Quantity: 5}},
+		OfferedGold:    300,
+		RequestedItems: []TradeItemRequest{{InventoryID: sword.ID, Quantity: 1}},
+		RequestedGold:  100,
+	})
+	require.NoError(t, err)
+
+	_, err = service.ConfirmTrade(trade.ID, bob.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, service.CancelTrade(trade.ID, bob.ID))
+
+	assert.Equal(t, 1000, userGold(t, db, alice.ID), "제안자 골드가 반환되어야 합니다")
+	assert.Equal(t, 1000, userGold(t, db, bob.ID), "대상자 골드가 반환되어야 합니다")
+	assert.Equal(t, 5, itemQuantity(t, db, alice.ID, "potion"), "제안자 아이템이 반환되어야 합니다")
+	assert.Equal(t, 1, itemQuantity(t, db, bob.ID, "sword"), "대상자 아이템이 반환되어야 합니다")
+
+	cancelled, err := service.GetTradeByID(trade.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.TradeStatusCancelled, cancelled.Status)
+	require.NotNil(t, cancelled.CancelledBy)
+	assert.Equal(t, bob.ID, *cancelled.CancelledBy)
+
+	assert.ErrorIs(t, service.CancelTrade(trade.ID, alice.ID), model.ErrTradeNotPending)
+}
+
+// 만료된 거래 처리 테스트
+func TestTradeService_ExpireTrades(t *testing.T) {
+	db := setupGameTestDB(t, &model.Trade{}, &model.TradeItem{})
+	service := NewTradeService(db)
+
+	alice := seedUser(t, db, "alice", 1000)
+	bob := seedUser(t, db, "bob", 1000)
+
+	trade, err := service.ProposeTrade(&TradeProposal{
+		ProposerID:  alice.ID,
+		TargetID:    bob.ID,
+		OfferedGold: 400,
+	})
+	require.NoError(t, err)
+
+	count, err := service.ExpireTrades()
+	require.NoError(t, err)
+	assert.Equal(t, 0, count, "만료 전 거래는 처리되지 않아야 합니다")
+
+	require.NoError(t, db.Model(&model.Trade{}).Where("id = ?", trade.ID).
+		Update("expires_at", time.Now().Add(-time.Minute)).Error)
+
+	_, err = service.ConfirmTrade(trade.ID, bob.ID)
+	assert.ErrorIs(t, err, model.ErrTradeExpired, "만료된 거래는 확인할 수 없어야 합니다")
+
+	count, err = service.ExpireTrades()
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 1000, userGold(t, db, alice.ID), "만료 시 골드가 반환되어야 합니다")
+
+	expired, err := service.GetTradeByID(trade.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.TradeStatusExpired, expired.Status)
+}
+
+// 의심 거래 표시 및 검토 테스트
+func TestTradeService_FlaggedTrades(t *testing.T) {
+	db := setupGameTestDB(t, &model.Trade{}, &model.TradeItem{})
+	service := NewTradeService(db)
+
+	alice := seedUser(t, db, "alice", 1000)
+	bob := seedUser(t, db, "bob", 1000)
+	admin := seedUser(t, db, "admin", 0)
+	legend := seedItem(t, db, alice.ID, "legend_sword", "legendary", 1)
+	potion := seedItem(t, db, alice.ID, "potion", "common", 10)
+
+	// 전설 아이템을 1골드에 넘기는 거래는 의심 거래
+	suspicious, err := service.ProposeTrade(&TradeProposal{
+		ProposerID:    alice.ID,
+		TargetID:      bob.ID,
+		OfferedItems:  []TradeItemRequest{{InventoryID: legend.ID, Quantity: 1}},
+		RequestedGold: 1,
+	})
+	require.NoError(t, err)
+	assert.True(t, suspicious.IsFlagged)
+	assert.NotEmpty(t, suspicious.FlagReason)
+
+	// 소액 거래는 의심 거래가 아님
+	normal, err := service.ProposeTrade(&TradeProposal{
+		ProposerID:    alice.ID,
+		TargetID:      bob.ID,
+		OfferedItems:  []TradeItemRequest{{InventoryID: potion.ID, Quantity: 10}},
+		RequestedGold: 1,
+	})
+	require.NoError(t, err)
+	assert.False(t, normal.IsFlagged)
+
+	flagged, err := service.GetFlaggedTrades(10, 0)
+	require.NoError(t, err)
+	require.Len(t, flagged, 1)
+	assert.Equal(t, suspicious.ID, flagged[0].ID)
+
+	require.NoError(t, service.ReviewTrade(suspicious.ID, admin.ID))
+	assert.ErrorIs(t, service.ReviewTrade(normal.ID, admin.ID), model.ErrTradeNotFound)
+
+	flagged, err = service.GetFlaggedTrades(10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, flagged, "검토된 거래는 목록에서 제외되어야 합니다")
+
+	history, err := service.GetTradeHistory(bob.ID, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, history, 2)
+}
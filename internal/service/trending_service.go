@@ -0,0 +1,216 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"g_dev/internal/model"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+// 트렌딩 점수를 한 번에 갱신하는 게임 수
+const trendingUpdateBatchSize = 500
+
+// 게임 활동을 시간 단위로 집계하고 트렌딩/인기도 점수를 다시 계산하는 서비스
+// 집계 기간 안의 버킷만 보관하며, 마지막으로 집계한 버킷부터 다시 집계해 진행 중이던 시간의 활동을 보완한다.
+type TrendingService struct {
+	db      *gorm.DB
+	weights model.TrendingWeights
+	window  time.Duration
+}
+
+// 새로운 TrendingService 인스턴스를 생성
+func NewTrendingService(db *gorm.DB, weights model.TrendingWeights, window time.Duration) *TrendingService {
+	return &TrendingService{
+		db:      db,
+		weights: weights,
+		window:  window,
+	}
+}
+
+// 활동 버킷 키
+type activityKey struct {
+	gameID uint
+	start  time.Time
+}
+
+// 최근 활동을 집계하고 게임의 트렌딩/인기도 점수를 갱신
+// 점수가 바뀐 게임 수를 반환
+func (s *TrendingService) Refresh(now time.Time) (int, error) {
+	windowStart := model.ActivityBucketStart(now.Add(-s.window))
+
+	from, err := s.refreshFrom(windowStart)
+	if err != nil {
+		return 0, err
+	}
+	buckets, err := s.aggregate(from)
+	if err != nil {
+		return 0, err
+	}
+
+	updated := 0
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("bucket_start >= ? OR bucket_start < ?", from, windowStart).Delete(&model.GameActivityBucket{}).Error; err != nil {
+			return fmt.Errorf("활동 집계 삭제 중 오류 발생: %w", err)
+		}
+		if len(buckets) > 0 {
+			if err := tx.CreateInBatches(buckets, trendingUpdateBatchSize).Error; err != nil {
+				return fmt.Errorf("활동 집계 저장 중 오류 발생: %w", err)
+			}
+		}
+
+		updated, err = s.updateScores(tx, now)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// 다시 집계할 시작 시각 (마지막 버킷, 없으면 집계 기간 시작)
+func (s *TrendingService) refreshFrom(windowStart time.Time) (time.Time, error) {
+	var latest model.GameActivityBucket
+	err := s.db.Order("bucket_start DESC").First(&latest).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return windowStart, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("활동 집계 조회 중 오류 발생: %w", err)
+	}
+	if latest.BucketStart.Before(windowStart) {
+		return windowStart, nil
+	}
+	return latest.BucketStart, nil
+}
+
+// 시작 시각 이후의 정상 점수와 공개 리뷰를 시간 단위로 집계
+func (s *TrendingService) aggregate(from time.Time) ([]*model.GameActivityBucket, error) {
+	buckets := make(map[activityKey]*model.GameActivityBucket)
+	var order []activityKey
+	bucketFor := func(gameID uint, at time.Time) *model.GameActivityBucket {
+		key := activityKey{gameID: gameID, start: model.ActivityBucketStart(at)}
+		bucket, ok := buckets[key]
+		if !ok {
+			bucket = &model.GameActivityBucket{GameID: gameID, BucketStart: key.start}
+			buckets[key] = bucket
+			order = append(order, key)
+		}
+		return bucket
+	}
+
+	var plays []struct {
+		GameID    uint
+		PlayTime  int
+		CreatedAt time.Time
+	}
+	err := s.db.Model(&model.Score{}).Select("game_id", "play_time", "created_at").
+		Where("created_at >= ? AND review_status = ?", from, model.ScoreAccepted).
+		Find(&plays).Error
+	if err != nil {
+		return nil, fmt.Errorf("점수 기록 조회 중 오류 발생: %w", err)
+	}
+	for _, play := range plays {
+		bucket := bucketFor(play.GameID, play.CreatedAt)
+		bucket.Plays++
+		bucket.PlaySeconds += play.PlayTime
+	}
+
+	// 투표/신고/숨김 처리로 바뀌는 updated_at 대신 평점을 매긴 시각으로 집계
+	var ratings []struct {
+		GameID  uint
+		Rating  int
+		RatedAt time.Time
+	}
+	err = s.db.Model(&model.GameReview{}).Select("game_id", "rating", "rated_at").
+		Where("rated_at >= ? AND is_hidden = ?", from, false).
+		Find(&ratings).Error
+	if err != nil {
+		return nil, fmt.Errorf("리뷰 조회 중 오류 발생: %w", err)
+	}
+	for _, rating := range ratings {
+		bucket := bucketFor(rating.GameID, rating.RatedAt)
+		bucket.Ratings++
+		bucket.RatingSum += rating.Rating
+	}
+
+	result := make([]*model.GameActivityBucket, len(order))
+	for i, key := range order {
+		result[i] = buckets[key]
+	}
+	return result, nil
+}
+
+// 보관 중인 버킷으로 게임별 점수를 계산해 바뀐 게임만 일괄 갱신
+func (s *TrendingService) updateScores(tx *gorm.DB, now time.Time) (int, error) {
+	var buckets []model.GameActivityBucket
+	if err := tx.Find(&buckets).Error; err != nil {
+		return 0, fmt.Errorf("활동 집계 조회 중 오류 발생: %w", err)
+	}
+	byGame := make(map[uint][]model.GameActivityBucket)
+	for _, bucket := range buckets {
+		byGame[bucket.GameID] = append(byGame[bucket.GameID], bucket)
+	}
+
+	var games []model.Game
+	err := tx.Select("id", "total_plays", "total_play_time", "average_rating", "total_ratings", "trending_score", "popularity_score").
+		Find(&games).Error
+	if err != nil {
+		return 0, fmt.Errorf("게임 조회 중 오류 발생: %w", err)
+	}
+
+	var changed []model.Game
+	for i := range games {
+		game := &games[i]
+		trending := s.weights.TrendingScore(byGame[game.ID], now)
+		popularity := s.weights.PopularityScore(game, trending)
+		if trending == game.TrendingScore && popularity == game.PopularityScore {
+			continue
+		}
+		game.TrendingScore = trending
+		game.PopularityScore = popularity
+		changed = append(changed, *game)
+	}
+
+	for start := 0; start < len(changed); start += trendingUpdateBatchSize {
+		end := start + trendingUpdateBatchSize
+		if end > len(changed) {
+			end = len(changed)
+		}
+		if err := updateGameScores(tx, changed[start:end]); err != nil {
+			return 0, err
+		}
+	}
+	return len(changed), nil
+}
+
+// 여러 게임의 트렌딩/인기도 점수를 한 번의 UPDATE로 갱신
+func updateGameScores(tx *gorm.DB, games []model.Game) error {
+	ids := make([]uint, len(games))
+	var trending, popularity strings.Builder
+	trendingArgs := make([]interface{}, 0, len(games)*2)
+	popularityArgs := make([]interface{}, 0, len(games)*2)
+
+	trending.WriteString("CASE id")
+	popularity.WriteString("CASE id")
+	for i := range games {
+		ids[i] = games[i].ID
+		trending.WriteString(" WHEN ? THEN ?")
+		popularity.WriteString(" WHEN ? THEN ?")
+		trendingArgs = append(trendingArgs, games[i].ID, games[i].TrendingScore)
+		popularityArgs = append(popularityArgs, games[i].ID, games[i].PopularityScore)
+	}
+	trending.WriteString(" END")
+	popularity.WriteString(" END")
+
+	// 점수 갱신은 게임 정보 수정이 아니므로 updated_at은 유지
+	err := tx.Model(&model.Game{}).Where("id IN ?", ids).UpdateColumns(map[string]interface{}{
+		"trending_score":   gorm.Expr(trending.String(), trendingArgs...),
+		"popularity_score": gorm.Expr(popularity.String(), popularityArgs...),
+	}).Error
+	if err != nil {
+		return fmt.Errorf("게임 점수 갱신 중 오류 발생: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"g_dev/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"math"
+	"testing"
+	"time"
+)
+
+func setupTrendingTest(t *testing.T) (*gorm.DB, *TrendingService, *model.User, *model.Game, *model.Game) {
+	db, _, user, tetris := setupGameSessionTest(t)
+	require.NoError(t, db.AutoMigrate(&model.GameReview{}, &model.GameActivityBucket{}))
+
+	chess := newTestGame("Chess", model.GameCategoryBoard)
+	require.NoError(t, db.Create(chess).Error)
+	return db, NewTrendingService(db, model.DefaultTrendingWeights(), 7*24*time.Hour), user, tetris, chess
+}
+
+// 지정한 시각에 플레이한 정상 점수 저장
+func seedPlayAt(t *testing.T, db *gorm.DB, userID, gameID uint, playTime int, at time.Time) {
+	score := seedScore(t, db, userID, gameID, 100, playTime, "")
+	require.NoError(t, db.Model(score).Update("created_at", at).Error)
+}
+
+// 게임 점수 조회
+func gameScores(t *testing.T, db *gorm.DB, gameID uint) (float64, float64) {
+	var game model.Game
+	require.NoError(t, db.First(&game, gameID).Error)
+	return game.TrendingScore, game.PopularityScore
+}
+
+// 활동 집계와 감쇠 트렌딩 점수, 인기도 일괄 갱신 테스트
+func TestTrendingService_Refresh(t *testing.T) {
+	db, service, user, tetris, chess := setupTrendingTest(t)
+	base := model.ActivityBucketStart(time.Now())
+	now := base.Add(time.Hour)
+
+	require.NoError(t, db.Model(tetris).Updates(map[string]interface{}{
+		"total_plays": 10, "total_play_time": 20, "average_rating": 4, "total_ratings": 5,
+	}).Error)
+	seedPlayAt(t, db, user.ID, tetris.ID, 60, base.Add(10*time.Minute))
+	seedPlayAt(t, db, user.ID, tetris.ID, 60, base.Add(20*time.Minute))
+	seedPlayAt(t, db, user.ID, tetris.ID, 120, base.Add(-48*time.Hour+10*time.Minute))
+	seedPlayAt(t, db, user.ID, chess.ID, 60, base.Add(10*time.Minute))
+	// 기간이 지난 기록은 집계하지 않음
+	seedPlayAt(t, db, user.ID, chess.ID, 60, base.AddDate(0, 0, -30))
+
+	require.NoError(t, db.Create(&model.GameReview{UserID: user.ID, GameID: tetris.ID, Rating: 5, RatedAt: base.Add(30 * time.Minute)}).Error)
+	require.NoError(t, db.Create(&model.GameReview{UserID: user.ID, GameID: chess.ID, Rating: 5, IsHidden: true, RatedAt: base.Add(30 * time.Minute)}).Error)
+
+	updated, err := service.Refresh(now)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated)
+
+	// 최근 버킷 4.1 + 48시간 지난 버킷 1.1 * 0.25
+	trending, popularity := gameScores(t, db, tetris.ID)
+	assert.InDelta(t, 4.375, trending, 0.01)
+	assert.InDelta(t, 1.9+4.375, popularity, 0.01)
+	trending, _ = gameScores(t, db, chess.ID)
+	assert.InDelta(t, 1.05, trending, 0.01, "숨긴 리뷰는 집계하지 않아야 합니다")
+
+	// 바뀐 활동이 없으면 갱신하지 않음
+	updated, err = service.Refresh(now)
+	require.NoError(t, err)
+	assert.Equal(t, 0, updated)
+
+	// 마지막 버킷부터 다시 집계해 늦게 들어온 기록도 반영
+	seedPlayAt(t, db, user.ID, chess.ID, 0, base.Add(50*time.Minute))
+	seedPlayAt(t, db, user.ID, chess.ID, 0, now.Add(10*time.Minute))
+	_, err = service.Refresh(now.Add(time.Hour))
+	require.NoError(t, err)
+	trending, _ = gameScores(t, db, chess.ID)
+	assert.InDelta(t, 2.05*math.Pow(0.5, 1.0/24)+1, trending, 0.01)
+
+	var buckets int64
+	require.NoError(t, db.Model(&model.GameActivityBucket{}).Where("game_id = ?", chess.ID).Count(&buckets).Error)
+	assert.Equal(t, int64(2), buckets)
+
+	// 집계 기간이 지난 버킷은 삭제
+	_, err = service.Refresh(base.Add(121 * time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, db.Model(&model.GameActivityBucket{}).Where("game_id = ?", tetris.ID).Count(&buckets).Error)
+	assert.Equal(t, int64(1), buckets)
+
+	games, err := NewGameService(db).GetTrendingGames(10)
+	require.NoError(t, err)
+	require.Len(t, games, 2)
+	assert.Equal(t, tetris.ID, games[0].ID)
+}